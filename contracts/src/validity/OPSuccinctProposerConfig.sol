@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: MIT
+pragma solidity 0.8.15;
+
+import {Ownable} from "@openzeppelin/contracts/access/Ownable.sol";
+
+/// @title OPSuccinctProposerConfig
+/// @notice Holds the proving parameters that OP Succinct proposers read and hot-apply, so governance can update them
+///         without coordinating proposer restarts. A zero value leaves the proposer's own setting unchanged. The
+///         program vkeys and the submission interval are read from the OPSuccinctL2OutputOracle itself.
+contract OPSuccinctProposerConfig is Ownable {
+    ////////////////////////////////////////////////////////////////
+    //                         Events                             //
+    ////////////////////////////////////////////////////////////////
+
+    /// @notice Emitted when any of the parameters is updated.
+    event ConfigUpdated(uint256 maxBlockRangePerSpanProof);
+
+    ////////////////////////////////////////////////////////////////
+    //                         State Vars                         //
+    ////////////////////////////////////////////////////////////////
+
+    /// @notice The max number of L2 blocks in a span proof.
+    uint256 public maxBlockRangePerSpanProof;
+
+    /// @notice Updates all parameters at once, so that proposers never read a partially updated config.
+    function updateConfig(uint256 _maxBlockRangePerSpanProof) external onlyOwner {
+        maxBlockRangePerSpanProof = _maxBlockRangePerSpanProof;
+        emit ConfigUpdated(_maxBlockRangePerSpanProof);
+    }
+
+    /// @notice Returns all parameters in a single call.
+    function getConfig() external view returns (uint256 maxBlockRangePerSpanProof_) {
+        return maxBlockRangePerSpanProof;
+    }
+}
@@ -22,4 +22,20 @@
     --use-cached-db=${USE_CACHED_DB:-false} \
     --metrics.enabled=${METRICS_ENABLED:-true} \
     --metrics.port=${METRICS_PORT:-7300} \
-    --mock=${OP_SUCCINCT_MOCK:-false}
\ No newline at end of file
+    --mock=${OP_SUCCINCT_MOCK:-false} \
+    --altda-server-url=${ALTDA_SERVER_URL} \
+    --align-to-channels=${ALIGN_TO_CHANNELS:-false} \
+    --max-unrequested-span-proofs=${MAX_UNREQUESTED_SPAN_PROOFS:-1000} \
+    --witness-gen-retries=${WITNESS_GEN_RETRIES:-3} \
+    --witness-gen-retry-backoff=${WITNESS_GEN_RETRY_BACKOFF:-5s} \
+    --span-compaction-interval=${SPAN_COMPACTION_INTERVAL:-0} \
+    --telemetry=${TELEMETRY:-false} \
+    --telemetry-endpoint=${TELEMETRY_ENDPOINT} \
+    --telemetry-interval=${TELEMETRY_INTERVAL:-24h} \
+    --config-contract-address=${CONFIG_CONTRACT_ADDRESS} \
+    --range-planner=${RANGE_PLANNER:-greedy} \
+    --l2-eth-rpc=${L2_RPC} \
+    --span-overhead-cycles=${SPAN_OVERHEAD_CYCLES:-100000000} \
+    --fast-path-max-blocks=${FAST_PATH_MAX_BLOCKS:-0} \
+    --ipfs-api-url=${IPFS_API_URL} \
+    "$@"
\ No newline at end of file
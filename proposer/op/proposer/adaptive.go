@@ -0,0 +1,90 @@
+package proposer
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// witnessGenMinWindow is the least number of witness generation requests that the latency controller observes before
+// adjusting the limit, so that a single slow request doesn't lower it.
+const witnessGenMinWindow = 5
+
+// SetLatencyTarget makes the limiter adjust the effective limit to keep the latency of witness generation requests at
+// the target, instead of recovering by one slot for every accepted request: after a window of requests, the limit is
+// lowered if too many of them failed or their latency is above the target, and raised if it's well below the target.
+// This finds the concurrency that the servers sustain, which depends on their hardware. The maximum is still the
+// ceiling.
+func (w *witnessGenLimiter) SetLatencyTarget(target time.Duration, maxFailureRate float64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.latencyTarget, w.maxFailureRate = target, maxFailureRate
+	w.latencies, w.failures = nil, 0
+}
+
+// Observe records the latency of an accepted witness generation request, or a request that failed because the server
+// is overloaded or timed out. Once the window of requests is full, the limit is adjusted to the latency target. Returns
+// the limit, and why it changed, or an empty string if it didn't. Does nothing without a latency target.
+func (w *witnessGenLimiter) Observe(latency time.Duration, failed bool) (uint64, string) {
+	w.mu.Lock()
+	if w.latencyTarget == 0 {
+		limit := w.limit
+		w.mu.Unlock()
+		return limit, ""
+	}
+	if failed {
+		w.failures++
+	} else {
+		w.latencies = append(w.latencies, latency)
+	}
+	if len(w.latencies)+w.failures < max(witnessGenMinWindow, int(w.limit)) {
+		limit := w.limit
+		w.mu.Unlock()
+		return limit, ""
+	}
+	prev := w.limit
+	next, reason := latencyAdjustment(w.limit, w.latencies, w.failures, w.latencyTarget, w.maxFailureRate)
+	w.latencies, w.failures = nil, 0
+	w.mu.Unlock()
+
+	limit := w.update(func(uint64) uint64 { return min(w.max, max(1, next)) })
+	if limit == prev {
+		return limit, ""
+	}
+	return limit, reason
+}
+
+// latencyAdjustment returns the limit for the next window of witness generation requests, and why it changed, or an
+// empty string if it didn't. Too many failures halve the limit, like an overloaded server does. A 90th percentile
+// latency above the target scales the limit down by how far it's above, and one well below the target adds a slot.
+func latencyAdjustment(limit uint64, latencies []time.Duration, failures int, target time.Duration, maxFailureRate float64) (uint64, string) {
+	failureRate := float64(failures) / float64(len(latencies)+failures)
+	if failureRate > maxFailureRate {
+		return max(1, limit/2), fmt.Sprintf("%.0f%% of the requests failed", failureRate*100)
+	}
+	if len(latencies) == 0 {
+		return limit, ""
+	}
+	sorted := slices.Clone(latencies)
+	slices.Sort(sorted)
+	p90 := sorted[(len(sorted)*9+9)/10-1]
+	switch {
+	case p90 > target:
+		next := uint64(float64(limit) * float64(target) / float64(p90))
+		return max(1, min(next, limit-1)), fmt.Sprintf("the p90 latency of %s is above the target of %s", p90.Round(time.Second), target)
+	case p90 <= target*3/4:
+		return limit + 1, fmt.Sprintf("the p90 latency of %s is well below the target of %s", p90.Round(time.Second), target)
+	}
+	return limit, ""
+}
+
+// observeWitnessGen records the outcome of a witness generation request for the latency controller, and logs the
+// adjustments of the limit.
+func (l *L2OutputSubmitter) observeWitnessGen(latency time.Duration, failed bool) {
+	limit, reason := l.witnessGenLimiter.Observe(latency, failed)
+	if reason == "" {
+		return
+	}
+	l.Log.Info("Adjusted the witness generation concurrency to the latency target", "limit", limit, "reason", reason)
+	l.Metr.RecordWitnessGenLimit(limit)
+}
@@ -0,0 +1,81 @@
+package proposer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatencyAdjustment(t *testing.T) {
+	target := time.Minute
+	latencies := func(d ...time.Duration) []time.Duration { return d }
+
+	// The p90 latency is well below the target.
+	limit, reason := latencyAdjustment(4, latencies(10*time.Second, 20*time.Second, 30*time.Second), 0, target, 0.1)
+	require.Equal(t, uint64(5), limit)
+	require.Contains(t, reason, "well below")
+
+	// The p90 latency is close to the target.
+	limit, reason = latencyAdjustment(4, latencies(10*time.Second, 50*time.Second), 0, target, 0.1)
+	require.Equal(t, uint64(4), limit)
+	require.Empty(t, reason)
+
+	// The p90 latency is twice the target, so the limit is halved.
+	limit, reason = latencyAdjustment(8, latencies(10*time.Second, 2*time.Minute), 0, target, 0.1)
+	require.Equal(t, uint64(4), limit)
+	require.Contains(t, reason, "above the target")
+
+	// A latency just above the target still lowers the limit.
+	limit, _ = latencyAdjustment(8, latencies(61*time.Second), 0, target, 0.1)
+	require.Equal(t, uint64(7), limit)
+
+	// Too many requests failed.
+	limit, reason = latencyAdjustment(8, latencies(time.Second, time.Second, time.Second), 1, target, 0.1)
+	require.Equal(t, uint64(4), limit)
+	require.Contains(t, reason, "25% of the requests failed")
+
+	// Every request failed, but the failures are tolerated.
+	limit, reason = latencyAdjustment(8, nil, 2, target, 1)
+	require.Equal(t, uint64(8), limit)
+	require.Empty(t, reason)
+}
+
+func TestWitnessGenLimiterLatencyTarget(t *testing.T) {
+	w := newWitnessGenLimiter(6)
+	w.OnOverloaded()
+	require.Equal(t, uint64(3), w.Limit())
+
+	// Without a latency target, observations are ignored.
+	for i := 0; i < witnessGenMinWindow; i++ {
+		limit, reason := w.Observe(time.Hour, false)
+		require.Equal(t, uint64(3), limit)
+		require.Empty(t, reason)
+	}
+
+	// With a latency target, accepted requests don't raise the limit, and it's only adjusted once the window is full.
+	w.SetLatencyTarget(time.Minute, 0.1)
+	require.Equal(t, uint64(3), w.OnAccepted())
+	for i := 0; i < witnessGenMinWindow-1; i++ {
+		_, reason := w.Observe(time.Second, false)
+		require.Empty(t, reason)
+	}
+	limit, reason := w.Observe(time.Second, false)
+	require.Equal(t, uint64(4), limit)
+	require.NotEmpty(t, reason)
+
+	// The limit isn't raised above the max.
+	for i := 0; i < 10*witnessGenMinWindow; i++ {
+		w.Observe(time.Second, false)
+	}
+	require.Equal(t, uint64(6), w.Limit())
+
+	// The window grows with the limit.
+	w.SetLatencyTarget(time.Minute, 0.1)
+	for i := 0; i < 5; i++ {
+		_, reason := w.Observe(2*time.Minute, false)
+		require.Empty(t, reason)
+	}
+	limit, _ = w.Observe(2*time.Minute, false)
+	require.Equal(t, uint64(3), limit)
+}
@@ -0,0 +1,215 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+// ProofRequestsWithStatus returns the proof requests with the given status.
+func (l *L2OutputSubmitter) ProofRequestsWithStatus(ctx context.Context, status string) ([]rpc.RequestStatus, error) {
+	if err := proofrequest.StatusValidator(proofrequest.Status(status)); err != nil {
+		return nil, fmt.Errorf("%w: %w", rpc.ErrInvalidRequest, err)
+	}
+	reqs, err := l.db.GetAllProofsWithStatus(proofrequest.Status(status))
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]rpc.RequestStatus, len(reqs))
+	for i, req := range reqs {
+		statuses[i] = newRequestStatus(req, "")
+	}
+	return statuses, nil
+}
+
+// RetryProofRequest fails the proof request with the given ID if it hasn't failed yet, and queues a new request for its
+// range on the default backend. The new request starts without retries and isn't backed off, so this is also how
+// dead-lettered requests are retried. A request that completed, or whose range already has another request that hasn't
+// failed, isn't retried.
+func (l *L2OutputSubmitter) RetryProofRequest(ctx context.Context, id int) (rpc.RequestStatus, error) {
+	req, err := l.adminProofRequest(id)
+	if err != nil {
+		return rpc.RequestStatus{}, err
+	}
+	if req.Status == proofrequest.StatusCOMPLETE {
+		return rpc.RequestStatus{}, fmt.Errorf("%w: proof request %d already completed", rpc.ErrInvalidRequest, id)
+	}
+	if req.Status != proofrequest.StatusFAILED && req.Status != proofrequest.StatusDEADLETTER {
+		if req, err = l.cancelProofRequest(id, "retried by an admin"); err != nil {
+			return rpc.RequestStatus{}, err
+		}
+	}
+
+	queued, err := l.db.HasProofRequestForRange(req.Type, req.StartBlock, req.EndBlock)
+	if err != nil {
+		return rpc.RequestStatus{}, err
+	}
+	if queued {
+		return rpc.RequestStatus{}, fmt.Errorf("%w: the range of proof request %d already has a request that hasn't failed", rpc.ErrInvalidRequest, id)
+	}
+	if err := l.db.NewEntry(req.Type, req.StartBlock, req.EndBlock, l.proofTimeout(req.Type, req.StartBlock, req.EndBlock)); err != nil {
+		return rpc.RequestStatus{}, err
+	}
+	retries, err := l.db.GetProofRequestsWithBlockRangeAndStatus(req.Type, req.StartBlock, req.EndBlock, proofrequest.StatusUNREQ)
+	if err != nil {
+		return rpc.RequestStatus{}, err
+	}
+	if len(retries) == 0 {
+		return rpc.RequestStatus{}, fmt.Errorf("retry of proof request %d was not queued", id)
+	}
+	retry := retries[len(retries)-1]
+	l.Log.Info("retried proof request on admin request", "id", id, "retryID", retry.ID, "type", req.Type, "start", req.StartBlock, "end", req.EndBlock)
+	return newRequestStatus(retry, ""), nil
+}
+
+// CancelProofRequest fails the proof request with the given ID without retrying it. A request that is being sent to the
+// server or proven is abandoned, the server and the prover network aren't told to stop working on it.
+func (l *L2OutputSubmitter) CancelProofRequest(ctx context.Context, id int) (rpc.RequestStatus, error) {
+	if _, err := l.adminProofRequest(id); err != nil {
+		return rpc.RequestStatus{}, err
+	}
+	req, err := l.cancelProofRequest(id, "cancelled by an admin")
+	if err != nil {
+		return rpc.RequestStatus{}, err
+	}
+	l.Log.Info("cancelled proof request on admin request", "id", id, "type", req.Type, "start", req.StartBlock, "end", req.EndBlock)
+	return newRequestStatus(req, ""), nil
+}
+
+// adminProofRequest returns the proof request with the given ID, or ErrInvalidRequest if there is none.
+func (l *L2OutputSubmitter) adminProofRequest(id int) (*ent.ProofRequest, error) {
+	req, err := l.db.GetProofRequest(id)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: proof request %d not found", rpc.ErrInvalidRequest, id)
+	}
+	return req, err
+}
+
+// cancelProofRequest fails a pending proof request with the given reason.
+func (l *L2OutputSubmitter) cancelProofRequest(id int, reason string) (*ent.ProofRequest, error) {
+	req, err := l.db.CancelProofRequest(id, reason)
+	if errors.Is(err, db.ErrProofStatusChanged) {
+		return nil, fmt.Errorf("%w: %w", rpc.ErrInvalidRequest, err)
+	}
+	return req, err
+}
+
+// CancelRange fails the proof requests of any type that haven't completed or failed yet and cover a block after start,
+// up to and including end, e.g. after a bad range plan was found or to re-prove the range after an incident. The
+// servers of the cancelled requests that were sent to the prover network are asked to cancel them there. The blocks of
+// the cancelled span proofs are planned again with the current settings and queued, so they are proven again.
+func (l *L2OutputSubmitter) CancelRange(ctx context.Context, start, end uint64) (rpc.RangeCancellation, error) {
+	if start >= end {
+		return rpc.RangeCancellation{}, fmt.Errorf("%w: the start block must be less than the end block", rpc.ErrInvalidRequest)
+	}
+	cancelled, err := l.db.CancelProofRequestsInRange(start, end, fmt.Sprintf("cancelled by an admin with range %d-%d", start, end))
+	if err != nil {
+		return rpc.RangeCancellation{}, err
+	}
+
+	result := rpc.RangeCancellation{Cancelled: make([]rpc.RequestStatus, len(cancelled)), Requeued: []rpc.ProofRange{}}
+	var spans []Span
+	for i, req := range cancelled {
+		result.Cancelled[i] = newRequestStatus(req, "")
+		if req.Type == proofrequest.TypeSPAN {
+			spans = append(spans, Span{Start: req.StartBlock, End: req.EndBlock})
+		}
+		if req.ProverRequestID == "" {
+			continue
+		}
+		ok, err := l.cancelOnProver(ctx, l.proverBackend(req), req.ProverRequestID)
+		if err != nil {
+			l.Log.Warn("failed to cancel proof request on the prover network", "id", req.ID, "proverRequestID", req.ProverRequestID, "err", err)
+			l.Metr.RecordError("prover_cancel", 1)
+			continue
+		}
+		if ok {
+			result.ProverCancelled++
+		}
+	}
+	l.Log.Info("cancelled proof requests in range on admin request", "start", start, "end", end, "cancelled", len(cancelled), "proverCancelled", result.ProverCancelled)
+
+	var ranges []db.SpanRange
+	for _, gap := range mergeSpans(spans) {
+		replanned, err := l.replanSpans(ctx, gap.Start, gap.End)
+		if err != nil {
+			return result, fmt.Errorf("failed to plan blocks %d-%d again: %w", gap.Start, gap.End, err)
+		}
+		for _, span := range replanned {
+			ranges = append(ranges, db.SpanRange{
+				Start:        span.Start,
+				End:          span.End,
+				ProofTimeout: l.proofTimeout(proofrequest.TypeSPAN, span.Start, span.End),
+			})
+			result.Requeued = append(result.Requeued, rpc.ProofRange{Start: span.Start, End: span.End})
+		}
+	}
+	if _, err := l.db.NewSpanEntries(ranges); err != nil {
+		return result, err
+	}
+	if len(ranges) > 0 {
+		l.Log.Info("queued span proofs for the cancelled range", "start", ranges[0].Start, "end", ranges[len(ranges)-1].End, "spans", len(ranges))
+	}
+	return result, nil
+}
+
+// mergeSpans merges the spans, which are sorted by their start, into the contiguous ranges they cover.
+func mergeSpans(spans []Span) []Span {
+	var merged []Span
+	for _, span := range spans {
+		if n := len(merged); n > 0 && span.Start <= merged[n-1].End {
+			merged[n-1].End = max(merged[n-1].End, span.End)
+			continue
+		}
+		merged = append(merged, span)
+	}
+	return merged
+}
+
+// replanSpans splits the blocks after start, up to and including end, into span proofs with the configured range
+// planner, like new blocks are. Unlike new blocks, the whole range is covered, since no more blocks are added to it.
+func (l *L2OutputSubmitter) replanSpans(ctx context.Context, start, end uint64) ([]Span, error) {
+	if l.planner == nil {
+		return l.SplitRangeCovering(start, end), nil
+	}
+	gasUsed, err := blockGasUsed(ctx, l.planner.client, start, end)
+	if err != nil {
+		return nil, err
+	}
+	cycles := make([]uint64, len(gasUsed))
+	for i, gas := range gasUsed {
+		cycles[i] = estimateBlockCycles(gas)
+	}
+	spans, _ := planSpans(start, cycles, l.settings().MaxBlockRangePerSpanProof, l.planner.overheadCycles)
+	return spans, nil
+}
+
+// cancelOnProver asks the server that sent a proof request to the prover network to cancel it there, so the network
+// stops proving it. Returns false if the server doesn't support cancelling proof requests.
+func (l *L2OutputSubmitter) cancelOnProver(ctx context.Context, serverUrl, proofID string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", serverUrl+"/cancel/"+proofID, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+	client := &http.Client{Timeout: PROOF_STATUS_TIMEOUT}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound, http.StatusNotImplemented:
+		return false, nil
+	}
+	body, _ := io.ReadAll(resp.Body)
+	return false, parseServerError(resp.StatusCode, body)
+}
@@ -0,0 +1,115 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+const (
+	// AggBoundaryExtend ends AGG proofs at the end of the longest chain of span proofs from the L2OO's latest output, as
+	// long as it reaches the next output block.
+	AggBoundaryExtend = "extend"
+	// AggBoundaryAlign ends AGG proofs exactly at the L2OO's next output block. A span proof that crosses it, e.g.
+	// because of a split or a change of the range planner, is trimmed by proving the range from its start up to the
+	// block.
+	AggBoundaryAlign = "align"
+)
+
+// deriveAlignedAggProof creates the AGG proof request for exactly [latest, next] once a chain of span proofs ends at
+// next, and queues the trimmed span proof that the chain needs if the span proofs cross next instead.
+func (l *L2OutputSubmitter) deriveAlignedAggProof(latest, next uint64) error {
+	created, err := l.db.TryCreateAlignedAggProof(latest, next, l.settings().AggProofTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to create aligned agg proof from span proofs: %w", err)
+	}
+	if created {
+		l.Log.Info("created new AGG proof", "from", latest, "to", next)
+		return nil
+	}
+	return l.trimBoundarySpan(latest, next)
+}
+
+// trimBoundarySpan queues a span proof from the start of a completed span proof that crosses the boundary up to the
+// boundary, so a chain of span proofs from latest ends exactly there. The latest start that the chain reaches is
+// trimmed, so the trimmed span proof is as short as possible.
+func (l *L2OutputSubmitter) trimBoundarySpan(latest, boundary uint64) error {
+	across, err := l.db.GetCompletedSpanProofsAcross(latest, boundary)
+	if err != nil {
+		return err
+	}
+	if len(across) == 0 {
+		return nil
+	}
+	ends, err := l.db.GetContiguousSpanProofBoundaries(latest, boundary)
+	if err != nil {
+		return fmt.Errorf("failed to get span proof boundaries: %w", err)
+	}
+	reachable := map[uint64]bool{latest: true}
+	for _, end := range ends {
+		reachable[end] = true
+	}
+
+	var crossing *ent.ProofRequest
+	for _, span := range across {
+		if reachable[span.StartBlock] && (crossing == nil || span.StartBlock > crossing.StartBlock) {
+			crossing = span
+		}
+	}
+	if crossing == nil {
+		return nil
+	}
+	exists, err := l.db.HasProofRequestForRange(proofrequest.TypeSPAN, crossing.StartBlock, boundary)
+	if err != nil || exists {
+		return err
+	}
+	if err := l.db.NewEntry(proofrequest.TypeSPAN, crossing.StartBlock, boundary, l.proofTimeout(proofrequest.TypeSPAN, crossing.StartBlock, boundary)); err != nil {
+		return fmt.Errorf("failed to queue trimmed span proof: %w", err)
+	}
+	l.Log.Info("Span proof crosses the next output block, proving it trimmed to the block", "spanStart", crossing.StartBlock, "spanEnd", crossing.EndBlock, "nextOutputBlock", boundary)
+	return nil
+}
+
+// aggBoundaryMismatch returns why the AGG proof can't be proposed on the L2OO, or an empty string if it can. The
+// contract only accepts an output that starts at its latest output and reaches its next output block, and with
+// AggBoundaryAlign, the proposer only proposes outputs that end exactly at it.
+func (l *L2OutputSubmitter) aggBoundaryMismatch(ctx context.Context, req *ent.ProofRequest) (string, error) {
+	latest, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return "", fmt.Errorf("failed to get latest L2OO output: %w", err)
+	}
+	next, err := l.l2ooContract.NextBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return "", fmt.Errorf("failed to get next L2OO output: %w", err)
+	}
+	switch {
+	case req.StartBlock != latest.Uint64():
+		return fmt.Sprintf("AGG proof starts at block %d, but the L2OO's latest output is at block %d", req.StartBlock, latest), nil
+	case req.EndBlock < next.Uint64():
+		return fmt.Sprintf("AGG proof ends at block %d, before the L2OO's next output block %d", req.EndBlock, next), nil
+	case l.Cfg.AggBoundaryPolicy == AggBoundaryAlign && req.EndBlock != next.Uint64():
+		return fmt.Sprintf("AGG proof ends at block %d, not at the L2OO's next output block %d", req.EndBlock, next), nil
+	}
+	return "", nil
+}
+
+// cancelMisalignedAgg fails an unrequested AGG proof request that can't be proposed, before its L1 block hash is
+// checkpointed, so DeriveAggProofs derives one that can. Returns true if the request was cancelled.
+func (l *L2OutputSubmitter) cancelMisalignedAgg(ctx context.Context, req *ent.ProofRequest) (bool, error) {
+	reason, err := l.aggBoundaryMismatch(ctx, req)
+	if err != nil || reason == "" {
+		return false, err
+	}
+	if _, err := l.db.CancelProofRequest(req.ID, reason); err != nil && !errors.Is(err, db.ErrProofStatusChanged) {
+		return false, err
+	}
+	l.Log.Warn("Cancelling AGG proof request that can't be proposed", "id", req.ID, "start", req.StartBlock, "end", req.EndBlock, "reason", reason)
+	l.Metr.RecordError("agg_boundary_mismatch", 1)
+	return true, nil
+}
@@ -0,0 +1,83 @@
+package proposer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+func TestDeriveAlignedAggProof(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	// The span proof 150-250 crosses the next output block 200.
+	addCompletedSpanProofs(t, proofDB, [2]uint64{100, 150}, [2]uint64{150, 250})
+
+	l := newFakeL2OODriver(t, newFakeL2OO(100, 100), proofDB)
+	l.Cfg.AggBoundaryPolicy = AggBoundaryAlign
+
+	// The crossing span proof is trimmed to the boundary, once.
+	for i := 0; i < 2; i++ {
+		require.NoError(t, l.DeriveAggProofs(context.Background()))
+		unreqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.Len(t, unreqs, 1)
+		require.Equal(t, proofrequest.TypeSPAN, unreqs[0].Type)
+		require.Equal(t, uint64(150), unreqs[0].StartBlock)
+		require.Equal(t, uint64(200), unreqs[0].EndBlock)
+	}
+
+	// Once the trimmed span proof is complete, the AGG proof ends exactly at the boundary.
+	trimmed, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.NoError(t, proofDB.UpdateProofStatus(trimmed[0].ID, proofrequest.StatusPROVING))
+	require.NoError(t, proofDB.AddFulfilledProof(trimmed[0].ID, []byte("proof")))
+	require.NoError(t, l.DeriveAggProofs(context.Background()))
+	aggs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, aggs, 1)
+	require.Equal(t, proofrequest.TypeAGG, aggs[0].Type)
+	require.Equal(t, uint64(100), aggs[0].StartBlock)
+	require.Equal(t, uint64(200), aggs[0].EndBlock)
+}
+
+func TestAggBoundaryMismatch(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l := newFakeL2OODriver(t, newFakeL2OO(100, 100), proofDB)
+	l.Cfg.AggBoundaryPolicy = AggBoundaryExtend
+	mismatch := func(start, end uint64) string {
+		reason, err := l.aggBoundaryMismatch(context.Background(), &ent.ProofRequest{Type: proofrequest.TypeAGG, StartBlock: start, EndBlock: end})
+		require.NoError(t, err)
+		return reason
+	}
+
+	require.Empty(t, mismatch(100, 200))
+	require.Empty(t, mismatch(100, 250))
+	require.Contains(t, mismatch(50, 200), "latest output")
+	require.Contains(t, mismatch(100, 150), "before the L2OO's next output block")
+
+	l.Cfg.AggBoundaryPolicy = AggBoundaryAlign
+	require.Empty(t, mismatch(100, 200))
+	require.Contains(t, mismatch(100, 250), "not at the L2OO's next output block")
+
+	// A misaligned AGG proof request is cancelled before its L1 block hash is checkpointed.
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 100, 250, 0))
+	aggs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	cancelled, err := l.cancelMisalignedAgg(context.Background(), aggs[0])
+	require.NoError(t, err)
+	require.True(t, cancelled)
+	failed, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusFAILED)
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	require.Contains(t, failed[0].ErrorMessage, "not at the L2OO's next output block")
+}
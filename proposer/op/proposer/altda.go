@@ -0,0 +1,93 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"golang.org/x/sync/errgroup"
+
+	altda "github.com/ethereum-optimism/optimism/op-alt-da"
+	"github.com/succinctlabs/op-succinct-go/proposer/utils"
+)
+
+// altdaFetchConcurrency bounds the number of L1 blocks fetched at once when checking Alt-DA availability.
+const altdaFetchConcurrency = 16
+
+// CheckAltDAAvailability checks that the batch data of every Alt-DA commitment posted to the batch inbox in the L1
+// range that the L2 blocks from start to end are derived from can be retrieved from the DA server. A span proof
+// request for a range with unavailable batch data would fail in witness generation, so the span isn't queued until
+// its data is available.
+func (l *L2OutputSubmitter) CheckAltDAAvailability(ctx context.Context, start, end uint64) error {
+	if l.altdaClient == nil {
+		return nil
+	}
+
+	rollupClient, err := l.RollupProvider.RollupClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get rollup client: %w", err)
+	}
+	l1Start, l1End, err := utils.GetL1SearchBoundaries(rollupClient, *l.L1Client, start, end)
+	if err != nil {
+		return fmt.Errorf("failed to get L1 search boundaries: %w", err)
+	}
+
+	// The L1 ranges of consecutive spans overlap, so skip the blocks that were already checked. The blocks are fetched
+	// in batches, and checked in order, so that the checked block only advances past available data.
+	for batchStart := max(l1Start, l.altdaCheckedL1Block+1); batchStart <= l1End; batchStart += altdaFetchConcurrency {
+		batchEnd := min(batchStart+altdaFetchConcurrency-1, l1End)
+		blocks := make([]*types.Block, batchEnd-batchStart+1)
+		g, gCtx := errgroup.WithContext(ctx)
+		for i := range blocks {
+			l1Block := batchStart + uint64(i)
+			g.Go(func() error {
+				block, err := l.L1Client.BlockByNumber(gCtx, new(big.Int).SetUint64(l1Block))
+				if err != nil {
+					return fmt.Errorf("failed to get L1 block %d: %w", l1Block, err)
+				}
+				blocks[i] = block
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return err
+		}
+
+		for _, block := range blocks {
+			if err := l.checkAltDACommitments(ctx, block); err != nil {
+				return err
+			}
+			l.altdaCheckedL1Block = block.NumberU64()
+		}
+	}
+
+	return nil
+}
+
+// checkAltDACommitments checks that the batch data of the Alt-DA commitments posted to the batch inbox in the L1 block
+// can be retrieved from the DA server.
+func (l *L2OutputSubmitter) checkAltDACommitments(ctx context.Context, block *types.Block) error {
+	for _, tx := range block.Transactions() {
+		if tx.To() == nil || *tx.To() != l.Cfg.BatchInboxAddr {
+			continue
+		}
+		data := tx.Data()
+		if len(data) == 0 || data[0] != altda.TxDataVersion1 {
+			continue
+		}
+		// Derivation skips commitments that don't decode, or aren't of the configured type, so they don't need to be available.
+		comm, err := altda.DecodeCommitmentData(data[1:])
+		if err != nil || comm.CommitmentType() != l.altdaCommitmentType {
+			continue
+		}
+		if _, err := l.altdaClient.GetInput(ctx, comm); err != nil {
+			if errors.Is(err, altda.ErrNotFound) {
+				return fmt.Errorf("batch data for commitment %s in L1 block %d is not available on the DA server", comm, block.NumberU64())
+			}
+			return fmt.Errorf("failed to get batch data for commitment %s in L1 block %d: %w", comm, block.NumberU64(), err)
+		}
+	}
+	return nil
+}
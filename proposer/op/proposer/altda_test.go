@@ -0,0 +1,56 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	altda "github.com/ethereum-optimism/optimism/op-alt-da"
+)
+
+func TestCheckAltDACommitments(t *testing.T) {
+	available := []byte("available batch data")
+	availableComm := altda.NewKeccak256Commitment(available)
+	missingComm := altda.NewKeccak256Commitment([]byte("missing batch data"))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == fmt.Sprintf("/get/0x%x", availableComm.Encode()) {
+			w.Write(available)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	inbox := common.HexToAddress("0xff00000000000000000000000000000000000010")
+	l := &L2OutputSubmitter{
+		DriverSetup:         DriverSetup{Log: log.New(), Cfg: ProposerConfig{BatchInboxAddr: inbox}},
+		altdaClient:         altda.NewDAClient(server.URL, true, false),
+		altdaCommitmentType: altda.Keccak256CommitmentType,
+	}
+	block := func(txs ...*types.Transaction) *types.Block {
+		return types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10)}).WithBody(types.Body{Transactions: txs})
+	}
+	tx := func(to common.Address, data []byte) *types.Transaction {
+		return types.NewTx(&types.LegacyTx{To: &to, Data: data})
+	}
+
+	require.NoError(t, l.checkAltDACommitments(context.Background(), block(tx(inbox, availableComm.TxData()))))
+	// Commitments sent to other addresses, and batches posted to L1, aren't looked up.
+	require.NoError(t, l.checkAltDACommitments(context.Background(), block(
+		tx(common.HexToAddress("0x01"), missingComm.TxData()),
+		tx(inbox, []byte{0x00, 0x01, 0x02}),
+	)))
+	require.ErrorContains(t, l.checkAltDACommitments(context.Background(), block(
+		tx(inbox, availableComm.TxData()),
+		tx(inbox, missingComm.TxData()),
+	)), "not available")
+}
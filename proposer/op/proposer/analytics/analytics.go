@@ -0,0 +1,168 @@
+// Package analytics exports the metadata of proof requests, like their ranges, durations and failures, to CSV or
+// Parquet files, so proving economics can be analyzed offline without access to the proposer's DB. Proofs themselves
+// aren't exported.
+package analytics
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Record is the exported metadata of a proof request. Times are unix seconds, and optional values that aren't set
+// are 0 or empty.
+type Record struct {
+	ID               int64
+	Type             string
+	StartBlock       int64
+	EndBlock         int64
+	Status           string
+	RequestAddedTime int64
+	ProofRequestTime int64
+	LastUpdatedTime  int64
+	// ProvingSeconds is how long the prover took, from the request being sent to it until it was fulfilled or failed.
+	// 0 while the request is pending.
+	ProvingSeconds int64
+	// TotalSeconds is how long the request took, from being added to the DB until it was fulfilled or failed. 0 while
+	// the request is pending.
+	TotalSeconds int64
+	// Attempts is the number of earlier attempts at proving the range that failed.
+	Attempts        int64
+	ProverBackend   string
+	ProverRequestID string
+	// ErrorMessage is the error the server reported for the last failed attempt to send the request.
+	ErrorMessage string
+	// AggRequestID is the ID of the AGG proof request that aggregates a span proof.
+	AggRequestID int64
+	// Cycles is the number of SP1 cycles of the fulfilled proof, and Fee the fee paid to the prover network for it, in
+	// the base units of the PROVE token, as reported by the prover network.
+	Cycles        int64
+	Fee           string
+	FulfilledTime int64
+	// Fulfiller is the address of the prover on the prover network that was assigned the request.
+	Fulfiller string
+	// DowntimeCause is the cause of the proposer downtime, MAINTENANCE or CRASH, if the request was created to catch
+	// up on the backlog of blocks that built up during it.
+	DowntimeCause string
+}
+
+// column is an exported column. Exactly one of intValue and stringValue is set, depending on its type.
+type column struct {
+	name        string
+	intValue    func(r *Record) int64
+	stringValue func(r *Record) string
+}
+
+var columns = []column{
+	{name: "id", intValue: func(r *Record) int64 { return r.ID }},
+	{name: "type", stringValue: func(r *Record) string { return r.Type }},
+	{name: "start_block", intValue: func(r *Record) int64 { return r.StartBlock }},
+	{name: "end_block", intValue: func(r *Record) int64 { return r.EndBlock }},
+	{name: "blocks", intValue: func(r *Record) int64 { return r.EndBlock - r.StartBlock }},
+	{name: "status", stringValue: func(r *Record) string { return r.Status }},
+	{name: "request_added_time", intValue: func(r *Record) int64 { return r.RequestAddedTime }},
+	{name: "proof_request_time", intValue: func(r *Record) int64 { return r.ProofRequestTime }},
+	{name: "last_updated_time", intValue: func(r *Record) int64 { return r.LastUpdatedTime }},
+	{name: "proving_seconds", intValue: func(r *Record) int64 { return r.ProvingSeconds }},
+	{name: "total_seconds", intValue: func(r *Record) int64 { return r.TotalSeconds }},
+	{name: "attempts", intValue: func(r *Record) int64 { return r.Attempts }},
+	{name: "prover_backend", stringValue: func(r *Record) string { return r.ProverBackend }},
+	{name: "prover_request_id", stringValue: func(r *Record) string { return r.ProverRequestID }},
+	{name: "error_message", stringValue: func(r *Record) string { return r.ErrorMessage }},
+	{name: "agg_request_id", intValue: func(r *Record) int64 { return r.AggRequestID }},
+	{name: "cycles", intValue: func(r *Record) int64 { return r.Cycles }},
+	{name: "fee", stringValue: func(r *Record) string { return r.Fee }},
+	{name: "fulfilled_time", intValue: func(r *Record) int64 { return r.FulfilledTime }},
+	{name: "fulfiller", stringValue: func(r *Record) string { return r.Fulfiller }},
+	{name: "downtime_cause", stringValue: func(r *Record) string { return r.DowntimeCause }},
+}
+
+// Format is the file format of an export.
+type Format string
+
+const (
+	FormatCSV     Format = "csv"
+	FormatParquet Format = "parquet"
+)
+
+// FormatOf returns the format of an export file from its extension, .csv or .parquet.
+func FormatOf(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".parquet":
+		return FormatParquet, nil
+	default:
+		return "", fmt.Errorf("unknown export format of %q, the file must end in .csv or .parquet", path)
+	}
+}
+
+// Write writes the records in the given format.
+func Write(w io.Writer, format Format, records []Record) error {
+	switch format {
+	case FormatCSV:
+		return WriteCSV(w, records)
+	case FormatParquet:
+		return WriteParquet(w, records)
+	default:
+		return fmt.Errorf("unknown export format %q", format)
+	}
+}
+
+// WriteFile writes the records to the file, in the format of its extension. The file is replaced atomically, so
+// readers never see a partial export.
+func WriteFile(path string, records []Record) error {
+	format, err := FormatOf(path)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("failed to create export file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if err := Write(tmp, format, records); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write export file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace export file: %w", err)
+	}
+	return nil
+}
+
+// WriteCSV writes the records as CSV, with a header row of the column names.
+func WriteCSV(w io.Writer, records []Record) error {
+	cw := csv.NewWriter(w)
+	row := make([]string, len(columns))
+	for i, col := range columns {
+		row[i] = col.name
+	}
+	if err := cw.Write(row); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for i := range records {
+		for j, col := range columns {
+			if col.intValue != nil {
+				row[j] = strconv.FormatInt(col.intValue(&records[i]), 10)
+			} else {
+				row[j] = col.stringValue(&records[i])
+			}
+		}
+		if err := cw.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package analytics
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testRecords = []Record{
+	{ID: 1, Type: "SPAN", StartBlock: 100, EndBlock: 200, Status: "COMPLETE", RequestAddedTime: 10, ProofRequestTime: 20, LastUpdatedTime: 80, ProvingSeconds: 60, TotalSeconds: 70, ProverBackend: "http://a", ProverRequestID: "0x01", AggRequestID: 3, Cycles: 5000000, Fee: "1000", FulfilledTime: 80, Fulfiller: "0xaa", DowntimeCause: "MAINTENANCE"},
+	{ID: 2, Type: "SPAN", StartBlock: 200, EndBlock: 300, Status: "FAILED", RequestAddedTime: 10, LastUpdatedTime: 30, Attempts: 1, ErrorMessage: "witness generation failed, retry"},
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, testRecords))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	require.Equal(t, "id,type,start_block,end_block,blocks,status,request_added_time,proof_request_time,last_updated_time,proving_seconds,total_seconds,attempts,prover_backend,prover_request_id,error_message,agg_request_id,cycles,fee,fulfilled_time,fulfiller,downtime_cause", lines[0])
+	require.Equal(t, "1,SPAN,100,200,100,COMPLETE,10,20,80,60,70,0,http://a,0x01,,3,5000000,1000,80,0xaa,MAINTENANCE", lines[1])
+	require.Equal(t, `2,SPAN,200,300,100,FAILED,10,0,30,0,0,1,,,"witness generation failed, retry",0,0,,0,,`, lines[2])
+}
+
+func TestWriteParquet(t *testing.T) {
+	for _, records := range [][]Record{testRecords, nil} {
+		var buf bytes.Buffer
+		require.NoError(t, WriteParquet(&buf, records))
+		file := buf.Bytes()
+
+		// The file starts and ends with the magic number, and the footer length points at the metadata.
+		require.Equal(t, parquetMagic, string(file[:4]))
+		require.Equal(t, parquetMagic, string(file[len(file)-4:]))
+		footerLen := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+		require.LessOrEqual(t, footerLen, len(file)-12)
+		footer := file[len(file)-8-footerLen : len(file)-8]
+		for _, col := range columns {
+			require.Contains(t, string(footer), col.name)
+		}
+		require.Equal(t, byte(0), footer[len(footer)-1])
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	dir := t.TempDir()
+	_, err := FormatOf(filepath.Join(dir, "proofs.json"))
+	require.Error(t, err)
+
+	path := filepath.Join(dir, "proofs.csv")
+	require.NoError(t, WriteFile(path, testRecords))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "COMPLETE")
+
+	// Only the export file is left behind.
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
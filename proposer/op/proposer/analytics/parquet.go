@@ -0,0 +1,228 @@
+package analytics
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// WriteParquet writes the records as a Parquet file, with a single row group and one uncompressed, PLAIN-encoded page
+// per column. All columns are required: integers are INT64, and strings are UTF-8 BYTE_ARRAYs. This is the subset of
+// the format that every Parquet reader supports, so the proposer doesn't depend on a Parquet library.
+func WriteParquet(w io.Writer, records []Record) error {
+	var file bytes.Buffer
+	file.WriteString(parquetMagic)
+
+	type chunk struct {
+		offset, size int64
+	}
+	var chunks []chunk
+	if len(records) > 0 {
+		for _, col := range columns {
+			var page bytes.Buffer
+			for i := range records {
+				if col.intValue != nil {
+					binary.Write(&page, binary.LittleEndian, col.intValue(&records[i]))
+				} else {
+					s := col.stringValue(&records[i])
+					binary.Write(&page, binary.LittleEndian, uint32(len(s)))
+					page.WriteString(s)
+				}
+			}
+
+			header := newCompactWriter()
+			header.i32(1, pageTypeDataPage)
+			header.i32(2, int32(page.Len()))
+			header.i32(3, int32(page.Len()))
+			header.structField(5)
+			header.i32(1, int32(len(records)))
+			header.i32(2, encodingPlain)
+			header.i32(3, encodingRLE)
+			header.i32(4, encodingRLE)
+			header.endStruct()
+			headerBytes := header.finish()
+
+			chunks = append(chunks, chunk{offset: int64(file.Len()), size: int64(len(headerBytes) + page.Len())})
+			file.Write(headerBytes)
+			file.Write(page.Bytes())
+		}
+	}
+
+	// FileMetaData
+	meta := newCompactWriter()
+	meta.i32(1, 1)
+	meta.list(2, compactStruct, len(columns)+1)
+	meta.beginStruct()
+	meta.string(4, "schema")
+	meta.i32(5, int32(len(columns)))
+	meta.endStruct()
+	for _, col := range columns {
+		meta.beginStruct()
+		meta.i32(1, col.physicalType())
+		meta.i32(3, repetitionRequired)
+		meta.string(4, col.name)
+		if col.stringValue != nil {
+			meta.i32(6, convertedTypeUTF8)
+		}
+		meta.endStruct()
+	}
+	meta.i64(3, int64(len(records)))
+	// A file without records has no row groups.
+	meta.list(4, compactStruct, len(chunks)/len(columns))
+	if len(chunks) > 0 {
+		// RowGroup
+		meta.beginStruct()
+		meta.list(1, compactStruct, len(columns))
+		var total int64
+		for i, col := range columns {
+			// ColumnChunk
+			meta.beginStruct()
+			meta.i64(2, chunks[i].offset)
+			// ColumnMetaData
+			meta.structField(3)
+			meta.i32(1, col.physicalType())
+			meta.list(2, compactI32, 1)
+			meta.listI32(encodingPlain)
+			meta.list(3, compactBinary, 1)
+			meta.listString(col.name)
+			meta.i32(4, codecUncompressed)
+			meta.i64(5, int64(len(records)))
+			meta.i64(6, chunks[i].size)
+			meta.i64(7, chunks[i].size)
+			meta.i64(9, chunks[i].offset)
+			meta.endStruct()
+			meta.endStruct()
+			total += chunks[i].size
+		}
+		meta.i64(2, total)
+		meta.i64(3, int64(len(records)))
+		meta.endStruct()
+	}
+	meta.string(6, "op-succinct-proposer")
+	footer := meta.finish()
+
+	file.Write(footer)
+	binary.Write(&file, binary.LittleEndian, uint32(len(footer)))
+	file.WriteString(parquetMagic)
+	if _, err := w.Write(file.Bytes()); err != nil {
+		return fmt.Errorf("failed to write Parquet file: %w", err)
+	}
+	return nil
+}
+
+const parquetMagic = "PAR1"
+
+// Values of the enums of the Parquet format.
+const (
+	physicalTypeInt64     = 2
+	physicalTypeByteArray = 6
+	repetitionRequired    = 0
+	convertedTypeUTF8     = 0
+	encodingPlain         = 0
+	encodingRLE           = 3
+	codecUncompressed     = 0
+	pageTypeDataPage      = 0
+)
+
+func (c column) physicalType() int32 {
+	if c.intValue != nil {
+		return physicalTypeInt64
+	}
+	return physicalTypeByteArray
+}
+
+// Types of the Thrift compact protocol, which the Parquet metadata is encoded with.
+const (
+	compactI32    = 5
+	compactI64    = 6
+	compactBinary = 8
+	compactList   = 9
+	compactStruct = 12
+)
+
+// compactWriter encodes a Thrift struct with the compact protocol. Fields of nested structs are written between
+// structField or beginStruct and endStruct, and the struct is done with finish.
+type compactWriter struct {
+	buf bytes.Buffer
+	// lastField is the ID of the last field written to each open struct, which field IDs are delta-encoded against.
+	lastField []int16
+}
+
+func newCompactWriter() *compactWriter {
+	return &compactWriter{lastField: []int16{0}}
+}
+
+func (w *compactWriter) varint(v uint64) {
+	w.buf.Write(binary.AppendUvarint(nil, v))
+}
+
+func zigzag(v int64) uint64 {
+	return uint64((v << 1) ^ (v >> 63))
+}
+
+func (w *compactWriter) field(id int16, typ byte) {
+	last := &w.lastField[len(w.lastField)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		w.buf.WriteByte(typ)
+		w.varint(zigzag(int64(id)))
+	}
+	*last = id
+}
+
+func (w *compactWriter) i32(id int16, v int32) {
+	w.field(id, compactI32)
+	w.varint(zigzag(int64(v)))
+}
+
+func (w *compactWriter) i64(id int16, v int64) {
+	w.field(id, compactI64)
+	w.varint(zigzag(v))
+}
+
+func (w *compactWriter) string(id int16, s string) {
+	w.field(id, compactBinary)
+	w.listString(s)
+}
+
+// list starts a list field of n elements, which are written with listI32, listString, or beginStruct and endStruct.
+func (w *compactWriter) list(id int16, elemType byte, n int) {
+	w.field(id, compactList)
+	if n < 15 {
+		w.buf.WriteByte(byte(n)<<4 | elemType)
+	} else {
+		w.buf.WriteByte(0xf0 | elemType)
+		w.varint(uint64(n))
+	}
+}
+
+func (w *compactWriter) listI32(v int32) {
+	w.varint(zigzag(int64(v)))
+}
+
+func (w *compactWriter) listString(s string) {
+	w.varint(uint64(len(s)))
+	w.buf.WriteString(s)
+}
+
+func (w *compactWriter) structField(id int16) {
+	w.field(id, compactStruct)
+	w.beginStruct()
+}
+
+func (w *compactWriter) beginStruct() {
+	w.lastField = append(w.lastField, 0)
+}
+
+func (w *compactWriter) endStruct() {
+	w.buf.WriteByte(0)
+	w.lastField = w.lastField[:len(w.lastField)-1]
+}
+
+// finish ends the top-level struct, and returns its encoding.
+func (w *compactWriter) finish() []byte {
+	w.buf.WriteByte(0)
+	return w.buf.Bytes()
+}
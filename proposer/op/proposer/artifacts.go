@@ -0,0 +1,74 @@
+package proposer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// CleanupArtifactsRequest is the request type for the `cleanup_artifacts` RPC of the op-succinct-server.
+type CleanupArtifactsRequest struct {
+	ArtifactID string `json:"artifact_id"`
+}
+
+// CleanupWitnessArtifacts asks the server to delete the witness data of proof requests that reached a terminal state,
+// including requests that were abandoned because they failed and were retried or split. The artifact ID is cleared
+// once the server deleted the artifact, so failed cleanups are retried on the next loop.
+func (l *L2OutputSubmitter) CleanupWitnessArtifacts() error {
+	reqs, err := l.db.GetProofsWithStaleWitnessArtifacts()
+	if err != nil {
+		return err
+	}
+	for _, req := range reqs {
+		// The server keeps the witness data of a span in a directory named after the range, so a retry of the same range
+		// that is generating its witness now is using the same directory.
+		inFlight, err := l.db.GetProofRequestsWithBlockRangeAndStatus(req.Type, req.StartBlock, req.EndBlock, proofrequest.StatusWITNESSGEN)
+		if err != nil {
+			return err
+		}
+		if len(inFlight) > 0 {
+			continue
+		}
+
+		if err := l.cleanupWitnessArtifact(l.proverBackend(req), req.WitnessArtifactID); err != nil {
+			l.Log.Warn("failed to clean up witness artifact", "id", req.ID, "artifact", req.WitnessArtifactID, "err", err)
+			l.Metr.RecordError("cleanup_witness_artifact", 1)
+			continue
+		}
+		if err := l.db.ClearWitnessArtifactID(req.ID); err != nil {
+			return err
+		}
+		l.Log.Info("cleaned up witness artifact", "id", req.ID, "status", req.Status, "artifact", req.WitnessArtifactID)
+	}
+	return nil
+}
+
+// cleanupWitnessArtifact asks the server that generated a witness artifact to delete it. Deleting an artifact that is
+// already gone succeeds.
+func (l *L2OutputSubmitter) cleanupWitnessArtifact(serverUrl, artifactID string) error {
+	jsonBody, err := json.Marshal(CleanupArtifactsRequest{ArtifactID: artifactID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request body: %w", err)
+	}
+	req, err := http.NewRequestWithContext(l.ctx, "POST", serverUrl+"/cleanup_artifacts", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: PROOF_STATUS_TIMEOUT}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("received status code %d: %s", resp.StatusCode, body)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+func TestCleanupWitnessArtifacts(t *testing.T) {
+	var cleaned []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req CleanupArtifactsRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		cleaned = append(cleaned, req.ArtifactID)
+	}))
+	defer server.Close()
+
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	// A failed request whose retry is generating its witness in the same directory, a failed request, and a request
+	// that is still proving.
+	for _, r := range []struct {
+		start, end uint64
+		status     proofrequest.Status
+		artifact   string
+	}{
+		{100, 200, proofrequest.StatusFAILED, "1/100-200"},
+		{100, 200, proofrequest.StatusWITNESSGEN, ""},
+		{200, 300, proofrequest.StatusFAILED, "1/200-300"},
+		{300, 400, proofrequest.StatusPROVING, "1/300-400"},
+	} {
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, r.start, r.end, 0))
+		reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.NoError(t, proofDB.UpdateProofStatus(reqs[0].ID, r.status))
+		if r.artifact != "" {
+			require.NoError(t, proofDB.SetWitnessArtifactID(reqs[0].ID, r.artifact))
+		}
+	}
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{OPSuccinctServerUrl: server.URL},
+		},
+		ctx: context.Background(),
+		db:  *proofDB,
+	}
+	require.NoError(t, l.CleanupWitnessArtifacts())
+	require.Equal(t, []string{"1/200-300"}, cleaned)
+
+	// Cleaned up artifacts aren't cleaned up again.
+	cleaned = nil
+	require.NoError(t, l.CleanupWitnessArtifacts())
+	require.Empty(t, cleaned)
+}
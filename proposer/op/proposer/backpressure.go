@@ -0,0 +1,221 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+// ErrServerOverloaded is returned when the OP Succinct server responds with a 503 (or 429), signaling that it
+// can't take on more witness generation work right now.
+var ErrServerOverloaded = errors.New("op-succinct server is overloaded")
+
+// witnessGenLimiter tracks the effective number of concurrent witness generation requests the proposer is
+// allowed to have in flight. It starts at the configured MaxConcurrentWitnessGen, is halved every time the
+// server reports it is overloaded, and recovers by one slot for every witness generation request the server
+// accepts (AIMD). This keeps a busy shared server from being hammered at the configured ceiling.
+type witnessGenLimiter struct {
+	mu    sync.Mutex
+	max   uint64
+	limit uint64
+	// onChange is called with the new effective limit whenever it changes, outside of the lock. Nil if unset.
+	onChange func(limit uint64)
+	// persister writes the limit to the DB. Nil if the limit isn't persisted.
+	persister *limitPersister
+
+	// latencyTarget is the latency that the limit is adjusted to by Observe, or 0 if it recovers with every accepted
+	// request. latencies and failures are the requests observed since the last adjustment.
+	latencyTarget  time.Duration
+	maxFailureRate float64
+	latencies      []time.Duration
+	failures       int
+}
+
+func newWitnessGenLimiter(max uint64) *witnessGenLimiter {
+	return &witnessGenLimiter{max: max, limit: max}
+}
+
+// Limit returns the current effective witness generation concurrency.
+func (w *witnessGenLimiter) Limit() uint64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.limit
+}
+
+// Max returns the maximum the effective limit recovers to.
+func (w *witnessGenLimiter) Max() uint64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.max
+}
+
+// OnOverloaded halves the effective limit, never going below a single request.
+func (w *witnessGenLimiter) OnOverloaded() uint64 {
+	return w.update(func(limit uint64) uint64 { return max(1, limit/2) })
+}
+
+// OnAccepted increases the effective limit by one, up to the configured maximum. With a latency target, the limit is
+// only raised by Observe.
+func (w *witnessGenLimiter) OnAccepted() uint64 {
+	return w.update(func(limit uint64) uint64 {
+		if w.latencyTarget > 0 {
+			return limit
+		}
+		return min(w.max, limit+1)
+	})
+}
+
+// Restore sets the effective limit to one persisted before a restart, clamped to the configured maximum.
+func (w *witnessGenLimiter) Restore(limit uint64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.limit = min(w.max, max(1, limit))
+}
+
+func (w *witnessGenLimiter) update(fn func(limit uint64) uint64) uint64 {
+	w.mu.Lock()
+	prev := w.limit
+	w.limit = fn(w.limit)
+	limit, onChange := w.limit, w.onChange
+	w.mu.Unlock()
+
+	if limit != prev && onChange != nil {
+		onChange(limit)
+	}
+	return limit
+}
+
+// SetMax updates the configured maximum, clamping the effective limit to it.
+func (w *witnessGenLimiter) SetMax(max uint64) {
+	w.mu.Lock()
+	w.max = max
+	w.mu.Unlock()
+	w.update(func(limit uint64) uint64 { return min(limit, max) })
+}
+
+// RunPersister writes the changes of a persistent limiter to the DB until ctx is done. Returns immediately if the limit
+// isn't persisted.
+func (w *witnessGenLimiter) RunPersister(ctx context.Context) {
+	if w.persister != nil {
+		w.persister.run(ctx)
+	}
+}
+
+// startLimitPersister starts writing the changes of the witness generation limit to the DB, unless it already was.
+func (l *L2OutputSubmitter) startLimitPersister() {
+	if l.persistingLimit {
+		return
+	}
+	l.persistingLimit = true
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		l.witnessGenLimiter.RunPersister(l.ctx)
+	}()
+}
+
+// witnessGenLimiterName is the name the witness generation limit is persisted under.
+const witnessGenLimiterName = "witness_gen"
+
+// newPersistentWitnessGenLimiter creates a witnessGenLimiter that starts at the limit persisted in the DB, and persists
+// every change to it while RunPersister runs. This keeps a proposer that is restarted, e.g. in a crash loop, from
+// sending the full configured concurrency to a server that is still overloaded.
+func newPersistentWitnessGenLimiter(proofDB *db.ProofDB, log log.Logger, maxConcurrent uint64) (*witnessGenLimiter, error) {
+	w := newWitnessGenLimiter(maxConcurrent)
+	limit, ok, err := proofDB.GetLimiterState(witnessGenLimiterName)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		w.Restore(limit)
+		log.Info("Restored the witness generation limit", "limit", w.Limit(), "max", maxConcurrent)
+	}
+	w.persister = &limitPersister{proofDB: proofDB, log: log, notify: make(chan struct{}, 1)}
+	w.onChange = w.persister.set
+	return w, nil
+}
+
+// limitPersister writes the latest witness generation limit to the DB in the background, so that sending a proof
+// request never waits on a DB write. Changes made while a write is in progress are coalesced into the next write.
+type limitPersister struct {
+	proofDB *db.ProofDB
+	log     log.Logger
+	notify  chan struct{}
+
+	mu      sync.Mutex
+	pending uint64
+	dirty   bool
+}
+
+// set records the limit to be written, without blocking.
+func (p *limitPersister) set(limit uint64) {
+	p.mu.Lock()
+	p.pending, p.dirty = limit, true
+	p.mu.Unlock()
+	select {
+	case p.notify <- struct{}{}:
+	default:
+	}
+}
+
+// run writes the recorded limits until ctx is done, and then writes the last one.
+func (p *limitPersister) run(ctx context.Context) {
+	for {
+		select {
+		case <-p.notify:
+			p.flush()
+		case <-ctx.Done():
+			p.flush()
+			return
+		}
+	}
+}
+
+func (p *limitPersister) flush() {
+	p.mu.Lock()
+	limit, dirty := p.pending, p.dirty
+	p.dirty = false
+	p.mu.Unlock()
+	if !dirty {
+		return
+	}
+	if err := p.proofDB.SaveLimiterState(witnessGenLimiterName, limit); err != nil {
+		p.log.Warn("failed to persist the witness generation limit", "limit", limit, "err", err)
+	}
+}
+
+// LimiterStatus returns the remaining capacity under the witness generation and proof request concurrency limits.
+func (l *L2OutputSubmitter) LimiterStatus(ctx context.Context) (rpc.LimiterStatus, error) {
+	var witnessGen, proving int
+	err := l.db.ReadSnapshot(func(snapshot *db.ProofDB) error {
+		var err error
+		if witnessGen, err = snapshot.GetNumberOfRequestsWithStatuses(proofrequest.StatusWITNESSGEN); err != nil {
+			return err
+		}
+		proving, err = snapshot.GetNumberOfRequestsWithStatuses(proofrequest.StatusPROVING)
+		return err
+	})
+	if err != nil {
+		return rpc.LimiterStatus{}, err
+	}
+
+	limit := l.witnessGenLimiter.Limit()
+	settings := l.settings()
+	inFlight := uint64(witnessGen + proving)
+	return rpc.LimiterStatus{
+		WitnessGenLimit:        limit,
+		WitnessGenMax:          l.witnessGenLimiter.Max(),
+		WitnessGenInFlight:     uint64(witnessGen),
+		WitnessGenRemaining:    limit - min(limit, uint64(witnessGen)),
+		ProofRequestsMax:       settings.MaxConcurrentProofRequests,
+		ProofRequestsInFlight:  inFlight,
+		ProofRequestsRemaining: settings.MaxConcurrentProofRequests - min(settings.MaxConcurrentProofRequests, inFlight),
+	}, nil
+}
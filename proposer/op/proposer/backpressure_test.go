@@ -0,0 +1,77 @@
+package proposer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+)
+
+func TestWitnessGenLimiter(t *testing.T) {
+	w := newWitnessGenLimiter(5)
+	require.Equal(t, uint64(5), w.Limit())
+
+	// Multiplicative decrease on overload, never below 1.
+	require.Equal(t, uint64(2), w.OnOverloaded())
+	require.Equal(t, uint64(1), w.OnOverloaded())
+	require.Equal(t, uint64(1), w.OnOverloaded())
+
+	// Additive increase on success, capped at the configured max.
+	for i := 0; i < 10; i++ {
+		w.OnAccepted()
+	}
+	require.Equal(t, uint64(5), w.Limit())
+}
+
+// runLimiterPersister runs the persister of the limiter, and returns a function that stops it once it wrote the last
+// change.
+func runLimiterPersister(w *witnessGenLimiter) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		w.RunPersister(ctx)
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func TestPersistentWitnessGenLimiter(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	w, err := newPersistentWitnessGenLimiter(proofDB, log.New(), 5)
+	require.NoError(t, err)
+	stop := runLimiterPersister(w)
+	require.Equal(t, uint64(5), w.Limit())
+	require.Equal(t, uint64(2), w.OnOverloaded())
+	stop()
+
+	// A restarted proposer starts at the persisted limit.
+	w, err = newPersistentWitnessGenLimiter(proofDB, log.New(), 5)
+	require.NoError(t, err)
+	stop = runLimiterPersister(w)
+	require.Equal(t, uint64(2), w.Limit())
+	require.Equal(t, uint64(3), w.OnAccepted())
+	require.Equal(t, uint64(4), w.OnAccepted())
+	// A lowered maximum clamps the limit, and the clamped limit is persisted too.
+	w.SetMax(3)
+	require.Equal(t, uint64(3), w.Limit())
+	stop()
+
+	w, err = newPersistentWitnessGenLimiter(proofDB, log.New(), 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), w.Limit())
+
+	// The persisted limit is clamped to a lowered maximum.
+	w, err = newPersistentWitnessGenLimiter(proofDB, log.New(), 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), w.Limit())
+}
@@ -0,0 +1,123 @@
+package proposer
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// blockedRange is a block range from BLOCKED_RANGES, which span proofs are never requested for.
+type blockedRange struct {
+	start, end uint64
+}
+
+// overlaps returns whether the span proof from start to end shares any blocks with the range.
+func (r blockedRange) overlaps(start, end uint64) bool {
+	return start < r.end && r.start < end
+}
+
+func (r blockedRange) String() string {
+	return fmt.Sprintf("%d-%d", r.start, r.end)
+}
+
+// parseBlockedRanges parses block ranges of the form start-end, where start is less than end.
+func parseBlockedRanges(ranges []string) ([]blockedRange, error) {
+	parsed := make([]blockedRange, 0, len(ranges))
+	for _, s := range ranges {
+		startStr, endStr, ok := strings.Cut(strings.TrimSpace(s), "-")
+		if !ok {
+			return nil, fmt.Errorf("invalid blocked range %q, must be start-end", s)
+		}
+		start, err := strconv.ParseUint(startStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start of blocked range %q: %w", s, err)
+		}
+		end, err := strconv.ParseUint(endStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end of blocked range %q: %w", s, err)
+		}
+		if start >= end {
+			return nil, fmt.Errorf("the start of blocked range %q must be less than its end", s)
+		}
+		parsed = append(parsed, blockedRange{start: start, end: end})
+	}
+	return parsed, nil
+}
+
+// blockedRangeOf returns the first blocked range that the span proof from start to end overlaps, if any.
+func (l *L2OutputSubmitter) blockedRangeOf(start, end uint64) (blockedRange, bool) {
+	for _, r := range l.blockedRanges {
+		if r.overlaps(start, end) {
+			return r, true
+		}
+	}
+	return blockedRange{}, false
+}
+
+// ParkBlockedRanges moves the unrequested span proof requests that overlap a range in BLOCKED_RANGES to the BLOCKED
+// status, so they aren't requested and don't use up retries on ranges the operator knows will fail. Each parked request
+// is logged as an error and counted in the blocked_range error metric. Requests that overlap a range leased to an
+// external proving job are parked too, so the range isn't proven twice. BLOCKED requests that don't overlap a blocked
+// range or a lease anymore, because the operator removed the range or the lease ended, are queued again.
+func (l *L2OutputSubmitter) ParkBlockedRanges() error {
+	leases, err := l.activeRangeLeases()
+	if err != nil {
+		return err
+	}
+	var unrequested []*ent.ProofRequest
+	if len(l.blockedRanges) > 0 || len(leases) > 0 {
+		if unrequested, err = l.db.GetAllProofsWithStatus(proofrequest.StatusUNREQ); err != nil {
+			return err
+		}
+	}
+	for _, req := range unrequested {
+		if req.Type != proofrequest.TypeSPAN {
+			continue
+		}
+		r, blocked := l.blockedRangeOf(req.StartBlock, req.EndBlock)
+		lease := rangeLeaseOf(leases, req.StartBlock, req.EndBlock)
+		if !blocked && lease == nil {
+			continue
+		}
+		err := l.db.TransitionProofStatus(req.ID, proofrequest.StatusUNREQ, proofrequest.StatusBLOCKED)
+		if errors.Is(err, db.ErrProofStatusChanged) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if blocked {
+			l.Log.Error("Span proof request overlaps a blocked range, parking it until the range is removed from BLOCKED_RANGES", "id", req.ID, "start", req.StartBlock, "end", req.EndBlock, "blockedRange", r)
+			l.Metr.RecordError("blocked_range", 1)
+		} else {
+			l.Log.Info("Span proof request overlaps a leased range, parking it until the lease ends", "id", req.ID, "start", req.StartBlock, "end", req.EndBlock, "leaseID", lease.ID, "owner", lease.Owner)
+		}
+	}
+
+	blocked, err := l.db.GetAllProofsWithStatus(proofrequest.StatusBLOCKED)
+	if err != nil {
+		return err
+	}
+	for _, req := range blocked {
+		if _, ok := l.blockedRangeOf(req.StartBlock, req.EndBlock); ok {
+			continue
+		}
+		if rangeLeaseOf(leases, req.StartBlock, req.EndBlock) != nil {
+			continue
+		}
+		err := l.db.TransitionProofStatus(req.ID, proofrequest.StatusBLOCKED, proofrequest.StatusUNREQ)
+		if errors.Is(err, db.ErrProofStatusChanged) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		l.Log.Info("Span proof request doesn't overlap a blocked range or a lease anymore, queueing it again", "id", req.ID, "start", req.StartBlock, "end", req.EndBlock)
+	}
+	return nil
+}
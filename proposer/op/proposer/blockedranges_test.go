@@ -0,0 +1,63 @@
+package proposer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+func TestParseBlockedRanges(t *testing.T) {
+	ranges, err := parseBlockedRanges([]string{"100-200", " 300-301"})
+	require.NoError(t, err)
+	require.Equal(t, []blockedRange{{100, 200}, {300, 301}}, ranges)
+
+	for _, invalid := range []string{"100", "a-200", "100-b", "200-100", "100-100"} {
+		_, err := parseBlockedRanges([]string{invalid})
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestParkBlockedRanges(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 150, 0))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 150, 200, 0))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 200, 250, 0))
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+		},
+		db:            *proofDB,
+		blockedRanges: []blockedRange{{170, 180}},
+	}
+	requireStatus := func(start, end uint64, status proofrequest.Status) {
+		reqs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, start, end, status)
+		require.NoError(t, err)
+		require.Len(t, reqs, 1)
+	}
+
+	// Only the span that overlaps the blocked range is parked.
+	require.NoError(t, l.ParkBlockedRanges())
+	requireStatus(100, 150, proofrequest.StatusUNREQ)
+	requireStatus(150, 200, proofrequest.StatusBLOCKED)
+	requireStatus(200, 250, proofrequest.StatusUNREQ)
+
+	next, err := proofDB.GetNextUnrequestedSpanProof()
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), next.StartBlock)
+
+	// Once the range is removed from the list, the span is queued again.
+	l.blockedRanges = nil
+	require.NoError(t, l.ParkBlockedRanges())
+	requireStatus(150, 200, proofrequest.StatusUNREQ)
+}
@@ -0,0 +1,170 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// checkL2OOState checks that the L2OO was initialized with a starting output, and starts the bootstrap if it has no
+// other outputs yet, i.e. it was freshly deployed. An L2OO that wasn't initialized reports a latest block of 0, which
+// the proposer would start proving the chain from.
+func (l *L2OutputSubmitter) checkL2OOState(ctx context.Context) error {
+	next, err := l.l2ooContract.NextOutputIndex(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get the next output index: %w", err)
+	}
+	if next.Sign() == 0 {
+		return errors.New("the L2OO isn't initialized, it has no starting output: initialize it before starting the proposer")
+	}
+	if next.Uint64() > 1 || l.Cfg.WatchOnly {
+		return nil
+	}
+
+	starting, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get the starting block number: %w", err)
+	}
+	l.Log.Info("Bootstrap: the L2OO has no outputs yet, proving its first output from the starting block", "startingBlock", starting)
+	l.bootstrapping = true
+	return nil
+}
+
+// advanceBootstrap logs the progress of proving the first output of a freshly deployed L2OO. Until the L2 chain has
+// finalized the starting block, and the starting output and the DB are validated against the L2OO, proof requests are
+// held, since proofs of a mismatched starting output can never be proposed. Once the first output is proposed, the
+// proposer runs as usual.
+func (l *L2OutputSubmitter) advanceBootstrap(ctx context.Context) error {
+	next, err := l.l2ooContract.NextOutputIndex(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get the next output index: %w", err)
+	}
+	if next.Uint64() > 1 {
+		l.Log.Info("Bootstrap: complete, the first output was proposed")
+		l.bootstrapping = false
+		l.bootstrapMismatch.Store(nil)
+		return nil
+	}
+	startingBig, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get the starting block number: %w", err)
+	}
+	firstOutputBig, err := l.l2ooContract.NextBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get the first output block number: %w", err)
+	}
+	starting, firstOutput := startingBig.Uint64(), firstOutputBig.Uint64()
+
+	if !l.bootstrapChecked {
+		ready, mismatch, err := l.checkBootstrap(ctx, starting, firstOutput)
+		if err != nil || !ready {
+			return err
+		}
+		if mismatch != "" {
+			l.Log.Error("Bootstrap: holding proof requests", "reason", mismatch)
+			l.Metr.RecordError("bootstrap_mismatch", 1)
+			l.bootstrapMismatch.Store(&mismatch)
+			return nil
+		}
+		l.bootstrapMismatch.Store(nil)
+		l.bootstrapChecked = true
+	}
+
+	stage, provenTo, err := l.bootstrapStage(starting, firstOutput)
+	if err != nil {
+		return err
+	}
+	l.Log.Info("Bootstrap: proving the first output", "stage", stage, "startingBlock", starting, "firstOutputBlock", firstOutput, "provenTo", provenTo)
+	return nil
+}
+
+// checkBootstrap validates the setup against a freshly deployed L2OO, and logs the plan of its first output. Returns
+// whether the setup can be validated yet, which it can't until the L2 chain finalizes the starting block, and why proof
+// requests must be held, or an empty string if the setup is valid.
+func (l *L2OutputSubmitter) checkBootstrap(ctx context.Context, starting, firstOutput uint64) (bool, string, error) {
+	rollupClient, err := l.RollupProvider.RollupClient(ctx)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get rollup client: %w", err)
+	}
+	status, err := rollupClient.SyncStatus(ctx)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get sync status: %w", err)
+	}
+	if status.FinalizedL2.Number < starting {
+		l.Log.Info("Bootstrap: waiting for the L2 chain to finalize the starting block", "startingBlock", starting, "finalizedBlock", status.FinalizedL2.Number)
+		return false, "", nil
+	}
+
+	// The span proofs start from the rollup node's output at the starting block, so they only aggregate into a
+	// proposable output if it matches the L2OO's starting output.
+	proposal, err := l.l2ooContract.GetL2OutputAfter(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(starting))
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get the starting output: %w", err)
+	}
+	output, err := l.FetchOutput(ctx, starting)
+	if err != nil {
+		return false, "", err
+	}
+	if common.Hash(proposal.OutputRoot) != common.Hash(output.OutputRoot) {
+		return true, fmt.Sprintf("the L2OO's starting output root %s doesn't match the rollup node's %s at block %d, check the L2OO's initialization and the rollup node", common.Hash(proposal.OutputRoot), common.Hash(output.OutputRoot), starting), nil
+	}
+
+	// New span proofs continue from the latest one in the DB, so a DB with proof requests before the starting block
+	// belongs to another deployment.
+	stale, err := l.db.GetNumberOfRequestsEndingBy(starting)
+	if err != nil {
+		return false, "", err
+	}
+	if stale > 0 {
+		return true, fmt.Sprintf("the DB has %d proof requests before the L2OO's starting block %d, it's likely from another deployment: start with an empty DB", stale, starting), nil
+	}
+
+	l.Log.Info("Bootstrap: the starting output matches the rollup node, planned the first output",
+		"startingBlock", starting, "firstOutputBlock", firstOutput, "submissionInterval", firstOutput-starting,
+		"spanProofs", len(l.SplitRangeBasic(starting, firstOutput)), "finalizedBlock", status.FinalizedL2.Number)
+	return true, "", nil
+}
+
+// bootstrapStage describes which step of proving and proposing the first output the proposer is at, and returns the
+// end of the contiguous span proofs from the starting block.
+func (l *L2OutputSubmitter) bootstrapStage(starting, firstOutput uint64) (string, uint64, error) {
+	provenTo, err := l.db.GetMaxContiguousSpanProofRange(starting)
+	if err != nil {
+		return "", 0, err
+	}
+	aggs, err := l.db.GetAllCompletedAggProofs(starting)
+	if err != nil {
+		return "", 0, err
+	}
+	if len(aggs) > 0 {
+		return "proposing the first output", provenTo, nil
+	}
+	unrequested, err := l.db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	if err != nil {
+		return "", 0, err
+	}
+	for _, req := range unrequested {
+		if req.Type == proofrequest.TypeAGG && req.StartBlock == starting && req.L1BlockHash == "" {
+			return "checkpointing an L1 block hash for the first AGG proof", provenTo, nil
+		}
+	}
+	if provenTo >= firstOutput {
+		return "proving the first AGG proof", provenTo, nil
+	}
+	return "proving the span proofs of the first output", provenTo, nil
+}
+
+// bootstrapMismatchReason returns why proof requests are held while bootstrapping a freshly deployed L2OO. Returns an
+// empty string if they aren't held.
+func (l *L2OutputSubmitter) bootstrapMismatchReason() string {
+	if reason := l.bootstrapMismatch.Load(); reason != nil {
+		return *reason
+	}
+	return ""
+}
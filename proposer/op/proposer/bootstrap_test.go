@@ -0,0 +1,87 @@
+package proposer
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	opsuccinctbindings "github.com/succinctlabs/op-succinct-go/bindings"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// freshL2OO is a freshly deployed L2OO, which only has the starting output it was initialized with, or no output at
+// all if it wasn't initialized.
+type freshL2OO struct {
+	*fakeL2OO
+	uninitialized bool
+	startingRoot  common.Hash
+}
+
+func (f *freshL2OO) NextOutputIndex(opts *bind.CallOpts) (*big.Int, error) {
+	if f.uninitialized {
+		return big.NewInt(0), nil
+	}
+	return f.fakeL2OO.NextOutputIndex(opts)
+}
+
+func (f *freshL2OO) GetL2OutputAfter(opts *bind.CallOpts, l2BlockNumber *big.Int) (opsuccinctbindings.TypesOutputProposal, error) {
+	if len(f.proposals) == 0 && l2BlockNumber.Uint64() <= f.latest {
+		return opsuccinctbindings.TypesOutputProposal{OutputRoot: f.startingRoot, Timestamp: big.NewInt(0), L2BlockNumber: new(big.Int).SetUint64(f.latest)}, nil
+	}
+	return f.fakeL2OO.GetL2OutputAfter(opts, l2BlockNumber)
+}
+
+func TestBootstrap(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l2oo := &freshL2OO{fakeL2OO: newFakeL2OO(200, 300), uninitialized: true, startingRoot: common.Hash{0x01}}
+	l := newFakeL2OODriver(t, l2oo.fakeL2OO, proofDB)
+	l.l2ooContract = l2oo
+	l.Cfg.MaxBlockRangePerSpanProof = 100
+	client := l.RollupProvider.(fakeRollupProvider).client
+
+	// An L2OO without a starting output can't be proposed to.
+	require.Error(t, l.checkL2OOState(context.Background()))
+	require.False(t, l.bootstrapping)
+
+	l2oo.uninitialized = false
+	require.NoError(t, l.checkL2OOState(context.Background()))
+	require.True(t, l.bootstrapping)
+
+	// The starting output can't be checked until the L2 chain finalizes the starting block.
+	require.NoError(t, l.advanceBootstrap(context.Background()))
+	require.False(t, l.bootstrapChecked)
+	require.Empty(t, l.proofRequestsHeldReason())
+
+	client.finalized = 300
+	require.NoError(t, l.advanceBootstrap(context.Background()))
+	require.False(t, l.bootstrapChecked)
+	require.Contains(t, l.proofRequestsHeldReason(), "doesn't match the rollup node")
+
+	// A proof request before the starting block is from another deployment.
+	l2oo.startingRoot = client.roots[200]
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+	require.NoError(t, l.advanceBootstrap(context.Background()))
+	require.Contains(t, l.proofRequestsHeldReason(), "from another deployment")
+
+	emptyDB, err := db.InitDB(filepath.Join(t.TempDir(), "empty.db"), false)
+	require.NoError(t, err)
+	defer emptyDB.CloseDB()
+	l.db = *emptyDB
+	require.NoError(t, l.advanceBootstrap(context.Background()))
+	require.True(t, l.bootstrapChecked)
+	require.Empty(t, l.proofRequestsHeldReason())
+
+	// Once the first output is proposed, the proposer runs as usual.
+	l2oo.proposals = append(l2oo.proposals, 500)
+	require.NoError(t, l.advanceBootstrap(context.Background()))
+	require.False(t, l.bootstrapping)
+}
@@ -0,0 +1,77 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+// MAX_BREAK_GLASS_DURATION is the longest a break-glass override can last, so that an override that is forgotten after
+// an incident doesn't lift the limits for good.
+const MAX_BREAK_GLASS_DURATION = 24 * time.Hour
+
+// breakGlassOverride lifts the span proof budget, the concurrency limits and the unrequested span proof budget of
+// imports until it expires.
+type breakGlassOverride struct {
+	reason    string
+	expiresAt time.Time
+}
+
+// activeBreakGlass returns the break-glass override, or nil if there is none or it expired.
+func (l *L2OutputSubmitter) activeBreakGlass() *breakGlassOverride {
+	o := l.breakGlass.Load()
+	if o == nil {
+		return nil
+	}
+	if time.Now().After(o.expiresAt) {
+		if l.breakGlass.CompareAndSwap(o, nil) {
+			l.Log.Warn("Break-glass override expired, limits are enforced again", "reason", o.reason)
+		}
+		return nil
+	}
+	return o
+}
+
+// BreakGlass lifts the budgets and concurrency limits of proof requests for the given number of seconds, so that an
+// on-call engineer can force a critical output through during an incident without editing the config and restarting.
+// Pausing and the program checks still apply. A new override replaces the active one.
+func (l *L2OutputSubmitter) BreakGlass(ctx context.Context, seconds uint64, reason string) (rpc.BreakGlassStatus, error) {
+	if reason == "" {
+		return rpc.BreakGlassStatus{}, errors.New("a break-glass override requires a reason")
+	}
+	if seconds == 0 || seconds > uint64(MAX_BREAK_GLASS_DURATION.Seconds()) {
+		return rpc.BreakGlassStatus{}, fmt.Errorf("a break-glass override must last between 1s and %s, got %ds", MAX_BREAK_GLASS_DURATION, seconds)
+	}
+	o := &breakGlassOverride{reason: reason, expiresAt: time.Now().Add(time.Duration(seconds) * time.Second)}
+	l.breakGlass.Store(o)
+	l.Log.Warn("Break-glass override activated, proof request limits are lifted", "reason", reason, "expires", o.expiresAt)
+	l.Metr.RecordError("break_glass", 1)
+	return breakGlassStatus(o), nil
+}
+
+// EndBreakGlass ends the active break-glass override before it expires.
+func (l *L2OutputSubmitter) EndBreakGlass(ctx context.Context) error {
+	if o := l.breakGlass.Swap(nil); o != nil {
+		l.Log.Warn("Break-glass override ended, limits are enforced again", "reason", o.reason)
+	}
+	return nil
+}
+
+// BreakGlassStatus returns the active break-glass override.
+func (l *L2OutputSubmitter) BreakGlassStatus(ctx context.Context) (rpc.BreakGlassStatus, error) {
+	return breakGlassStatus(l.activeBreakGlass()), nil
+}
+
+func breakGlassStatus(o *breakGlassOverride) rpc.BreakGlassStatus {
+	if o == nil {
+		return rpc.BreakGlassStatus{}
+	}
+	return rpc.BreakGlassStatus{
+		Active:    true,
+		Reason:    o.reason,
+		ExpiresAt: uint64(o.expiresAt.Unix()),
+	}
+}
@@ -0,0 +1,102 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// CapacityResponse is the response type for the `capacity` RPC of the op-succinct-server.
+type CapacityResponse struct {
+	// MaxWitnessGen is the number of witness generation runs the server runs at once, derived from its CPUs. Requests
+	// beyond it are rejected with a 503.
+	MaxWitnessGen uint64 `json:"max_witness_gen"`
+	// InFlight is the number of witness generation runs the server is running, for any client.
+	InFlight uint64 `json:"in_flight"`
+}
+
+// errCapacityUnsupported is returned when the server doesn't report its witness generation capacity.
+var errCapacityUnsupported = errors.New("the server doesn't report its witness generation capacity")
+
+// NegotiateWitnessGenCapacity sets the maximum witness generation concurrency to the capacity that the OP Succinct
+// servers report, instead of MAX_CONCURRENT_WITNESS_GEN. Span proofs are balanced across the servers with the same
+// limit, so the smallest capacity is used. Servers that don't report their capacity count as
+// MAX_CONCURRENT_WITNESS_GEN, and servers that can't be reached are left out. A server that is full while fewer of
+// its runs are the proposer's is shared with other clients, so it's treated like a 503, and the effective limit is
+// halved.
+func (l *L2OutputSubmitter) NegotiateWitnessGenCapacity(ctx context.Context) error {
+	reqs, err := l.db.GetAllProofsWithStatus(proofrequest.StatusWITNESSGEN)
+	if err != nil {
+		return fmt.Errorf("failed to get requests in witness generation: %w", err)
+	}
+	own := make(map[string]uint64)
+	for _, req := range reqs {
+		own[l.proverBackend(req)]++
+	}
+
+	var capacity uint64
+	var overloaded []string
+	var errs []error
+	for _, server := range l.Cfg.witnessGenServers() {
+		resp, err := l.getServerCapacity(ctx, server)
+		if errors.Is(err, errCapacityUnsupported) {
+			resp = CapacityResponse{MaxWitnessGen: l.settings().MaxConcurrentWitnessGen}
+		} else if err != nil {
+			errs = append(errs, fmt.Errorf("server %s: %w", server, err))
+			continue
+		}
+		if capacity == 0 || resp.MaxWitnessGen < capacity {
+			capacity = resp.MaxWitnessGen
+		}
+		if resp.InFlight >= resp.MaxWitnessGen && own[server] < resp.InFlight {
+			overloaded = append(overloaded, server)
+		}
+	}
+
+	if capacity > 0 && capacity != l.witnessGenLimiter.Max() {
+		l.Log.Info("Negotiated the witness generation concurrency with the servers", "old", l.witnessGenLimiter.Max(), "new", capacity)
+		l.witnessGenLimiter.SetMax(capacity)
+		l.Metr.RecordWitnessGenLimit(l.witnessGenLimiter.Limit())
+	}
+	if len(overloaded) > 0 {
+		limit := l.witnessGenLimiter.OnOverloaded()
+		l.Log.Warn("Witness generation server is full with other clients' requests, reducing concurrency", "servers", overloaded, "limit", limit)
+		l.Metr.RecordWitnessGenLimit(limit)
+	}
+	return errors.Join(errs...)
+}
+
+// getServerCapacity gets the witness generation capacity of the server.
+func (l *L2OutputSubmitter) getServerCapacity(ctx context.Context, server string) (CapacityResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", server+"/capacity", nil)
+	if err != nil {
+		return CapacityResponse{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	client := &http.Client{Timeout: PROOF_STATUS_TIMEOUT}
+	resp, err := client.Do(req)
+	if err != nil {
+		return CapacityResponse{}, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Older servers don't serve /capacity.
+	if resp.StatusCode == http.StatusNotFound {
+		return CapacityResponse{}, errCapacityUnsupported
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return CapacityResponse{}, fmt.Errorf("error reading the response body: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return CapacityResponse{}, parseServerError(resp.StatusCode, body)
+	}
+	var capacity CapacityResponse
+	if err := l.decodeServerResponse("capacity", body, &capacity); err != nil {
+		return CapacityResponse{}, err
+	}
+	return capacity, nil
+}
@@ -0,0 +1,66 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+func TestNegotiateWitnessGenCapacity(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	capacity := CapacityResponse{MaxWitnessGen: 8}
+	reporting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/capacity", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(capacity))
+	}))
+	defer reporting.Close()
+	legacy := httptest.NewServer(http.NotFoundHandler())
+	defer legacy.Close()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg: ProposerConfig{
+				OPSuccinctServerUrl:     reporting.URL,
+				MaxConcurrentWitnessGen: 5,
+			},
+		},
+		ctx:               context.Background(),
+		db:                *proofDB,
+		witnessGenLimiter: newWitnessGenLimiter(5),
+	}
+
+	// The maximum is raised to the reported capacity, and the limit recovers to it gradually.
+	require.NoError(t, l.NegotiateWitnessGenCapacity(context.Background()))
+	require.Equal(t, uint64(8), l.witnessGenLimiter.Max())
+	require.Equal(t, uint64(5), l.witnessGenLimiter.Limit())
+
+	// Servers that don't report their capacity count as MAX_CONCURRENT_WITNESS_GEN, and the smallest capacity wins.
+	l.Cfg.OPSuccinctServerUrls = []string{reporting.URL, legacy.URL}
+	require.NoError(t, l.NegotiateWitnessGenCapacity(context.Background()))
+	require.Equal(t, uint64(5), l.witnessGenLimiter.Max())
+
+	// The server shrinks, and is full with requests of other clients.
+	capacity = CapacityResponse{MaxWitnessGen: 4, InFlight: 4}
+	require.NoError(t, l.NegotiateWitnessGenCapacity(context.Background()))
+	require.Equal(t, uint64(4), l.witnessGenLimiter.Max())
+	require.Equal(t, uint64(2), l.witnessGenLimiter.Limit())
+
+	// Unreachable servers are left out.
+	reporting.Close()
+	require.Error(t, l.NegotiateWitnessGenCapacity(context.Background()))
+	require.Equal(t, uint64(5), l.witnessGenLimiter.Max())
+}
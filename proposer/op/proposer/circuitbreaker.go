@@ -0,0 +1,144 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// maxCircuitBreakerBackoffs caps how often the backoff of a circuit breaker doubles, at 32 times
+// CIRCUIT_BREAKER_BACKOFF.
+const maxCircuitBreakerBackoffs = 5
+
+// circuitBreaker stops proof requests to an OP Succinct server after CIRCUIT_BREAKER_THRESHOLD consecutive requests to
+// it failed, e.g. because it's down, so they don't wait out the witness generation timeout and hold concurrency slots.
+// Once the breaker has backed off, the server's health endpoint is probed, and the breaker closes if the server is
+// healthy. The backoff doubles every time the breaker trips again before a request to the server succeeds.
+type circuitBreaker struct {
+	mu       sync.Mutex
+	breakers map[string]*serverBreaker
+}
+
+type serverBreaker struct {
+	// failures is the number of consecutive failed requests to the server.
+	failures uint64
+	// trips is the number of times the breaker tripped since a request to the server last succeeded.
+	trips int
+	// probed is whether the breaker was closed after a successful probe, and trips again on the next failure.
+	probed bool
+	// openUntil is when the server is probed. Zero while the breaker is closed.
+	openUntil time.Time
+}
+
+func (b *circuitBreaker) get(server string) *serverBreaker {
+	if b.breakers == nil {
+		b.breakers = make(map[string]*serverBreaker)
+	}
+	if _, ok := b.breakers[server]; !ok {
+		b.breakers[server] = &serverBreaker{}
+	}
+	return b.breakers[server]
+}
+
+// onSuccess resets the breaker of the server, after it accepted a request.
+func (b *circuitBreaker) onSuccess(server string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.breakers, server)
+}
+
+// onFailure counts a failed request to the server. Returns the backoff if the breaker tripped, or 0.
+func (b *circuitBreaker) onFailure(server string, threshold uint64, backoff time.Duration, now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s := b.get(server)
+	s.failures++
+	if threshold == 0 || !s.openUntil.IsZero() || (s.failures < threshold && !s.probed) {
+		return 0
+	}
+	backoff <<= min(s.trips, maxCircuitBreakerBackoffs)
+	s.trips++
+	s.openUntil = now.Add(backoff)
+	return backoff
+}
+
+// open returns whether the breaker of the server is open, so no proofs are requested from it.
+func (b *circuitBreaker) open(server string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s, ok := b.breakers[server]
+	return ok && !s.openUntil.IsZero()
+}
+
+// probeDue returns the servers whose breaker is open and has backed off, so their health is probed.
+func (b *circuitBreaker) probeDue(now time.Time) []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var servers []string
+	for server, s := range b.breakers {
+		if !s.openUntil.IsZero() && !now.Before(s.openUntil) {
+			servers = append(servers, server)
+		}
+	}
+	return servers
+}
+
+// onProbe closes the breaker of the server if it's healthy, and backs off again otherwise. A closed breaker trips again
+// after a single failed request, until a request to the server succeeds. Returns the backoff if the server is
+// unhealthy.
+func (b *circuitBreaker) onProbe(server string, healthy bool, backoff time.Duration, now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s := b.get(server)
+	if healthy {
+		s.openUntil = time.Time{}
+		s.probed = true
+		return 0
+	}
+	backoff <<= min(s.trips, maxCircuitBreakerBackoffs)
+	s.trips++
+	s.openUntil = now.Add(backoff)
+	return backoff
+}
+
+// onServerRequestFailed counts a failed request to the server, and logs if its circuit breaker tripped.
+func (l *L2OutputSubmitter) onServerRequestFailed(server string) {
+	if backoff := l.circuitBreaker.onFailure(server, l.Cfg.CircuitBreakerThreshold, l.Cfg.CircuitBreakerBackoff, time.Now()); backoff > 0 {
+		l.Log.Error("Circuit breaker tripped, not requesting proofs from the server until it's healthy", "server", server, "failures", l.Cfg.CircuitBreakerThreshold, "backoff", backoff)
+		l.Metr.RecordError("circuit_breaker_tripped", 1)
+	}
+}
+
+// ProbeCircuitBreakers probes the health endpoint of the servers whose circuit breaker has backed off, and resumes
+// the proof requests to the healthy ones.
+func (l *L2OutputSubmitter) ProbeCircuitBreakers(ctx context.Context) {
+	for _, server := range l.circuitBreaker.probeDue(time.Now()) {
+		err := l.probeServerHealth(ctx, server)
+		if backoff := l.circuitBreaker.onProbe(server, err == nil, l.Cfg.CircuitBreakerBackoff, time.Now()); backoff > 0 {
+			l.Log.Warn("Server is still unhealthy, circuit breaker stays open", "server", server, "backoff", backoff, "err", err)
+			continue
+		}
+		l.Log.Info("Server is healthy again, closing circuit breaker", "server", server)
+	}
+}
+
+// probeServerHealth returns an error unless the server's health endpoint responds with 200.
+func (l *L2OutputSubmitter) probeServerHealth(ctx context.Context, server string) error {
+	ctx, cancel := context.WithTimeout(ctx, PROOF_STATUS_TIMEOUT)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "GET", server+"/health", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("received status code %d", resp.StatusCode)
+	}
+	return nil
+}
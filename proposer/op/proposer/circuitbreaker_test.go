@@ -0,0 +1,87 @@
+package proposer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var b circuitBreaker
+	now := time.Now()
+
+	// The breaker trips after 3 consecutive failures, and a success in between resets the count.
+	require.Zero(t, b.onFailure("a", 3, time.Minute, now))
+	require.Zero(t, b.onFailure("a", 3, time.Minute, now))
+	b.onSuccess("a")
+	require.Zero(t, b.onFailure("a", 3, time.Minute, now))
+	require.Zero(t, b.onFailure("a", 3, time.Minute, now))
+	require.False(t, b.open("a"))
+	require.Equal(t, time.Minute, b.onFailure("a", 3, time.Minute, now))
+	require.True(t, b.open("a"))
+	require.False(t, b.open("b"))
+
+	// The server is only probed once the breaker has backed off, and the backoff doubles while it's unhealthy.
+	require.Empty(t, b.probeDue(now))
+	now = now.Add(time.Minute)
+	require.Equal(t, []string{"a"}, b.probeDue(now))
+	require.Equal(t, 2*time.Minute, b.onProbe("a", false, time.Minute, now))
+	require.True(t, b.open("a"))
+
+	// Once healthy, the breaker closes, but trips again on the next failure until a request succeeds.
+	now = now.Add(2 * time.Minute)
+	require.Zero(t, b.onProbe("a", true, time.Minute, now))
+	require.False(t, b.open("a"))
+	require.Equal(t, 4*time.Minute, b.onFailure("a", 3, time.Minute, now))
+	require.Zero(t, b.onProbe("a", true, time.Minute, now.Add(4*time.Minute)))
+	b.onSuccess("a")
+	require.Zero(t, b.onFailure("a", 3, time.Minute, now))
+
+	// A threshold of 0 disables the breaker.
+	for i := 0; i < 10; i++ {
+		require.Zero(t, b.onFailure("c", 0, time.Minute, now))
+	}
+	require.False(t, b.open("c"))
+}
+
+func TestProbeCircuitBreakers(t *testing.T) {
+	healthy := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/health", r.URL.Path)
+		if !healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg: ProposerConfig{
+				OPSuccinctServerUrl:     server.URL,
+				CircuitBreakerThreshold: 1,
+				// The breaker is probed right away.
+				CircuitBreakerBackoff: time.Nanosecond,
+			},
+		},
+	}
+	l.onServerRequestFailed(server.URL)
+	require.True(t, l.circuitBreaker.open(server.URL))
+
+	time.Sleep(time.Millisecond)
+	l.ProbeCircuitBreakers(context.Background())
+	require.True(t, l.circuitBreaker.open(server.URL))
+
+	healthy = true
+	time.Sleep(time.Millisecond)
+	l.ProbeCircuitBreakers(context.Background())
+	require.False(t, l.circuitBreaker.open(server.URL))
+}
@@ -36,6 +36,42 @@ func main() {
 			Name:        "doc",
 			Subcommands: doc.NewSubcommands(metrics.NewMetrics("default")),
 		},
+		{
+			Name:   "doctor",
+			Usage:  "Checks every dependency of the proposer with the configured flags, and prints a pass/fail report",
+			Action: proposer.Doctor,
+		},
+		{
+			Name:   "loadtest",
+			Usage:  "Sends mock span proof requests to the OP Succinct server at a fixed rate, and reports the latencies and error rates",
+			Flags:  []cli.Flag{flags.LoadTestRateFlag, flags.LoadTestDurationFlag, flags.LoadTestSpanBlocksFlag, flags.LoadTestStartBlockFlag, flags.LoadTestEndBlockFlag},
+			Action: proposer.LoadTestCmd,
+		},
+		{
+			Name:  "proofs",
+			Usage: "Manages and inspects the proof requests of a proposer",
+			Subcommands: []*cli.Command{
+				{
+					Name:      "import",
+					Usage:     "Queues span proofs for the ranges in a CSV (start,end) or JSON ([{\"start\", \"end\"}]) file",
+					ArgsUsage: "<file>",
+					Flags:     []cli.Flag{flags.AdminRpcFlag},
+					Action:    proposer.ImportProofsCmd,
+				},
+				{
+					Name:      "state-at",
+					Usage:     "Reconstructs the proof request queue at a past time from the event log of a proposer DB",
+					ArgsUsage: "<proofs.db> <unix seconds or RFC 3339 time>",
+					Action:    proposer.StateAtCmd,
+				},
+				{
+					Name:      "export",
+					Usage:     "Exports the metadata of every proof request in a proposer DB, like their ranges, durations and failures, to a CSV or Parquet file",
+					ArgsUsage: "<proofs.db> <file.csv or file.parquet>",
+					Action:    proposer.ExportProofMetadataCmd,
+				},
+			},
+		},
 	}
 
 	err := app.Run(os.Args)
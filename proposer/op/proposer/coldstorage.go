@@ -0,0 +1,119 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+// coldStorageKey is the key under which a proof is archived in the cold tier.
+func (l *L2OutputSubmitter) coldStorageKey(req *ent.ProofRequest) string {
+	return fmt.Sprintf("%d/%s-%d-%d-%d.bin", l.Cfg.L2ChainID, req.Type, req.StartBlock, req.EndBlock, req.ID)
+}
+
+// ArchiveProofs moves proofs older than the hot window to the cold tier. Only proofs for blocks that have already been
+// proposed on-chain are archived, as they are never needed to build future AGG proofs. Proofs restored from the cold
+// tier are evicted from the DB again once they are older than the hot window.
+func (l *L2OutputSubmitter) ArchiveProofs(ctx context.Context) error {
+	if l.coldStore == nil {
+		return nil
+	}
+
+	latestBlockNumber, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get latest L2OO output: %w", err)
+	}
+	olderThan := uint64(time.Now().Add(-l.Cfg.ProofHotWindow).Unix())
+	evicted, err := l.db.EvictRestoredProofs(olderThan)
+	if err != nil {
+		return err
+	}
+	if evicted > 0 {
+		l.Log.Info("evicted restored proofs", "count", evicted)
+	}
+
+	proofs, err := l.db.GetProofsToArchive(latestBlockNumber.Uint64(), olderThan)
+	if err != nil {
+		return err
+	}
+
+	for _, req := range proofs {
+		key := l.coldStorageKey(req)
+		if err := l.coldStore.Put(ctx, key, req.Proof); err != nil {
+			return fmt.Errorf("failed to archive proof %d: %w", req.ID, err)
+		}
+		if err := l.db.ArchiveProof(req.ID, key); err != nil {
+			return err
+		}
+		l.Log.Info("archived proof to cold storage", "id", req.ID, "type", req.Type, "start", req.StartBlock, "end", req.EndBlock, "key", key)
+	}
+	return nil
+}
+
+// ProcessProofRetrievals restores proofs from the cold tier that have a pending retrieval request. Restores that
+// haven't completed yet are checked again on the next loop iteration.
+func (l *L2OutputSubmitter) ProcessProofRetrievals(ctx context.Context) error {
+	if l.coldStore == nil {
+		return nil
+	}
+
+	reqs, err := l.db.GetProofsPendingRetrieval()
+	if err != nil {
+		return err
+	}
+	for _, req := range reqs {
+		ready, err := l.coldStore.Restore(ctx, req.ColdStorageKey)
+		if err != nil {
+			return fmt.Errorf("failed to restore proof %d: %w", req.ID, err)
+		}
+		if !ready {
+			l.Log.Info("proof retrieval in progress", "id", req.ID, "key", req.ColdStorageKey)
+			continue
+		}
+		proof, err := l.coldStore.Get(ctx, req.ColdStorageKey)
+		if err != nil {
+			return fmt.Errorf("failed to read restored proof %d: %w", req.ID, err)
+		}
+		if err := l.db.RestoreProof(req.ID, proof); err != nil {
+			return err
+		}
+		l.Log.Info("restored proof from cold storage", "id", req.ID, "key", req.ColdStorageKey)
+	}
+	return nil
+}
+
+// RetrieveProof returns a proof for auditing. If the proof is in the cold tier and hasn't been restored, a retrieval is
+// requested and the caller should poll until the retrieval status is RESTORED.
+func (l *L2OutputSubmitter) RetrieveProof(ctx context.Context, id int) (rpc.ProofRetrieval, error) {
+	req, err := l.db.GetProofRequest(id)
+	if err != nil {
+		return rpc.ProofRetrieval{}, err
+	}
+
+	if req.StorageTier == proofrequest.StorageTierCOLD && req.RetrievalStatus == proofrequest.RetrievalStatusNONE {
+		if l.coldStore == nil {
+			return rpc.ProofRetrieval{}, fmt.Errorf("proof %d is in cold storage, but cold storage is not configured", id)
+		}
+		if err := l.db.RequestProofRetrieval(id); err != nil {
+			return rpc.ProofRetrieval{}, err
+		}
+		req.RetrievalStatus = proofrequest.RetrievalStatusPENDING
+	}
+	if err := l.db.LoadProof(req); err != nil {
+		return rpc.ProofRetrieval{}, err
+	}
+
+	return rpc.ProofRetrieval{
+		ID:              req.ID,
+		StorageTier:     req.StorageTier.String(),
+		RetrievalStatus: req.RetrievalStatus.String(),
+		Proof:           req.Proof,
+		IPFSCID:         req.IpfsCid,
+		ProverBackend:   req.ProverBackend,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package proposer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/coldstore"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+func TestArchiveAndRestoreProof(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	addCompletedSpanProofs(t, proofDB, [2]uint64{100, 200})
+	spans, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusCOMPLETE)
+	require.NoError(t, err)
+	id := spans[0].ID
+
+	store, err := coldstore.NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	l := newFakeL2OODriver(t, newFakeL2OO(300, 100), proofDB)
+	l.coldStore = store
+	ctx := context.Background()
+
+	// A negative hot window moves every proposed proof out of the hot tier.
+	l.Cfg.ProofHotWindow = -time.Hour
+	require.NoError(t, l.ArchiveProofs(ctx))
+	req, err := proofDB.GetProofRequest(id)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StorageTierCOLD, req.StorageTier)
+	require.Empty(t, req.Proof)
+	archived, err := store.Get(ctx, req.ColdStorageKey)
+	require.NoError(t, err)
+	require.Equal(t, []byte("proof"), archived)
+
+	retrieval, err := l.RetrieveProof(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.RetrievalStatusPENDING.String(), retrieval.RetrievalStatus)
+	require.Empty(t, retrieval.Proof)
+
+	require.NoError(t, l.ProcessProofRetrievals(ctx))
+	retrieval, err = l.RetrieveProof(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StorageTierCOLD.String(), retrieval.StorageTier)
+	require.Equal(t, proofrequest.RetrievalStatusRESTORED.String(), retrieval.RetrievalStatus)
+	require.Equal(t, []byte("proof"), retrieval.Proof)
+
+	// The restored proof isn't archived again, and is kept for the hot window.
+	l.Cfg.ProofHotWindow = time.Hour
+	require.NoError(t, l.ArchiveProofs(ctx))
+	req, err = proofDB.GetProofRequest(id)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.RetrievalStatusRESTORED, req.RetrievalStatus)
+	require.Equal(t, []byte("proof"), req.Proof)
+
+	// Once the hot window has passed, the restored proof is evicted, and can be retrieved again.
+	l.Cfg.ProofHotWindow = -time.Hour
+	require.NoError(t, l.ArchiveProofs(ctx))
+	req, err = proofDB.GetProofRequest(id)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StorageTierCOLD, req.StorageTier)
+	require.Equal(t, proofrequest.RetrievalStatusNONE, req.RetrievalStatus)
+	require.Empty(t, req.Proof)
+
+	retrieval, err = l.RetrieveProof(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.RetrievalStatusPENDING.String(), retrieval.RetrievalStatus)
+}
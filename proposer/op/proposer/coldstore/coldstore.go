@@ -0,0 +1,68 @@
+package coldstore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Store is a cold storage tier for historical proofs. It is modeled after archival object stores like S3 Glacier,
+// where objects must be restored before they can be read, and a restore can take a while to complete.
+type Store interface {
+	// Put archives the data under the given key.
+	Put(ctx context.Context, key string, data []byte) error
+	// Restore requests that the object under the given key be made readable. It returns true once the object is
+	// available, and may need to be called repeatedly until then.
+	Restore(ctx context.Context, key string) (bool, error)
+	// Get reads a restored object.
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// FileStore is a Store backed by a local (or network-mounted) directory. Objects are always immediately restorable.
+type FileStore struct {
+	dir string
+}
+
+var _ Store = (*FileStore)(nil)
+
+// NewFileStore creates a FileStore rooted at dir, creating the directory if it doesn't exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create cold storage directory: %w", err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (f *FileStore) path(key string) string {
+	return filepath.Join(f.dir, filepath.FromSlash(key))
+}
+
+func (f *FileStore) Put(_ context.Context, key string, data []byte) error {
+	path := f.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", key, err)
+	}
+
+	// Write to a temporary file first so a crash never leaves a partially written object behind.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", key, err)
+	}
+	return os.Rename(tmp, path)
+}
+
+func (f *FileStore) Restore(_ context.Context, key string) (bool, error) {
+	if _, err := os.Stat(f.path(key)); err != nil {
+		return false, fmt.Errorf("failed to find %s: %w", key, err)
+	}
+	return true, nil
+}
+
+func (f *FileStore) Get(_ context.Context, key string) ([]byte, error) {
+	data, err := os.ReadFile(f.path(key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", key, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,244 @@
+package coldstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3Config configures an S3Store.
+type S3Config struct {
+	// Endpoint is the URL of the S3 API, e.g. https://s3.us-east-1.amazonaws.com. Objects are addressed path-style, so
+	// S3-compatible stores work as well.
+	Endpoint string
+	Region   string
+	Bucket   string
+	// StorageClass is the storage class objects are archived with, e.g. GLACIER or DEEP_ARCHIVE.
+	StorageClass string
+	// RestoreDays is how many days a restored copy of an archived object stays readable.
+	RestoreDays int
+
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is only set for temporary credentials.
+	SessionToken string
+}
+
+// S3Store is a Store backed by an S3 bucket. Objects are archived in an archival storage class, and have to be
+// restored with a restore request before they can be read, which can take hours.
+type S3Store struct {
+	cfg    S3Config
+	client *http.Client
+}
+
+var _ Store = (*S3Store)(nil)
+
+// NewS3Store creates an S3Store for the bucket in cfg.
+func NewS3Store(cfg S3Config) (*S3Store, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("no S3 bucket configured")
+	}
+	if cfg.Region == "" {
+		return nil, fmt.Errorf("no S3 region configured")
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, fmt.Errorf("no S3 credentials configured")
+	}
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", cfg.Region)
+	}
+	if cfg.StorageClass == "" {
+		cfg.StorageClass = "GLACIER"
+	}
+	if cfg.RestoreDays <= 0 {
+		cfg.RestoreDays = 1
+	}
+	return &S3Store{cfg: cfg, client: &http.Client{Timeout: time.Minute}}, nil
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, key, "", data, map[string]string{"x-amz-storage-class": s.cfg.StorageClass})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return s3Error("put", key, resp)
+	}
+	return nil
+}
+
+// Restore checks whether the object is readable, and requests a restore if it isn't and no restore is in progress.
+func (s *S3Store) Restore(ctx context.Context, key string) (bool, error) {
+	resp, err := s.do(ctx, http.MethodHead, key, "", nil, nil)
+	if err != nil {
+		return false, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, s3Error("head", key, resp)
+	}
+
+	switch class := resp.Header.Get("x-amz-storage-class"); class {
+	case "GLACIER", "DEEP_ARCHIVE":
+	default:
+		// Objects in other storage classes are always readable.
+		return true, nil
+	}
+	restore := resp.Header.Get("x-amz-restore")
+	if strings.Contains(restore, `ongoing-request="false"`) {
+		return true, nil
+	}
+	if strings.Contains(restore, `ongoing-request="true"`) {
+		return false, nil
+	}
+
+	body := fmt.Sprintf("<RestoreRequest><Days>%d</Days><GlacierJobParameters><Tier>Standard</Tier></GlacierJobParameters></RestoreRequest>", s.cfg.RestoreDays)
+	resp, err = s.do(ctx, http.MethodPost, key, "restore", []byte(body), nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// The object was restored in the meantime.
+		return true, nil
+	case http.StatusAccepted, http.StatusConflict:
+		// The restore was started, or is already in progress.
+		return false, nil
+	default:
+		return false, s3Error("restore", key, resp)
+	}
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, s3Error("get", key, resp)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", key, err)
+	}
+	return data, nil
+}
+
+// do sends a signed request for the object under key.
+func (s *S3Store) do(ctx context.Context, method, key, query string, body []byte, headers map[string]string) (*http.Response, error) {
+	u, err := url.Parse(strings.TrimSuffix(s.cfg.Endpoint, "/") + "/" + s.cfg.Bucket + "/" + key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 object URL: %w", err)
+	}
+	u.RawQuery = query
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if s.cfg.SessionToken != "" {
+		req.Header.Set("x-amz-security-token", s.cfg.SessionToken)
+	}
+	signV4(req, body, s.cfg.AccessKeyID, s.cfg.SecretAccessKey, s.cfg.Region, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s request for %s: %w", method, key, err)
+	}
+	return resp, nil
+}
+
+func s3Error(op, key string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("S3 %s of %s failed with status %d: %s", op, key, resp.StatusCode, body)
+}
+
+// signV4 signs an S3 request with AWS Signature Version 4, signing the host and all headers set on the request.
+func signV4(req *http.Request, body []byte, accessKeyID, secretAccessKey, region string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := date + "/" + region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", accessKeyID, scope, signedHeaders, signature))
+}
+
+// canonicalQuery encodes the query parameters sorted by name, with empty values kept as "name=".
+func canonicalQuery(values url.Values) string {
+	names := make([]string, 0, len(values))
+	for k := range values {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var parts []string
+	for _, k := range names {
+		for _, v := range values[k] {
+			parts = append(parts, uriEncode(k)+"="+uriEncode(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode percent-encodes everything but unreserved characters, as SigV4 requires.
+func uriEncode(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,92 @@
+package proposer
+
+import (
+	"sort"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// compactionFailureWindow is how long a failed span proof prevents its range from being merged into. Splits are
+// triggered by failures, so this keeps compaction from immediately undoing a split, while still merging ranges that
+// have since become provable, e.g. after a prover upgrade.
+const compactionFailureWindow = 24 * time.Hour
+
+// CompactSpanProofs merges runs of adjacent unrequested span proofs into span proofs of at most
+// MaxBlockRangePerSpanProof blocks. Splitting failed requests leaves behind many small spans, and proving fewer, larger
+// ranges reduces the per-proof overhead and prover network fees.
+func (l *L2OutputSubmitter) CompactSpanProofs() error {
+	unreqs, err := l.db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	if err != nil {
+		return err
+	}
+	failed, err := l.db.GetFailedSpanProofsSince(uint64(time.Now().Add(-compactionFailureWindow).Unix()))
+	if err != nil {
+		return err
+	}
+	// Never merge the request that is dispatched next, since it may be requested concurrently with the merge.
+	next, err := l.db.GetNextUnrequestedProof()
+	if err != nil {
+		return err
+	}
+
+	var spans []*ent.ProofRequest
+	for _, req := range unreqs {
+		if req.Type == proofrequest.TypeSPAN && (next == nil || req.ID != next.ID) {
+			spans = append(spans, req)
+		}
+	}
+
+	for _, group := range planSpanCompaction(spans, failed, l.settings().MaxBlockRangePerSpanProof) {
+		start, end := group[0].StartBlock, group[len(group)-1].EndBlock
+		ids := make([]int, len(group))
+		for i, req := range group {
+			ids[i] = req.ID
+		}
+		if err := l.db.MergeUnrequestedSpanProofs(ids, start, end, l.proofTimeout(proofrequest.TypeSPAN, start, end)); err != nil {
+			l.Log.Warn("failed to merge span proofs", "start", start, "end", end, "err", err)
+			continue
+		}
+		l.Log.Info("merged unrequested span proofs", "start", start, "end", end, "spans", len(group))
+	}
+	return nil
+}
+
+// planSpanCompaction groups runs of adjacent spans into merged ranges of at most maxRange blocks. A group isn't
+// extended over a range that would contain one of the failed spans, since the merged range would likely fail the same
+// way. Only groups of at least two spans are returned.
+func planSpanCompaction(spans, failed []*ent.ProofRequest, maxRange uint64) [][]*ent.ProofRequest {
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].StartBlock < spans[j].StartBlock
+	})
+
+	containsFailure := func(start, end uint64) bool {
+		for _, f := range failed {
+			if f.StartBlock >= start && f.EndBlock <= end {
+				return true
+			}
+		}
+		return false
+	}
+
+	var groups [][]*ent.ProofRequest
+	var group []*ent.ProofRequest
+	flush := func() {
+		if len(group) > 1 {
+			groups = append(groups, group)
+		}
+		group = nil
+	}
+	for _, span := range spans {
+		if len(group) > 0 {
+			start := group[0].StartBlock
+			if group[len(group)-1].EndBlock != span.StartBlock || span.EndBlock-start > maxRange || containsFailure(start, span.EndBlock) {
+				flush()
+			}
+		}
+		group = append(group, span)
+	}
+	flush()
+	return groups
+}
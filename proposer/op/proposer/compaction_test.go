@@ -0,0 +1,30 @@
+package proposer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+)
+
+func TestPlanSpanCompaction(t *testing.T) {
+	span := func(id int, start, end uint64) *ent.ProofRequest {
+		return &ent.ProofRequest{ID: id, StartBlock: start, EndBlock: end}
+	}
+	spans := []*ent.ProofRequest{
+		span(1, 100, 125), span(2, 125, 150), span(3, 150, 200),
+		// Not adjacent to the previous span.
+		span(4, 210, 230), span(5, 230, 250),
+		// Merging these would recreate a range that failed recently.
+		span(6, 300, 320), span(7, 320, 340),
+		// Merging these would exceed the max range.
+		span(8, 400, 450), span(9, 450, 520),
+	}
+	failed := []*ent.ProofRequest{span(10, 300, 340)}
+
+	groups := planSpanCompaction(spans, failed, 100)
+	require.Len(t, groups, 2)
+	require.Equal(t, []*ent.ProofRequest{spans[0], spans[1], spans[2]}, groups[0])
+	require.Equal(t, []*ent.ProofRequest{spans[3], spans[4]}, groups[1])
+}
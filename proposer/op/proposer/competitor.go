@@ -0,0 +1,153 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// competingOutputCheckInterval is how often the L2OO is checked for an output proposed by a competing proposer while
+// the proposer's own proposal transaction is being sent.
+const competingOutputCheckInterval = 5 * time.Second
+
+// errCompetingOutput cancels the proposer's own proposal once a competing proposer's output landed first.
+var errCompetingOutput = errors.New("a competing proposer proposed an output first")
+
+// DetectCompetingOutputs checks the outputs proposed on the L2OO since the last call for outputs proposed by other
+// proposers. The unrequested AGG proof requests that such an output made unnecessary are marked as satisfied by it,
+// and the proposer yields to competing proposals from then on.
+func (l *L2OutputSubmitter) DetectCompetingOutputs(ctx context.Context) error {
+	// Dispute games for the same range don't conflict with each other.
+	if l.Cfg.DisputeGameFactoryAddr != nil {
+		return nil
+	}
+
+	l1Head, err := l.L1Client.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get L1 head: %w", err)
+	}
+	// Outputs proposed before the proposer started are already reflected in the L2OO's latest block.
+	if l.competitorFromL1Block == 0 {
+		l.competitorFromL1Block = l1Head
+	}
+	if l1Head < l.competitorFromL1Block {
+		return nil
+	}
+
+	iter, err := l.l2ooFilterer.FilterOutputProposed(&bind.FilterOpts{
+		Start:   l.competitorFromL1Block,
+		End:     &l1Head,
+		Context: ctx,
+	}, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to filter OutputProposed events: %w", err)
+	}
+	defer iter.Close()
+
+	for iter.Next() {
+		event := iter.Event
+		proposer, err := l.txSender(ctx, event.Raw.TxHash)
+		if err != nil {
+			return err
+		}
+		if proposer == l.Txmgr.From() {
+			continue
+		}
+
+		l.competitorSeen = true
+		l.Metr.RecordError("competing_output", 1)
+		n, err := l.db.MarkAggRequestsSatisfied(event.L2BlockNumber.Uint64(), event.Raw.TxHash.Hex())
+		if err != nil {
+			return err
+		}
+		l.Log.Warn("Output proposed by a competing proposer", "proposer", proposer, "l2BlockNumber", event.L2BlockNumber, "tx", event.Raw.TxHash, "satisfiedAggRequests", n)
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("failed to iterate OutputProposed events: %w", err)
+	}
+
+	l.competitorFromL1Block = l1Head + 1
+	return nil
+}
+
+// competitionPossible returns whether another proposer can propose outputs on the L2OO, either because proposing is
+// permissionless, or because another proposer has been seen proposing.
+func (l *L2OutputSubmitter) competitionPossible(ctx context.Context) (bool, error) {
+	if l.Cfg.DisputeGameFactoryAddr != nil {
+		return false, nil
+	}
+	if l.competitorSeen {
+		return true, nil
+	}
+	permissionless, err := l.l2ooContract.ApprovedProposers(&bind.CallOpts{Context: ctx}, common.Address{})
+	if err != nil {
+		return false, fmt.Errorf("failed to check if proposing is permissionless: %w", err)
+	}
+	return permissionless, nil
+}
+
+// pendingCompetingProposal returns the hash of a proposal transaction from another proposer that is pending in the L1
+// mempool, if there is one. The proposer's own proposal would revert once it is mined, since an AGG proof must start at
+// the latest proposed block.
+func (l *L2OutputSubmitter) pendingCompetingProposal(ctx context.Context) (common.Hash, bool, error) {
+	pending, err := l.L1Client.BlockByNumber(ctx, big.NewInt(int64(rpc.PendingBlockNumber)))
+	if err != nil {
+		return common.Hash{}, false, fmt.Errorf("failed to get pending L1 block: %w", err)
+	}
+	selector := l.l2ooABI.Methods["proposeL2Output"].ID
+	for _, tx := range pending.Transactions() {
+		if tx.To() == nil || *tx.To() != *l.Cfg.L2OutputOracleAddr || !bytes.HasPrefix(tx.Data(), selector) {
+			continue
+		}
+		sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			return common.Hash{}, false, fmt.Errorf("failed to recover sender of transaction %s: %w", tx.Hash(), err)
+		}
+		if sender != l.Txmgr.From() {
+			return tx.Hash(), true, nil
+		}
+	}
+	return common.Hash{}, false, nil
+}
+
+// cancelOnCompetingOutput cancels ctx with errCompetingOutput once the L2OO's latest block moves from
+// latestBlockNumber to a block other than ownBlockNumber, i.e. once an output other than the proposer's own landed.
+// The returned function stops watching.
+func (l *L2OutputSubmitter) cancelOnCompetingOutput(ctx context.Context, latestBlockNumber, ownBlockNumber uint64) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(competingOutputCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				latest, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+				if err != nil {
+					l.Log.Warn("failed to check for a competing output", "err", err)
+					continue
+				}
+				if latest.Uint64() != latestBlockNumber && latest.Uint64() != ownBlockNumber {
+					cancel(errCompetingOutput)
+					return
+				}
+			}
+		}
+	}()
+	return ctx, func() {
+		close(done)
+		cancel(nil)
+	}
+}
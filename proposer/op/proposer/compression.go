@@ -0,0 +1,52 @@
+package proposer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"strings"
+)
+
+// recordRequestEncodings records whether the server accepts gzip-compressed request bodies, which servers advertise
+// with an Accept-Encoding header on their responses (RFC 7694).
+func (l *L2OutputSubmitter) recordRequestEncodings(serverUrl string, header http.Header) {
+	l.gzipBackends.Store(serverUrl, acceptsGzip(header))
+}
+
+// acceptsGzip returns whether the Accept-Encoding header of a response lists gzip.
+func acceptsGzip(header http.Header) bool {
+	for _, value := range header.Values("Accept-Encoding") {
+		for _, coding := range strings.Split(value, ",") {
+			name, params, _ := strings.Cut(coding, ";")
+			if strings.EqualFold(strings.TrimSpace(name), "gzip") && strings.ReplaceAll(strings.TrimSpace(params), " ", "") != "q=0" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// compressesProofRequest returns whether the body of a proof request to the server is gzip-compressed. Bodies are
+// compressed once they reach PROOF_REQUEST_GZIP_THRESHOLD, e.g. AGG proof requests that embed all of their
+// subproofs, and only for servers that advertised that they accept compressed bodies.
+func (l *L2OutputSubmitter) compressesProofRequest(serverUrl string, body []byte) bool {
+	threshold := l.Cfg.ProofRequestGzipThreshold
+	if threshold == 0 || uint64(len(body)) < threshold {
+		return false
+	}
+	accepts, ok := l.gzipBackends.Load(serverUrl)
+	return ok && accepts.(bool)
+}
+
+// gzipBody compresses a request body with gzip.
+func gzipBody(body []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(body); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"log"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum-optimism/optimism/op-service/dial"
@@ -15,6 +17,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/succinctlabs/op-succinct-go/proposer/analytics"
 	"github.com/succinctlabs/op-succinct-go/proposer/flags"
 )
 
@@ -84,14 +87,234 @@ type CLIConfig struct {
 	WitnessGenTimeout uint64
 	// The Chain ID of the L2 chain.
 	L2ChainID uint64
-	// The maximum amount of time we will spend waiting for a proof before giving up and trying again.
-	ProofTimeout uint64
-	// The URL of the OP Succinct server to request proofs from.
-	OPSuccinctServerUrl string
+	// The maximum amount of time we will spend waiting for a span proof before giving up and trying again.
+	SpanProofTimeout uint64
+	// The additional time a span proof is given for each block in its range.
+	SpanProofTimeoutPerBlock uint64
+	// The maximum amount of time we will spend waiting for an agg proof before giving up and trying again.
+	AggProofTimeout uint64
+	// The URLs of the OP Succinct servers to request proofs from. Span proof requests are balanced across them, and AGG
+	// proof requests are sent to the first one.
+	OPSuccinctServerUrls []string
 	// The maximum proofs that can be requested from the server concurrently.
 	MaxConcurrentProofRequests uint64
 	// Mock is a flag to use the mock OP Succinct server.
 	Mock bool
+	// DifferentialTest re-runs fulfilled span proofs through the mock pipeline and compares their public values.
+	DifferentialTest bool
+	// PipelineSpecPath is the path to a declarative YAML pipeline spec that is continuously reconciled.
+	PipelineSpecPath string
+	// ColdStorageDir is the directory of the cold storage tier for historical proofs.
+	ColdStorageDir string
+	// ColdStorageS3Bucket is the S3 bucket of the cold storage tier for historical proofs.
+	ColdStorageS3Bucket string
+	// ColdStorageS3Region is the region of ColdStorageS3Bucket.
+	ColdStorageS3Region string
+	// ColdStorageS3Endpoint overrides the S3 API endpoint, e.g. for S3-compatible stores.
+	ColdStorageS3Endpoint string
+	// ColdStorageS3StorageClass is the storage class proofs are archived with in ColdStorageS3Bucket.
+	ColdStorageS3StorageClass string
+	// ProofHotWindow is how long proposed proofs stay in the DB before they are moved to cold storage.
+	ProofHotWindow time.Duration
+	// WatchOnly disables proposing, and verifies the outputs proposed by WatchProposerAddress instead.
+	WatchOnly bool
+	// WatchProposerAddress is the address of the proposer whose outputs are verified in watch-only mode.
+	WatchProposerAddress string
+	// WatchReproveSampleRate is the fraction of the watched proposer's ranges that are re-proven in watch-only mode.
+	WatchReproveSampleRate float64
+	// AltDAServerUrl is the URL of the Alt-DA server that batch data commitments are resolved against.
+	AltDAServerUrl string
+	// AltDACommitmentType is the commitment type of the chain's Alt-DA config. Empty if the chain doesn't use Alt-DA.
+	AltDACommitmentType string
+	// BatchInboxAddress is the address that the chain's batches are posted to on L1.
+	BatchInboxAddress common.Address
+	// AlignToChannels ends span proofs on batcher channel boundaries where possible.
+	AlignToChannels bool
+	// MaxUnrequestedSpanProofs is the maximum number of unrequested span proofs that can be queued after a bulk import.
+	MaxUnrequestedSpanProofs uint64
+	// WitnessGenRetries is the number of times a proof request to the server is retried after a transient failure.
+	WitnessGenRetries uint64
+	// WitnessGenRetryBackoff is the time to wait before the first retry of a proof request to the server.
+	WitnessGenRetryBackoff time.Duration
+	// SpanCompactionInterval is the interval at which adjacent unrequested span proofs are merged. Disabled if 0.
+	SpanCompactionInterval time.Duration
+	// Telemetry opts in to reporting anonymized aggregate pipeline statistics to TelemetryEndpoint every
+	// TelemetryInterval.
+	Telemetry         bool
+	TelemetryEndpoint string
+	TelemetryInterval time.Duration
+	// ConfigContractAddress is the address of the OPSuccinctProposerConfig contract that proving parameters are read
+	// from. Empty if the parameters are only configured locally.
+	ConfigContractAddress string
+	// RangePlanner is how new ranges are split into span proofs, either "greedy" or "cost".
+	RangePlanner string
+	// L2EthRpc is the HTTP provider URL for the L2 execution node, which the cost range planner reads gas usage from.
+	L2EthRpc string
+	// SpanOverheadCycles is the fixed cost of a span proof request in cycles, used by the cost range planner.
+	SpanOverheadCycles uint64
+	// FastPathMaxBlocks is the max size of the span proofs that are merged when they are requested. Disabled if 0.
+	FastPathMaxBlocks uint64
+	// IPFSApiUrl is the URL of the RPC API of the IPFS node that completed AGG proofs are pinned to. Empty if disabled.
+	IPFSApiUrl string
+	// ProverFallbackServerUrls are the OP Succinct servers that proof requests are failed over to, in order.
+	ProverFallbackServerUrls []string
+	// ProverFailoverAttempts is the number of unclaimed attempts of a range on a backend before it's failed over.
+	ProverFailoverAttempts uint64
+	// ProverUnreachableTimeout is how long a backend can be unreachable before its requests are failed over.
+	ProverUnreachableTimeout time.Duration
+	// ProofStatusLongPoll is how long a status request waits for the status of a proof request to change, or 0 to
+	// only poll the status in the loop.
+	ProofStatusLongPoll time.Duration
+	// InstanceID identifies this proposer instance on the proof requests it creates, requests and completes.
+	InstanceID string
+	// AdminAddr is the address the admin HTTP API is served on, or empty to not serve it.
+	AdminAddr string
+	// AdminToken is the bearer token that requests to the admin HTTP API are authenticated with.
+	AdminToken string
+	// PreCheckpointLead is how long before the span proofs of the next AGG proof are forecast to complete that an
+	// L1 block hash is checkpointed for it, or 0 to only checkpoint when the AGG proof is requested.
+	PreCheckpointLead time.Duration
+	// PreCheckpointMaxAge is how long an L1 block hash checkpointed ahead of time can be used for the next AGG proof.
+	PreCheckpointMaxAge time.Duration
+	// DbConnectionString is the connection string of a Postgres proof DB, used instead of the SQLite DB at DbPath.
+	DbConnectionString string
+	// SchedulingPolicy is how queued proofs are scheduled, see SchedulingPolicyStartBlock and SchedulingPolicyPreempt.
+	SchedulingPolicy string
+	// MaxProofRetries is the maximum number of retries of a proof request for the same range before it is dead-lettered.
+	// 0 retries without limit.
+	MaxProofRetries uint64
+	// ProofRetryBackoff is the time to wait before re-requesting a failed proof request, doubled after every retry.
+	ProofRetryBackoff time.Duration
+	// NonceConflictAction is what to do when another sender uses the proposer's account, see NonceConflictAlert and
+	// NonceConflictWait.
+	NonceConflictAction string
+	// ProofRequestGzipThreshold is the size in bytes from which proof request bodies are gzip-compressed. 0
+	// disables compression.
+	ProofRequestGzipThreshold uint64
+	// MinSpanProofBlocks is the minimum number of blocks of the span proofs that a failing span proof is split into.
+	MinSpanProofBlocks uint64
+	// AggSubproofsByReference sends the subproofs of AGG proof requests by their prover network request IDs, which the
+	// server fetches them with, instead of embedding the proofs in the request.
+	AggSubproofsByReference bool
+	// ProverNetworkRpcUrl is the RPC URL of the SP1 prover network that proof statuses are polled from directly, instead
+	// of through the OP Succinct server. Empty polls through the server.
+	ProverNetworkRpcUrl string
+	// BlockedRanges are the block ranges, as start-end, that span proofs are never requested for. Span proof requests that
+	// overlap them are parked in the BLOCKED status.
+	BlockedRanges []string
+	// ProofStoreDir is the directory that the bytes of fulfilled proofs are written to instead of the DB.
+	ProofStoreDir string
+	// ProofStoreS3Bucket is the S3 bucket that the bytes of fulfilled proofs are written to instead of the DB.
+	ProofStoreS3Bucket string
+	// ProofStoreS3Region is the region of ProofStoreS3Bucket.
+	ProofStoreS3Region string
+	// ProofStoreS3Endpoint overrides the S3 API endpoint, e.g. for GCS or other S3-compatible stores.
+	ProofStoreS3Endpoint string
+	// DoubleCheckServerUrl is the URL of a second OP Succinct server that a sample of the fulfilled span proofs is
+	// re-executed on.
+	DoubleCheckServerUrl string
+	// DoubleCheckSampleRate is the fraction of the fulfilled span proofs that are re-executed on DoubleCheckServerUrl.
+	DoubleCheckSampleRate float64
+	// TracingEndpoint is the OTLP/HTTP endpoint of an OpenTelemetry collector that the spans of the proof pipeline are
+	// exported to.
+	TracingEndpoint string
+	// SLAMaxUnprovenAge is the age of the oldest unproven L2 block past which the proof requests of the next output are
+	// escalated. Disabled if 0.
+	SLAMaxUnprovenAge time.Duration
+	// SLAPremiumServerUrl is the server that escalated proof requests are sent to, if set.
+	SLAPremiumServerUrl string
+	// MetadataExportPath is the .csv or .parquet file that the metadata of all proof requests is exported to.
+	MetadataExportPath string
+	// MetadataExportInterval is how often the metadata of all proof requests is exported.
+	MetadataExportInterval time.Duration
+	// CircuitBreakerThreshold is the number of consecutive failed requests to a server after which its circuit breaker trips. Disabled if 0.
+	CircuitBreakerThreshold uint64
+	// CircuitBreakerBackoff is how long a tripped circuit breaker waits before probing its server.
+	CircuitBreakerBackoff time.Duration
+	// StrictMode fails startup if the L2OO's verifier can't be checked, or doesn't match the mock mode.
+	StrictMode bool
+	// AggBoundaryPolicy is where AGG proofs end, see AggBoundaryExtend and AggBoundaryAlign.
+	AggBoundaryPolicy string
+	// ShutdownGracePeriod is how long the in-flight proof requests are drained on shutdown.
+	ShutdownGracePeriod time.Duration
+	// WitnessGenCapacityInterval is how often the witness generation concurrency is negotiated with the servers.
+	WitnessGenCapacityInterval time.Duration
+	// ReplicateFrom is the URL of the admin HTTP API of the active proposer that this warm standby replicates, or empty
+	// if the proposer is active.
+	ReplicateFrom string
+	// ReplicateToken is the admin token of the active proposer that this warm standby replicates.
+	ReplicateToken string
+	// CostBudget is the most PROVE to spend on the prover network per CostBudgetPeriod, as a decimal, or empty if the
+	// spending isn't limited.
+	CostBudget string
+	// CostBudgetPeriod is the length of the sliding window that the spending is summed over.
+	CostBudgetPeriod time.Duration
+	// MaxSubmissionBaseFeeGwei is the L1 base fee in gwei above which proposals are delayed, or 0 if they aren't.
+	MaxSubmissionBaseFeeGwei uint64
+	// MaxSubmissionDelay bounds how long a proposal is delayed because of the L1 base fee, since its AGG proof was
+	// fulfilled.
+	MaxSubmissionDelay time.Duration
+	// SubmissionMaxReverts, SubmissionMaxResends and SubmissionMaxNonceResyncs bound the failed attempts at proposing
+	// the output of a completed AGG proof, by how they failed, before the AGG proof is dead-lettered. 0 doesn't bound them.
+	SubmissionMaxReverts      uint64
+	SubmissionMaxResends      uint64
+	SubmissionMaxNonceResyncs uint64
+	// L1TxTimeout bounds how long a checkpoint or proposal transaction is rebroadcast with bumped fees until it's mined.
+	L1TxTimeout time.Duration
+	// DbReplicaConnectionString is the connection string of a read replica of the Postgres proof DB, which analytical
+	// queries read from, or empty to read them from the primary.
+	DbReplicaConnectionString string
+	// WitnessGenLatencyTarget is the latency of witness generation requests that the witness generation concurrency is
+	// adjusted to, or 0 to only back off when a server is overloaded.
+	WitnessGenLatencyTarget time.Duration
+	// WitnessGenMaxFailureRate is the share of failed witness generation requests above which the latency controller
+	// halves the concurrency.
+	WitnessGenMaxFailureRate float64
+	// PrivateTxRpc is the RPC of a private relay that the L1 transactions are sent through, or empty to send them to the
+	// public mempool.
+	PrivateTxRpc string
+	// PrivateTxTimeout is how long a transaction sent through the private relay can take to be mined before it falls
+	// back to the public mempool.
+	PrivateTxTimeout time.Duration
+	// SignerMode is how the L1 transactions are signed, see SignerModeLocal and SignerModeRemote, or empty to pick the
+	// signer from the tx manager's flags.
+	SignerMode string
+	// VerificationServiceUrl is the URL of the verification service that must accept an AGG proof before it's
+	// proposed, or empty to propose without it.
+	VerificationServiceUrl string
+	// VerificationServiceToken is the bearer token of the verification service.
+	VerificationServiceToken string
+	// SafeAddress is the Gnosis Safe that output proposals are proposed to, or empty to send them from the proposer's
+	// account.
+	SafeAddress string
+	// SafeTxServiceUrl is the URL of the Safe Transaction Service that Safe transactions are proposed through.
+	SafeTxServiceUrl string
+	// SafeTxServiceToken is the API key of the Safe Transaction Service.
+	SafeTxServiceToken string
+	// L1RpcFallbackUrls are the L1 RPC endpoints that L1 requests fail over to, in order.
+	L1RpcFallbackUrls []string
+	// RollupRpcFallbackUrls are the rollup node RPC endpoints that rollup node requests fail over to, in order.
+	RollupRpcFallbackUrls []string
+	// RpcMaxLagBlocks is how many blocks an RPC endpoint can lag behind the others before requests fail over from it.
+	RpcMaxLagBlocks uint64
+	// RpcHealthCheckInterval is how often the heads of RPC endpoints with fallbacks are checked.
+	RpcHealthCheckInterval time.Duration
+	// DowntimeThreshold is the shortest gap between proposer runs that is recorded as downtime.
+	DowntimeThreshold time.Duration
+	// ReorgCheckInterval is the interval at which the blocks that unproposed proofs were requested for are checked for reorgs, or 0 if they aren't.
+	ReorgCheckInterval time.Duration
+	// CheckpointConfirmations is the number of confirmations of the L1 block whose hash is checkpointed for AGG proofs.
+	CheckpointConfirmations uint64
+	// CheckpointFinalized is whether the hash of the finalized L1 block is checkpointed for AGG proofs, instead of one CheckpointConfirmations deep.
+	CheckpointFinalized bool
+	// WitnessGenProbeInterval is the interval at which every server executes a tiny range that was already proposed as a health probe, or 0 if they aren't probed.
+	WitnessGenProbeInterval time.Duration
+	// LeaderElection is whether only the elected leader of the instances sharing the Postgres DB runs the pipeline, while the others stand by.
+	LeaderElection bool
+	// Follower is whether the proposer only follows the DB, polling the statuses of PROVING requests, without writing to the DB, requesting proofs or sending transactions.
+	Follower bool
+	// ForecastPath is the file that the proof throughput forecast is persisted to. If empty, it's next to the SQLite DB, or not persisted with a Postgres DB.
+	ForecastPath string
 }
 
 func (c *CLIConfig) Check() error {
@@ -107,11 +330,235 @@ func (c *CLIConfig) Check() error {
 	if err := c.TxMgrConfig.Check(); err != nil {
 		return err
 	}
+	if !c.WatchOnly {
+		if err := checkSignerMode(c.SignerMode, c.TxMgrConfig); err != nil {
+			return err
+		}
+	}
 
 	if c.L2OOAddress == "" && c.DGFAddress == "" {
 		return errors.New("one of the `DisputeGameFactory` or `L2OutputOracle` address must be provided")
 	}
 
+	if len(c.OPSuccinctServerUrls) == 0 {
+		return errors.New("at least one OP Succinct server URL must be provided")
+	}
+	servers := map[string]bool{}
+	for _, server := range c.OPSuccinctServerUrls {
+		if servers[server] {
+			return fmt.Errorf("the OP Succinct server URL %q is listed more than once", server)
+		}
+		servers[server] = true
+	}
+
+	if c.PipelineSpecPath != "" {
+		spec, _, err := LoadPipelineSpec(c.PipelineSpecPath)
+		if err != nil {
+			return err
+		}
+		if err := spec.Check(c.L2ChainID); err != nil {
+			return fmt.Errorf("invalid pipeline spec: %w", err)
+		}
+	}
+
+	if c.ConfigContractAddress != "" && !common.IsHexAddress(c.ConfigContractAddress) {
+		return fmt.Errorf("invalid config contract address %q", c.ConfigContractAddress)
+	}
+
+	switch c.RangePlanner {
+	case RangePlannerGreedy:
+	case RangePlannerCost:
+		if c.L2EthRpc == "" {
+			return errors.New("the cost range planner requires the L2 execution node RPC")
+		}
+		if c.AlignToChannels {
+			return errors.New("the cost range planner can't be combined with aligning spans to channels")
+		}
+	default:
+		return fmt.Errorf("unknown range planner %q, must be %q or %q", c.RangePlanner, RangePlannerGreedy, RangePlannerCost)
+	}
+
+	if c.SchedulingPolicy != SchedulingPolicyStartBlock && c.SchedulingPolicy != SchedulingPolicyPreempt {
+		return fmt.Errorf("unknown scheduling policy %q, must be %q or %q", c.SchedulingPolicy, SchedulingPolicyStartBlock, SchedulingPolicyPreempt)
+	}
+	if c.AggBoundaryPolicy != AggBoundaryExtend && c.AggBoundaryPolicy != AggBoundaryAlign {
+		return fmt.Errorf("unknown AGG boundary policy %q, must be %q or %q", c.AggBoundaryPolicy, AggBoundaryExtend, AggBoundaryAlign)
+	}
+	if c.SLAPremiumServerUrl != "" && c.SLAMaxUnprovenAge <= 0 {
+		return errors.New("the SLA premium server requires a max unproven age to escalate proof requests at")
+	}
+	if c.WitnessGenCapacityInterval < 0 {
+		return errors.New("the witness generation capacity interval must not be negative")
+	}
+	if c.ReplicateFrom != "" && c.ReplicateToken == "" {
+		return errors.New("replicating an active proposer requires its admin token")
+	}
+	if c.ReplicateFrom != "" && c.DbConnectionString != "" {
+		return errors.New("a warm standby can't replicate into a Postgres DB, it can share the active proposer's instead")
+	}
+	if c.WitnessGenLatencyTarget < 0 {
+		return errors.New("the witness generation latency target must not be negative")
+	}
+	if c.WitnessGenMaxFailureRate < 0 || c.WitnessGenMaxFailureRate > 1 {
+		return errors.New("the witness generation max failure rate must be between 0 and 1")
+	}
+	if c.PrivateTxRpc != "" && (c.PrivateTxTimeout <= 0 || c.PrivateTxTimeout >= c.L1TxTimeout) {
+		return errors.New("the private relay timeout must be positive and less than the L1 transaction timeout, so transactions can fall back to the public mempool")
+	}
+	if c.SafeAddress != "" {
+		if !common.IsHexAddress(c.SafeAddress) {
+			return fmt.Errorf("invalid Safe address %q", c.SafeAddress)
+		}
+		if c.SafeTxServiceUrl == "" {
+			return errors.New("proposing to a Safe requires the URL of the Safe Transaction Service")
+		}
+		if c.TxMgrConfig.PrivateKey == "" {
+			return errors.New("proposing to a Safe requires a private key, which Safe transactions are signed with, and which must be an owner or a delegate of the Safe")
+		}
+	}
+	if len(c.L1RpcFallbackUrls) > 0 || len(c.RollupRpcFallbackUrls) > 0 {
+		if c.RpcHealthCheckInterval <= 0 {
+			return errors.New("the RPC health check interval must be positive")
+		}
+		if len(c.RollupRpcFallbackUrls) > 0 && strings.Contains(c.RollupRpc, ",") {
+			return errors.New("rollup RPC fallback URLs can't be combined with a comma-separated list of rollup RPCs, which follows the active sequencer instead")
+		}
+	}
+	// Requests are failed over per HTTP request, so RPC endpoints with fallbacks have to be HTTP endpoints.
+	if len(c.L1RpcFallbackUrls) > 0 {
+		if err := checkHTTPEndpoints(append([]string{c.L1EthRpc}, c.L1RpcFallbackUrls...)); err != nil {
+			return fmt.Errorf("invalid L1 RPC with fallbacks: %w", err)
+		}
+	}
+	if len(c.RollupRpcFallbackUrls) > 0 {
+		if err := checkHTTPEndpoints(append([]string{c.RollupRpc}, c.RollupRpcFallbackUrls...)); err != nil {
+			return fmt.Errorf("invalid rollup RPC with fallbacks: %w", err)
+		}
+	}
+	if c.DowntimeThreshold <= 0 {
+		return errors.New("the downtime threshold must be positive")
+	}
+	// The L2OO can only checkpoint the hashes of the last BLOCKHASH_WINDOW L1 blocks.
+	if c.CheckpointConfirmations >= BLOCKHASH_WINDOW {
+		return fmt.Errorf("the checkpoint confirmations must be less than %d", BLOCKHASH_WINDOW)
+	}
+	if c.Follower {
+		if c.WatchOnly || c.ReplicateFrom != "" || c.LeaderElection {
+			return errors.New("a follower can't be combined with watch-only mode, replication or leader election")
+		}
+		// InitDB deletes the SQLite DB unless it's cached, which would leave the follower nothing to follow.
+		if c.DbConnectionString == "" && !c.UseCachedDb {
+			return errors.New("a follower follows an existing DB: set a Postgres DB connection string, or use the cached SQLite DB")
+		}
+	}
+	if c.LeaderElection && c.DbConnectionString == "" {
+		return errors.New("leader election requires a Postgres DB connection string, instances can't share the SQLite DB")
+	}
+	if c.DbReplicaConnectionString != "" && c.DbConnectionString == "" {
+		return errors.New("a DB read replica requires a Postgres DB connection string, the SQLite DB has no replicas")
+	}
+	if c.CostBudget != "" {
+		if _, err := parseProveAmount(c.CostBudget); err != nil {
+			return fmt.Errorf("invalid cost budget: %w", err)
+		}
+		if c.CostBudgetPeriod <= 0 {
+			return errors.New("the cost budget period must be positive")
+		}
+	}
+	if c.MaxSubmissionBaseFeeGwei > 0 && c.MaxSubmissionDelay <= 0 {
+		return errors.New("the max submission delay must be positive")
+	}
+	if c.L1TxTimeout <= 0 {
+		return errors.New("the L1 transaction timeout must be positive")
+	}
+	if c.ShutdownGracePeriod < 0 {
+		return errors.New("the shutdown grace period must not be negative")
+	}
+	if c.CircuitBreakerThreshold > 0 && c.CircuitBreakerBackoff <= 0 {
+		return errors.New("the circuit breaker backoff must be positive")
+	}
+	if c.MetadataExportPath != "" {
+		if _, err := analytics.FormatOf(c.MetadataExportPath); err != nil {
+			return err
+		}
+		if c.MetadataExportInterval <= 0 {
+			return errors.New("the metadata export interval must be positive")
+		}
+	}
+	if c.NonceConflictAction != NonceConflictAlert && c.NonceConflictAction != NonceConflictWait {
+		return fmt.Errorf("unknown nonce conflict action %q, must be %q or %q", c.NonceConflictAction, NonceConflictAlert, NonceConflictWait)
+	}
+	if _, err := parseBlockedRanges(c.BlockedRanges); err != nil {
+		return err
+	}
+	// gRPC calls need HTTP/2, which the proposer only negotiates over TLS.
+	if c.ProverNetworkRpcUrl != "" && !strings.HasPrefix(c.ProverNetworkRpcUrl, "https://") {
+		return fmt.Errorf("the prover network RPC URL %q must be an https:// URL", c.ProverNetworkRpcUrl)
+	}
+
+	if c.MinSpanProofBlocks == 0 || c.MinSpanProofBlocks > c.MaxBlockRangePerSpanProof {
+		return fmt.Errorf("the min span proof blocks (%d) must be between 1 and the max block range per span proof (%d)", c.MinSpanProofBlocks, c.MaxBlockRangePerSpanProof)
+	}
+	if c.FastPathMaxBlocks > 0 && c.FastPathMaxBlocks >= c.MaxBlockRangePerSpanProof {
+		return fmt.Errorf("the fast path max blocks (%d) must be less than the max block range per span proof (%d)", c.FastPathMaxBlocks, c.MaxBlockRangePerSpanProof)
+	}
+
+	if c.Telemetry {
+		if c.TelemetryEndpoint == "" {
+			return errors.New("telemetry is enabled, but no telemetry endpoint was provided")
+		}
+		if c.TelemetryInterval <= 0 {
+			return errors.New("the telemetry interval must be positive")
+		}
+	}
+
+	if c.Mock && c.DifferentialTest {
+		return errors.New("differential testing compares real proofs against mock proofs and can't be used in mock mode")
+	}
+
+	if c.WatchOnly {
+		if c.L2OOAddress == "" {
+			return errors.New("watch-only mode requires the `L2OutputOracle` address")
+		}
+		if !common.IsHexAddress(c.WatchProposerAddress) {
+			return fmt.Errorf("watch-only mode requires a valid watch proposer address, got %q", c.WatchProposerAddress)
+		}
+	}
+	if c.WatchReproveSampleRate < 0 || c.WatchReproveSampleRate > 1 {
+		return fmt.Errorf("watch re-prove sample rate must be between 0 and 1, got %f", c.WatchReproveSampleRate)
+	}
+
+	if c.DoubleCheckSampleRate < 0 || c.DoubleCheckSampleRate > 1 {
+		return fmt.Errorf("double-check sample rate must be between 0 and 1, got %f", c.DoubleCheckSampleRate)
+	}
+
+	if c.ColdStorageDir != "" && c.ColdStorageS3Bucket != "" {
+		return errors.New("only one of the cold storage directory and the cold storage S3 bucket can be set")
+	}
+	if c.ColdStorageS3Bucket != "" && c.ColdStorageS3Region == "" {
+		return errors.New("the cold storage S3 bucket requires its region")
+	}
+
+	if c.ProofStoreDir != "" && c.ProofStoreS3Bucket != "" {
+		return errors.New("only one of the proof store directory and the proof store S3 bucket can be set")
+	}
+	if c.ProofStoreS3Bucket != "" && c.ProofStoreS3Region == "" {
+		return errors.New("the proof store S3 bucket requires its region")
+	}
+
+	if c.AltDACommitmentType != "" && c.AltDAServerUrl == "" {
+		return errors.New("the rollup config enables Alt-DA, so the Alt-DA server URL must be provided")
+	}
+	if c.ProofStatusLongPoll > MAX_PROOF_STATUS_LONG_POLL {
+		return fmt.Errorf("the proof status long poll can be at most %s, the longest the server waits", MAX_PROOF_STATUS_LONG_POLL)
+	}
+	if c.PreCheckpointLead > 0 && c.PreCheckpointMaxAge <= 0 {
+		return errors.New("pre-checkpointing requires a positive max age for pre-checkpoints")
+	}
+	if c.AdminAddr != "" && c.AdminToken == "" {
+		return errors.New("the admin HTTP API requires a token to authenticate requests with")
+	}
+
 	return nil
 }
 
@@ -131,6 +578,17 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 	dbPath := ctx.String(flags.DbPathFlag.Name)
 	dbPath = filepath.Join(dbPath, fmt.Sprintf("%d", rollupConfig.L2ChainID.Uint64()), "proofs.db")
 
+	spanProofTimeout := ctx.Uint64(flags.SpanProofTimeoutFlag.Name)
+	if !ctx.IsSet(flags.SpanProofTimeoutFlag.Name) && ctx.IsSet(flags.ProofTimeoutFlag.Name) {
+		log.Printf("--%s (MAX_PROOF_TIME) is deprecated, use --%s (SPAN_PROOF_TIMEOUT) instead", flags.ProofTimeoutFlag.Name, flags.SpanProofTimeoutFlag.Name)
+		spanProofTimeout = ctx.Uint64(flags.ProofTimeoutFlag.Name)
+	}
+
+	var altDACommitmentType string
+	if rollupConfig.AltDAConfig != nil {
+		altDACommitmentType = rollupConfig.AltDAConfig.CommitmentType
+	}
+
 	return &CLIConfig{
 		// Required Flags
 		L1EthRpc:     ctx.String(flags.L1EthRpcFlag.Name),
@@ -141,6 +599,10 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		BeaconRpc:    ctx.String(flags.BeaconRpcFlag.Name),
 		L2ChainID:    rollupConfig.L2ChainID.Uint64(),
 
+		// Alt-DA, read from the rollup config.
+		AltDACommitmentType: altDACommitmentType,
+		BatchInboxAddress:   rollupConfig.BatchInboxAddress,
+
 		// Optional Flags
 		AllowNonFinalized:            ctx.Bool(flags.AllowNonFinalizedFlag.Name),
 		RPCConfig:                    oprpc.ReadCLIConfig(ctx),
@@ -154,10 +616,107 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		MaxBlockRangePerSpanProof:    ctx.Uint64(flags.MaxBlockRangePerSpanProofFlag.Name),
 		MaxConcurrentWitnessGen:      ctx.Uint64(flags.MaxConcurrentWitnessGenFlag.Name),
 		WitnessGenTimeout:            ctx.Uint64(flags.WitnessGenTimeoutFlag.Name),
-		ProofTimeout:                 ctx.Uint64(flags.ProofTimeoutFlag.Name),
-		OPSuccinctServerUrl:          ctx.String(flags.OPSuccinctServerUrlFlag.Name),
+		SpanProofTimeout:             spanProofTimeout,
+		SpanProofTimeoutPerBlock:     ctx.Uint64(flags.SpanProofTimeoutPerBlockFlag.Name),
+		AggProofTimeout:              ctx.Uint64(flags.AggProofTimeoutFlag.Name),
+		OPSuccinctServerUrls:         ctx.StringSlice(flags.OPSuccinctServerUrlFlag.Name),
 		MaxConcurrentProofRequests:   ctx.Uint64(flags.MaxConcurrentProofRequestsFlag.Name),
 		Mock:                         ctx.Bool(flags.MockFlag.Name),
+		DifferentialTest:             ctx.Bool(flags.DifferentialTestFlag.Name),
+		PipelineSpecPath:             ctx.String(flags.PipelineSpecFlag.Name),
+		ColdStorageDir:               ctx.String(flags.ColdStorageDirFlag.Name),
+		ColdStorageS3Bucket:          ctx.String(flags.ColdStorageS3BucketFlag.Name),
+		ColdStorageS3Region:          ctx.String(flags.ColdStorageS3RegionFlag.Name),
+		ColdStorageS3Endpoint:        ctx.String(flags.ColdStorageS3EndpointFlag.Name),
+		ColdStorageS3StorageClass:    ctx.String(flags.ColdStorageS3StorageClassFlag.Name),
+		ProofHotWindow:               ctx.Duration(flags.ProofHotWindowFlag.Name),
+		WatchOnly:                    ctx.Bool(flags.WatchOnlyFlag.Name),
+		WatchProposerAddress:         ctx.String(flags.WatchProposerAddressFlag.Name),
+		WatchReproveSampleRate:       ctx.Float64(flags.WatchReproveSampleRateFlag.Name),
+		AltDAServerUrl:               ctx.String(flags.AltDAServerUrlFlag.Name),
+		AlignToChannels:              ctx.Bool(flags.AlignToChannelsFlag.Name),
+		MaxUnrequestedSpanProofs:     ctx.Uint64(flags.MaxUnrequestedSpanProofsFlag.Name),
+		WitnessGenRetries:            ctx.Uint64(flags.WitnessGenRetriesFlag.Name),
+		WitnessGenRetryBackoff:       ctx.Duration(flags.WitnessGenRetryBackoffFlag.Name),
+		SpanCompactionInterval:       ctx.Duration(flags.SpanCompactionIntervalFlag.Name),
+		Telemetry:                    ctx.Bool(flags.TelemetryFlag.Name),
+		TelemetryEndpoint:            ctx.String(flags.TelemetryEndpointFlag.Name),
+		TelemetryInterval:            ctx.Duration(flags.TelemetryIntervalFlag.Name),
+		ConfigContractAddress:        ctx.String(flags.ConfigContractAddressFlag.Name),
+		RangePlanner:                 ctx.String(flags.RangePlannerFlag.Name),
+		L2EthRpc:                     ctx.String(flags.L2EthRpcFlag.Name),
+		SpanOverheadCycles:           ctx.Uint64(flags.SpanOverheadCyclesFlag.Name),
+		FastPathMaxBlocks:            ctx.Uint64(flags.FastPathMaxBlocksFlag.Name),
+		IPFSApiUrl:                   ctx.String(flags.IPFSApiUrlFlag.Name),
+		ProverFallbackServerUrls:     ctx.StringSlice(flags.ProverFallbackServerUrlsFlag.Name),
+		ProverFailoverAttempts:       ctx.Uint64(flags.ProverFailoverAttemptsFlag.Name),
+		ProverUnreachableTimeout:     ctx.Duration(flags.ProverUnreachableTimeoutFlag.Name),
+		ProofStatusLongPoll:          ctx.Duration(flags.ProofStatusLongPollFlag.Name),
+		InstanceID:                   ctx.String(flags.InstanceIDFlag.Name),
+		AdminAddr:                    ctx.String(flags.AdminAddrFlag.Name),
+		AdminToken:                   ctx.String(flags.AdminTokenFlag.Name),
+		PreCheckpointLead:            ctx.Duration(flags.PreCheckpointLeadFlag.Name),
+		PreCheckpointMaxAge:          ctx.Duration(flags.PreCheckpointMaxAgeFlag.Name),
+		DbConnectionString:           ctx.String(flags.DbConnectionStringFlag.Name),
+		SchedulingPolicy:             ctx.String(flags.SchedulingPolicyFlag.Name),
+		MaxProofRetries:              ctx.Uint64(flags.MaxProofRetriesFlag.Name),
+		ProofRetryBackoff:            ctx.Duration(flags.ProofRetryBackoffFlag.Name),
+		NonceConflictAction:          ctx.String(flags.NonceConflictActionFlag.Name),
+		ProofRequestGzipThreshold:    ctx.Uint64(flags.ProofRequestGzipThresholdFlag.Name),
+		MinSpanProofBlocks:           ctx.Uint64(flags.MinSpanProofBlocksFlag.Name),
+		AggSubproofsByReference:      ctx.Bool(flags.AggSubproofsByReferenceFlag.Name),
+		ProverNetworkRpcUrl:          ctx.String(flags.ProverNetworkRpcUrlFlag.Name),
+		BlockedRanges:                ctx.StringSlice(flags.BlockedRangesFlag.Name),
+		ProofStoreDir:                ctx.String(flags.ProofStoreDirFlag.Name),
+		ProofStoreS3Bucket:           ctx.String(flags.ProofStoreS3BucketFlag.Name),
+		ProofStoreS3Region:           ctx.String(flags.ProofStoreS3RegionFlag.Name),
+		ProofStoreS3Endpoint:         ctx.String(flags.ProofStoreS3EndpointFlag.Name),
+		DoubleCheckServerUrl:         ctx.String(flags.DoubleCheckServerUrlFlag.Name),
+		DoubleCheckSampleRate:        ctx.Float64(flags.DoubleCheckSampleRateFlag.Name),
+		TracingEndpoint:              ctx.String(flags.TracingEndpointFlag.Name),
+		SLAMaxUnprovenAge:            ctx.Duration(flags.SLAMaxUnprovenAgeFlag.Name),
+		SLAPremiumServerUrl:          ctx.String(flags.SLAPremiumServerUrlFlag.Name),
+		MetadataExportPath:           ctx.String(flags.MetadataExportPathFlag.Name),
+		MetadataExportInterval:       ctx.Duration(flags.MetadataExportIntervalFlag.Name),
+		CircuitBreakerThreshold:      ctx.Uint64(flags.CircuitBreakerThresholdFlag.Name),
+		CircuitBreakerBackoff:        ctx.Duration(flags.CircuitBreakerBackoffFlag.Name),
+		StrictMode:                   ctx.Bool(flags.StrictModeFlag.Name),
+		AggBoundaryPolicy:            ctx.String(flags.AggBoundaryPolicyFlag.Name),
+		ShutdownGracePeriod:          ctx.Duration(flags.ShutdownGracePeriodFlag.Name),
+		WitnessGenCapacityInterval:   ctx.Duration(flags.WitnessGenCapacityIntervalFlag.Name),
+		ReplicateFrom:                ctx.String(flags.ReplicateFromFlag.Name),
+		ReplicateToken:               ctx.String(flags.ReplicateTokenFlag.Name),
+		CostBudget:                   ctx.String(flags.CostBudgetFlag.Name),
+		CostBudgetPeriod:             ctx.Duration(flags.CostBudgetPeriodFlag.Name),
+		MaxSubmissionBaseFeeGwei:     ctx.Uint64(flags.MaxSubmissionBaseFeeGweiFlag.Name),
+		MaxSubmissionDelay:           ctx.Duration(flags.MaxSubmissionDelayFlag.Name),
+		SubmissionMaxReverts:         ctx.Uint64(flags.SubmissionMaxRevertsFlag.Name),
+		SubmissionMaxResends:         ctx.Uint64(flags.SubmissionMaxResendsFlag.Name),
+		SubmissionMaxNonceResyncs:    ctx.Uint64(flags.SubmissionMaxNonceResyncsFlag.Name),
+		L1TxTimeout:                  ctx.Duration(flags.L1TxTimeoutFlag.Name),
+		DbReplicaConnectionString:    ctx.String(flags.DbReplicaConnectionStringFlag.Name),
+		WitnessGenLatencyTarget:      ctx.Duration(flags.WitnessGenLatencyTargetFlag.Name),
+		WitnessGenMaxFailureRate:     ctx.Float64(flags.WitnessGenMaxFailureRateFlag.Name),
+		PrivateTxRpc:                 ctx.String(flags.PrivateTxRpcFlag.Name),
+		PrivateTxTimeout:             ctx.Duration(flags.PrivateTxTimeoutFlag.Name),
+		SignerMode:                   ctx.String(flags.SignerModeFlag.Name),
+		VerificationServiceUrl:       ctx.String(flags.VerificationServiceUrlFlag.Name),
+		VerificationServiceToken:     ctx.String(flags.VerificationServiceTokenFlag.Name),
+		SafeAddress:                  ctx.String(flags.SafeAddressFlag.Name),
+		SafeTxServiceUrl:             ctx.String(flags.SafeTxServiceUrlFlag.Name),
+		SafeTxServiceToken:           ctx.String(flags.SafeTxServiceTokenFlag.Name),
+		L1RpcFallbackUrls:            ctx.StringSlice(flags.L1RpcFallbackUrlsFlag.Name),
+		RollupRpcFallbackUrls:        ctx.StringSlice(flags.RollupRpcFallbackUrlsFlag.Name),
+		RpcMaxLagBlocks:              ctx.Uint64(flags.RpcMaxLagBlocksFlag.Name),
+		RpcHealthCheckInterval:       ctx.Duration(flags.RpcHealthCheckIntervalFlag.Name),
+		DowntimeThreshold:            ctx.Duration(flags.DowntimeThresholdFlag.Name),
+		ReorgCheckInterval:           ctx.Duration(flags.ReorgCheckIntervalFlag.Name),
+		CheckpointConfirmations:      ctx.Uint64(flags.CheckpointConfirmationsFlag.Name),
+		CheckpointFinalized:          ctx.Bool(flags.CheckpointFinalizedFlag.Name),
+		WitnessGenProbeInterval:      ctx.Duration(flags.WitnessGenProbeIntervalFlag.Name),
+		LeaderElection:               ctx.Bool(flags.LeaderElectionFlag.Name),
+		Follower:                     ctx.Bool(flags.FollowerFlag.Name),
+		ForecastPath:                 ctx.String(flags.ForecastPathFlag.Name),
 		DGFAddress:                   ctx.String(flags.DGFAddressFlag.Name),
 
 		// NOTE(fakedev9999): GameType 6 is the game type for the op-succinct proof system.
@@ -0,0 +1,158 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+// proveBaseUnits is the number of base units in a PROVE token, which the prover network reports fees in.
+var proveBaseUnits = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// proveFromBaseUnits converts an amount in base units to PROVE, for metrics and logs.
+func proveFromBaseUnits(baseUnits *big.Int) float64 {
+	prove, _ := new(big.Rat).SetFrac(baseUnits, proveBaseUnits).Float64()
+	return prove
+}
+
+// parseProveAmount parses a decimal amount of PROVE, like 12.5, into base units.
+func parseProveAmount(amount string) (*big.Int, error) {
+	prove, ok := new(big.Rat).SetString(amount)
+	if !ok || prove.Sign() < 0 {
+		return nil, fmt.Errorf("%q is not a non-negative decimal amount of PROVE", amount)
+	}
+	baseUnits := prove.Mul(prove, new(big.Rat).SetInt(proveBaseUnits))
+	if !baseUnits.IsInt() {
+		return nil, fmt.Errorf("%q has more than 18 decimals", amount)
+	}
+	return baseUnits.Num(), nil
+}
+
+// recordFulfillment stores the prover, cycles and fee that the prover network reported for a proof request, and records
+// the cost in the metrics. Only the prover is reported for requests that it failed to fulfill. Servers that don't report
+// them, like older servers and the direct prover network backend, are skipped.
+func (l *L2OutputSubmitter) recordFulfillment(req *ent.ProofRequest, status ProofStatusResponse) {
+	if status.Fulfiller == "" && status.Cycles == 0 && status.Fee == "" {
+		return
+	}
+	if err := l.db.SetFulfillmentDetails(req.ID, status.Fulfiller, status.Cycles, status.Fee); err != nil {
+		l.Log.Error("failed to store fulfillment details", "id", req.ID, "err", err)
+		l.Metr.RecordError("set_fulfillment_details", 1)
+	}
+	if status.Cycles == 0 && status.Fee == "" {
+		return
+	}
+
+	var fee float64
+	if status.Fee != "" {
+		// The fee was validated when the response was decoded.
+		baseUnits, _ := new(big.Int).SetString(status.Fee, 10)
+		fee = proveFromBaseUnits(baseUnits)
+	}
+	l.Log.Info("Proof cost", "id", req.ID, "type", req.Type, "fulfiller", status.Fulfiller, "cycles", status.Cycles, "fee", status.Fee)
+	l.Metr.RecordProofCost(req.Type.String(), req.EndBlock-req.StartBlock, status.Cycles, fee)
+}
+
+// ProofCosts sums the cycles and fees of the proofs completed within the L2 block range from start to end. Proofs
+// whose cost wasn't reported are counted, but left out of the totals, so the per-block costs are only based on the
+// span proofs with a reported cost.
+func (l *L2OutputSubmitter) ProofCosts(ctx context.Context, start, end uint64) (rpc.ProofCosts, error) {
+	if start >= end {
+		return rpc.ProofCosts{}, fmt.Errorf("%w: the start block must be less than the end block", rpc.ErrInvalidRequest)
+	}
+	proofs, err := l.db.Analytics().GetCompletedProofsWithin(start, end)
+	if err != nil {
+		return rpc.ProofCosts{}, err
+	}
+
+	costs := rpc.ProofCosts{Start: start, End: end}
+	fee := new(big.Int)
+	var fulfillmentSeconds, fulfilled uint64
+	for _, proof := range proofs {
+		switch proof.Type {
+		case proofrequest.TypeSPAN:
+			costs.SpanProofs++
+		case proofrequest.TypeAGG:
+			costs.AggProofs++
+		}
+		if proof.FulfilledTime != 0 && proof.ProofRequestTime != 0 && proof.FulfilledTime >= proof.ProofRequestTime {
+			fulfillmentSeconds += proof.FulfilledTime - proof.ProofRequestTime
+			fulfilled++
+		}
+		if proof.Cycles == 0 && proof.Fee == "" {
+			costs.Unreported++
+			continue
+		}
+		if proof.Type == proofrequest.TypeSPAN {
+			costs.Blocks += proof.EndBlock - proof.StartBlock
+		}
+		costs.Cycles += proof.Cycles
+		if proofFee, ok := new(big.Int).SetString(proof.Fee, 10); ok {
+			fee.Add(fee, proofFee)
+		}
+	}
+
+	costs.Fee = fee.String()
+	costs.FeePerBlock = "0"
+	if costs.Blocks > 0 {
+		costs.CyclesPerBlock = float64(costs.Cycles) / float64(costs.Blocks)
+		costs.FeePerBlock = new(big.Int).Quo(fee, new(big.Int).SetUint64(costs.Blocks)).String()
+	}
+	if fulfilled > 0 {
+		costs.FulfillmentSeconds = float64(fulfillmentSeconds) / float64(fulfilled)
+	}
+	return costs, nil
+}
+
+// costBudgetSpent returns the fees of the proofs fulfilled within the last cost budget period, and the budget, in
+// base units. The budget is nil if the spending isn't limited.
+func (l *L2OutputSubmitter) costBudgetSpent() (spent, budget *big.Int, err error) {
+	if l.Cfg.CostBudget == "" {
+		return nil, nil, nil
+	}
+	// The budget was validated when the config was checked.
+	budget, _ = parseProveAmount(l.Cfg.CostBudget)
+	spent, err = l.db.GetFeesSince(uint64(time.Now().Add(-l.Cfg.CostBudgetPeriod).Unix()))
+	if err != nil {
+		return nil, nil, err
+	}
+	return spent, budget, nil
+}
+
+// costBudgetReason returns why no span proofs are requested because the cost budget is exhausted, or an empty string
+// if it isn't. Proofs that were already requested keep proving, so the spending can overshoot the budget by their
+// fees.
+func (l *L2OutputSubmitter) costBudgetReason() (string, error) {
+	spent, budget, err := l.costBudgetSpent()
+	if err != nil {
+		return "", fmt.Errorf("failed to check the cost budget: %w", err)
+	}
+	if budget == nil || spent.Cmp(budget) < 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("cost budget exhausted: %g of %s PROVE were spent in the last %s", proveFromBaseUnits(spent), l.Cfg.CostBudget, l.Cfg.CostBudgetPeriod), nil
+}
+
+// checkCostBudget records the spending against the cost budget, and alerts when the budget is exhausted and new span
+// proof requests are paused, and when they resume.
+func (l *L2OutputSubmitter) checkCostBudget() error {
+	spent, budget, err := l.costBudgetSpent()
+	if err != nil || budget == nil {
+		return err
+	}
+	l.Metr.RecordCostBudget(proveFromBaseUnits(spent), proveFromBaseUnits(budget))
+
+	exhausted := spent.Cmp(budget) >= 0
+	if exhausted && !l.costBudgetExhausted.Swap(true) {
+		l.Log.Error("Cost budget exhausted, pausing new span proof requests", "spent", proveFromBaseUnits(spent), "budget", l.Cfg.CostBudget, "period", l.Cfg.CostBudgetPeriod)
+		l.Metr.RecordError("alert_cost_budget_exhausted", 1)
+	} else if !exhausted && l.costBudgetExhausted.Swap(false) {
+		l.Log.Info("Cost budget available again, resuming span proof requests", "spent", proveFromBaseUnits(spent), "budget", l.Cfg.CostBudget, "period", l.Cfg.CostBudgetPeriod)
+	}
+	return nil
+}
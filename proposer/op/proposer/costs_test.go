@@ -0,0 +1,134 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+func TestProofCosts(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+		},
+		ctx: context.Background(),
+		db:  *proofDB,
+	}
+
+	// The span proof of 300-400 was fulfilled by a server that doesn't report its cost.
+	statuses := map[uint64]ProofStatusResponse{
+		100: {Cycles: 3_000_000, Fee: "1000000000000000000"},
+		200: {Cycles: 5_000_000, Fee: "2000000000000000000"},
+		300: {},
+	}
+	for start := range statuses {
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, start, start+100, 0))
+	}
+	spans, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, span := range spans {
+		require.NoError(t, proofDB.UpdateProofStatus(span.ID, proofrequest.StatusPROVING))
+		require.NoError(t, proofDB.AddFulfilledProof(span.ID, []byte("proof")))
+		l.recordFulfillment(span, statuses[span.StartBlock])
+	}
+
+	costs, err := l.ProofCosts(context.Background(), 100, 400)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), costs.SpanProofs)
+	require.Equal(t, uint64(1), costs.Unreported)
+	require.Equal(t, uint64(200), costs.Blocks)
+	require.Equal(t, uint64(8_000_000), costs.Cycles)
+	require.Equal(t, "3000000000000000000", costs.Fee)
+	require.Equal(t, float64(40_000), costs.CyclesPerBlock)
+	require.Equal(t, "15000000000000000", costs.FeePerBlock)
+
+	// Proofs that extend past the range are left out.
+	costs, err = l.ProofCosts(context.Background(), 100, 250)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), costs.SpanProofs)
+	require.Equal(t, uint64(3_000_000), costs.Cycles)
+
+	// There is nothing to divide by without proofs.
+	costs, err = l.ProofCosts(context.Background(), 1000, 2000)
+	require.NoError(t, err)
+	require.Equal(t, "0", costs.Fee)
+	require.Equal(t, "0", costs.FeePerBlock)
+
+	_, err = l.ProofCosts(context.Background(), 400, 100)
+	require.True(t, errors.Is(err, rpc.ErrInvalidRequest))
+}
+
+func TestParseProveAmount(t *testing.T) {
+	amount, err := parseProveAmount("12.5")
+	require.NoError(t, err)
+	require.Equal(t, "12500000000000000000", amount.String())
+
+	for _, invalid := range []string{"", "-1", "PROVE", "0.0000000000000000001"} {
+		_, err := parseProveAmount(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestCostBudget(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{CostBudget: "2.5", CostBudgetPeriod: 24 * time.Hour},
+		},
+		ctx: context.Background(),
+		db:  *proofDB,
+	}
+	fulfill := func(start uint64, fee string) {
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, start, start+100, 0))
+		reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.NoError(t, proofDB.UpdateProofStatus(reqs[0].ID, proofrequest.StatusPROVING))
+		require.NoError(t, proofDB.AddFulfilledProof(reqs[0].ID, []byte("proof")))
+		l.recordFulfillment(reqs[0], ProofStatusResponse{Fee: fee})
+	}
+
+	fulfill(100, "1500000000000000000")
+	reason, err := l.costBudgetReason()
+	require.NoError(t, err)
+	require.Empty(t, reason)
+	require.NoError(t, l.checkCostBudget())
+	require.False(t, l.costBudgetExhausted.Load())
+
+	fulfill(200, "1000000000000000000")
+	reason, err = l.costBudgetReason()
+	require.NoError(t, err)
+	require.Contains(t, reason, "cost budget exhausted")
+	require.NoError(t, l.checkCostBudget())
+	require.True(t, l.costBudgetExhausted.Load())
+
+	// The fees roll out of the period.
+	l.Cfg.CostBudgetPeriod = -time.Hour
+	require.NoError(t, l.checkCostBudget())
+	require.False(t, l.costBudgetExhausted.Load())
+
+	// Without a budget, the spending isn't limited.
+	l.Cfg.CostBudget = ""
+	reason, err = l.costBudgetReason()
+	require.NoError(t, err)
+	require.Empty(t, reason)
+}
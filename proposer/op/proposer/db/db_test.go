@@ -0,0 +1,461 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/coldstore"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+)
+
+func TestImportSpanProofs(t *testing.T) {
+	proofDB, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+
+	// Overlaps the existing request.
+	require.ErrorContains(t, proofDB.ImportSpanProofs(100, []SpanRange{{Start: 150, End: 250}}, 10), "overlaps")
+	// Leaves a gap between the existing request and the imported range.
+	require.ErrorContains(t, proofDB.ImportSpanProofs(100, []SpanRange{{Start: 210, End: 300}}, 10), "gap before")
+	// Exceeds the budget of unrequested span proofs.
+	require.ErrorContains(t, proofDB.ImportSpanProofs(100, []SpanRange{{Start: 200, End: 300}, {Start: 300, End: 400}}, 2), "budget")
+
+	require.NoError(t, proofDB.ImportSpanProofs(100, []SpanRange{{Start: 200, End: 300}, {Start: 300, End: 400}}, 3))
+	count, err := proofDB.GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+}
+
+func TestNewSpanEntries(t *testing.T) {
+	proofDB, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	// More spans than fit in a single insert statement.
+	var ranges []SpanRange
+	for start := uint64(0); start < 2*spanEntryBatchSize+10; start++ {
+		ranges = append(ranges, SpanRange{Start: start, End: start + 1})
+	}
+	created, err := proofDB.NewSpanEntries(ranges)
+	require.NoError(t, err)
+	require.Equal(t, len(ranges), created)
+
+	// Spans that are already queued are skipped, unless their request failed.
+	reqs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, 0, 1, proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.NoError(t, proofDB.UpdateProofStatus(reqs[0].ID, proofrequest.StatusFAILED))
+	created, err = proofDB.NewSpanEntries(ranges[:3])
+	require.NoError(t, err)
+	require.Equal(t, 1, created)
+	count, err := proofDB.GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Equal(t, len(ranges), count)
+}
+
+func TestGetProofRequestsAt(t *testing.T) {
+	proofDB, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+	require.NoError(t, proofDB.ImportSpanProofs(200, []SpanRange{{Start: 200, End: 300}}, 10))
+	reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, reqs, 2)
+	require.NoError(t, proofDB.UpdateProofStatus(reqs[0].ID, proofrequest.StatusPROVING))
+
+	first, ok, err := proofDB.GetFirstEventTime()
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Each request is reported once, with its latest status.
+	events, err := proofDB.GetProofRequestsAt(first + 60)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, reqs[0].ID, events[0].ProofRequestID)
+	require.Equal(t, proofrequestevent.StatusPROVING, events[0].Status)
+	require.Equal(t, proofrequestevent.StatusUNREQ, events[1].Status)
+
+	events, err = proofDB.GetProofRequestsAt(first - 1)
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func TestAggSpanLinks(t *testing.T) {
+	proofDB, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	require.NoError(t, proofDB.ImportSpanProofs(100, []SpanRange{{Start: 100, End: 200}, {Start: 200, End: 300}}, 10))
+	spans, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, span := range spans {
+		require.NoError(t, proofDB.UpdateProofStatus(span.ID, proofrequest.StatusPROVING))
+		require.NoError(t, proofDB.AddFulfilledProof(span.ID, []byte("proof")))
+	}
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 100, 300, 0))
+	aggs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, aggs, 1)
+	linked, err := proofDB.GetAggSpans(aggs[0].ID)
+	require.NoError(t, err)
+	require.Len(t, linked, 2)
+	require.Equal(t, uint64(100), linked[0].StartBlock)
+	require.Equal(t, uint64(200), linked[1].StartBlock)
+
+	// The spans can't be archived while the AGG proof request is pending.
+	toArchive, err := proofDB.GetProofsToArchive(300, math.MaxInt64)
+	require.NoError(t, err)
+	require.Empty(t, toArchive)
+	require.ErrorContains(t, proofDB.ArchiveProof(linked[0].ID, "key"), "can't be archived")
+
+	require.NoError(t, proofDB.UpdateProofStatus(aggs[0].ID, proofrequest.StatusFAILED))
+	toArchive, err = proofDB.GetProofsToArchive(300, math.MaxInt64)
+	require.NoError(t, err)
+	require.Len(t, toArchive, 2)
+	require.NoError(t, proofDB.ArchiveProof(linked[0].ID, "key"))
+}
+
+func TestMarkAggRequestsSatisfied(t *testing.T) {
+	proofDB, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 100, 300, 0))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 300, 400, 0))
+
+	// A competing output at block 300 satisfies the AGG proof request that ends there.
+	n, err := proofDB.MarkAggRequestsSatisfied(300, "0xabc")
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	failed, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusFAILED)
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	require.Equal(t, uint64(100), failed[0].StartBlock)
+	require.Equal(t, "0xabc", failed[0].SatisfiedByTx)
+
+	unreqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, unreqs, 1)
+	require.Equal(t, uint64(300), unreqs[0].StartBlock)
+}
+
+func TestExternalRef(t *testing.T) {
+	proofDB, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	require.NoError(t, proofDB.ImportSpanProofs(100, []SpanRange{{Start: 100, End: 200}, {Start: 200, End: 300}}, 10))
+	reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, reqs, 2)
+
+	req, err := proofDB.SetExternalRef(reqs[0].ID, "job-1")
+	require.NoError(t, err)
+	require.Equal(t, "job-1", req.ExternalRef)
+
+	// Setting the same reference again is a no-op.
+	_, err = proofDB.SetExternalRef(reqs[0].ID, "job-1")
+	require.NoError(t, err)
+
+	// References can't be changed, or attached to another request.
+	_, err = proofDB.SetExternalRef(reqs[0].ID, "job-2")
+	require.ErrorContains(t, err, "already has external reference")
+	_, err = proofDB.SetExternalRef(reqs[1].ID, "job-1")
+	require.ErrorContains(t, err, "already attached")
+
+	found, err := proofDB.GetProofRequestByExternalRef("job-1")
+	require.NoError(t, err)
+	require.Equal(t, reqs[0].ID, found.ID)
+	_, err = proofDB.GetProofRequestByExternalRef("job-2")
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestSetInstanceID(t *testing.T) {
+	proofDB, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	// Requests created before the instance ID is set aren't attributed.
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+	proofDB.SetInstanceID("proposer-1")
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 200, 300, 0))
+
+	reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, reqs, 2)
+	require.Empty(t, reqs[0].CreatedBy)
+	require.Equal(t, "proposer-1", reqs[1].CreatedBy)
+
+	id := reqs[1].ID
+	require.NoError(t, proofDB.UpdateProofStatus(id, proofrequest.StatusWITNESSGEN))
+	require.NoError(t, proofDB.UpdateProofStatus(id, proofrequest.StatusPROVING))
+	require.NoError(t, proofDB.AddFulfilledProof(id, []byte("proof")))
+
+	req, err := proofDB.GetProofRequest(id)
+	require.NoError(t, err)
+	require.Equal(t, "proposer-1", req.RequestedBy)
+	require.Equal(t, "proposer-1", req.CompletedBy)
+}
+
+func TestAddFulfilledProofDuplicates(t *testing.T) {
+	proofDB, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 200, 300, 0))
+	reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, req := range reqs {
+		require.NoError(t, proofDB.UpdateProofStatus(req.ID, proofrequest.StatusPROVING))
+	}
+
+	// A second delivery of the same proof is recognized, and a different proof isn't stored over it.
+	id := reqs[0].ID
+	require.NoError(t, proofDB.AddFulfilledProof(id, []byte("proof")))
+	require.ErrorIs(t, proofDB.AddFulfilledProof(id, []byte("proof")), ErrProofAlreadyFulfilled)
+	require.ErrorIs(t, proofDB.AddFulfilledProof(id, []byte("other")), ErrProofStatusChanged)
+	req, err := proofDB.GetProofRequest(id)
+	require.NoError(t, err)
+	require.Equal(t, []byte("proof"), req.Proof)
+
+	// A request that failed in the meantime isn't completed.
+	id = reqs[1].ID
+	require.NoError(t, proofDB.UpdateProofStatus(id, proofrequest.StatusFAILED))
+	require.ErrorIs(t, proofDB.AddFulfilledProof(id, []byte("proof")), ErrProofStatusChanged)
+	req, err = proofDB.GetProofRequest(id)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusFAILED, req.Status)
+	require.Nil(t, req.Proof)
+}
+
+func TestProofStore(t *testing.T) {
+	proofDB, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 200, 300, 0))
+	reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, req := range reqs {
+		require.NoError(t, proofDB.UpdateProofStatus(req.ID, proofrequest.StatusPROVING))
+	}
+
+	// A proof stored before the proof store was set stays in the DB.
+	require.NoError(t, proofDB.AddFulfilledProof(reqs[0].ID, []byte("first")))
+	store, err := coldstore.NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	proofDB.SetProofStore(store)
+
+	// Later proofs are only referenced by their hash in the DB.
+	require.NoError(t, proofDB.AddFulfilledProof(reqs[1].ID, []byte("second")))
+	require.ErrorIs(t, proofDB.AddFulfilledProof(reqs[1].ID, []byte("second")), ErrProofAlreadyFulfilled)
+	req, err := proofDB.GetProofRequest(reqs[1].ID)
+	require.NoError(t, err)
+	require.Nil(t, req.Proof)
+	require.Equal(t, proofRef([]byte("second")), req.ProofRef)
+	require.NoError(t, proofDB.LoadProof(req))
+	require.Equal(t, []byte("second"), req.Proof)
+
+	// Both proofs are read transparently when building an AGG proof request.
+	require.Equal(t, [][]byte{[]byte("first"), []byte("second")}, consecutiveSpanProofs(t, proofDB, 100, 300))
+
+	// Proofs that don't match their hash aren't returned.
+	require.NoError(t, store.Put(context.Background(), proofStoreKey(req.ProofRef), []byte("tampered")))
+	chain, err := proofDB.GetConsecutiveSpanProofs(100, 300)
+	require.NoError(t, err)
+	_, err = proofDB.ReadProof(chain[1])
+	require.ErrorContains(t, err, "expected "+req.ProofRef)
+}
+
+// consecutiveSpanProofs reads the proofs of the span proofs that cover the range [start, end].
+func consecutiveSpanProofs(t *testing.T, proofDB *ProofDB, start, end uint64) [][]byte {
+	chain, err := proofDB.GetConsecutiveSpanProofs(start, end)
+	require.NoError(t, err)
+	proofs := make([][]byte, len(chain))
+	for i, span := range chain {
+		// The proofs are read lazily.
+		require.Nil(t, span.Proof)
+		proofs[i], err = proofDB.ReadProof(span)
+		require.NoError(t, err)
+	}
+	return proofs
+}
+
+func TestSpanProofChainWithSharedStartBlocks(t *testing.T) {
+	proofDB, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	// 100-200 was proven both as a whole and in parts.
+	for _, r := range [][2]uint64{{100, 150}, {150, 200}, {100, 200}, {200, 300}} {
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, r[0], r[1], 0))
+		reqs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, r[0], r[1], proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.NoError(t, proofDB.UpdateProofStatus(reqs[0].ID, proofrequest.StatusPROVING))
+		require.NoError(t, proofDB.AddFulfilledProof(reqs[0].ID, []byte(fmt.Sprintf("%d-%d", r[0], r[1]))))
+	}
+
+	boundaries, err := proofDB.GetContiguousSpanProofBoundaries(100, 300)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{150, 200, 300}, boundaries)
+
+	end, err := proofDB.GetMaxContiguousSpanProofRange(100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), end)
+
+	// The chain with the fewest span proofs is preferred.
+	require.Equal(t, [][]byte{[]byte("100-200"), []byte("200-300")}, consecutiveSpanProofs(t, proofDB, 100, 300))
+	require.Equal(t, [][]byte{[]byte("100-150")}, consecutiveSpanProofs(t, proofDB, 100, 150))
+
+	_, err = proofDB.GetConsecutiveSpanProofs(100, 250)
+	require.ErrorContains(t, err, "incomplete proof chain")
+}
+
+func TestReadSnapshot(t *testing.T) {
+	proofDB, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+
+	err = proofDB.ReadSnapshot(func(snapshot *ProofDB) error {
+		count, err := snapshot.GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		// The pipeline keeps writing while the snapshot is open, without the snapshot seeing the write.
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 200, 300, 0))
+		count, err = snapshot.GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		require.ErrorContains(t, snapshot.NewEntry(proofrequest.TypeSPAN, 300, 400, 0), ErrReadOnlySnapshot.Error())
+		return nil
+	})
+	require.NoError(t, err)
+
+	count, err := proofDB.GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
+
+func TestAnalytics(t *testing.T) {
+	proofDB, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+
+	// Without a replica, analytical queries read from the DB itself.
+	require.Same(t, proofDB, proofDB.Analytics())
+
+	// With a replica, they read from it, and can't write. A second SQLite DB stands in for the lagging replica.
+	replicaDB, err := InitDB(filepath.Join(t.TempDir(), "replica.db"), false)
+	require.NoError(t, err)
+	defer replicaDB.CloseDB()
+	proofDB.replicaClient = replicaDB.readClient
+	defer func() { proofDB.replicaClient = nil }()
+
+	count, err := proofDB.Analytics().GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+	require.ErrorContains(t, proofDB.Analytics().NewEntry(proofrequest.TypeSPAN, 200, 300, 0), ErrReadOnlySnapshot.Error())
+
+	require.ErrorContains(t, proofDB.SetReplica("mysql://replica/proofs"), "unsupported DB connection string")
+}
+
+func TestOpenReadOnly(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "proofs.db")
+	proofDB, err := InitDB(dbPath, false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+
+	readOnlyDB, err := OpenReadOnly(dbPath)
+	require.NoError(t, err)
+	defer readOnlyDB.CloseDB()
+	count, err := readOnlyDB.GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.ErrorContains(t, readOnlyDB.NewEntry(proofrequest.TypeSPAN, 200, 300, 0), ErrReadOnlySnapshot.Error())
+
+	// A DB that doesn't exist isn't created.
+	missingDB, err := OpenReadOnly(filepath.Join(t.TempDir(), "missing.db"))
+	require.NoError(t, err)
+	defer missingDB.CloseDB()
+	_, err = missingDB.GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ)
+	require.Error(t, err)
+}
+
+func TestOpenUnsupportedConnectionString(t *testing.T) {
+	for _, connectionString := range []string{"mysql://user@localhost/proofs", "file:proofs.db", "localhost:5432"} {
+		_, err := Open(connectionString)
+		require.ErrorContains(t, err, "unsupported DB connection string", connectionString)
+	}
+}
+
+func TestReplication(t *testing.T) {
+	active, err := InitDB(filepath.Join(t.TempDir(), "active.db"), false)
+	require.NoError(t, err)
+	defer active.CloseDB()
+	standby, err := InitDB(filepath.Join(t.TempDir(), "standby.db"), false)
+	require.NoError(t, err)
+	defer standby.CloseDB()
+
+	require.NoError(t, active.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+	require.NoError(t, active.NewEntry(proofrequest.TypeSPAN, 200, 300, 0))
+	reqs, err := active.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+
+	// A new standby starts from a snapshot.
+	batch, err := active.ReplicationChanges(context.Background(), "", 0, 0)
+	require.NoError(t, err)
+	require.True(t, batch.Snapshot)
+	require.NoError(t, standby.ApplyReplicationBatch(batch))
+	for _, req := range reqs {
+		replicated, err := standby.GetProofRequest(req.ID)
+		require.NoError(t, err)
+		require.Equal(t, req.StartBlock, replicated.StartBlock)
+	}
+
+	// The merged requests are deleted, and the merged one keeps its ID and status.
+	require.NoError(t, active.MergeUnrequestedSpanProofs([]int{reqs[0].ID, reqs[1].ID}, 100, 300, 0))
+	merged, err := active.GetNextUnrequestedSpanProof()
+	require.NoError(t, err)
+	require.NoError(t, active.UpdateProofStatus(merged.ID, proofrequest.StatusWITNESSGEN))
+	batch, err = active.ReplicationChanges(context.Background(), batch.Epoch, batch.Seq, 0)
+	require.NoError(t, err)
+	require.False(t, batch.Snapshot)
+	require.ElementsMatch(t, []int{reqs[0].ID, reqs[1].ID}, batch.Deleted)
+	require.NoError(t, standby.ApplyReplicationBatch(batch))
+
+	replicated, err := standby.GetProofRequest(merged.ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusWITNESSGEN, replicated.Status)
+	require.Equal(t, uint64(300), replicated.EndBlock)
+	_, err = standby.GetProofRequest(reqs[0].ID)
+	require.Error(t, err)
+
+	// Without changes, the batch is empty once the wait is over.
+	next, err := active.ReplicationChanges(context.Background(), batch.Epoch, batch.Seq, 10*time.Millisecond)
+	require.NoError(t, err)
+	require.Empty(t, next.Requests)
+	require.Equal(t, batch.Seq, next.Seq)
+
+	// A standby of an earlier run of the active proposer is sent a snapshot.
+	next, err = active.ReplicationChanges(context.Background(), "earlier", batch.Seq, 0)
+	require.NoError(t, err)
+	require.True(t, next.Snapshot)
+	require.Len(t, next.Requests, 1)
+}
@@ -14,7 +14,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/heartbeat"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/limiterstate"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/rangelease"
 )
 
 // Client is the client that holds all ent builders.
@@ -22,8 +27,16 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Heartbeat is the client for interacting with the Heartbeat builders.
+	Heartbeat *HeartbeatClient
+	// LimiterState is the client for interacting with the LimiterState builders.
+	LimiterState *LimiterStateClient
 	// ProofRequest is the client for interacting with the ProofRequest builders.
 	ProofRequest *ProofRequestClient
+	// ProofRequestEvent is the client for interacting with the ProofRequestEvent builders.
+	ProofRequestEvent *ProofRequestEventClient
+	// RangeLease is the client for interacting with the RangeLease builders.
+	RangeLease *RangeLeaseClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -35,7 +48,11 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Heartbeat = NewHeartbeatClient(c.config)
+	c.LimiterState = NewLimiterStateClient(c.config)
 	c.ProofRequest = NewProofRequestClient(c.config)
+	c.ProofRequestEvent = NewProofRequestEventClient(c.config)
+	c.RangeLease = NewRangeLeaseClient(c.config)
 }
 
 type (
@@ -126,9 +143,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		ProofRequest: NewProofRequestClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		Heartbeat:         NewHeartbeatClient(cfg),
+		LimiterState:      NewLimiterStateClient(cfg),
+		ProofRequest:      NewProofRequestClient(cfg),
+		ProofRequestEvent: NewProofRequestEventClient(cfg),
+		RangeLease:        NewRangeLeaseClient(cfg),
 	}, nil
 }
 
@@ -146,16 +167,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		ProofRequest: NewProofRequestClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		Heartbeat:         NewHeartbeatClient(cfg),
+		LimiterState:      NewLimiterStateClient(cfg),
+		ProofRequest:      NewProofRequestClient(cfg),
+		ProofRequestEvent: NewProofRequestEventClient(cfg),
+		RangeLease:        NewRangeLeaseClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		ProofRequest.
+//		Heartbeat.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -177,25 +202,307 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.Heartbeat.Use(hooks...)
+	c.LimiterState.Use(hooks...)
 	c.ProofRequest.Use(hooks...)
+	c.ProofRequestEvent.Use(hooks...)
+	c.RangeLease.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Heartbeat.Intercept(interceptors...)
+	c.LimiterState.Intercept(interceptors...)
 	c.ProofRequest.Intercept(interceptors...)
+	c.ProofRequestEvent.Intercept(interceptors...)
+	c.RangeLease.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *HeartbeatMutation:
+		return c.Heartbeat.mutate(ctx, m)
+	case *LimiterStateMutation:
+		return c.LimiterState.mutate(ctx, m)
 	case *ProofRequestMutation:
 		return c.ProofRequest.mutate(ctx, m)
+	case *ProofRequestEventMutation:
+		return c.ProofRequestEvent.mutate(ctx, m)
+	case *RangeLeaseMutation:
+		return c.RangeLease.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// HeartbeatClient is a client for the Heartbeat schema.
+type HeartbeatClient struct {
+	config
+}
+
+// NewHeartbeatClient returns a client for the Heartbeat from the given config.
+func NewHeartbeatClient(c config) *HeartbeatClient {
+	return &HeartbeatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `heartbeat.Hooks(f(g(h())))`.
+func (c *HeartbeatClient) Use(hooks ...Hook) {
+	c.hooks.Heartbeat = append(c.hooks.Heartbeat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `heartbeat.Intercept(f(g(h())))`.
+func (c *HeartbeatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Heartbeat = append(c.inters.Heartbeat, interceptors...)
+}
+
+// Create returns a builder for creating a Heartbeat entity.
+func (c *HeartbeatClient) Create() *HeartbeatCreate {
+	mutation := newHeartbeatMutation(c.config, OpCreate)
+	return &HeartbeatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Heartbeat entities.
+func (c *HeartbeatClient) CreateBulk(builders ...*HeartbeatCreate) *HeartbeatCreateBulk {
+	return &HeartbeatCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HeartbeatClient) MapCreateBulk(slice any, setFunc func(*HeartbeatCreate, int)) *HeartbeatCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HeartbeatCreateBulk{err: fmt.Errorf("calling to HeartbeatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HeartbeatCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HeartbeatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Heartbeat.
+func (c *HeartbeatClient) Update() *HeartbeatUpdate {
+	mutation := newHeartbeatMutation(c.config, OpUpdate)
+	return &HeartbeatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HeartbeatClient) UpdateOne(h *Heartbeat) *HeartbeatUpdateOne {
+	mutation := newHeartbeatMutation(c.config, OpUpdateOne, withHeartbeat(h))
+	return &HeartbeatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HeartbeatClient) UpdateOneID(id int) *HeartbeatUpdateOne {
+	mutation := newHeartbeatMutation(c.config, OpUpdateOne, withHeartbeatID(id))
+	return &HeartbeatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Heartbeat.
+func (c *HeartbeatClient) Delete() *HeartbeatDelete {
+	mutation := newHeartbeatMutation(c.config, OpDelete)
+	return &HeartbeatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HeartbeatClient) DeleteOne(h *Heartbeat) *HeartbeatDeleteOne {
+	return c.DeleteOneID(h.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HeartbeatClient) DeleteOneID(id int) *HeartbeatDeleteOne {
+	builder := c.Delete().Where(heartbeat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HeartbeatDeleteOne{builder}
+}
+
+// Query returns a query builder for Heartbeat.
+func (c *HeartbeatClient) Query() *HeartbeatQuery {
+	return &HeartbeatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHeartbeat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Heartbeat entity by its id.
+func (c *HeartbeatClient) Get(ctx context.Context, id int) (*Heartbeat, error) {
+	return c.Query().Where(heartbeat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HeartbeatClient) GetX(ctx context.Context, id int) *Heartbeat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *HeartbeatClient) Hooks() []Hook {
+	return c.hooks.Heartbeat
+}
+
+// Interceptors returns the client interceptors.
+func (c *HeartbeatClient) Interceptors() []Interceptor {
+	return c.inters.Heartbeat
+}
+
+func (c *HeartbeatClient) mutate(ctx context.Context, m *HeartbeatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HeartbeatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HeartbeatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HeartbeatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HeartbeatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Heartbeat mutation op: %q", m.Op())
+	}
+}
+
+// LimiterStateClient is a client for the LimiterState schema.
+type LimiterStateClient struct {
+	config
+}
+
+// NewLimiterStateClient returns a client for the LimiterState from the given config.
+func NewLimiterStateClient(c config) *LimiterStateClient {
+	return &LimiterStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `limiterstate.Hooks(f(g(h())))`.
+func (c *LimiterStateClient) Use(hooks ...Hook) {
+	c.hooks.LimiterState = append(c.hooks.LimiterState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `limiterstate.Intercept(f(g(h())))`.
+func (c *LimiterStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LimiterState = append(c.inters.LimiterState, interceptors...)
+}
+
+// Create returns a builder for creating a LimiterState entity.
+func (c *LimiterStateClient) Create() *LimiterStateCreate {
+	mutation := newLimiterStateMutation(c.config, OpCreate)
+	return &LimiterStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LimiterState entities.
+func (c *LimiterStateClient) CreateBulk(builders ...*LimiterStateCreate) *LimiterStateCreateBulk {
+	return &LimiterStateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LimiterStateClient) MapCreateBulk(slice any, setFunc func(*LimiterStateCreate, int)) *LimiterStateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LimiterStateCreateBulk{err: fmt.Errorf("calling to LimiterStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LimiterStateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LimiterStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LimiterState.
+func (c *LimiterStateClient) Update() *LimiterStateUpdate {
+	mutation := newLimiterStateMutation(c.config, OpUpdate)
+	return &LimiterStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LimiterStateClient) UpdateOne(ls *LimiterState) *LimiterStateUpdateOne {
+	mutation := newLimiterStateMutation(c.config, OpUpdateOne, withLimiterState(ls))
+	return &LimiterStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LimiterStateClient) UpdateOneID(id int) *LimiterStateUpdateOne {
+	mutation := newLimiterStateMutation(c.config, OpUpdateOne, withLimiterStateID(id))
+	return &LimiterStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LimiterState.
+func (c *LimiterStateClient) Delete() *LimiterStateDelete {
+	mutation := newLimiterStateMutation(c.config, OpDelete)
+	return &LimiterStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LimiterStateClient) DeleteOne(ls *LimiterState) *LimiterStateDeleteOne {
+	return c.DeleteOneID(ls.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LimiterStateClient) DeleteOneID(id int) *LimiterStateDeleteOne {
+	builder := c.Delete().Where(limiterstate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LimiterStateDeleteOne{builder}
+}
+
+// Query returns a query builder for LimiterState.
+func (c *LimiterStateClient) Query() *LimiterStateQuery {
+	return &LimiterStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLimiterState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LimiterState entity by its id.
+func (c *LimiterStateClient) Get(ctx context.Context, id int) (*LimiterState, error) {
+	return c.Query().Where(limiterstate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LimiterStateClient) GetX(ctx context.Context, id int) *LimiterState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LimiterStateClient) Hooks() []Hook {
+	return c.hooks.LimiterState
+}
+
+// Interceptors returns the client interceptors.
+func (c *LimiterStateClient) Interceptors() []Interceptor {
+	return c.inters.LimiterState
+}
+
+func (c *LimiterStateClient) mutate(ctx context.Context, m *LimiterStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LimiterStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LimiterStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LimiterStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LimiterStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LimiterState mutation op: %q", m.Op())
+	}
+}
+
 // ProofRequestClient is a client for the ProofRequest schema.
 type ProofRequestClient struct {
 	config
@@ -304,6 +611,38 @@ func (c *ProofRequestClient) GetX(ctx context.Context, id int) *ProofRequest {
 	return obj
 }
 
+// QueryAgg queries the agg edge of a ProofRequest.
+func (c *ProofRequestClient) QueryAgg(pr *ProofRequest) *ProofRequestQuery {
+	query := (&ProofRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(proofrequest.Table, proofrequest.FieldID, id),
+			sqlgraph.To(proofrequest.Table, proofrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, proofrequest.AggTable, proofrequest.AggColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySpans queries the spans edge of a ProofRequest.
+func (c *ProofRequestClient) QuerySpans(pr *ProofRequest) *ProofRequestQuery {
+	query := (&ProofRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(proofrequest.Table, proofrequest.FieldID, id),
+			sqlgraph.To(proofrequest.Table, proofrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, proofrequest.SpansTable, proofrequest.SpansColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ProofRequestClient) Hooks() []Hook {
 	return c.hooks.ProofRequest
@@ -329,12 +668,278 @@ func (c *ProofRequestClient) mutate(ctx context.Context, m *ProofRequestMutation
 	}
 }
 
+// ProofRequestEventClient is a client for the ProofRequestEvent schema.
+type ProofRequestEventClient struct {
+	config
+}
+
+// NewProofRequestEventClient returns a client for the ProofRequestEvent from the given config.
+func NewProofRequestEventClient(c config) *ProofRequestEventClient {
+	return &ProofRequestEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `proofrequestevent.Hooks(f(g(h())))`.
+func (c *ProofRequestEventClient) Use(hooks ...Hook) {
+	c.hooks.ProofRequestEvent = append(c.hooks.ProofRequestEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `proofrequestevent.Intercept(f(g(h())))`.
+func (c *ProofRequestEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProofRequestEvent = append(c.inters.ProofRequestEvent, interceptors...)
+}
+
+// Create returns a builder for creating a ProofRequestEvent entity.
+func (c *ProofRequestEventClient) Create() *ProofRequestEventCreate {
+	mutation := newProofRequestEventMutation(c.config, OpCreate)
+	return &ProofRequestEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProofRequestEvent entities.
+func (c *ProofRequestEventClient) CreateBulk(builders ...*ProofRequestEventCreate) *ProofRequestEventCreateBulk {
+	return &ProofRequestEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProofRequestEventClient) MapCreateBulk(slice any, setFunc func(*ProofRequestEventCreate, int)) *ProofRequestEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProofRequestEventCreateBulk{err: fmt.Errorf("calling to ProofRequestEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProofRequestEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProofRequestEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProofRequestEvent.
+func (c *ProofRequestEventClient) Update() *ProofRequestEventUpdate {
+	mutation := newProofRequestEventMutation(c.config, OpUpdate)
+	return &ProofRequestEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProofRequestEventClient) UpdateOne(pre *ProofRequestEvent) *ProofRequestEventUpdateOne {
+	mutation := newProofRequestEventMutation(c.config, OpUpdateOne, withProofRequestEvent(pre))
+	return &ProofRequestEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProofRequestEventClient) UpdateOneID(id int) *ProofRequestEventUpdateOne {
+	mutation := newProofRequestEventMutation(c.config, OpUpdateOne, withProofRequestEventID(id))
+	return &ProofRequestEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProofRequestEvent.
+func (c *ProofRequestEventClient) Delete() *ProofRequestEventDelete {
+	mutation := newProofRequestEventMutation(c.config, OpDelete)
+	return &ProofRequestEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProofRequestEventClient) DeleteOne(pre *ProofRequestEvent) *ProofRequestEventDeleteOne {
+	return c.DeleteOneID(pre.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProofRequestEventClient) DeleteOneID(id int) *ProofRequestEventDeleteOne {
+	builder := c.Delete().Where(proofrequestevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProofRequestEventDeleteOne{builder}
+}
+
+// Query returns a query builder for ProofRequestEvent.
+func (c *ProofRequestEventClient) Query() *ProofRequestEventQuery {
+	return &ProofRequestEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProofRequestEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProofRequestEvent entity by its id.
+func (c *ProofRequestEventClient) Get(ctx context.Context, id int) (*ProofRequestEvent, error) {
+	return c.Query().Where(proofrequestevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProofRequestEventClient) GetX(ctx context.Context, id int) *ProofRequestEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProofRequestEventClient) Hooks() []Hook {
+	return c.hooks.ProofRequestEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProofRequestEventClient) Interceptors() []Interceptor {
+	return c.inters.ProofRequestEvent
+}
+
+func (c *ProofRequestEventClient) mutate(ctx context.Context, m *ProofRequestEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProofRequestEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProofRequestEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProofRequestEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProofRequestEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProofRequestEvent mutation op: %q", m.Op())
+	}
+}
+
+// RangeLeaseClient is a client for the RangeLease schema.
+type RangeLeaseClient struct {
+	config
+}
+
+// NewRangeLeaseClient returns a client for the RangeLease from the given config.
+func NewRangeLeaseClient(c config) *RangeLeaseClient {
+	return &RangeLeaseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rangelease.Hooks(f(g(h())))`.
+func (c *RangeLeaseClient) Use(hooks ...Hook) {
+	c.hooks.RangeLease = append(c.hooks.RangeLease, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rangelease.Intercept(f(g(h())))`.
+func (c *RangeLeaseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RangeLease = append(c.inters.RangeLease, interceptors...)
+}
+
+// Create returns a builder for creating a RangeLease entity.
+func (c *RangeLeaseClient) Create() *RangeLeaseCreate {
+	mutation := newRangeLeaseMutation(c.config, OpCreate)
+	return &RangeLeaseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RangeLease entities.
+func (c *RangeLeaseClient) CreateBulk(builders ...*RangeLeaseCreate) *RangeLeaseCreateBulk {
+	return &RangeLeaseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RangeLeaseClient) MapCreateBulk(slice any, setFunc func(*RangeLeaseCreate, int)) *RangeLeaseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RangeLeaseCreateBulk{err: fmt.Errorf("calling to RangeLeaseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RangeLeaseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RangeLeaseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RangeLease.
+func (c *RangeLeaseClient) Update() *RangeLeaseUpdate {
+	mutation := newRangeLeaseMutation(c.config, OpUpdate)
+	return &RangeLeaseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RangeLeaseClient) UpdateOne(rl *RangeLease) *RangeLeaseUpdateOne {
+	mutation := newRangeLeaseMutation(c.config, OpUpdateOne, withRangeLease(rl))
+	return &RangeLeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RangeLeaseClient) UpdateOneID(id int) *RangeLeaseUpdateOne {
+	mutation := newRangeLeaseMutation(c.config, OpUpdateOne, withRangeLeaseID(id))
+	return &RangeLeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RangeLease.
+func (c *RangeLeaseClient) Delete() *RangeLeaseDelete {
+	mutation := newRangeLeaseMutation(c.config, OpDelete)
+	return &RangeLeaseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RangeLeaseClient) DeleteOne(rl *RangeLease) *RangeLeaseDeleteOne {
+	return c.DeleteOneID(rl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RangeLeaseClient) DeleteOneID(id int) *RangeLeaseDeleteOne {
+	builder := c.Delete().Where(rangelease.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RangeLeaseDeleteOne{builder}
+}
+
+// Query returns a query builder for RangeLease.
+func (c *RangeLeaseClient) Query() *RangeLeaseQuery {
+	return &RangeLeaseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRangeLease},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RangeLease entity by its id.
+func (c *RangeLeaseClient) Get(ctx context.Context, id int) (*RangeLease, error) {
+	return c.Query().Where(rangelease.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RangeLeaseClient) GetX(ctx context.Context, id int) *RangeLease {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RangeLeaseClient) Hooks() []Hook {
+	return c.hooks.RangeLease
+}
+
+// Interceptors returns the client interceptors.
+func (c *RangeLeaseClient) Interceptors() []Interceptor {
+	return c.inters.RangeLease
+}
+
+func (c *RangeLeaseClient) mutate(ctx context.Context, m *RangeLeaseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RangeLeaseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RangeLeaseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RangeLeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RangeLeaseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RangeLease mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ProofRequest []ent.Hook
+		Heartbeat, LimiterState, ProofRequest, ProofRequestEvent, RangeLease []ent.Hook
 	}
 	inters struct {
-		ProofRequest []ent.Interceptor
+		Heartbeat, LimiterState, ProofRequest, ProofRequestEvent, RangeLease []ent.Interceptor
 	}
 )
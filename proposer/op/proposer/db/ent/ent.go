@@ -12,7 +12,11 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/heartbeat"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/limiterstate"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/rangelease"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +77,11 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			proofrequest.Table: proofrequest.ValidColumn,
+			heartbeat.Table:         heartbeat.ValidColumn,
+			limiterstate.Table:      limiterstate.ValidColumn,
+			proofrequest.Table:      proofrequest.ValidColumn,
+			proofrequestevent.Table: proofrequestevent.ValidColumn,
+			rangelease.Table:        rangelease.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
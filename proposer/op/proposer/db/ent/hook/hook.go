@@ -9,6 +9,30 @@ import (
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
 )
 
+// The HeartbeatFunc type is an adapter to allow the use of ordinary
+// function as Heartbeat mutator.
+type HeartbeatFunc func(context.Context, *ent.HeartbeatMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HeartbeatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HeartbeatMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HeartbeatMutation", m)
+}
+
+// The LimiterStateFunc type is an adapter to allow the use of ordinary
+// function as LimiterState mutator.
+type LimiterStateFunc func(context.Context, *ent.LimiterStateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LimiterStateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LimiterStateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LimiterStateMutation", m)
+}
+
 // The ProofRequestFunc type is an adapter to allow the use of ordinary
 // function as ProofRequest mutator.
 type ProofRequestFunc func(context.Context, *ent.ProofRequestMutation) (ent.Value, error)
@@ -21,6 +45,30 @@ func (f ProofRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProofRequestMutation", m)
 }
 
+// The ProofRequestEventFunc type is an adapter to allow the use of ordinary
+// function as ProofRequestEvent mutator.
+type ProofRequestEventFunc func(context.Context, *ent.ProofRequestEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProofRequestEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProofRequestEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProofRequestEventMutation", m)
+}
+
+// The RangeLeaseFunc type is an adapter to allow the use of ordinary
+// function as RangeLease mutator.
+type RangeLeaseFunc func(context.Context, *ent.RangeLeaseMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RangeLeaseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RangeLeaseMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RangeLeaseMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
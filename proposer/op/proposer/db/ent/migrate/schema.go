@@ -9,36 +9,156 @@ import (
 )
 
 var (
+	// HeartbeatsColumns holds the columns for the "heartbeats" table.
+	HeartbeatsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "instance_id", Type: field.TypeString},
+		{Name: "start_time", Type: field.TypeUint64},
+		{Name: "last_time", Type: field.TypeUint64},
+		{Name: "stopped_time", Type: field.TypeUint64},
+	}
+	// HeartbeatsTable holds the schema information for the "heartbeats" table.
+	HeartbeatsTable = &schema.Table{
+		Name:       "heartbeats",
+		Columns:    HeartbeatsColumns,
+		PrimaryKey: []*schema.Column{HeartbeatsColumns[0]},
+	}
+	// LimiterStatesColumns holds the columns for the "limiter_states" table.
+	LimiterStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "limit", Type: field.TypeUint64},
+		{Name: "updated_time", Type: field.TypeUint64},
+	}
+	// LimiterStatesTable holds the schema information for the "limiter_states" table.
+	LimiterStatesTable = &schema.Table{
+		Name:       "limiter_states",
+		Columns:    LimiterStatesColumns,
+		PrimaryKey: []*schema.Column{LimiterStatesColumns[0]},
+	}
 	// ProofRequestsColumns holds the columns for the "proof_requests" table.
 	ProofRequestsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"SPAN", "AGG"}},
 		{Name: "start_block", Type: field.TypeUint64},
 		{Name: "end_block", Type: field.TypeUint64},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"UNREQ", "WITNESSGEN", "PROVING", "FAILED", "COMPLETE"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"UNREQ", "WITNESSGEN", "PROVING", "FAILED", "COMPLETE", "DEADLETTER", "BLOCKED"}},
 		{Name: "request_added_time", Type: field.TypeUint64},
 		{Name: "prover_request_id", Type: field.TypeString, Nullable: true},
+		{Name: "idempotency_key", Type: field.TypeString, Nullable: true},
+		{Name: "external_ref", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "witness_artifact_id", Type: field.TypeString, Nullable: true},
 		{Name: "proof_request_time", Type: field.TypeUint64, Nullable: true},
 		{Name: "last_updated_time", Type: field.TypeUint64},
+		{Name: "proof_timeout", Type: field.TypeUint64, Nullable: true},
+		{Name: "attempts", Type: field.TypeUint64, Nullable: true},
+		{Name: "not_before", Type: field.TypeUint64, Nullable: true},
+		{Name: "cycles", Type: field.TypeUint64, Nullable: true},
+		{Name: "fulfilled_time", Type: field.TypeUint64, Nullable: true},
+		{Name: "submission_reverts", Type: field.TypeUint64, Nullable: true},
+		{Name: "submission_resends", Type: field.TypeUint64, Nullable: true},
+		{Name: "submission_nonce_resyncs", Type: field.TypeUint64, Nullable: true},
 		{Name: "l1_block_number", Type: field.TypeUint64, Nullable: true},
 		{Name: "l1_block_hash", Type: field.TypeString, Nullable: true},
+		{Name: "satisfied_by_tx", Type: field.TypeString, Nullable: true},
 		{Name: "proof", Type: field.TypeBytes, Nullable: true},
+		{Name: "storage_tier", Type: field.TypeEnum, Enums: []string{"HOT", "COLD"}, Default: "HOT"},
+		{Name: "cold_storage_key", Type: field.TypeString, Nullable: true},
+		{Name: "proof_ref", Type: field.TypeString, Nullable: true},
+		{Name: "retrieval_status", Type: field.TypeEnum, Enums: []string{"NONE", "PENDING", "RESTORED"}, Default: "NONE"},
+		{Name: "ipfs_cid", Type: field.TypeString, Nullable: true},
+		{Name: "prover_backend", Type: field.TypeString, Nullable: true},
+		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "requested_by", Type: field.TypeString, Nullable: true},
+		{Name: "completed_by", Type: field.TypeString, Nullable: true},
+		{Name: "fee", Type: field.TypeString, Nullable: true},
+		{Name: "fulfiller", Type: field.TypeString, Nullable: true},
+		{Name: "downtime_cause", Type: field.TypeString, Nullable: true},
+		{Name: "l2_block_hash", Type: field.TypeString, Nullable: true},
+		{Name: "agg_request_id", Type: field.TypeInt, Nullable: true},
 	}
 	// ProofRequestsTable holds the schema information for the "proof_requests" table.
 	ProofRequestsTable = &schema.Table{
 		Name:       "proof_requests",
 		Columns:    ProofRequestsColumns,
 		PrimaryKey: []*schema.Column{ProofRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "proof_requests_proof_requests_spans",
+				Columns:    []*schema.Column{ProofRequestsColumns[38]},
+				RefColumns: []*schema.Column{ProofRequestsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// ProofRequestEventsColumns holds the columns for the "proof_request_events" table.
+	ProofRequestEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "proof_request_id", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"SPAN", "AGG"}},
+		{Name: "start_block", Type: field.TypeUint64},
+		{Name: "end_block", Type: field.TypeUint64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"UNREQ", "WITNESSGEN", "PROVING", "FAILED", "COMPLETE", "DEADLETTER", "BLOCKED"}},
+		{Name: "time", Type: field.TypeUint64},
+	}
+	// ProofRequestEventsTable holds the schema information for the "proof_request_events" table.
+	ProofRequestEventsTable = &schema.Table{
+		Name:       "proof_request_events",
+		Columns:    ProofRequestEventsColumns,
+		PrimaryKey: []*schema.Column{ProofRequestEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "proofrequestevent_time",
+				Unique:  false,
+				Columns: []*schema.Column{ProofRequestEventsColumns[6]},
+			},
+		},
+	}
+	// RangeLeasesColumns holds the columns for the "range_leases" table.
+	RangeLeasesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "owner", Type: field.TypeString},
+		{Name: "start_block", Type: field.TypeUint64},
+		{Name: "end_block", Type: field.TypeUint64},
+		{Name: "expires_time", Type: field.TypeUint64},
+	}
+	// RangeLeasesTable holds the schema information for the "range_leases" table.
+	RangeLeasesTable = &schema.Table{
+		Name:       "range_leases",
+		Columns:    RangeLeasesColumns,
+		PrimaryKey: []*schema.Column{RangeLeasesColumns[0]},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		HeartbeatsTable,
+		LimiterStatesTable,
 		ProofRequestsTable,
+		ProofRequestEventsTable,
+		RangeLeasesTable,
 	}
 )
 
 func init() {
+	HeartbeatsTable.Annotation = &entsql.Annotation{
+		Table:   "heartbeats",
+		Options: "STRICT",
+	}
+	LimiterStatesTable.Annotation = &entsql.Annotation{
+		Table:   "limiter_states",
+		Options: "STRICT",
+	}
+	ProofRequestsTable.ForeignKeys[0].RefTable = ProofRequestsTable
 	ProofRequestsTable.Annotation = &entsql.Annotation{
 		Table:   "proof_requests",
 		Options: "STRICT",
 	}
+	ProofRequestEventsTable.Annotation = &entsql.Annotation{
+		Table:   "proof_request_events",
+		Options: "STRICT",
+	}
+	RangeLeasesTable.Annotation = &entsql.Annotation{
+		Table:   "range_leases",
+		Options: "STRICT",
+	}
 }
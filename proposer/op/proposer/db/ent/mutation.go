@@ -10,8 +10,12 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/heartbeat"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/limiterstate"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/predicate"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/rangelease"
 )
 
 const (
@@ -23,49 +27,43 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeProofRequest = "ProofRequest"
+	TypeHeartbeat         = "Heartbeat"
+	TypeLimiterState      = "LimiterState"
+	TypeProofRequest      = "ProofRequest"
+	TypeProofRequestEvent = "ProofRequestEvent"
+	TypeRangeLease        = "RangeLease"
 )
 
-// ProofRequestMutation represents an operation that mutates the ProofRequest nodes in the graph.
-type ProofRequestMutation struct {
+// HeartbeatMutation represents an operation that mutates the Heartbeat nodes in the graph.
+type HeartbeatMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	_type                 *proofrequest.Type
-	start_block           *uint64
-	addstart_block        *int64
-	end_block             *uint64
-	addend_block          *int64
-	status                *proofrequest.Status
-	request_added_time    *uint64
-	addrequest_added_time *int64
-	prover_request_id     *string
-	proof_request_time    *uint64
-	addproof_request_time *int64
-	last_updated_time     *uint64
-	addlast_updated_time  *int64
-	l1_block_number       *uint64
-	addl1_block_number    *int64
-	l1_block_hash         *string
-	proof                 *[]byte
-	clearedFields         map[string]struct{}
-	done                  bool
-	oldValue              func(context.Context) (*ProofRequest, error)
-	predicates            []predicate.ProofRequest
+	op              Op
+	typ             string
+	id              *int
+	instance_id     *string
+	start_time      *uint64
+	last_time       *uint64
+	addstart_time   *int64
+	addlast_time    *int64
+	stopped_time    *uint64
+	addstopped_time *int64
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Heartbeat, error)
+	predicates      []predicate.Heartbeat
 }
 
-var _ ent.Mutation = (*ProofRequestMutation)(nil)
+var _ ent.Mutation = (*HeartbeatMutation)(nil)
 
-// proofrequestOption allows management of the mutation configuration using functional options.
-type proofrequestOption func(*ProofRequestMutation)
+// heartbeatOption allows management of the mutation configuration using functional options.
+type heartbeatOption func(*HeartbeatMutation)
 
-// newProofRequestMutation creates new mutation for the ProofRequest entity.
-func newProofRequestMutation(c config, op Op, opts ...proofrequestOption) *ProofRequestMutation {
-	m := &ProofRequestMutation{
+// newHeartbeatMutation creates new mutation for the Heartbeat entity.
+func newHeartbeatMutation(c config, op Op, opts ...heartbeatOption) *HeartbeatMutation {
+	m := &HeartbeatMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProofRequest,
+		typ:           TypeHeartbeat,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -74,20 +72,20 @@ func newProofRequestMutation(c config, op Op, opts ...proofrequestOption) *Proof
 	return m
 }
 
-// withProofRequestID sets the ID field of the mutation.
-func withProofRequestID(id int) proofrequestOption {
-	return func(m *ProofRequestMutation) {
+// withHeartbeatID sets the ID field of the mutation.
+func withHeartbeatID(id int) heartbeatOption {
+	return func(m *HeartbeatMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ProofRequest
+			value *Heartbeat
 		)
-		m.oldValue = func(ctx context.Context) (*ProofRequest, error) {
+		m.oldValue = func(ctx context.Context) (*Heartbeat, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ProofRequest.Get(ctx, id)
+					value, err = m.Client().Heartbeat.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -96,10 +94,10 @@ func withProofRequestID(id int) proofrequestOption {
 	}
 }
 
-// withProofRequest sets the old ProofRequest of the mutation.
-func withProofRequest(node *ProofRequest) proofrequestOption {
-	return func(m *ProofRequestMutation) {
-		m.oldValue = func(context.Context) (*ProofRequest, error) {
+// withHeartbeat sets the old Heartbeat of the mutation.
+func withHeartbeat(node *Heartbeat) heartbeatOption {
+	return func(m *HeartbeatMutation) {
+		m.oldValue = func(context.Context) (*Heartbeat, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -108,7 +106,7 @@ func withProofRequest(node *ProofRequest) proofrequestOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProofRequestMutation) Client() *Client {
+func (m HeartbeatMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -116,7 +114,7 @@ func (m ProofRequestMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProofRequestMutation) Tx() (*Tx, error) {
+func (m HeartbeatMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -127,7 +125,7 @@ func (m ProofRequestMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProofRequestMutation) ID() (id int, exists bool) {
+func (m *HeartbeatMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -138,7 +136,7 @@ func (m *ProofRequestMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProofRequestMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *HeartbeatMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -147,662 +145,5616 @@ func (m *ProofRequestMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ProofRequest.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Heartbeat.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetType sets the "type" field.
-func (m *ProofRequestMutation) SetType(pr proofrequest.Type) {
-	m._type = &pr
+// SetInstanceID sets the "instance_id" field.
+func (m *HeartbeatMutation) SetInstanceID(s string) {
+	m.instance_id = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *ProofRequestMutation) GetType() (r proofrequest.Type, exists bool) {
-	v := m._type
+// InstanceID returns the value of the "instance_id" field in the mutation.
+func (m *HeartbeatMutation) InstanceID() (r string, exists bool) {
+	v := m.instance_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the ProofRequest entity.
-// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldInstanceID returns the old "instance_id" field's value of the Heartbeat entity.
+// If the Heartbeat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProofRequestMutation) OldType(ctx context.Context) (v proofrequest.Type, err error) {
+func (m *HeartbeatMutation) OldInstanceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldInstanceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldInstanceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldInstanceID: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.InstanceID, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *ProofRequestMutation) ResetType() {
-	m._type = nil
+// ResetInstanceID resets all changes to the "instance_id" field.
+func (m *HeartbeatMutation) ResetInstanceID() {
+	m.instance_id = nil
 }
 
-// SetStartBlock sets the "start_block" field.
-func (m *ProofRequestMutation) SetStartBlock(u uint64) {
-	m.start_block = &u
-	m.addstart_block = nil
+// SetStartTime sets the "start_time" field.
+func (m *HeartbeatMutation) SetStartTime(u uint64) {
+	m.start_time = &u
+	m.addstart_time = nil
 }
 
-// StartBlock returns the value of the "start_block" field in the mutation.
-func (m *ProofRequestMutation) StartBlock() (r uint64, exists bool) {
-	v := m.start_block
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *HeartbeatMutation) StartTime() (r uint64, exists bool) {
+	v := m.start_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartBlock returns the old "start_block" field's value of the ProofRequest entity.
-// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldStartTime returns the old "start_time" field's value of the Heartbeat entity.
+// If the Heartbeat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProofRequestMutation) OldStartBlock(ctx context.Context) (v uint64, err error) {
+func (m *HeartbeatMutation) OldStartTime(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartBlock is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartBlock requires an ID field in the mutation")
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartBlock: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
 	}
-	return oldValue.StartBlock, nil
+	return oldValue.StartTime, nil
 }
 
-// AddStartBlock adds u to the "start_block" field.
-func (m *ProofRequestMutation) AddStartBlock(u int64) {
-	if m.addstart_block != nil {
-		*m.addstart_block += u
+// AddStartTime adds u to the "start_time" field.
+func (m *HeartbeatMutation) AddStartTime(u int64) {
+	if m.addstart_time != nil {
+		*m.addstart_time += u
 	} else {
-		m.addstart_block = &u
+		m.addstart_time = &u
 	}
 }
 
-// AddedStartBlock returns the value that was added to the "start_block" field in this mutation.
-func (m *ProofRequestMutation) AddedStartBlock() (r int64, exists bool) {
-	v := m.addstart_block
+// AddedStartTime returns the value that was added to the "start_time" field in this mutation.
+func (m *HeartbeatMutation) AddedStartTime() (r int64, exists bool) {
+	v := m.addstart_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetStartBlock resets all changes to the "start_block" field.
-func (m *ProofRequestMutation) ResetStartBlock() {
-	m.start_block = nil
-	m.addstart_block = nil
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *HeartbeatMutation) ResetStartTime() {
+	m.start_time = nil
+	m.addstart_time = nil
 }
 
-// SetEndBlock sets the "end_block" field.
-func (m *ProofRequestMutation) SetEndBlock(u uint64) {
-	m.end_block = &u
-	m.addend_block = nil
+// SetLastTime sets the "last_time" field.
+func (m *HeartbeatMutation) SetLastTime(u uint64) {
+	m.last_time = &u
+	m.addlast_time = nil
 }
 
-// EndBlock returns the value of the "end_block" field in the mutation.
-func (m *ProofRequestMutation) EndBlock() (r uint64, exists bool) {
-	v := m.end_block
+// LastTime returns the value of the "last_time" field in the mutation.
+func (m *HeartbeatMutation) LastTime() (r uint64, exists bool) {
+	v := m.last_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndBlock returns the old "end_block" field's value of the ProofRequest entity.
-// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldLastTime returns the old "last_time" field's value of the Heartbeat entity.
+// If the Heartbeat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProofRequestMutation) OldEndBlock(ctx context.Context) (v uint64, err error) {
+func (m *HeartbeatMutation) OldLastTime(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndBlock is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndBlock requires an ID field in the mutation")
+		return v, errors.New("OldLastTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndBlock: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastTime: %w", err)
 	}
-	return oldValue.EndBlock, nil
+	return oldValue.LastTime, nil
 }
 
-// AddEndBlock adds u to the "end_block" field.
-func (m *ProofRequestMutation) AddEndBlock(u int64) {
-	if m.addend_block != nil {
-		*m.addend_block += u
+// AddLastTime adds u to the "last_time" field.
+func (m *HeartbeatMutation) AddLastTime(u int64) {
+	if m.addlast_time != nil {
+		*m.addlast_time += u
 	} else {
-		m.addend_block = &u
-	}
-}
-
-// AddedEndBlock returns the value that was added to the "end_block" field in this mutation.
-func (m *ProofRequestMutation) AddedEndBlock() (r int64, exists bool) {
-	v := m.addend_block
-	if v == nil {
-		return
+		m.addlast_time = &u
 	}
-	return *v, true
-}
-
-// ResetEndBlock resets all changes to the "end_block" field.
-func (m *ProofRequestMutation) ResetEndBlock() {
-	m.end_block = nil
-	m.addend_block = nil
-}
-
-// SetStatus sets the "status" field.
-func (m *ProofRequestMutation) SetStatus(pr proofrequest.Status) {
-	m.status = &pr
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ProofRequestMutation) Status() (r proofrequest.Status, exists bool) {
-	v := m.status
+// AddedLastTime returns the value that was added to the "last_time" field in this mutation.
+func (m *HeartbeatMutation) AddedLastTime() (r int64, exists bool) {
+	v := m.addlast_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the ProofRequest entity.
-// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProofRequestMutation) OldStatus(ctx context.Context) (v proofrequest.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *ProofRequestMutation) ResetStatus() {
-	m.status = nil
+// ResetLastTime resets all changes to the "last_time" field.
+func (m *HeartbeatMutation) ResetLastTime() {
+	m.last_time = nil
+	m.addlast_time = nil
 }
 
-// SetRequestAddedTime sets the "request_added_time" field.
-func (m *ProofRequestMutation) SetRequestAddedTime(u uint64) {
-	m.request_added_time = &u
-	m.addrequest_added_time = nil
+// SetStoppedTime sets the "stopped_time" field.
+func (m *HeartbeatMutation) SetStoppedTime(u uint64) {
+	m.stopped_time = &u
+	m.addstopped_time = nil
 }
 
-// RequestAddedTime returns the value of the "request_added_time" field in the mutation.
-func (m *ProofRequestMutation) RequestAddedTime() (r uint64, exists bool) {
-	v := m.request_added_time
+// StoppedTime returns the value of the "stopped_time" field in the mutation.
+func (m *HeartbeatMutation) StoppedTime() (r uint64, exists bool) {
+	v := m.stopped_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRequestAddedTime returns the old "request_added_time" field's value of the ProofRequest entity.
-// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldStoppedTime returns the old "stopped_time" field's value of the Heartbeat entity.
+// If the Heartbeat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProofRequestMutation) OldRequestAddedTime(ctx context.Context) (v uint64, err error) {
+func (m *HeartbeatMutation) OldStoppedTime(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRequestAddedTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldStoppedTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRequestAddedTime requires an ID field in the mutation")
+		return v, errors.New("OldStoppedTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRequestAddedTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldStoppedTime: %w", err)
 	}
-	return oldValue.RequestAddedTime, nil
+	return oldValue.StoppedTime, nil
 }
 
-// AddRequestAddedTime adds u to the "request_added_time" field.
-func (m *ProofRequestMutation) AddRequestAddedTime(u int64) {
-	if m.addrequest_added_time != nil {
-		*m.addrequest_added_time += u
+// AddStoppedTime adds u to the "stopped_time" field.
+func (m *HeartbeatMutation) AddStoppedTime(u int64) {
+	if m.addstopped_time != nil {
+		*m.addstopped_time += u
 	} else {
-		m.addrequest_added_time = &u
+		m.addstopped_time = &u
 	}
 }
 
-// AddedRequestAddedTime returns the value that was added to the "request_added_time" field in this mutation.
-func (m *ProofRequestMutation) AddedRequestAddedTime() (r int64, exists bool) {
-	v := m.addrequest_added_time
+// AddedStoppedTime returns the value that was added to the "stopped_time" field in this mutation.
+func (m *HeartbeatMutation) AddedStoppedTime() (r int64, exists bool) {
+	v := m.addstopped_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRequestAddedTime resets all changes to the "request_added_time" field.
-func (m *ProofRequestMutation) ResetRequestAddedTime() {
-	m.request_added_time = nil
-	m.addrequest_added_time = nil
-}
-
-// SetProverRequestID sets the "prover_request_id" field.
-func (m *ProofRequestMutation) SetProverRequestID(s string) {
-	m.prover_request_id = &s
+// ResetStoppedTime resets all changes to the "stopped_time" field.
+func (m *HeartbeatMutation) ResetStoppedTime() {
+	m.stopped_time = nil
+	m.addstopped_time = nil
 }
 
-// ProverRequestID returns the value of the "prover_request_id" field in the mutation.
-func (m *ProofRequestMutation) ProverRequestID() (r string, exists bool) {
-	v := m.prover_request_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the HeartbeatMutation builder.
+func (m *HeartbeatMutation) Where(ps ...predicate.Heartbeat) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldProverRequestID returns the old "prover_request_id" field's value of the ProofRequest entity.
-// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProofRequestMutation) OldProverRequestID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProverRequestID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProverRequestID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProverRequestID: %w", err)
+// WhereP appends storage-level predicates to the HeartbeatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HeartbeatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Heartbeat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.ProverRequestID, nil
-}
-
-// ClearProverRequestID clears the value of the "prover_request_id" field.
-func (m *ProofRequestMutation) ClearProverRequestID() {
-	m.prover_request_id = nil
-	m.clearedFields[proofrequest.FieldProverRequestID] = struct{}{}
+	m.Where(p...)
 }
 
-// ProverRequestIDCleared returns if the "prover_request_id" field was cleared in this mutation.
-func (m *ProofRequestMutation) ProverRequestIDCleared() bool {
-	_, ok := m.clearedFields[proofrequest.FieldProverRequestID]
-	return ok
+// Op returns the operation name.
+func (m *HeartbeatMutation) Op() Op {
+	return m.op
 }
 
-// ResetProverRequestID resets all changes to the "prover_request_id" field.
-func (m *ProofRequestMutation) ResetProverRequestID() {
-	m.prover_request_id = nil
-	delete(m.clearedFields, proofrequest.FieldProverRequestID)
+// SetOp allows setting the mutation operation.
+func (m *HeartbeatMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetProofRequestTime sets the "proof_request_time" field.
-func (m *ProofRequestMutation) SetProofRequestTime(u uint64) {
-	m.proof_request_time = &u
-	m.addproof_request_time = nil
+// Type returns the node type of this mutation (Heartbeat).
+func (m *HeartbeatMutation) Type() string {
+	return m.typ
 }
 
-// ProofRequestTime returns the value of the "proof_request_time" field in the mutation.
-func (m *ProofRequestMutation) ProofRequestTime() (r uint64, exists bool) {
-	v := m.proof_request_time
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HeartbeatMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.instance_id != nil {
+		fields = append(fields, heartbeat.FieldInstanceID)
 	}
-	return *v, true
-}
-
-// OldProofRequestTime returns the old "proof_request_time" field's value of the ProofRequest entity.
-// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProofRequestMutation) OldProofRequestTime(ctx context.Context) (v uint64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProofRequestTime is only allowed on UpdateOne operations")
+	if m.start_time != nil {
+		fields = append(fields, heartbeat.FieldStartTime)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProofRequestTime requires an ID field in the mutation")
+	if m.last_time != nil {
+		fields = append(fields, heartbeat.FieldLastTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProofRequestTime: %w", err)
+	if m.stopped_time != nil {
+		fields = append(fields, heartbeat.FieldStoppedTime)
 	}
-	return oldValue.ProofRequestTime, nil
+	return fields
 }
 
-// AddProofRequestTime adds u to the "proof_request_time" field.
-func (m *ProofRequestMutation) AddProofRequestTime(u int64) {
-	if m.addproof_request_time != nil {
-		*m.addproof_request_time += u
-	} else {
-		m.addproof_request_time = &u
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HeartbeatMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case heartbeat.FieldInstanceID:
+		return m.InstanceID()
+	case heartbeat.FieldStartTime:
+		return m.StartTime()
+	case heartbeat.FieldLastTime:
+		return m.LastTime()
+	case heartbeat.FieldStoppedTime:
+		return m.StoppedTime()
 	}
+	return nil, false
 }
 
-// AddedProofRequestTime returns the value that was added to the "proof_request_time" field in this mutation.
-func (m *ProofRequestMutation) AddedProofRequestTime() (r int64, exists bool) {
-	v := m.addproof_request_time
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HeartbeatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case heartbeat.FieldInstanceID:
+		return m.OldInstanceID(ctx)
+	case heartbeat.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case heartbeat.FieldLastTime:
+		return m.OldLastTime(ctx)
+	case heartbeat.FieldStoppedTime:
+		return m.OldStoppedTime(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown Heartbeat field %s", name)
 }
 
-// ClearProofRequestTime clears the value of the "proof_request_time" field.
-func (m *ProofRequestMutation) ClearProofRequestTime() {
-	m.proof_request_time = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HeartbeatMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case heartbeat.FieldInstanceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstanceID(v)
+		return nil
+	case heartbeat.FieldStartTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case heartbeat.FieldLastTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastTime(v)
+		return nil
+	case heartbeat.FieldStoppedTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStoppedTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Heartbeat field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HeartbeatMutation) AddedFields() []string {
+	var fields []string
+	if m.addstart_time != nil {
+		fields = append(fields, heartbeat.FieldStartTime)
+	}
+	if m.addlast_time != nil {
+		fields = append(fields, heartbeat.FieldLastTime)
+	}
+	if m.addstopped_time != nil {
+		fields = append(fields, heartbeat.FieldStoppedTime)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HeartbeatMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case heartbeat.FieldStartTime:
+		return m.AddedStartTime()
+	case heartbeat.FieldLastTime:
+		return m.AddedLastTime()
+	case heartbeat.FieldStoppedTime:
+		return m.AddedStoppedTime()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HeartbeatMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case heartbeat.FieldStartTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartTime(v)
+		return nil
+	case heartbeat.FieldLastTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastTime(v)
+		return nil
+	case heartbeat.FieldStoppedTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStoppedTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Heartbeat numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HeartbeatMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HeartbeatMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HeartbeatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Heartbeat nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HeartbeatMutation) ResetField(name string) error {
+	switch name {
+	case heartbeat.FieldInstanceID:
+		m.ResetInstanceID()
+		return nil
+	case heartbeat.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case heartbeat.FieldLastTime:
+		m.ResetLastTime()
+		return nil
+	case heartbeat.FieldStoppedTime:
+		m.ResetStoppedTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Heartbeat field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HeartbeatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HeartbeatMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HeartbeatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HeartbeatMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HeartbeatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HeartbeatMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HeartbeatMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Heartbeat unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HeartbeatMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Heartbeat edge %s", name)
+}
+
+// LimiterStateMutation represents an operation that mutates the LimiterState nodes in the graph.
+type LimiterStateMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	_limit          *uint64
+	add_limit       *int64
+	updated_time    *uint64
+	addupdated_time *int64
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*LimiterState, error)
+	predicates      []predicate.LimiterState
+}
+
+var _ ent.Mutation = (*LimiterStateMutation)(nil)
+
+// limiterstateOption allows management of the mutation configuration using functional options.
+type limiterstateOption func(*LimiterStateMutation)
+
+// newLimiterStateMutation creates new mutation for the LimiterState entity.
+func newLimiterStateMutation(c config, op Op, opts ...limiterstateOption) *LimiterStateMutation {
+	m := &LimiterStateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLimiterState,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLimiterStateID sets the ID field of the mutation.
+func withLimiterStateID(id int) limiterstateOption {
+	return func(m *LimiterStateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LimiterState
+		)
+		m.oldValue = func(ctx context.Context) (*LimiterState, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LimiterState.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLimiterState sets the old LimiterState of the mutation.
+func withLimiterState(node *LimiterState) limiterstateOption {
+	return func(m *LimiterStateMutation) {
+		m.oldValue = func(context.Context) (*LimiterState, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LimiterStateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LimiterStateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LimiterStateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LimiterStateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LimiterState.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *LimiterStateMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LimiterStateMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the LimiterState entity.
+// If the LimiterState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LimiterStateMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LimiterStateMutation) ResetName() {
+	m.name = nil
+}
+
+// SetLimit sets the "limit" field.
+func (m *LimiterStateMutation) SetLimit(u uint64) {
+	m._limit = &u
+	m.add_limit = nil
+}
+
+// Limit returns the value of the "limit" field in the mutation.
+func (m *LimiterStateMutation) Limit() (r uint64, exists bool) {
+	v := m._limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLimit returns the old "limit" field's value of the LimiterState entity.
+// If the LimiterState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LimiterStateMutation) OldLimit(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLimit: %w", err)
+	}
+	return oldValue.Limit, nil
+}
+
+// AddLimit adds u to the "limit" field.
+func (m *LimiterStateMutation) AddLimit(u int64) {
+	if m.add_limit != nil {
+		*m.add_limit += u
+	} else {
+		m.add_limit = &u
+	}
+}
+
+// AddedLimit returns the value that was added to the "limit" field in this mutation.
+func (m *LimiterStateMutation) AddedLimit() (r int64, exists bool) {
+	v := m.add_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLimit resets all changes to the "limit" field.
+func (m *LimiterStateMutation) ResetLimit() {
+	m._limit = nil
+	m.add_limit = nil
+}
+
+// SetUpdatedTime sets the "updated_time" field.
+func (m *LimiterStateMutation) SetUpdatedTime(u uint64) {
+	m.updated_time = &u
+	m.addupdated_time = nil
+}
+
+// UpdatedTime returns the value of the "updated_time" field in the mutation.
+func (m *LimiterStateMutation) UpdatedTime() (r uint64, exists bool) {
+	v := m.updated_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedTime returns the old "updated_time" field's value of the LimiterState entity.
+// If the LimiterState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LimiterStateMutation) OldUpdatedTime(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedTime: %w", err)
+	}
+	return oldValue.UpdatedTime, nil
+}
+
+// AddUpdatedTime adds u to the "updated_time" field.
+func (m *LimiterStateMutation) AddUpdatedTime(u int64) {
+	if m.addupdated_time != nil {
+		*m.addupdated_time += u
+	} else {
+		m.addupdated_time = &u
+	}
+}
+
+// AddedUpdatedTime returns the value that was added to the "updated_time" field in this mutation.
+func (m *LimiterStateMutation) AddedUpdatedTime() (r int64, exists bool) {
+	v := m.addupdated_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedTime resets all changes to the "updated_time" field.
+func (m *LimiterStateMutation) ResetUpdatedTime() {
+	m.updated_time = nil
+	m.addupdated_time = nil
+}
+
+// Where appends a list predicates to the LimiterStateMutation builder.
+func (m *LimiterStateMutation) Where(ps ...predicate.LimiterState) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LimiterStateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LimiterStateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LimiterState, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LimiterStateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LimiterStateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LimiterState).
+func (m *LimiterStateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LimiterStateMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, limiterstate.FieldName)
+	}
+	if m._limit != nil {
+		fields = append(fields, limiterstate.FieldLimit)
+	}
+	if m.updated_time != nil {
+		fields = append(fields, limiterstate.FieldUpdatedTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LimiterStateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case limiterstate.FieldName:
+		return m.Name()
+	case limiterstate.FieldLimit:
+		return m.Limit()
+	case limiterstate.FieldUpdatedTime:
+		return m.UpdatedTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LimiterStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case limiterstate.FieldName:
+		return m.OldName(ctx)
+	case limiterstate.FieldLimit:
+		return m.OldLimit(ctx)
+	case limiterstate.FieldUpdatedTime:
+		return m.OldUpdatedTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown LimiterState field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LimiterStateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case limiterstate.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case limiterstate.FieldLimit:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLimit(v)
+		return nil
+	case limiterstate.FieldUpdatedTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LimiterState field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LimiterStateMutation) AddedFields() []string {
+	var fields []string
+	if m.add_limit != nil {
+		fields = append(fields, limiterstate.FieldLimit)
+	}
+	if m.addupdated_time != nil {
+		fields = append(fields, limiterstate.FieldUpdatedTime)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LimiterStateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case limiterstate.FieldLimit:
+		return m.AddedLimit()
+	case limiterstate.FieldUpdatedTime:
+		return m.AddedUpdatedTime()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LimiterStateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case limiterstate.FieldLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLimit(v)
+		return nil
+	case limiterstate.FieldUpdatedTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LimiterState numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LimiterStateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LimiterStateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LimiterStateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LimiterState nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LimiterStateMutation) ResetField(name string) error {
+	switch name {
+	case limiterstate.FieldName:
+		m.ResetName()
+		return nil
+	case limiterstate.FieldLimit:
+		m.ResetLimit()
+		return nil
+	case limiterstate.FieldUpdatedTime:
+		m.ResetUpdatedTime()
+		return nil
+	}
+	return fmt.Errorf("unknown LimiterState field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LimiterStateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LimiterStateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LimiterStateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LimiterStateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LimiterStateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LimiterStateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LimiterStateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LimiterState unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LimiterStateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LimiterState edge %s", name)
+}
+
+// ProofRequestMutation represents an operation that mutates the ProofRequest nodes in the graph.
+type ProofRequestMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *int
+	_type                       *proofrequest.Type
+	start_block                 *uint64
+	addstart_block              *int64
+	end_block                   *uint64
+	addend_block                *int64
+	status                      *proofrequest.Status
+	request_added_time          *uint64
+	addrequest_added_time       *int64
+	prover_request_id           *string
+	idempotency_key             *string
+	external_ref                *string
+	witness_artifact_id         *string
+	proof_request_time          *uint64
+	addproof_request_time       *int64
+	last_updated_time           *uint64
+	addlast_updated_time        *int64
+	proof_timeout               *uint64
+	attempts                    *uint64
+	not_before                  *uint64
+	cycles                      *uint64
+	fulfilled_time              *uint64
+	submission_reverts          *uint64
+	submission_resends          *uint64
+	submission_nonce_resyncs    *uint64
+	addproof_timeout            *int64
+	addattempts                 *int64
+	addnot_before               *int64
+	addcycles                   *int64
+	addfulfilled_time           *int64
+	addsubmission_reverts       *int64
+	addsubmission_resends       *int64
+	addsubmission_nonce_resyncs *int64
+	l1_block_number             *uint64
+	addl1_block_number          *int64
+	l1_block_hash               *string
+	satisfied_by_tx             *string
+	proof                       *[]byte
+	storage_tier                *proofrequest.StorageTier
+	cold_storage_key            *string
+	proof_ref                   *string
+	retrieval_status            *proofrequest.RetrievalStatus
+	ipfs_cid                    *string
+	prover_backend              *string
+	error_message               *string
+	created_by                  *string
+	requested_by                *string
+	completed_by                *string
+	fee                         *string
+	fulfiller                   *string
+	downtime_cause              *string
+	l2_block_hash               *string
+	clearedFields               map[string]struct{}
+	agg                         *int
+	clearedagg                  bool
+	spans                       map[int]struct{}
+	removedspans                map[int]struct{}
+	clearedspans                bool
+	done                        bool
+	oldValue                    func(context.Context) (*ProofRequest, error)
+	predicates                  []predicate.ProofRequest
+}
+
+var _ ent.Mutation = (*ProofRequestMutation)(nil)
+
+// proofrequestOption allows management of the mutation configuration using functional options.
+type proofrequestOption func(*ProofRequestMutation)
+
+// newProofRequestMutation creates new mutation for the ProofRequest entity.
+func newProofRequestMutation(c config, op Op, opts ...proofrequestOption) *ProofRequestMutation {
+	m := &ProofRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProofRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProofRequestID sets the ID field of the mutation.
+func withProofRequestID(id int) proofrequestOption {
+	return func(m *ProofRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProofRequest
+		)
+		m.oldValue = func(ctx context.Context) (*ProofRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProofRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProofRequest sets the old ProofRequest of the mutation.
+func withProofRequest(node *ProofRequest) proofrequestOption {
+	return func(m *ProofRequestMutation) {
+		m.oldValue = func(context.Context) (*ProofRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProofRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProofRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ProofRequest entities.
+func (m *ProofRequestMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProofRequestMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProofRequestMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProofRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *ProofRequestMutation) SetType(pr proofrequest.Type) {
+	m._type = &pr
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ProofRequestMutation) GetType() (r proofrequest.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldType(ctx context.Context) (v proofrequest.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ProofRequestMutation) ResetType() {
+	m._type = nil
+}
+
+// SetStartBlock sets the "start_block" field.
+func (m *ProofRequestMutation) SetStartBlock(u uint64) {
+	m.start_block = &u
+	m.addstart_block = nil
+}
+
+// StartBlock returns the value of the "start_block" field in the mutation.
+func (m *ProofRequestMutation) StartBlock() (r uint64, exists bool) {
+	v := m.start_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartBlock returns the old "start_block" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldStartBlock(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartBlock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartBlock requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartBlock: %w", err)
+	}
+	return oldValue.StartBlock, nil
+}
+
+// AddStartBlock adds u to the "start_block" field.
+func (m *ProofRequestMutation) AddStartBlock(u int64) {
+	if m.addstart_block != nil {
+		*m.addstart_block += u
+	} else {
+		m.addstart_block = &u
+	}
+}
+
+// AddedStartBlock returns the value that was added to the "start_block" field in this mutation.
+func (m *ProofRequestMutation) AddedStartBlock() (r int64, exists bool) {
+	v := m.addstart_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStartBlock resets all changes to the "start_block" field.
+func (m *ProofRequestMutation) ResetStartBlock() {
+	m.start_block = nil
+	m.addstart_block = nil
+}
+
+// SetEndBlock sets the "end_block" field.
+func (m *ProofRequestMutation) SetEndBlock(u uint64) {
+	m.end_block = &u
+	m.addend_block = nil
+}
+
+// EndBlock returns the value of the "end_block" field in the mutation.
+func (m *ProofRequestMutation) EndBlock() (r uint64, exists bool) {
+	v := m.end_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndBlock returns the old "end_block" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldEndBlock(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndBlock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndBlock requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndBlock: %w", err)
+	}
+	return oldValue.EndBlock, nil
+}
+
+// AddEndBlock adds u to the "end_block" field.
+func (m *ProofRequestMutation) AddEndBlock(u int64) {
+	if m.addend_block != nil {
+		*m.addend_block += u
+	} else {
+		m.addend_block = &u
+	}
+}
+
+// AddedEndBlock returns the value that was added to the "end_block" field in this mutation.
+func (m *ProofRequestMutation) AddedEndBlock() (r int64, exists bool) {
+	v := m.addend_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEndBlock resets all changes to the "end_block" field.
+func (m *ProofRequestMutation) ResetEndBlock() {
+	m.end_block = nil
+	m.addend_block = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ProofRequestMutation) SetStatus(pr proofrequest.Status) {
+	m.status = &pr
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ProofRequestMutation) Status() (r proofrequest.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldStatus(ctx context.Context) (v proofrequest.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ProofRequestMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRequestAddedTime sets the "request_added_time" field.
+func (m *ProofRequestMutation) SetRequestAddedTime(u uint64) {
+	m.request_added_time = &u
+	m.addrequest_added_time = nil
+}
+
+// RequestAddedTime returns the value of the "request_added_time" field in the mutation.
+func (m *ProofRequestMutation) RequestAddedTime() (r uint64, exists bool) {
+	v := m.request_added_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestAddedTime returns the old "request_added_time" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldRequestAddedTime(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestAddedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestAddedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestAddedTime: %w", err)
+	}
+	return oldValue.RequestAddedTime, nil
+}
+
+// AddRequestAddedTime adds u to the "request_added_time" field.
+func (m *ProofRequestMutation) AddRequestAddedTime(u int64) {
+	if m.addrequest_added_time != nil {
+		*m.addrequest_added_time += u
+	} else {
+		m.addrequest_added_time = &u
+	}
+}
+
+// AddedRequestAddedTime returns the value that was added to the "request_added_time" field in this mutation.
+func (m *ProofRequestMutation) AddedRequestAddedTime() (r int64, exists bool) {
+	v := m.addrequest_added_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRequestAddedTime resets all changes to the "request_added_time" field.
+func (m *ProofRequestMutation) ResetRequestAddedTime() {
+	m.request_added_time = nil
+	m.addrequest_added_time = nil
+}
+
+// SetProverRequestID sets the "prover_request_id" field.
+func (m *ProofRequestMutation) SetProverRequestID(s string) {
+	m.prover_request_id = &s
+}
+
+// ProverRequestID returns the value of the "prover_request_id" field in the mutation.
+func (m *ProofRequestMutation) ProverRequestID() (r string, exists bool) {
+	v := m.prover_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProverRequestID returns the old "prover_request_id" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldProverRequestID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProverRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProverRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProverRequestID: %w", err)
+	}
+	return oldValue.ProverRequestID, nil
+}
+
+// ClearProverRequestID clears the value of the "prover_request_id" field.
+func (m *ProofRequestMutation) ClearProverRequestID() {
+	m.prover_request_id = nil
+	m.clearedFields[proofrequest.FieldProverRequestID] = struct{}{}
+}
+
+// ProverRequestIDCleared returns if the "prover_request_id" field was cleared in this mutation.
+func (m *ProofRequestMutation) ProverRequestIDCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldProverRequestID]
+	return ok
+}
+
+// ResetProverRequestID resets all changes to the "prover_request_id" field.
+func (m *ProofRequestMutation) ResetProverRequestID() {
+	m.prover_request_id = nil
+	delete(m.clearedFields, proofrequest.FieldProverRequestID)
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (m *ProofRequestMutation) SetIdempotencyKey(s string) {
+	m.idempotency_key = &s
+}
+
+// IdempotencyKey returns the value of the "idempotency_key" field in the mutation.
+func (m *ProofRequestMutation) IdempotencyKey() (r string, exists bool) {
+	v := m.idempotency_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdempotencyKey returns the old "idempotency_key" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldIdempotencyKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdempotencyKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdempotencyKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdempotencyKey: %w", err)
+	}
+	return oldValue.IdempotencyKey, nil
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (m *ProofRequestMutation) ClearIdempotencyKey() {
+	m.idempotency_key = nil
+	m.clearedFields[proofrequest.FieldIdempotencyKey] = struct{}{}
+}
+
+// IdempotencyKeyCleared returns if the "idempotency_key" field was cleared in this mutation.
+func (m *ProofRequestMutation) IdempotencyKeyCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldIdempotencyKey]
+	return ok
+}
+
+// ResetIdempotencyKey resets all changes to the "idempotency_key" field.
+func (m *ProofRequestMutation) ResetIdempotencyKey() {
+	m.idempotency_key = nil
+	delete(m.clearedFields, proofrequest.FieldIdempotencyKey)
+}
+
+// SetExternalRef sets the "external_ref" field.
+func (m *ProofRequestMutation) SetExternalRef(s string) {
+	m.external_ref = &s
+}
+
+// ExternalRef returns the value of the "external_ref" field in the mutation.
+func (m *ProofRequestMutation) ExternalRef() (r string, exists bool) {
+	v := m.external_ref
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalRef returns the old "external_ref" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldExternalRef(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalRef is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalRef requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalRef: %w", err)
+	}
+	return oldValue.ExternalRef, nil
+}
+
+// ClearExternalRef clears the value of the "external_ref" field.
+func (m *ProofRequestMutation) ClearExternalRef() {
+	m.external_ref = nil
+	m.clearedFields[proofrequest.FieldExternalRef] = struct{}{}
+}
+
+// ExternalRefCleared returns if the "external_ref" field was cleared in this mutation.
+func (m *ProofRequestMutation) ExternalRefCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldExternalRef]
+	return ok
+}
+
+// ResetExternalRef resets all changes to the "external_ref" field.
+func (m *ProofRequestMutation) ResetExternalRef() {
+	m.external_ref = nil
+	delete(m.clearedFields, proofrequest.FieldExternalRef)
+}
+
+// SetWitnessArtifactID sets the "witness_artifact_id" field.
+func (m *ProofRequestMutation) SetWitnessArtifactID(s string) {
+	m.witness_artifact_id = &s
+}
+
+// WitnessArtifactID returns the value of the "witness_artifact_id" field in the mutation.
+func (m *ProofRequestMutation) WitnessArtifactID() (r string, exists bool) {
+	v := m.witness_artifact_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWitnessArtifactID returns the old "witness_artifact_id" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldWitnessArtifactID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWitnessArtifactID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWitnessArtifactID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWitnessArtifactID: %w", err)
+	}
+	return oldValue.WitnessArtifactID, nil
+}
+
+// ClearWitnessArtifactID clears the value of the "witness_artifact_id" field.
+func (m *ProofRequestMutation) ClearWitnessArtifactID() {
+	m.witness_artifact_id = nil
+	m.clearedFields[proofrequest.FieldWitnessArtifactID] = struct{}{}
+}
+
+// WitnessArtifactIDCleared returns if the "witness_artifact_id" field was cleared in this mutation.
+func (m *ProofRequestMutation) WitnessArtifactIDCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldWitnessArtifactID]
+	return ok
+}
+
+// ResetWitnessArtifactID resets all changes to the "witness_artifact_id" field.
+func (m *ProofRequestMutation) ResetWitnessArtifactID() {
+	m.witness_artifact_id = nil
+	delete(m.clearedFields, proofrequest.FieldWitnessArtifactID)
+}
+
+// SetAggRequestID sets the "agg_request_id" field.
+func (m *ProofRequestMutation) SetAggRequestID(i int) {
+	m.agg = &i
+}
+
+// AggRequestID returns the value of the "agg_request_id" field in the mutation.
+func (m *ProofRequestMutation) AggRequestID() (r int, exists bool) {
+	v := m.agg
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAggRequestID returns the old "agg_request_id" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldAggRequestID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAggRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAggRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAggRequestID: %w", err)
+	}
+	return oldValue.AggRequestID, nil
+}
+
+// ClearAggRequestID clears the value of the "agg_request_id" field.
+func (m *ProofRequestMutation) ClearAggRequestID() {
+	m.agg = nil
+	m.clearedFields[proofrequest.FieldAggRequestID] = struct{}{}
+}
+
+// AggRequestIDCleared returns if the "agg_request_id" field was cleared in this mutation.
+func (m *ProofRequestMutation) AggRequestIDCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldAggRequestID]
+	return ok
+}
+
+// ResetAggRequestID resets all changes to the "agg_request_id" field.
+func (m *ProofRequestMutation) ResetAggRequestID() {
+	m.agg = nil
+	delete(m.clearedFields, proofrequest.FieldAggRequestID)
+}
+
+// SetProofRequestTime sets the "proof_request_time" field.
+func (m *ProofRequestMutation) SetProofRequestTime(u uint64) {
+	m.proof_request_time = &u
+	m.addproof_request_time = nil
+}
+
+// ProofRequestTime returns the value of the "proof_request_time" field in the mutation.
+func (m *ProofRequestMutation) ProofRequestTime() (r uint64, exists bool) {
+	v := m.proof_request_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProofRequestTime returns the old "proof_request_time" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldProofRequestTime(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProofRequestTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProofRequestTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProofRequestTime: %w", err)
+	}
+	return oldValue.ProofRequestTime, nil
+}
+
+// AddProofRequestTime adds u to the "proof_request_time" field.
+func (m *ProofRequestMutation) AddProofRequestTime(u int64) {
+	if m.addproof_request_time != nil {
+		*m.addproof_request_time += u
+	} else {
+		m.addproof_request_time = &u
+	}
+}
+
+// AddedProofRequestTime returns the value that was added to the "proof_request_time" field in this mutation.
+func (m *ProofRequestMutation) AddedProofRequestTime() (r int64, exists bool) {
+	v := m.addproof_request_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearProofRequestTime clears the value of the "proof_request_time" field.
+func (m *ProofRequestMutation) ClearProofRequestTime() {
+	m.proof_request_time = nil
 	m.addproof_request_time = nil
 	m.clearedFields[proofrequest.FieldProofRequestTime] = struct{}{}
 }
 
-// ProofRequestTimeCleared returns if the "proof_request_time" field was cleared in this mutation.
-func (m *ProofRequestMutation) ProofRequestTimeCleared() bool {
-	_, ok := m.clearedFields[proofrequest.FieldProofRequestTime]
-	return ok
+// ProofRequestTimeCleared returns if the "proof_request_time" field was cleared in this mutation.
+func (m *ProofRequestMutation) ProofRequestTimeCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldProofRequestTime]
+	return ok
+}
+
+// ResetProofRequestTime resets all changes to the "proof_request_time" field.
+func (m *ProofRequestMutation) ResetProofRequestTime() {
+	m.proof_request_time = nil
+	m.addproof_request_time = nil
+	delete(m.clearedFields, proofrequest.FieldProofRequestTime)
+}
+
+// SetLastUpdatedTime sets the "last_updated_time" field.
+func (m *ProofRequestMutation) SetLastUpdatedTime(u uint64) {
+	m.last_updated_time = &u
+	m.addlast_updated_time = nil
+}
+
+// LastUpdatedTime returns the value of the "last_updated_time" field in the mutation.
+func (m *ProofRequestMutation) LastUpdatedTime() (r uint64, exists bool) {
+	v := m.last_updated_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUpdatedTime returns the old "last_updated_time" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldLastUpdatedTime(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUpdatedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUpdatedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUpdatedTime: %w", err)
+	}
+	return oldValue.LastUpdatedTime, nil
+}
+
+// AddLastUpdatedTime adds u to the "last_updated_time" field.
+func (m *ProofRequestMutation) AddLastUpdatedTime(u int64) {
+	if m.addlast_updated_time != nil {
+		*m.addlast_updated_time += u
+	} else {
+		m.addlast_updated_time = &u
+	}
+}
+
+// AddedLastUpdatedTime returns the value that was added to the "last_updated_time" field in this mutation.
+func (m *ProofRequestMutation) AddedLastUpdatedTime() (r int64, exists bool) {
+	v := m.addlast_updated_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLastUpdatedTime resets all changes to the "last_updated_time" field.
+func (m *ProofRequestMutation) ResetLastUpdatedTime() {
+	m.last_updated_time = nil
+	m.addlast_updated_time = nil
+}
+
+// SetProofTimeout sets the "proof_timeout" field.
+func (m *ProofRequestMutation) SetProofTimeout(u uint64) {
+	m.proof_timeout = &u
+	m.addproof_timeout = nil
+}
+
+// ProofTimeout returns the value of the "proof_timeout" field in the mutation.
+func (m *ProofRequestMutation) ProofTimeout() (r uint64, exists bool) {
+	v := m.proof_timeout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProofTimeout returns the old "proof_timeout" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldProofTimeout(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProofTimeout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProofTimeout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProofTimeout: %w", err)
+	}
+	return oldValue.ProofTimeout, nil
+}
+
+// AddProofTimeout adds u to the "proof_timeout" field.
+func (m *ProofRequestMutation) AddProofTimeout(u int64) {
+	if m.addproof_timeout != nil {
+		*m.addproof_timeout += u
+	} else {
+		m.addproof_timeout = &u
+	}
+}
+
+// AddedProofTimeout returns the value that was added to the "proof_timeout" field in this mutation.
+func (m *ProofRequestMutation) AddedProofTimeout() (r int64, exists bool) {
+	v := m.addproof_timeout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearProofTimeout clears the value of the "proof_timeout" field.
+func (m *ProofRequestMutation) ClearProofTimeout() {
+	m.proof_timeout = nil
+	m.addproof_timeout = nil
+	m.clearedFields[proofrequest.FieldProofTimeout] = struct{}{}
+}
+
+// ProofTimeoutCleared returns if the "proof_timeout" field was cleared in this mutation.
+func (m *ProofRequestMutation) ProofTimeoutCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldProofTimeout]
+	return ok
+}
+
+// ResetProofTimeout resets all changes to the "proof_timeout" field.
+func (m *ProofRequestMutation) ResetProofTimeout() {
+	m.proof_timeout = nil
+	m.addproof_timeout = nil
+	delete(m.clearedFields, proofrequest.FieldProofTimeout)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *ProofRequestMutation) SetAttempts(u uint64) {
+	m.attempts = &u
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *ProofRequestMutation) Attempts() (r uint64, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldAttempts(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds u to the "attempts" field.
+func (m *ProofRequestMutation) AddAttempts(u int64) {
+	if m.addattempts != nil {
+		*m.addattempts += u
+	} else {
+		m.addattempts = &u
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *ProofRequestMutation) AddedAttempts() (r int64, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (m *ProofRequestMutation) ClearAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+	m.clearedFields[proofrequest.FieldAttempts] = struct{}{}
+}
+
+// AttemptsCleared returns if the "attempts" field was cleared in this mutation.
+func (m *ProofRequestMutation) AttemptsCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldAttempts]
+	return ok
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *ProofRequestMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+	delete(m.clearedFields, proofrequest.FieldAttempts)
+}
+
+// SetNotBefore sets the "not_before" field.
+func (m *ProofRequestMutation) SetNotBefore(u uint64) {
+	m.not_before = &u
+	m.addnot_before = nil
+}
+
+// NotBefore returns the value of the "not_before" field in the mutation.
+func (m *ProofRequestMutation) NotBefore() (r uint64, exists bool) {
+	v := m.not_before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotBefore returns the old "not_before" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldNotBefore(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotBefore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotBefore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotBefore: %w", err)
+	}
+	return oldValue.NotBefore, nil
+}
+
+// AddNotBefore adds u to the "not_before" field.
+func (m *ProofRequestMutation) AddNotBefore(u int64) {
+	if m.addnot_before != nil {
+		*m.addnot_before += u
+	} else {
+		m.addnot_before = &u
+	}
+}
+
+// AddedNotBefore returns the value that was added to the "not_before" field in this mutation.
+func (m *ProofRequestMutation) AddedNotBefore() (r int64, exists bool) {
+	v := m.addnot_before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearNotBefore clears the value of the "not_before" field.
+func (m *ProofRequestMutation) ClearNotBefore() {
+	m.not_before = nil
+	m.addnot_before = nil
+	m.clearedFields[proofrequest.FieldNotBefore] = struct{}{}
+}
+
+// NotBeforeCleared returns if the "not_before" field was cleared in this mutation.
+func (m *ProofRequestMutation) NotBeforeCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldNotBefore]
+	return ok
+}
+
+// ResetNotBefore resets all changes to the "not_before" field.
+func (m *ProofRequestMutation) ResetNotBefore() {
+	m.not_before = nil
+	m.addnot_before = nil
+	delete(m.clearedFields, proofrequest.FieldNotBefore)
+}
+
+// SetCycles sets the "cycles" field.
+func (m *ProofRequestMutation) SetCycles(u uint64) {
+	m.cycles = &u
+	m.addcycles = nil
+}
+
+// Cycles returns the value of the "cycles" field in the mutation.
+func (m *ProofRequestMutation) Cycles() (r uint64, exists bool) {
+	v := m.cycles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCycles returns the old "cycles" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldCycles(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCycles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCycles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCycles: %w", err)
+	}
+	return oldValue.Cycles, nil
+}
+
+// AddCycles adds u to the "cycles" field.
+func (m *ProofRequestMutation) AddCycles(u int64) {
+	if m.addcycles != nil {
+		*m.addcycles += u
+	} else {
+		m.addcycles = &u
+	}
+}
+
+// AddedCycles returns the value that was added to the "cycles" field in this mutation.
+func (m *ProofRequestMutation) AddedCycles() (r int64, exists bool) {
+	v := m.addcycles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCycles clears the value of the "cycles" field.
+func (m *ProofRequestMutation) ClearCycles() {
+	m.cycles = nil
+	m.addcycles = nil
+	m.clearedFields[proofrequest.FieldCycles] = struct{}{}
+}
+
+// CyclesCleared returns if the "cycles" field was cleared in this mutation.
+func (m *ProofRequestMutation) CyclesCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldCycles]
+	return ok
+}
+
+// ResetCycles resets all changes to the "cycles" field.
+func (m *ProofRequestMutation) ResetCycles() {
+	m.cycles = nil
+	m.addcycles = nil
+	delete(m.clearedFields, proofrequest.FieldCycles)
+}
+
+// SetFulfilledTime sets the "fulfilled_time" field.
+func (m *ProofRequestMutation) SetFulfilledTime(u uint64) {
+	m.fulfilled_time = &u
+	m.addfulfilled_time = nil
+}
+
+// FulfilledTime returns the value of the "fulfilled_time" field in the mutation.
+func (m *ProofRequestMutation) FulfilledTime() (r uint64, exists bool) {
+	v := m.fulfilled_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFulfilledTime returns the old "fulfilled_time" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldFulfilledTime(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFulfilledTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFulfilledTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFulfilledTime: %w", err)
+	}
+	return oldValue.FulfilledTime, nil
+}
+
+// AddFulfilledTime adds u to the "fulfilled_time" field.
+func (m *ProofRequestMutation) AddFulfilledTime(u int64) {
+	if m.addfulfilled_time != nil {
+		*m.addfulfilled_time += u
+	} else {
+		m.addfulfilled_time = &u
+	}
+}
+
+// AddedFulfilledTime returns the value that was added to the "fulfilled_time" field in this mutation.
+func (m *ProofRequestMutation) AddedFulfilledTime() (r int64, exists bool) {
+	v := m.addfulfilled_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFulfilledTime clears the value of the "fulfilled_time" field.
+func (m *ProofRequestMutation) ClearFulfilledTime() {
+	m.fulfilled_time = nil
+	m.addfulfilled_time = nil
+	m.clearedFields[proofrequest.FieldFulfilledTime] = struct{}{}
+}
+
+// FulfilledTimeCleared returns if the "fulfilled_time" field was cleared in this mutation.
+func (m *ProofRequestMutation) FulfilledTimeCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldFulfilledTime]
+	return ok
+}
+
+// ResetFulfilledTime resets all changes to the "fulfilled_time" field.
+func (m *ProofRequestMutation) ResetFulfilledTime() {
+	m.fulfilled_time = nil
+	m.addfulfilled_time = nil
+	delete(m.clearedFields, proofrequest.FieldFulfilledTime)
+}
+
+// SetSubmissionReverts sets the "submission_reverts" field.
+func (m *ProofRequestMutation) SetSubmissionReverts(u uint64) {
+	m.submission_reverts = &u
+	m.addsubmission_reverts = nil
+}
+
+// SubmissionReverts returns the value of the "submission_reverts" field in the mutation.
+func (m *ProofRequestMutation) SubmissionReverts() (r uint64, exists bool) {
+	v := m.submission_reverts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmissionReverts returns the old "submission_reverts" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldSubmissionReverts(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmissionReverts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmissionReverts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmissionReverts: %w", err)
+	}
+	return oldValue.SubmissionReverts, nil
+}
+
+// AddSubmissionReverts adds u to the "submission_reverts" field.
+func (m *ProofRequestMutation) AddSubmissionReverts(u int64) {
+	if m.addsubmission_reverts != nil {
+		*m.addsubmission_reverts += u
+	} else {
+		m.addsubmission_reverts = &u
+	}
+}
+
+// AddedSubmissionReverts returns the value that was added to the "submission_reverts" field in this mutation.
+func (m *ProofRequestMutation) AddedSubmissionReverts() (r int64, exists bool) {
+	v := m.addsubmission_reverts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSubmissionReverts clears the value of the "submission_reverts" field.
+func (m *ProofRequestMutation) ClearSubmissionReverts() {
+	m.submission_reverts = nil
+	m.addsubmission_reverts = nil
+	m.clearedFields[proofrequest.FieldSubmissionReverts] = struct{}{}
+}
+
+// SubmissionRevertsCleared returns if the "submission_reverts" field was cleared in this mutation.
+func (m *ProofRequestMutation) SubmissionRevertsCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldSubmissionReverts]
+	return ok
+}
+
+// ResetSubmissionReverts resets all changes to the "submission_reverts" field.
+func (m *ProofRequestMutation) ResetSubmissionReverts() {
+	m.submission_reverts = nil
+	m.addsubmission_reverts = nil
+	delete(m.clearedFields, proofrequest.FieldSubmissionReverts)
+}
+
+// SetSubmissionResends sets the "submission_resends" field.
+func (m *ProofRequestMutation) SetSubmissionResends(u uint64) {
+	m.submission_resends = &u
+	m.addsubmission_resends = nil
+}
+
+// SubmissionResends returns the value of the "submission_resends" field in the mutation.
+func (m *ProofRequestMutation) SubmissionResends() (r uint64, exists bool) {
+	v := m.submission_resends
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmissionResends returns the old "submission_resends" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldSubmissionResends(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmissionResends is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmissionResends requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmissionResends: %w", err)
+	}
+	return oldValue.SubmissionResends, nil
+}
+
+// AddSubmissionResends adds u to the "submission_resends" field.
+func (m *ProofRequestMutation) AddSubmissionResends(u int64) {
+	if m.addsubmission_resends != nil {
+		*m.addsubmission_resends += u
+	} else {
+		m.addsubmission_resends = &u
+	}
+}
+
+// AddedSubmissionResends returns the value that was added to the "submission_resends" field in this mutation.
+func (m *ProofRequestMutation) AddedSubmissionResends() (r int64, exists bool) {
+	v := m.addsubmission_resends
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSubmissionResends clears the value of the "submission_resends" field.
+func (m *ProofRequestMutation) ClearSubmissionResends() {
+	m.submission_resends = nil
+	m.addsubmission_resends = nil
+	m.clearedFields[proofrequest.FieldSubmissionResends] = struct{}{}
+}
+
+// SubmissionResendsCleared returns if the "submission_resends" field was cleared in this mutation.
+func (m *ProofRequestMutation) SubmissionResendsCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldSubmissionResends]
+	return ok
+}
+
+// ResetSubmissionResends resets all changes to the "submission_resends" field.
+func (m *ProofRequestMutation) ResetSubmissionResends() {
+	m.submission_resends = nil
+	m.addsubmission_resends = nil
+	delete(m.clearedFields, proofrequest.FieldSubmissionResends)
+}
+
+// SetSubmissionNonceResyncs sets the "submission_nonce_resyncs" field.
+func (m *ProofRequestMutation) SetSubmissionNonceResyncs(u uint64) {
+	m.submission_nonce_resyncs = &u
+	m.addsubmission_nonce_resyncs = nil
+}
+
+// SubmissionNonceResyncs returns the value of the "submission_nonce_resyncs" field in the mutation.
+func (m *ProofRequestMutation) SubmissionNonceResyncs() (r uint64, exists bool) {
+	v := m.submission_nonce_resyncs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmissionNonceResyncs returns the old "submission_nonce_resyncs" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldSubmissionNonceResyncs(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmissionNonceResyncs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmissionNonceResyncs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmissionNonceResyncs: %w", err)
+	}
+	return oldValue.SubmissionNonceResyncs, nil
+}
+
+// AddSubmissionNonceResyncs adds u to the "submission_nonce_resyncs" field.
+func (m *ProofRequestMutation) AddSubmissionNonceResyncs(u int64) {
+	if m.addsubmission_nonce_resyncs != nil {
+		*m.addsubmission_nonce_resyncs += u
+	} else {
+		m.addsubmission_nonce_resyncs = &u
+	}
+}
+
+// AddedSubmissionNonceResyncs returns the value that was added to the "submission_nonce_resyncs" field in this mutation.
+func (m *ProofRequestMutation) AddedSubmissionNonceResyncs() (r int64, exists bool) {
+	v := m.addsubmission_nonce_resyncs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSubmissionNonceResyncs clears the value of the "submission_nonce_resyncs" field.
+func (m *ProofRequestMutation) ClearSubmissionNonceResyncs() {
+	m.submission_nonce_resyncs = nil
+	m.addsubmission_nonce_resyncs = nil
+	m.clearedFields[proofrequest.FieldSubmissionNonceResyncs] = struct{}{}
+}
+
+// SubmissionNonceResyncsCleared returns if the "submission_nonce_resyncs" field was cleared in this mutation.
+func (m *ProofRequestMutation) SubmissionNonceResyncsCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldSubmissionNonceResyncs]
+	return ok
+}
+
+// ResetSubmissionNonceResyncs resets all changes to the "submission_nonce_resyncs" field.
+func (m *ProofRequestMutation) ResetSubmissionNonceResyncs() {
+	m.submission_nonce_resyncs = nil
+	m.addsubmission_nonce_resyncs = nil
+	delete(m.clearedFields, proofrequest.FieldSubmissionNonceResyncs)
+}
+
+// SetL1BlockNumber sets the "l1_block_number" field.
+func (m *ProofRequestMutation) SetL1BlockNumber(u uint64) {
+	m.l1_block_number = &u
+	m.addl1_block_number = nil
+}
+
+// L1BlockNumber returns the value of the "l1_block_number" field in the mutation.
+func (m *ProofRequestMutation) L1BlockNumber() (r uint64, exists bool) {
+	v := m.l1_block_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldL1BlockNumber returns the old "l1_block_number" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldL1BlockNumber(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldL1BlockNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldL1BlockNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldL1BlockNumber: %w", err)
+	}
+	return oldValue.L1BlockNumber, nil
+}
+
+// AddL1BlockNumber adds u to the "l1_block_number" field.
+func (m *ProofRequestMutation) AddL1BlockNumber(u int64) {
+	if m.addl1_block_number != nil {
+		*m.addl1_block_number += u
+	} else {
+		m.addl1_block_number = &u
+	}
+}
+
+// AddedL1BlockNumber returns the value that was added to the "l1_block_number" field in this mutation.
+func (m *ProofRequestMutation) AddedL1BlockNumber() (r int64, exists bool) {
+	v := m.addl1_block_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearL1BlockNumber clears the value of the "l1_block_number" field.
+func (m *ProofRequestMutation) ClearL1BlockNumber() {
+	m.l1_block_number = nil
+	m.addl1_block_number = nil
+	m.clearedFields[proofrequest.FieldL1BlockNumber] = struct{}{}
+}
+
+// L1BlockNumberCleared returns if the "l1_block_number" field was cleared in this mutation.
+func (m *ProofRequestMutation) L1BlockNumberCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldL1BlockNumber]
+	return ok
+}
+
+// ResetL1BlockNumber resets all changes to the "l1_block_number" field.
+func (m *ProofRequestMutation) ResetL1BlockNumber() {
+	m.l1_block_number = nil
+	m.addl1_block_number = nil
+	delete(m.clearedFields, proofrequest.FieldL1BlockNumber)
+}
+
+// SetL1BlockHash sets the "l1_block_hash" field.
+func (m *ProofRequestMutation) SetL1BlockHash(s string) {
+	m.l1_block_hash = &s
+}
+
+// L1BlockHash returns the value of the "l1_block_hash" field in the mutation.
+func (m *ProofRequestMutation) L1BlockHash() (r string, exists bool) {
+	v := m.l1_block_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldL1BlockHash returns the old "l1_block_hash" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldL1BlockHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldL1BlockHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldL1BlockHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldL1BlockHash: %w", err)
+	}
+	return oldValue.L1BlockHash, nil
+}
+
+// ClearL1BlockHash clears the value of the "l1_block_hash" field.
+func (m *ProofRequestMutation) ClearL1BlockHash() {
+	m.l1_block_hash = nil
+	m.clearedFields[proofrequest.FieldL1BlockHash] = struct{}{}
+}
+
+// L1BlockHashCleared returns if the "l1_block_hash" field was cleared in this mutation.
+func (m *ProofRequestMutation) L1BlockHashCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldL1BlockHash]
+	return ok
+}
+
+// ResetL1BlockHash resets all changes to the "l1_block_hash" field.
+func (m *ProofRequestMutation) ResetL1BlockHash() {
+	m.l1_block_hash = nil
+	delete(m.clearedFields, proofrequest.FieldL1BlockHash)
+}
+
+// SetSatisfiedByTx sets the "satisfied_by_tx" field.
+func (m *ProofRequestMutation) SetSatisfiedByTx(s string) {
+	m.satisfied_by_tx = &s
+}
+
+// SatisfiedByTx returns the value of the "satisfied_by_tx" field in the mutation.
+func (m *ProofRequestMutation) SatisfiedByTx() (r string, exists bool) {
+	v := m.satisfied_by_tx
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSatisfiedByTx returns the old "satisfied_by_tx" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldSatisfiedByTx(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSatisfiedByTx is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSatisfiedByTx requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSatisfiedByTx: %w", err)
+	}
+	return oldValue.SatisfiedByTx, nil
+}
+
+// ClearSatisfiedByTx clears the value of the "satisfied_by_tx" field.
+func (m *ProofRequestMutation) ClearSatisfiedByTx() {
+	m.satisfied_by_tx = nil
+	m.clearedFields[proofrequest.FieldSatisfiedByTx] = struct{}{}
+}
+
+// SatisfiedByTxCleared returns if the "satisfied_by_tx" field was cleared in this mutation.
+func (m *ProofRequestMutation) SatisfiedByTxCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldSatisfiedByTx]
+	return ok
+}
+
+// ResetSatisfiedByTx resets all changes to the "satisfied_by_tx" field.
+func (m *ProofRequestMutation) ResetSatisfiedByTx() {
+	m.satisfied_by_tx = nil
+	delete(m.clearedFields, proofrequest.FieldSatisfiedByTx)
+}
+
+// SetProof sets the "proof" field.
+func (m *ProofRequestMutation) SetProof(b []byte) {
+	m.proof = &b
+}
+
+// Proof returns the value of the "proof" field in the mutation.
+func (m *ProofRequestMutation) Proof() (r []byte, exists bool) {
+	v := m.proof
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProof returns the old "proof" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldProof(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProof is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProof requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProof: %w", err)
+	}
+	return oldValue.Proof, nil
+}
+
+// ClearProof clears the value of the "proof" field.
+func (m *ProofRequestMutation) ClearProof() {
+	m.proof = nil
+	m.clearedFields[proofrequest.FieldProof] = struct{}{}
+}
+
+// ProofCleared returns if the "proof" field was cleared in this mutation.
+func (m *ProofRequestMutation) ProofCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldProof]
+	return ok
+}
+
+// ResetProof resets all changes to the "proof" field.
+func (m *ProofRequestMutation) ResetProof() {
+	m.proof = nil
+	delete(m.clearedFields, proofrequest.FieldProof)
+}
+
+// SetStorageTier sets the "storage_tier" field.
+func (m *ProofRequestMutation) SetStorageTier(pt proofrequest.StorageTier) {
+	m.storage_tier = &pt
+}
+
+// StorageTier returns the value of the "storage_tier" field in the mutation.
+func (m *ProofRequestMutation) StorageTier() (r proofrequest.StorageTier, exists bool) {
+	v := m.storage_tier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageTier returns the old "storage_tier" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldStorageTier(ctx context.Context) (v proofrequest.StorageTier, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageTier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageTier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageTier: %w", err)
+	}
+	return oldValue.StorageTier, nil
+}
+
+// ResetStorageTier resets all changes to the "storage_tier" field.
+func (m *ProofRequestMutation) ResetStorageTier() {
+	m.storage_tier = nil
+}
+
+// SetColdStorageKey sets the "cold_storage_key" field.
+func (m *ProofRequestMutation) SetColdStorageKey(s string) {
+	m.cold_storage_key = &s
+}
+
+// ColdStorageKey returns the value of the "cold_storage_key" field in the mutation.
+func (m *ProofRequestMutation) ColdStorageKey() (r string, exists bool) {
+	v := m.cold_storage_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColdStorageKey returns the old "cold_storage_key" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldColdStorageKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColdStorageKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColdStorageKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColdStorageKey: %w", err)
+	}
+	return oldValue.ColdStorageKey, nil
+}
+
+// ClearColdStorageKey clears the value of the "cold_storage_key" field.
+func (m *ProofRequestMutation) ClearColdStorageKey() {
+	m.cold_storage_key = nil
+	m.clearedFields[proofrequest.FieldColdStorageKey] = struct{}{}
+}
+
+// ColdStorageKeyCleared returns if the "cold_storage_key" field was cleared in this mutation.
+func (m *ProofRequestMutation) ColdStorageKeyCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldColdStorageKey]
+	return ok
+}
+
+// ResetColdStorageKey resets all changes to the "cold_storage_key" field.
+func (m *ProofRequestMutation) ResetColdStorageKey() {
+	m.cold_storage_key = nil
+	delete(m.clearedFields, proofrequest.FieldColdStorageKey)
+}
+
+// SetProofRef sets the "proof_ref" field.
+func (m *ProofRequestMutation) SetProofRef(s string) {
+	m.proof_ref = &s
+}
+
+// ProofRef returns the value of the "proof_ref" field in the mutation.
+func (m *ProofRequestMutation) ProofRef() (r string, exists bool) {
+	v := m.proof_ref
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProofRef returns the old "proof_ref" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldProofRef(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProofRef is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProofRef requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProofRef: %w", err)
+	}
+	return oldValue.ProofRef, nil
+}
+
+// ClearProofRef clears the value of the "proof_ref" field.
+func (m *ProofRequestMutation) ClearProofRef() {
+	m.proof_ref = nil
+	m.clearedFields[proofrequest.FieldProofRef] = struct{}{}
+}
+
+// ProofRefCleared returns if the "proof_ref" field was cleared in this mutation.
+func (m *ProofRequestMutation) ProofRefCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldProofRef]
+	return ok
+}
+
+// ResetProofRef resets all changes to the "proof_ref" field.
+func (m *ProofRequestMutation) ResetProofRef() {
+	m.proof_ref = nil
+	delete(m.clearedFields, proofrequest.FieldProofRef)
+}
+
+// SetRetrievalStatus sets the "retrieval_status" field.
+func (m *ProofRequestMutation) SetRetrievalStatus(ps proofrequest.RetrievalStatus) {
+	m.retrieval_status = &ps
+}
+
+// RetrievalStatus returns the value of the "retrieval_status" field in the mutation.
+func (m *ProofRequestMutation) RetrievalStatus() (r proofrequest.RetrievalStatus, exists bool) {
+	v := m.retrieval_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetrievalStatus returns the old "retrieval_status" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldRetrievalStatus(ctx context.Context) (v proofrequest.RetrievalStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetrievalStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetrievalStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetrievalStatus: %w", err)
+	}
+	return oldValue.RetrievalStatus, nil
+}
+
+// ResetRetrievalStatus resets all changes to the "retrieval_status" field.
+func (m *ProofRequestMutation) ResetRetrievalStatus() {
+	m.retrieval_status = nil
+}
+
+// SetIpfsCid sets the "ipfs_cid" field.
+func (m *ProofRequestMutation) SetIpfsCid(s string) {
+	m.ipfs_cid = &s
+}
+
+// IpfsCid returns the value of the "ipfs_cid" field in the mutation.
+func (m *ProofRequestMutation) IpfsCid() (r string, exists bool) {
+	v := m.ipfs_cid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIpfsCid returns the old "ipfs_cid" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldIpfsCid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIpfsCid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIpfsCid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIpfsCid: %w", err)
+	}
+	return oldValue.IpfsCid, nil
+}
+
+// ClearIpfsCid clears the value of the "ipfs_cid" field.
+func (m *ProofRequestMutation) ClearIpfsCid() {
+	m.ipfs_cid = nil
+	m.clearedFields[proofrequest.FieldIpfsCid] = struct{}{}
+}
+
+// IpfsCidCleared returns if the "ipfs_cid" field was cleared in this mutation.
+func (m *ProofRequestMutation) IpfsCidCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldIpfsCid]
+	return ok
+}
+
+// ResetIpfsCid resets all changes to the "ipfs_cid" field.
+func (m *ProofRequestMutation) ResetIpfsCid() {
+	m.ipfs_cid = nil
+	delete(m.clearedFields, proofrequest.FieldIpfsCid)
+}
+
+// SetProverBackend sets the "prover_backend" field.
+func (m *ProofRequestMutation) SetProverBackend(s string) {
+	m.prover_backend = &s
+}
+
+// ProverBackend returns the value of the "prover_backend" field in the mutation.
+func (m *ProofRequestMutation) ProverBackend() (r string, exists bool) {
+	v := m.prover_backend
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProverBackend returns the old "prover_backend" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldProverBackend(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProverBackend is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProverBackend requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProverBackend: %w", err)
+	}
+	return oldValue.ProverBackend, nil
+}
+
+// ClearProverBackend clears the value of the "prover_backend" field.
+func (m *ProofRequestMutation) ClearProverBackend() {
+	m.prover_backend = nil
+	m.clearedFields[proofrequest.FieldProverBackend] = struct{}{}
+}
+
+// ProverBackendCleared returns if the "prover_backend" field was cleared in this mutation.
+func (m *ProofRequestMutation) ProverBackendCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldProverBackend]
+	return ok
+}
+
+// ResetProverBackend resets all changes to the "prover_backend" field.
+func (m *ProofRequestMutation) ResetProverBackend() {
+	m.prover_backend = nil
+	delete(m.clearedFields, proofrequest.FieldProverBackend)
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *ProofRequestMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *ProofRequestMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *ProofRequestMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[proofrequest.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *ProofRequestMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *ProofRequestMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, proofrequest.FieldErrorMessage)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *ProofRequestMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *ProofRequestMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *ProofRequestMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[proofrequest.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *ProofRequestMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *ProofRequestMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, proofrequest.FieldCreatedBy)
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (m *ProofRequestMutation) SetRequestedBy(s string) {
+	m.requested_by = &s
+}
+
+// RequestedBy returns the value of the "requested_by" field in the mutation.
+func (m *ProofRequestMutation) RequestedBy() (r string, exists bool) {
+	v := m.requested_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedBy returns the old "requested_by" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldRequestedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedBy: %w", err)
+	}
+	return oldValue.RequestedBy, nil
+}
+
+// ClearRequestedBy clears the value of the "requested_by" field.
+func (m *ProofRequestMutation) ClearRequestedBy() {
+	m.requested_by = nil
+	m.clearedFields[proofrequest.FieldRequestedBy] = struct{}{}
+}
+
+// RequestedByCleared returns if the "requested_by" field was cleared in this mutation.
+func (m *ProofRequestMutation) RequestedByCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldRequestedBy]
+	return ok
+}
+
+// ResetRequestedBy resets all changes to the "requested_by" field.
+func (m *ProofRequestMutation) ResetRequestedBy() {
+	m.requested_by = nil
+	delete(m.clearedFields, proofrequest.FieldRequestedBy)
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (m *ProofRequestMutation) SetCompletedBy(s string) {
+	m.completed_by = &s
+}
+
+// CompletedBy returns the value of the "completed_by" field in the mutation.
+func (m *ProofRequestMutation) CompletedBy() (r string, exists bool) {
+	v := m.completed_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedBy returns the old "completed_by" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldCompletedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedBy: %w", err)
+	}
+	return oldValue.CompletedBy, nil
+}
+
+// ClearCompletedBy clears the value of the "completed_by" field.
+func (m *ProofRequestMutation) ClearCompletedBy() {
+	m.completed_by = nil
+	m.clearedFields[proofrequest.FieldCompletedBy] = struct{}{}
+}
+
+// CompletedByCleared returns if the "completed_by" field was cleared in this mutation.
+func (m *ProofRequestMutation) CompletedByCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldCompletedBy]
+	return ok
+}
+
+// ResetCompletedBy resets all changes to the "completed_by" field.
+func (m *ProofRequestMutation) ResetCompletedBy() {
+	m.completed_by = nil
+	delete(m.clearedFields, proofrequest.FieldCompletedBy)
+}
+
+// SetFee sets the "fee" field.
+func (m *ProofRequestMutation) SetFee(s string) {
+	m.fee = &s
+}
+
+// Fee returns the value of the "fee" field in the mutation.
+func (m *ProofRequestMutation) Fee() (r string, exists bool) {
+	v := m.fee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFee returns the old "fee" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldFee(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFee: %w", err)
+	}
+	return oldValue.Fee, nil
+}
+
+// ClearFee clears the value of the "fee" field.
+func (m *ProofRequestMutation) ClearFee() {
+	m.fee = nil
+	m.clearedFields[proofrequest.FieldFee] = struct{}{}
+}
+
+// FeeCleared returns if the "fee" field was cleared in this mutation.
+func (m *ProofRequestMutation) FeeCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldFee]
+	return ok
+}
+
+// ResetFee resets all changes to the "fee" field.
+func (m *ProofRequestMutation) ResetFee() {
+	m.fee = nil
+	delete(m.clearedFields, proofrequest.FieldFee)
+}
+
+// SetFulfiller sets the "fulfiller" field.
+func (m *ProofRequestMutation) SetFulfiller(s string) {
+	m.fulfiller = &s
+}
+
+// Fulfiller returns the value of the "fulfiller" field in the mutation.
+func (m *ProofRequestMutation) Fulfiller() (r string, exists bool) {
+	v := m.fulfiller
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFulfiller returns the old "fulfiller" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldFulfiller(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFulfiller is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFulfiller requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFulfiller: %w", err)
+	}
+	return oldValue.Fulfiller, nil
+}
+
+// ClearFulfiller clears the value of the "fulfiller" field.
+func (m *ProofRequestMutation) ClearFulfiller() {
+	m.fulfiller = nil
+	m.clearedFields[proofrequest.FieldFulfiller] = struct{}{}
+}
+
+// FulfillerCleared returns if the "fulfiller" field was cleared in this mutation.
+func (m *ProofRequestMutation) FulfillerCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldFulfiller]
+	return ok
+}
+
+// ResetFulfiller resets all changes to the "fulfiller" field.
+func (m *ProofRequestMutation) ResetFulfiller() {
+	m.fulfiller = nil
+	delete(m.clearedFields, proofrequest.FieldFulfiller)
+}
+
+// SetDowntimeCause sets the "downtime_cause" field.
+func (m *ProofRequestMutation) SetDowntimeCause(s string) {
+	m.downtime_cause = &s
+}
+
+// DowntimeCause returns the value of the "downtime_cause" field in the mutation.
+func (m *ProofRequestMutation) DowntimeCause() (r string, exists bool) {
+	v := m.downtime_cause
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDowntimeCause returns the old "downtime_cause" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldDowntimeCause(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDowntimeCause is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDowntimeCause requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDowntimeCause: %w", err)
+	}
+	return oldValue.DowntimeCause, nil
+}
+
+// ClearDowntimeCause clears the value of the "downtime_cause" field.
+func (m *ProofRequestMutation) ClearDowntimeCause() {
+	m.downtime_cause = nil
+	m.clearedFields[proofrequest.FieldDowntimeCause] = struct{}{}
+}
+
+// DowntimeCauseCleared returns if the "downtime_cause" field was cleared in this mutation.
+func (m *ProofRequestMutation) DowntimeCauseCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldDowntimeCause]
+	return ok
+}
+
+// ResetDowntimeCause resets all changes to the "downtime_cause" field.
+func (m *ProofRequestMutation) ResetDowntimeCause() {
+	m.downtime_cause = nil
+	delete(m.clearedFields, proofrequest.FieldDowntimeCause)
+}
+
+// SetL2BlockHash sets the "l2_block_hash" field.
+func (m *ProofRequestMutation) SetL2BlockHash(s string) {
+	m.l2_block_hash = &s
+}
+
+// L2BlockHash returns the value of the "l2_block_hash" field in the mutation.
+func (m *ProofRequestMutation) L2BlockHash() (r string, exists bool) {
+	v := m.l2_block_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldL2BlockHash returns the old "l2_block_hash" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldL2BlockHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldL2BlockHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldL2BlockHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldL2BlockHash: %w", err)
+	}
+	return oldValue.L2BlockHash, nil
+}
+
+// ClearL2BlockHash clears the value of the "l2_block_hash" field.
+func (m *ProofRequestMutation) ClearL2BlockHash() {
+	m.l2_block_hash = nil
+	m.clearedFields[proofrequest.FieldL2BlockHash] = struct{}{}
+}
+
+// L2BlockHashCleared returns if the "l2_block_hash" field was cleared in this mutation.
+func (m *ProofRequestMutation) L2BlockHashCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldL2BlockHash]
+	return ok
+}
+
+// ResetL2BlockHash resets all changes to the "l2_block_hash" field.
+func (m *ProofRequestMutation) ResetL2BlockHash() {
+	m.l2_block_hash = nil
+	delete(m.clearedFields, proofrequest.FieldL2BlockHash)
+}
+
+// SetAggID sets the "agg" edge to the ProofRequest entity by id.
+func (m *ProofRequestMutation) SetAggID(id int) {
+	m.agg = &id
+}
+
+// ClearAgg clears the "agg" edge to the ProofRequest entity.
+func (m *ProofRequestMutation) ClearAgg() {
+	m.clearedagg = true
+	m.clearedFields[proofrequest.FieldAggRequestID] = struct{}{}
+}
+
+// AggCleared reports if the "agg" edge to the ProofRequest entity was cleared.
+func (m *ProofRequestMutation) AggCleared() bool {
+	return m.AggRequestIDCleared() || m.clearedagg
+}
+
+// AggID returns the "agg" edge ID in the mutation.
+func (m *ProofRequestMutation) AggID() (id int, exists bool) {
+	if m.agg != nil {
+		return *m.agg, true
+	}
+	return
+}
+
+// AggIDs returns the "agg" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AggID instead. It exists only for internal usage by the builders.
+func (m *ProofRequestMutation) AggIDs() (ids []int) {
+	if id := m.agg; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAgg resets all changes to the "agg" edge.
+func (m *ProofRequestMutation) ResetAgg() {
+	m.agg = nil
+	m.clearedagg = false
+}
+
+// AddSpanIDs adds the "spans" edge to the ProofRequest entity by ids.
+func (m *ProofRequestMutation) AddSpanIDs(ids ...int) {
+	if m.spans == nil {
+		m.spans = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.spans[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSpans clears the "spans" edge to the ProofRequest entity.
+func (m *ProofRequestMutation) ClearSpans() {
+	m.clearedspans = true
+}
+
+// SpansCleared reports if the "spans" edge to the ProofRequest entity was cleared.
+func (m *ProofRequestMutation) SpansCleared() bool {
+	return m.clearedspans
+}
+
+// RemoveSpanIDs removes the "spans" edge to the ProofRequest entity by IDs.
+func (m *ProofRequestMutation) RemoveSpanIDs(ids ...int) {
+	if m.removedspans == nil {
+		m.removedspans = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.spans, ids[i])
+		m.removedspans[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSpans returns the removed IDs of the "spans" edge to the ProofRequest entity.
+func (m *ProofRequestMutation) RemovedSpansIDs() (ids []int) {
+	for id := range m.removedspans {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SpansIDs returns the "spans" edge IDs in the mutation.
+func (m *ProofRequestMutation) SpansIDs() (ids []int) {
+	for id := range m.spans {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSpans resets all changes to the "spans" edge.
+func (m *ProofRequestMutation) ResetSpans() {
+	m.spans = nil
+	m.clearedspans = false
+	m.removedspans = nil
+}
+
+// Where appends a list predicates to the ProofRequestMutation builder.
+func (m *ProofRequestMutation) Where(ps ...predicate.ProofRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProofRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProofRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProofRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProofRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProofRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProofRequest).
+func (m *ProofRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProofRequestMutation) Fields() []string {
+	fields := make([]string, 0, 38)
+	if m._type != nil {
+		fields = append(fields, proofrequest.FieldType)
+	}
+	if m.start_block != nil {
+		fields = append(fields, proofrequest.FieldStartBlock)
+	}
+	if m.end_block != nil {
+		fields = append(fields, proofrequest.FieldEndBlock)
+	}
+	if m.status != nil {
+		fields = append(fields, proofrequest.FieldStatus)
+	}
+	if m.request_added_time != nil {
+		fields = append(fields, proofrequest.FieldRequestAddedTime)
+	}
+	if m.prover_request_id != nil {
+		fields = append(fields, proofrequest.FieldProverRequestID)
+	}
+	if m.idempotency_key != nil {
+		fields = append(fields, proofrequest.FieldIdempotencyKey)
+	}
+	if m.external_ref != nil {
+		fields = append(fields, proofrequest.FieldExternalRef)
+	}
+	if m.witness_artifact_id != nil {
+		fields = append(fields, proofrequest.FieldWitnessArtifactID)
+	}
+	if m.agg != nil {
+		fields = append(fields, proofrequest.FieldAggRequestID)
+	}
+	if m.proof_request_time != nil {
+		fields = append(fields, proofrequest.FieldProofRequestTime)
+	}
+	if m.last_updated_time != nil {
+		fields = append(fields, proofrequest.FieldLastUpdatedTime)
+	}
+	if m.proof_timeout != nil {
+		fields = append(fields, proofrequest.FieldProofTimeout)
+	}
+	if m.attempts != nil {
+		fields = append(fields, proofrequest.FieldAttempts)
+	}
+	if m.not_before != nil {
+		fields = append(fields, proofrequest.FieldNotBefore)
+	}
+	if m.cycles != nil {
+		fields = append(fields, proofrequest.FieldCycles)
+	}
+	if m.fulfilled_time != nil {
+		fields = append(fields, proofrequest.FieldFulfilledTime)
+	}
+	if m.submission_reverts != nil {
+		fields = append(fields, proofrequest.FieldSubmissionReverts)
+	}
+	if m.submission_resends != nil {
+		fields = append(fields, proofrequest.FieldSubmissionResends)
+	}
+	if m.submission_nonce_resyncs != nil {
+		fields = append(fields, proofrequest.FieldSubmissionNonceResyncs)
+	}
+	if m.l1_block_number != nil {
+		fields = append(fields, proofrequest.FieldL1BlockNumber)
+	}
+	if m.l1_block_hash != nil {
+		fields = append(fields, proofrequest.FieldL1BlockHash)
+	}
+	if m.satisfied_by_tx != nil {
+		fields = append(fields, proofrequest.FieldSatisfiedByTx)
+	}
+	if m.proof != nil {
+		fields = append(fields, proofrequest.FieldProof)
+	}
+	if m.storage_tier != nil {
+		fields = append(fields, proofrequest.FieldStorageTier)
+	}
+	if m.cold_storage_key != nil {
+		fields = append(fields, proofrequest.FieldColdStorageKey)
+	}
+	if m.proof_ref != nil {
+		fields = append(fields, proofrequest.FieldProofRef)
+	}
+	if m.retrieval_status != nil {
+		fields = append(fields, proofrequest.FieldRetrievalStatus)
+	}
+	if m.ipfs_cid != nil {
+		fields = append(fields, proofrequest.FieldIpfsCid)
+	}
+	if m.prover_backend != nil {
+		fields = append(fields, proofrequest.FieldProverBackend)
+	}
+	if m.error_message != nil {
+		fields = append(fields, proofrequest.FieldErrorMessage)
+	}
+	if m.created_by != nil {
+		fields = append(fields, proofrequest.FieldCreatedBy)
+	}
+	if m.requested_by != nil {
+		fields = append(fields, proofrequest.FieldRequestedBy)
+	}
+	if m.completed_by != nil {
+		fields = append(fields, proofrequest.FieldCompletedBy)
+	}
+	if m.fee != nil {
+		fields = append(fields, proofrequest.FieldFee)
+	}
+	if m.fulfiller != nil {
+		fields = append(fields, proofrequest.FieldFulfiller)
+	}
+	if m.downtime_cause != nil {
+		fields = append(fields, proofrequest.FieldDowntimeCause)
+	}
+	if m.l2_block_hash != nil {
+		fields = append(fields, proofrequest.FieldL2BlockHash)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProofRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case proofrequest.FieldType:
+		return m.GetType()
+	case proofrequest.FieldStartBlock:
+		return m.StartBlock()
+	case proofrequest.FieldEndBlock:
+		return m.EndBlock()
+	case proofrequest.FieldStatus:
+		return m.Status()
+	case proofrequest.FieldRequestAddedTime:
+		return m.RequestAddedTime()
+	case proofrequest.FieldProverRequestID:
+		return m.ProverRequestID()
+	case proofrequest.FieldIdempotencyKey:
+		return m.IdempotencyKey()
+	case proofrequest.FieldExternalRef:
+		return m.ExternalRef()
+	case proofrequest.FieldWitnessArtifactID:
+		return m.WitnessArtifactID()
+	case proofrequest.FieldAggRequestID:
+		return m.AggRequestID()
+	case proofrequest.FieldProofRequestTime:
+		return m.ProofRequestTime()
+	case proofrequest.FieldLastUpdatedTime:
+		return m.LastUpdatedTime()
+	case proofrequest.FieldProofTimeout:
+		return m.ProofTimeout()
+	case proofrequest.FieldAttempts:
+		return m.Attempts()
+	case proofrequest.FieldNotBefore:
+		return m.NotBefore()
+	case proofrequest.FieldCycles:
+		return m.Cycles()
+	case proofrequest.FieldFulfilledTime:
+		return m.FulfilledTime()
+	case proofrequest.FieldSubmissionReverts:
+		return m.SubmissionReverts()
+	case proofrequest.FieldSubmissionResends:
+		return m.SubmissionResends()
+	case proofrequest.FieldSubmissionNonceResyncs:
+		return m.SubmissionNonceResyncs()
+	case proofrequest.FieldL1BlockNumber:
+		return m.L1BlockNumber()
+	case proofrequest.FieldL1BlockHash:
+		return m.L1BlockHash()
+	case proofrequest.FieldSatisfiedByTx:
+		return m.SatisfiedByTx()
+	case proofrequest.FieldProof:
+		return m.Proof()
+	case proofrequest.FieldStorageTier:
+		return m.StorageTier()
+	case proofrequest.FieldColdStorageKey:
+		return m.ColdStorageKey()
+	case proofrequest.FieldProofRef:
+		return m.ProofRef()
+	case proofrequest.FieldRetrievalStatus:
+		return m.RetrievalStatus()
+	case proofrequest.FieldIpfsCid:
+		return m.IpfsCid()
+	case proofrequest.FieldProverBackend:
+		return m.ProverBackend()
+	case proofrequest.FieldErrorMessage:
+		return m.ErrorMessage()
+	case proofrequest.FieldCreatedBy:
+		return m.CreatedBy()
+	case proofrequest.FieldRequestedBy:
+		return m.RequestedBy()
+	case proofrequest.FieldCompletedBy:
+		return m.CompletedBy()
+	case proofrequest.FieldFee:
+		return m.Fee()
+	case proofrequest.FieldFulfiller:
+		return m.Fulfiller()
+	case proofrequest.FieldDowntimeCause:
+		return m.DowntimeCause()
+	case proofrequest.FieldL2BlockHash:
+		return m.L2BlockHash()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProofRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case proofrequest.FieldType:
+		return m.OldType(ctx)
+	case proofrequest.FieldStartBlock:
+		return m.OldStartBlock(ctx)
+	case proofrequest.FieldEndBlock:
+		return m.OldEndBlock(ctx)
+	case proofrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case proofrequest.FieldRequestAddedTime:
+		return m.OldRequestAddedTime(ctx)
+	case proofrequest.FieldProverRequestID:
+		return m.OldProverRequestID(ctx)
+	case proofrequest.FieldIdempotencyKey:
+		return m.OldIdempotencyKey(ctx)
+	case proofrequest.FieldExternalRef:
+		return m.OldExternalRef(ctx)
+	case proofrequest.FieldWitnessArtifactID:
+		return m.OldWitnessArtifactID(ctx)
+	case proofrequest.FieldAggRequestID:
+		return m.OldAggRequestID(ctx)
+	case proofrequest.FieldProofRequestTime:
+		return m.OldProofRequestTime(ctx)
+	case proofrequest.FieldLastUpdatedTime:
+		return m.OldLastUpdatedTime(ctx)
+	case proofrequest.FieldProofTimeout:
+		return m.OldProofTimeout(ctx)
+	case proofrequest.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case proofrequest.FieldNotBefore:
+		return m.OldNotBefore(ctx)
+	case proofrequest.FieldCycles:
+		return m.OldCycles(ctx)
+	case proofrequest.FieldFulfilledTime:
+		return m.OldFulfilledTime(ctx)
+	case proofrequest.FieldSubmissionReverts:
+		return m.OldSubmissionReverts(ctx)
+	case proofrequest.FieldSubmissionResends:
+		return m.OldSubmissionResends(ctx)
+	case proofrequest.FieldSubmissionNonceResyncs:
+		return m.OldSubmissionNonceResyncs(ctx)
+	case proofrequest.FieldL1BlockNumber:
+		return m.OldL1BlockNumber(ctx)
+	case proofrequest.FieldL1BlockHash:
+		return m.OldL1BlockHash(ctx)
+	case proofrequest.FieldSatisfiedByTx:
+		return m.OldSatisfiedByTx(ctx)
+	case proofrequest.FieldProof:
+		return m.OldProof(ctx)
+	case proofrequest.FieldStorageTier:
+		return m.OldStorageTier(ctx)
+	case proofrequest.FieldColdStorageKey:
+		return m.OldColdStorageKey(ctx)
+	case proofrequest.FieldProofRef:
+		return m.OldProofRef(ctx)
+	case proofrequest.FieldRetrievalStatus:
+		return m.OldRetrievalStatus(ctx)
+	case proofrequest.FieldIpfsCid:
+		return m.OldIpfsCid(ctx)
+	case proofrequest.FieldProverBackend:
+		return m.OldProverBackend(ctx)
+	case proofrequest.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case proofrequest.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case proofrequest.FieldRequestedBy:
+		return m.OldRequestedBy(ctx)
+	case proofrequest.FieldCompletedBy:
+		return m.OldCompletedBy(ctx)
+	case proofrequest.FieldFee:
+		return m.OldFee(ctx)
+	case proofrequest.FieldFulfiller:
+		return m.OldFulfiller(ctx)
+	case proofrequest.FieldDowntimeCause:
+		return m.OldDowntimeCause(ctx)
+	case proofrequest.FieldL2BlockHash:
+		return m.OldL2BlockHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProofRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProofRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case proofrequest.FieldType:
+		v, ok := value.(proofrequest.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case proofrequest.FieldStartBlock:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartBlock(v)
+		return nil
+	case proofrequest.FieldEndBlock:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndBlock(v)
+		return nil
+	case proofrequest.FieldStatus:
+		v, ok := value.(proofrequest.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case proofrequest.FieldRequestAddedTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestAddedTime(v)
+		return nil
+	case proofrequest.FieldProverRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProverRequestID(v)
+		return nil
+	case proofrequest.FieldIdempotencyKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdempotencyKey(v)
+		return nil
+	case proofrequest.FieldExternalRef:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalRef(v)
+		return nil
+	case proofrequest.FieldWitnessArtifactID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWitnessArtifactID(v)
+		return nil
+	case proofrequest.FieldAggRequestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAggRequestID(v)
+		return nil
+	case proofrequest.FieldProofRequestTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProofRequestTime(v)
+		return nil
+	case proofrequest.FieldLastUpdatedTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUpdatedTime(v)
+		return nil
+	case proofrequest.FieldProofTimeout:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProofTimeout(v)
+		return nil
+	case proofrequest.FieldAttempts:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case proofrequest.FieldNotBefore:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotBefore(v)
+		return nil
+	case proofrequest.FieldCycles:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCycles(v)
+		return nil
+	case proofrequest.FieldFulfilledTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFulfilledTime(v)
+		return nil
+	case proofrequest.FieldSubmissionReverts:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmissionReverts(v)
+		return nil
+	case proofrequest.FieldSubmissionResends:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmissionResends(v)
+		return nil
+	case proofrequest.FieldSubmissionNonceResyncs:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmissionNonceResyncs(v)
+		return nil
+	case proofrequest.FieldL1BlockNumber:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetL1BlockNumber(v)
+		return nil
+	case proofrequest.FieldL1BlockHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetL1BlockHash(v)
+		return nil
+	case proofrequest.FieldSatisfiedByTx:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSatisfiedByTx(v)
+		return nil
+	case proofrequest.FieldProof:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProof(v)
+		return nil
+	case proofrequest.FieldStorageTier:
+		v, ok := value.(proofrequest.StorageTier)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageTier(v)
+		return nil
+	case proofrequest.FieldColdStorageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColdStorageKey(v)
+		return nil
+	case proofrequest.FieldProofRef:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProofRef(v)
+		return nil
+	case proofrequest.FieldRetrievalStatus:
+		v, ok := value.(proofrequest.RetrievalStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetrievalStatus(v)
+		return nil
+	case proofrequest.FieldIpfsCid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIpfsCid(v)
+		return nil
+	case proofrequest.FieldProverBackend:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProverBackend(v)
+		return nil
+	case proofrequest.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case proofrequest.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case proofrequest.FieldRequestedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedBy(v)
+		return nil
+	case proofrequest.FieldCompletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedBy(v)
+		return nil
+	case proofrequest.FieldFee:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFee(v)
+		return nil
+	case proofrequest.FieldFulfiller:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFulfiller(v)
+		return nil
+	case proofrequest.FieldDowntimeCause:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDowntimeCause(v)
+		return nil
+	case proofrequest.FieldL2BlockHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetL2BlockHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProofRequestMutation) AddedFields() []string {
+	var fields []string
+	if m.addstart_block != nil {
+		fields = append(fields, proofrequest.FieldStartBlock)
+	}
+	if m.addend_block != nil {
+		fields = append(fields, proofrequest.FieldEndBlock)
+	}
+	if m.addrequest_added_time != nil {
+		fields = append(fields, proofrequest.FieldRequestAddedTime)
+	}
+	if m.addproof_request_time != nil {
+		fields = append(fields, proofrequest.FieldProofRequestTime)
+	}
+	if m.addlast_updated_time != nil {
+		fields = append(fields, proofrequest.FieldLastUpdatedTime)
+	}
+	if m.addproof_timeout != nil {
+		fields = append(fields, proofrequest.FieldProofTimeout)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, proofrequest.FieldAttempts)
+	}
+	if m.addnot_before != nil {
+		fields = append(fields, proofrequest.FieldNotBefore)
+	}
+	if m.addcycles != nil {
+		fields = append(fields, proofrequest.FieldCycles)
+	}
+	if m.addfulfilled_time != nil {
+		fields = append(fields, proofrequest.FieldFulfilledTime)
+	}
+	if m.addsubmission_reverts != nil {
+		fields = append(fields, proofrequest.FieldSubmissionReverts)
+	}
+	if m.addsubmission_resends != nil {
+		fields = append(fields, proofrequest.FieldSubmissionResends)
+	}
+	if m.addsubmission_nonce_resyncs != nil {
+		fields = append(fields, proofrequest.FieldSubmissionNonceResyncs)
+	}
+	if m.addl1_block_number != nil {
+		fields = append(fields, proofrequest.FieldL1BlockNumber)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProofRequestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case proofrequest.FieldStartBlock:
+		return m.AddedStartBlock()
+	case proofrequest.FieldEndBlock:
+		return m.AddedEndBlock()
+	case proofrequest.FieldRequestAddedTime:
+		return m.AddedRequestAddedTime()
+	case proofrequest.FieldProofRequestTime:
+		return m.AddedProofRequestTime()
+	case proofrequest.FieldLastUpdatedTime:
+		return m.AddedLastUpdatedTime()
+	case proofrequest.FieldProofTimeout:
+		return m.AddedProofTimeout()
+	case proofrequest.FieldAttempts:
+		return m.AddedAttempts()
+	case proofrequest.FieldNotBefore:
+		return m.AddedNotBefore()
+	case proofrequest.FieldCycles:
+		return m.AddedCycles()
+	case proofrequest.FieldFulfilledTime:
+		return m.AddedFulfilledTime()
+	case proofrequest.FieldSubmissionReverts:
+		return m.AddedSubmissionReverts()
+	case proofrequest.FieldSubmissionResends:
+		return m.AddedSubmissionResends()
+	case proofrequest.FieldSubmissionNonceResyncs:
+		return m.AddedSubmissionNonceResyncs()
+	case proofrequest.FieldL1BlockNumber:
+		return m.AddedL1BlockNumber()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProofRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case proofrequest.FieldStartBlock:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartBlock(v)
+		return nil
+	case proofrequest.FieldEndBlock:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEndBlock(v)
+		return nil
+	case proofrequest.FieldRequestAddedTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRequestAddedTime(v)
+		return nil
+	case proofrequest.FieldProofRequestTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProofRequestTime(v)
+		return nil
+	case proofrequest.FieldLastUpdatedTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastUpdatedTime(v)
+		return nil
+	case proofrequest.FieldProofTimeout:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProofTimeout(v)
+		return nil
+	case proofrequest.FieldAttempts:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case proofrequest.FieldNotBefore:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNotBefore(v)
+		return nil
+	case proofrequest.FieldCycles:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCycles(v)
+		return nil
+	case proofrequest.FieldFulfilledTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFulfilledTime(v)
+		return nil
+	case proofrequest.FieldSubmissionReverts:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubmissionReverts(v)
+		return nil
+	case proofrequest.FieldSubmissionResends:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubmissionResends(v)
+		return nil
+	case proofrequest.FieldSubmissionNonceResyncs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubmissionNonceResyncs(v)
+		return nil
+	case proofrequest.FieldL1BlockNumber:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddL1BlockNumber(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProofRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(proofrequest.FieldProverRequestID) {
+		fields = append(fields, proofrequest.FieldProverRequestID)
+	}
+	if m.FieldCleared(proofrequest.FieldIdempotencyKey) {
+		fields = append(fields, proofrequest.FieldIdempotencyKey)
+	}
+	if m.FieldCleared(proofrequest.FieldExternalRef) {
+		fields = append(fields, proofrequest.FieldExternalRef)
+	}
+	if m.FieldCleared(proofrequest.FieldWitnessArtifactID) {
+		fields = append(fields, proofrequest.FieldWitnessArtifactID)
+	}
+	if m.FieldCleared(proofrequest.FieldAggRequestID) {
+		fields = append(fields, proofrequest.FieldAggRequestID)
+	}
+	if m.FieldCleared(proofrequest.FieldProofRequestTime) {
+		fields = append(fields, proofrequest.FieldProofRequestTime)
+	}
+	if m.FieldCleared(proofrequest.FieldProofTimeout) {
+		fields = append(fields, proofrequest.FieldProofTimeout)
+	}
+	if m.FieldCleared(proofrequest.FieldAttempts) {
+		fields = append(fields, proofrequest.FieldAttempts)
+	}
+	if m.FieldCleared(proofrequest.FieldNotBefore) {
+		fields = append(fields, proofrequest.FieldNotBefore)
+	}
+	if m.FieldCleared(proofrequest.FieldCycles) {
+		fields = append(fields, proofrequest.FieldCycles)
+	}
+	if m.FieldCleared(proofrequest.FieldFulfilledTime) {
+		fields = append(fields, proofrequest.FieldFulfilledTime)
+	}
+	if m.FieldCleared(proofrequest.FieldSubmissionReverts) {
+		fields = append(fields, proofrequest.FieldSubmissionReverts)
+	}
+	if m.FieldCleared(proofrequest.FieldSubmissionResends) {
+		fields = append(fields, proofrequest.FieldSubmissionResends)
+	}
+	if m.FieldCleared(proofrequest.FieldSubmissionNonceResyncs) {
+		fields = append(fields, proofrequest.FieldSubmissionNonceResyncs)
+	}
+	if m.FieldCleared(proofrequest.FieldL1BlockNumber) {
+		fields = append(fields, proofrequest.FieldL1BlockNumber)
+	}
+	if m.FieldCleared(proofrequest.FieldL1BlockHash) {
+		fields = append(fields, proofrequest.FieldL1BlockHash)
+	}
+	if m.FieldCleared(proofrequest.FieldSatisfiedByTx) {
+		fields = append(fields, proofrequest.FieldSatisfiedByTx)
+	}
+	if m.FieldCleared(proofrequest.FieldProof) {
+		fields = append(fields, proofrequest.FieldProof)
+	}
+	if m.FieldCleared(proofrequest.FieldColdStorageKey) {
+		fields = append(fields, proofrequest.FieldColdStorageKey)
+	}
+	if m.FieldCleared(proofrequest.FieldProofRef) {
+		fields = append(fields, proofrequest.FieldProofRef)
+	}
+	if m.FieldCleared(proofrequest.FieldIpfsCid) {
+		fields = append(fields, proofrequest.FieldIpfsCid)
+	}
+	if m.FieldCleared(proofrequest.FieldProverBackend) {
+		fields = append(fields, proofrequest.FieldProverBackend)
+	}
+	if m.FieldCleared(proofrequest.FieldErrorMessage) {
+		fields = append(fields, proofrequest.FieldErrorMessage)
+	}
+	if m.FieldCleared(proofrequest.FieldCreatedBy) {
+		fields = append(fields, proofrequest.FieldCreatedBy)
+	}
+	if m.FieldCleared(proofrequest.FieldRequestedBy) {
+		fields = append(fields, proofrequest.FieldRequestedBy)
+	}
+	if m.FieldCleared(proofrequest.FieldCompletedBy) {
+		fields = append(fields, proofrequest.FieldCompletedBy)
+	}
+	if m.FieldCleared(proofrequest.FieldFee) {
+		fields = append(fields, proofrequest.FieldFee)
+	}
+	if m.FieldCleared(proofrequest.FieldFulfiller) {
+		fields = append(fields, proofrequest.FieldFulfiller)
+	}
+	if m.FieldCleared(proofrequest.FieldDowntimeCause) {
+		fields = append(fields, proofrequest.FieldDowntimeCause)
+	}
+	if m.FieldCleared(proofrequest.FieldL2BlockHash) {
+		fields = append(fields, proofrequest.FieldL2BlockHash)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProofRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProofRequestMutation) ClearField(name string) error {
+	switch name {
+	case proofrequest.FieldProverRequestID:
+		m.ClearProverRequestID()
+		return nil
+	case proofrequest.FieldIdempotencyKey:
+		m.ClearIdempotencyKey()
+		return nil
+	case proofrequest.FieldExternalRef:
+		m.ClearExternalRef()
+		return nil
+	case proofrequest.FieldWitnessArtifactID:
+		m.ClearWitnessArtifactID()
+		return nil
+	case proofrequest.FieldAggRequestID:
+		m.ClearAggRequestID()
+		return nil
+	case proofrequest.FieldProofRequestTime:
+		m.ClearProofRequestTime()
+		return nil
+	case proofrequest.FieldProofTimeout:
+		m.ClearProofTimeout()
+		return nil
+	case proofrequest.FieldAttempts:
+		m.ClearAttempts()
+		return nil
+	case proofrequest.FieldNotBefore:
+		m.ClearNotBefore()
+		return nil
+	case proofrequest.FieldCycles:
+		m.ClearCycles()
+		return nil
+	case proofrequest.FieldFulfilledTime:
+		m.ClearFulfilledTime()
+		return nil
+	case proofrequest.FieldSubmissionReverts:
+		m.ClearSubmissionReverts()
+		return nil
+	case proofrequest.FieldSubmissionResends:
+		m.ClearSubmissionResends()
+		return nil
+	case proofrequest.FieldSubmissionNonceResyncs:
+		m.ClearSubmissionNonceResyncs()
+		return nil
+	case proofrequest.FieldL1BlockNumber:
+		m.ClearL1BlockNumber()
+		return nil
+	case proofrequest.FieldL1BlockHash:
+		m.ClearL1BlockHash()
+		return nil
+	case proofrequest.FieldSatisfiedByTx:
+		m.ClearSatisfiedByTx()
+		return nil
+	case proofrequest.FieldProof:
+		m.ClearProof()
+		return nil
+	case proofrequest.FieldColdStorageKey:
+		m.ClearColdStorageKey()
+		return nil
+	case proofrequest.FieldProofRef:
+		m.ClearProofRef()
+		return nil
+	case proofrequest.FieldIpfsCid:
+		m.ClearIpfsCid()
+		return nil
+	case proofrequest.FieldProverBackend:
+		m.ClearProverBackend()
+		return nil
+	case proofrequest.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case proofrequest.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case proofrequest.FieldRequestedBy:
+		m.ClearRequestedBy()
+		return nil
+	case proofrequest.FieldCompletedBy:
+		m.ClearCompletedBy()
+		return nil
+	case proofrequest.FieldFee:
+		m.ClearFee()
+		return nil
+	case proofrequest.FieldFulfiller:
+		m.ClearFulfiller()
+		return nil
+	case proofrequest.FieldDowntimeCause:
+		m.ClearDowntimeCause()
+		return nil
+	case proofrequest.FieldL2BlockHash:
+		m.ClearL2BlockHash()
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProofRequestMutation) ResetField(name string) error {
+	switch name {
+	case proofrequest.FieldType:
+		m.ResetType()
+		return nil
+	case proofrequest.FieldStartBlock:
+		m.ResetStartBlock()
+		return nil
+	case proofrequest.FieldEndBlock:
+		m.ResetEndBlock()
+		return nil
+	case proofrequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case proofrequest.FieldRequestAddedTime:
+		m.ResetRequestAddedTime()
+		return nil
+	case proofrequest.FieldProverRequestID:
+		m.ResetProverRequestID()
+		return nil
+	case proofrequest.FieldIdempotencyKey:
+		m.ResetIdempotencyKey()
+		return nil
+	case proofrequest.FieldExternalRef:
+		m.ResetExternalRef()
+		return nil
+	case proofrequest.FieldWitnessArtifactID:
+		m.ResetWitnessArtifactID()
+		return nil
+	case proofrequest.FieldAggRequestID:
+		m.ResetAggRequestID()
+		return nil
+	case proofrequest.FieldProofRequestTime:
+		m.ResetProofRequestTime()
+		return nil
+	case proofrequest.FieldLastUpdatedTime:
+		m.ResetLastUpdatedTime()
+		return nil
+	case proofrequest.FieldProofTimeout:
+		m.ResetProofTimeout()
+		return nil
+	case proofrequest.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case proofrequest.FieldNotBefore:
+		m.ResetNotBefore()
+		return nil
+	case proofrequest.FieldCycles:
+		m.ResetCycles()
+		return nil
+	case proofrequest.FieldFulfilledTime:
+		m.ResetFulfilledTime()
+		return nil
+	case proofrequest.FieldSubmissionReverts:
+		m.ResetSubmissionReverts()
+		return nil
+	case proofrequest.FieldSubmissionResends:
+		m.ResetSubmissionResends()
+		return nil
+	case proofrequest.FieldSubmissionNonceResyncs:
+		m.ResetSubmissionNonceResyncs()
+		return nil
+	case proofrequest.FieldL1BlockNumber:
+		m.ResetL1BlockNumber()
+		return nil
+	case proofrequest.FieldL1BlockHash:
+		m.ResetL1BlockHash()
+		return nil
+	case proofrequest.FieldSatisfiedByTx:
+		m.ResetSatisfiedByTx()
+		return nil
+	case proofrequest.FieldProof:
+		m.ResetProof()
+		return nil
+	case proofrequest.FieldStorageTier:
+		m.ResetStorageTier()
+		return nil
+	case proofrequest.FieldColdStorageKey:
+		m.ResetColdStorageKey()
+		return nil
+	case proofrequest.FieldProofRef:
+		m.ResetProofRef()
+		return nil
+	case proofrequest.FieldRetrievalStatus:
+		m.ResetRetrievalStatus()
+		return nil
+	case proofrequest.FieldIpfsCid:
+		m.ResetIpfsCid()
+		return nil
+	case proofrequest.FieldProverBackend:
+		m.ResetProverBackend()
+		return nil
+	case proofrequest.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case proofrequest.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case proofrequest.FieldRequestedBy:
+		m.ResetRequestedBy()
+		return nil
+	case proofrequest.FieldCompletedBy:
+		m.ResetCompletedBy()
+		return nil
+	case proofrequest.FieldFee:
+		m.ResetFee()
+		return nil
+	case proofrequest.FieldFulfiller:
+		m.ResetFulfiller()
+		return nil
+	case proofrequest.FieldDowntimeCause:
+		m.ResetDowntimeCause()
+		return nil
+	case proofrequest.FieldL2BlockHash:
+		m.ResetL2BlockHash()
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProofRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.agg != nil {
+		edges = append(edges, proofrequest.EdgeAgg)
+	}
+	if m.spans != nil {
+		edges = append(edges, proofrequest.EdgeSpans)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProofRequestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case proofrequest.EdgeAgg:
+		if id := m.agg; id != nil {
+			return []ent.Value{*id}
+		}
+	case proofrequest.EdgeSpans:
+		ids := make([]ent.Value, 0, len(m.spans))
+		for id := range m.spans {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProofRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedspans != nil {
+		edges = append(edges, proofrequest.EdgeSpans)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProofRequestMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case proofrequest.EdgeSpans:
+		ids := make([]ent.Value, 0, len(m.removedspans))
+		for id := range m.removedspans {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProofRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedagg {
+		edges = append(edges, proofrequest.EdgeAgg)
+	}
+	if m.clearedspans {
+		edges = append(edges, proofrequest.EdgeSpans)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProofRequestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case proofrequest.EdgeAgg:
+		return m.clearedagg
+	case proofrequest.EdgeSpans:
+		return m.clearedspans
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProofRequestMutation) ClearEdge(name string) error {
+	switch name {
+	case proofrequest.EdgeAgg:
+		m.ClearAgg()
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProofRequestMutation) ResetEdge(name string) error {
+	switch name {
+	case proofrequest.EdgeAgg:
+		m.ResetAgg()
+		return nil
+	case proofrequest.EdgeSpans:
+		m.ResetSpans()
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequest edge %s", name)
+}
+
+// ProofRequestEventMutation represents an operation that mutates the ProofRequestEvent nodes in the graph.
+type ProofRequestEventMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	proof_request_id    *int
+	addproof_request_id *int
+	_type               *proofrequestevent.Type
+	start_block         *uint64
+	addstart_block      *int64
+	end_block           *uint64
+	addend_block        *int64
+	status              *proofrequestevent.Status
+	time                *uint64
+	addtime             *int64
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*ProofRequestEvent, error)
+	predicates          []predicate.ProofRequestEvent
+}
+
+var _ ent.Mutation = (*ProofRequestEventMutation)(nil)
+
+// proofrequesteventOption allows management of the mutation configuration using functional options.
+type proofrequesteventOption func(*ProofRequestEventMutation)
+
+// newProofRequestEventMutation creates new mutation for the ProofRequestEvent entity.
+func newProofRequestEventMutation(c config, op Op, opts ...proofrequesteventOption) *ProofRequestEventMutation {
+	m := &ProofRequestEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProofRequestEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProofRequestEventID sets the ID field of the mutation.
+func withProofRequestEventID(id int) proofrequesteventOption {
+	return func(m *ProofRequestEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProofRequestEvent
+		)
+		m.oldValue = func(ctx context.Context) (*ProofRequestEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProofRequestEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProofRequestEvent sets the old ProofRequestEvent of the mutation.
+func withProofRequestEvent(node *ProofRequestEvent) proofrequesteventOption {
+	return func(m *ProofRequestEventMutation) {
+		m.oldValue = func(context.Context) (*ProofRequestEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProofRequestEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProofRequestEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProofRequestEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProofRequestEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProofRequestEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProofRequestID sets the "proof_request_id" field.
+func (m *ProofRequestEventMutation) SetProofRequestID(i int) {
+	m.proof_request_id = &i
+	m.addproof_request_id = nil
+}
+
+// ProofRequestID returns the value of the "proof_request_id" field in the mutation.
+func (m *ProofRequestEventMutation) ProofRequestID() (r int, exists bool) {
+	v := m.proof_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProofRequestID returns the old "proof_request_id" field's value of the ProofRequestEvent entity.
+// If the ProofRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestEventMutation) OldProofRequestID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProofRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProofRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProofRequestID: %w", err)
+	}
+	return oldValue.ProofRequestID, nil
+}
+
+// AddProofRequestID adds i to the "proof_request_id" field.
+func (m *ProofRequestEventMutation) AddProofRequestID(i int) {
+	if m.addproof_request_id != nil {
+		*m.addproof_request_id += i
+	} else {
+		m.addproof_request_id = &i
+	}
+}
+
+// AddedProofRequestID returns the value that was added to the "proof_request_id" field in this mutation.
+func (m *ProofRequestEventMutation) AddedProofRequestID() (r int, exists bool) {
+	v := m.addproof_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProofRequestID resets all changes to the "proof_request_id" field.
+func (m *ProofRequestEventMutation) ResetProofRequestID() {
+	m.proof_request_id = nil
+	m.addproof_request_id = nil
+}
+
+// SetType sets the "type" field.
+func (m *ProofRequestEventMutation) SetType(pr proofrequestevent.Type) {
+	m._type = &pr
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ProofRequestEventMutation) GetType() (r proofrequestevent.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the ProofRequestEvent entity.
+// If the ProofRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestEventMutation) OldType(ctx context.Context) (v proofrequestevent.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ProofRequestEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetStartBlock sets the "start_block" field.
+func (m *ProofRequestEventMutation) SetStartBlock(u uint64) {
+	m.start_block = &u
+	m.addstart_block = nil
+}
+
+// StartBlock returns the value of the "start_block" field in the mutation.
+func (m *ProofRequestEventMutation) StartBlock() (r uint64, exists bool) {
+	v := m.start_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartBlock returns the old "start_block" field's value of the ProofRequestEvent entity.
+// If the ProofRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestEventMutation) OldStartBlock(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartBlock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartBlock requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartBlock: %w", err)
+	}
+	return oldValue.StartBlock, nil
+}
+
+// AddStartBlock adds u to the "start_block" field.
+func (m *ProofRequestEventMutation) AddStartBlock(u int64) {
+	if m.addstart_block != nil {
+		*m.addstart_block += u
+	} else {
+		m.addstart_block = &u
+	}
+}
+
+// AddedStartBlock returns the value that was added to the "start_block" field in this mutation.
+func (m *ProofRequestEventMutation) AddedStartBlock() (r int64, exists bool) {
+	v := m.addstart_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStartBlock resets all changes to the "start_block" field.
+func (m *ProofRequestEventMutation) ResetStartBlock() {
+	m.start_block = nil
+	m.addstart_block = nil
+}
+
+// SetEndBlock sets the "end_block" field.
+func (m *ProofRequestEventMutation) SetEndBlock(u uint64) {
+	m.end_block = &u
+	m.addend_block = nil
+}
+
+// EndBlock returns the value of the "end_block" field in the mutation.
+func (m *ProofRequestEventMutation) EndBlock() (r uint64, exists bool) {
+	v := m.end_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndBlock returns the old "end_block" field's value of the ProofRequestEvent entity.
+// If the ProofRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestEventMutation) OldEndBlock(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndBlock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndBlock requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndBlock: %w", err)
+	}
+	return oldValue.EndBlock, nil
+}
+
+// AddEndBlock adds u to the "end_block" field.
+func (m *ProofRequestEventMutation) AddEndBlock(u int64) {
+	if m.addend_block != nil {
+		*m.addend_block += u
+	} else {
+		m.addend_block = &u
+	}
+}
+
+// AddedEndBlock returns the value that was added to the "end_block" field in this mutation.
+func (m *ProofRequestEventMutation) AddedEndBlock() (r int64, exists bool) {
+	v := m.addend_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEndBlock resets all changes to the "end_block" field.
+func (m *ProofRequestEventMutation) ResetEndBlock() {
+	m.end_block = nil
+	m.addend_block = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ProofRequestEventMutation) SetStatus(pr proofrequestevent.Status) {
+	m.status = &pr
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ProofRequestEventMutation) Status() (r proofrequestevent.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ProofRequestEvent entity.
+// If the ProofRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestEventMutation) OldStatus(ctx context.Context) (v proofrequestevent.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ProofRequestEventMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetTime sets the "time" field.
+func (m *ProofRequestEventMutation) SetTime(u uint64) {
+	m.time = &u
+	m.addtime = nil
+}
+
+// Time returns the value of the "time" field in the mutation.
+func (m *ProofRequestEventMutation) Time() (r uint64, exists bool) {
+	v := m.time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTime returns the old "time" field's value of the ProofRequestEvent entity.
+// If the ProofRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestEventMutation) OldTime(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+	}
+	return oldValue.Time, nil
+}
+
+// AddTime adds u to the "time" field.
+func (m *ProofRequestEventMutation) AddTime(u int64) {
+	if m.addtime != nil {
+		*m.addtime += u
+	} else {
+		m.addtime = &u
+	}
+}
+
+// AddedTime returns the value that was added to the "time" field in this mutation.
+func (m *ProofRequestEventMutation) AddedTime() (r int64, exists bool) {
+	v := m.addtime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTime resets all changes to the "time" field.
+func (m *ProofRequestEventMutation) ResetTime() {
+	m.time = nil
+	m.addtime = nil
+}
+
+// Where appends a list predicates to the ProofRequestEventMutation builder.
+func (m *ProofRequestEventMutation) Where(ps ...predicate.ProofRequestEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProofRequestEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProofRequestEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProofRequestEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProofRequestEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProofRequestEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProofRequestEvent).
+func (m *ProofRequestEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProofRequestEventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.proof_request_id != nil {
+		fields = append(fields, proofrequestevent.FieldProofRequestID)
+	}
+	if m._type != nil {
+		fields = append(fields, proofrequestevent.FieldType)
+	}
+	if m.start_block != nil {
+		fields = append(fields, proofrequestevent.FieldStartBlock)
+	}
+	if m.end_block != nil {
+		fields = append(fields, proofrequestevent.FieldEndBlock)
+	}
+	if m.status != nil {
+		fields = append(fields, proofrequestevent.FieldStatus)
+	}
+	if m.time != nil {
+		fields = append(fields, proofrequestevent.FieldTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProofRequestEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case proofrequestevent.FieldProofRequestID:
+		return m.ProofRequestID()
+	case proofrequestevent.FieldType:
+		return m.GetType()
+	case proofrequestevent.FieldStartBlock:
+		return m.StartBlock()
+	case proofrequestevent.FieldEndBlock:
+		return m.EndBlock()
+	case proofrequestevent.FieldStatus:
+		return m.Status()
+	case proofrequestevent.FieldTime:
+		return m.Time()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProofRequestEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case proofrequestevent.FieldProofRequestID:
+		return m.OldProofRequestID(ctx)
+	case proofrequestevent.FieldType:
+		return m.OldType(ctx)
+	case proofrequestevent.FieldStartBlock:
+		return m.OldStartBlock(ctx)
+	case proofrequestevent.FieldEndBlock:
+		return m.OldEndBlock(ctx)
+	case proofrequestevent.FieldStatus:
+		return m.OldStatus(ctx)
+	case proofrequestevent.FieldTime:
+		return m.OldTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProofRequestEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProofRequestEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case proofrequestevent.FieldProofRequestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProofRequestID(v)
+		return nil
+	case proofrequestevent.FieldType:
+		v, ok := value.(proofrequestevent.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case proofrequestevent.FieldStartBlock:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartBlock(v)
+		return nil
+	case proofrequestevent.FieldEndBlock:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndBlock(v)
+		return nil
+	case proofrequestevent.FieldStatus:
+		v, ok := value.(proofrequestevent.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case proofrequestevent.FieldTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequestEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProofRequestEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addproof_request_id != nil {
+		fields = append(fields, proofrequestevent.FieldProofRequestID)
+	}
+	if m.addstart_block != nil {
+		fields = append(fields, proofrequestevent.FieldStartBlock)
+	}
+	if m.addend_block != nil {
+		fields = append(fields, proofrequestevent.FieldEndBlock)
+	}
+	if m.addtime != nil {
+		fields = append(fields, proofrequestevent.FieldTime)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProofRequestEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case proofrequestevent.FieldProofRequestID:
+		return m.AddedProofRequestID()
+	case proofrequestevent.FieldStartBlock:
+		return m.AddedStartBlock()
+	case proofrequestevent.FieldEndBlock:
+		return m.AddedEndBlock()
+	case proofrequestevent.FieldTime:
+		return m.AddedTime()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProofRequestEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case proofrequestevent.FieldProofRequestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProofRequestID(v)
+		return nil
+	case proofrequestevent.FieldStartBlock:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartBlock(v)
+		return nil
+	case proofrequestevent.FieldEndBlock:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEndBlock(v)
+		return nil
+	case proofrequestevent.FieldTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequestEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProofRequestEventMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProofRequestEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProofRequestEventMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ProofRequestEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProofRequestEventMutation) ResetField(name string) error {
+	switch name {
+	case proofrequestevent.FieldProofRequestID:
+		m.ResetProofRequestID()
+		return nil
+	case proofrequestevent.FieldType:
+		m.ResetType()
+		return nil
+	case proofrequestevent.FieldStartBlock:
+		m.ResetStartBlock()
+		return nil
+	case proofrequestevent.FieldEndBlock:
+		m.ResetEndBlock()
+		return nil
+	case proofrequestevent.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case proofrequestevent.FieldTime:
+		m.ResetTime()
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequestEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProofRequestEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProofRequestEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProofRequestEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProofRequestEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProofRequestEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProofRequestEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProofRequestEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ProofRequestEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProofRequestEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ProofRequestEvent edge %s", name)
+}
+
+// RangeLeaseMutation represents an operation that mutates the RangeLease nodes in the graph.
+type RangeLeaseMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	owner           *string
+	start_block     *uint64
+	end_block       *uint64
+	addstart_block  *int64
+	addend_block    *int64
+	expires_time    *uint64
+	addexpires_time *int64
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*RangeLease, error)
+	predicates      []predicate.RangeLease
+}
+
+var _ ent.Mutation = (*RangeLeaseMutation)(nil)
+
+// rangeleaseOption allows management of the mutation configuration using functional options.
+type rangeleaseOption func(*RangeLeaseMutation)
+
+// newRangeLeaseMutation creates new mutation for the RangeLease entity.
+func newRangeLeaseMutation(c config, op Op, opts ...rangeleaseOption) *RangeLeaseMutation {
+	m := &RangeLeaseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRangeLease,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRangeLeaseID sets the ID field of the mutation.
+func withRangeLeaseID(id int) rangeleaseOption {
+	return func(m *RangeLeaseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RangeLease
+		)
+		m.oldValue = func(ctx context.Context) (*RangeLease, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RangeLease.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRangeLease sets the old RangeLease of the mutation.
+func withRangeLease(node *RangeLease) rangeleaseOption {
+	return func(m *RangeLeaseMutation) {
+		m.oldValue = func(context.Context) (*RangeLease, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RangeLeaseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RangeLeaseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RangeLeaseMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetProofRequestTime resets all changes to the "proof_request_time" field.
-func (m *ProofRequestMutation) ResetProofRequestTime() {
-	m.proof_request_time = nil
-	m.addproof_request_time = nil
-	delete(m.clearedFields, proofrequest.FieldProofRequestTime)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RangeLeaseMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RangeLease.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetLastUpdatedTime sets the "last_updated_time" field.
-func (m *ProofRequestMutation) SetLastUpdatedTime(u uint64) {
-	m.last_updated_time = &u
-	m.addlast_updated_time = nil
+// SetOwner sets the "owner" field.
+func (m *RangeLeaseMutation) SetOwner(s string) {
+	m.owner = &s
 }
 
-// LastUpdatedTime returns the value of the "last_updated_time" field in the mutation.
-func (m *ProofRequestMutation) LastUpdatedTime() (r uint64, exists bool) {
-	v := m.last_updated_time
+// Owner returns the value of the "owner" field in the mutation.
+func (m *RangeLeaseMutation) Owner() (r string, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastUpdatedTime returns the old "last_updated_time" field's value of the ProofRequest entity.
-// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldOwner returns the old "owner" field's value of the RangeLease entity.
+// If the RangeLease object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProofRequestMutation) OldLastUpdatedTime(ctx context.Context) (v uint64, err error) {
+func (m *RangeLeaseMutation) OldOwner(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUpdatedTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUpdatedTime requires an ID field in the mutation")
+		return v, errors.New("OldOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUpdatedTime: %w", err)
-	}
-	return oldValue.LastUpdatedTime, nil
-}
-
-// AddLastUpdatedTime adds u to the "last_updated_time" field.
-func (m *ProofRequestMutation) AddLastUpdatedTime(u int64) {
-	if m.addlast_updated_time != nil {
-		*m.addlast_updated_time += u
-	} else {
-		m.addlast_updated_time = &u
-	}
-}
-
-// AddedLastUpdatedTime returns the value that was added to the "last_updated_time" field in this mutation.
-func (m *ProofRequestMutation) AddedLastUpdatedTime() (r int64, exists bool) {
-	v := m.addlast_updated_time
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
 	}
-	return *v, true
+	return oldValue.Owner, nil
 }
 
-// ResetLastUpdatedTime resets all changes to the "last_updated_time" field.
-func (m *ProofRequestMutation) ResetLastUpdatedTime() {
-	m.last_updated_time = nil
-	m.addlast_updated_time = nil
+// ResetOwner resets all changes to the "owner" field.
+func (m *RangeLeaseMutation) ResetOwner() {
+	m.owner = nil
 }
 
-// SetL1BlockNumber sets the "l1_block_number" field.
-func (m *ProofRequestMutation) SetL1BlockNumber(u uint64) {
-	m.l1_block_number = &u
-	m.addl1_block_number = nil
+// SetStartBlock sets the "start_block" field.
+func (m *RangeLeaseMutation) SetStartBlock(u uint64) {
+	m.start_block = &u
+	m.addstart_block = nil
 }
 
-// L1BlockNumber returns the value of the "l1_block_number" field in the mutation.
-func (m *ProofRequestMutation) L1BlockNumber() (r uint64, exists bool) {
-	v := m.l1_block_number
+// StartBlock returns the value of the "start_block" field in the mutation.
+func (m *RangeLeaseMutation) StartBlock() (r uint64, exists bool) {
+	v := m.start_block
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldL1BlockNumber returns the old "l1_block_number" field's value of the ProofRequest entity.
-// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldStartBlock returns the old "start_block" field's value of the RangeLease entity.
+// If the RangeLease object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProofRequestMutation) OldL1BlockNumber(ctx context.Context) (v uint64, err error) {
+func (m *RangeLeaseMutation) OldStartBlock(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldL1BlockNumber is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartBlock is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldL1BlockNumber requires an ID field in the mutation")
+		return v, errors.New("OldStartBlock requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldL1BlockNumber: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartBlock: %w", err)
 	}
-	return oldValue.L1BlockNumber, nil
+	return oldValue.StartBlock, nil
 }
 
-// AddL1BlockNumber adds u to the "l1_block_number" field.
-func (m *ProofRequestMutation) AddL1BlockNumber(u int64) {
-	if m.addl1_block_number != nil {
-		*m.addl1_block_number += u
+// AddStartBlock adds u to the "start_block" field.
+func (m *RangeLeaseMutation) AddStartBlock(u int64) {
+	if m.addstart_block != nil {
+		*m.addstart_block += u
 	} else {
-		m.addl1_block_number = &u
+		m.addstart_block = &u
 	}
 }
 
-// AddedL1BlockNumber returns the value that was added to the "l1_block_number" field in this mutation.
-func (m *ProofRequestMutation) AddedL1BlockNumber() (r int64, exists bool) {
-	v := m.addl1_block_number
+// AddedStartBlock returns the value that was added to the "start_block" field in this mutation.
+func (m *RangeLeaseMutation) AddedStartBlock() (r int64, exists bool) {
+	v := m.addstart_block
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearL1BlockNumber clears the value of the "l1_block_number" field.
-func (m *ProofRequestMutation) ClearL1BlockNumber() {
-	m.l1_block_number = nil
-	m.addl1_block_number = nil
-	m.clearedFields[proofrequest.FieldL1BlockNumber] = struct{}{}
-}
-
-// L1BlockNumberCleared returns if the "l1_block_number" field was cleared in this mutation.
-func (m *ProofRequestMutation) L1BlockNumberCleared() bool {
-	_, ok := m.clearedFields[proofrequest.FieldL1BlockNumber]
-	return ok
-}
-
-// ResetL1BlockNumber resets all changes to the "l1_block_number" field.
-func (m *ProofRequestMutation) ResetL1BlockNumber() {
-	m.l1_block_number = nil
-	m.addl1_block_number = nil
-	delete(m.clearedFields, proofrequest.FieldL1BlockNumber)
+// ResetStartBlock resets all changes to the "start_block" field.
+func (m *RangeLeaseMutation) ResetStartBlock() {
+	m.start_block = nil
+	m.addstart_block = nil
 }
 
-// SetL1BlockHash sets the "l1_block_hash" field.
-func (m *ProofRequestMutation) SetL1BlockHash(s string) {
-	m.l1_block_hash = &s
+// SetEndBlock sets the "end_block" field.
+func (m *RangeLeaseMutation) SetEndBlock(u uint64) {
+	m.end_block = &u
+	m.addend_block = nil
 }
 
-// L1BlockHash returns the value of the "l1_block_hash" field in the mutation.
-func (m *ProofRequestMutation) L1BlockHash() (r string, exists bool) {
-	v := m.l1_block_hash
+// EndBlock returns the value of the "end_block" field in the mutation.
+func (m *RangeLeaseMutation) EndBlock() (r uint64, exists bool) {
+	v := m.end_block
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldL1BlockHash returns the old "l1_block_hash" field's value of the ProofRequest entity.
-// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldEndBlock returns the old "end_block" field's value of the RangeLease entity.
+// If the RangeLease object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProofRequestMutation) OldL1BlockHash(ctx context.Context) (v string, err error) {
+func (m *RangeLeaseMutation) OldEndBlock(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldL1BlockHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndBlock is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldL1BlockHash requires an ID field in the mutation")
+		return v, errors.New("OldEndBlock requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldL1BlockHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndBlock: %w", err)
 	}
-	return oldValue.L1BlockHash, nil
+	return oldValue.EndBlock, nil
 }
 
-// ClearL1BlockHash clears the value of the "l1_block_hash" field.
-func (m *ProofRequestMutation) ClearL1BlockHash() {
-	m.l1_block_hash = nil
-	m.clearedFields[proofrequest.FieldL1BlockHash] = struct{}{}
+// AddEndBlock adds u to the "end_block" field.
+func (m *RangeLeaseMutation) AddEndBlock(u int64) {
+	if m.addend_block != nil {
+		*m.addend_block += u
+	} else {
+		m.addend_block = &u
+	}
 }
 
-// L1BlockHashCleared returns if the "l1_block_hash" field was cleared in this mutation.
-func (m *ProofRequestMutation) L1BlockHashCleared() bool {
-	_, ok := m.clearedFields[proofrequest.FieldL1BlockHash]
-	return ok
+// AddedEndBlock returns the value that was added to the "end_block" field in this mutation.
+func (m *RangeLeaseMutation) AddedEndBlock() (r int64, exists bool) {
+	v := m.addend_block
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetL1BlockHash resets all changes to the "l1_block_hash" field.
-func (m *ProofRequestMutation) ResetL1BlockHash() {
-	m.l1_block_hash = nil
-	delete(m.clearedFields, proofrequest.FieldL1BlockHash)
+// ResetEndBlock resets all changes to the "end_block" field.
+func (m *RangeLeaseMutation) ResetEndBlock() {
+	m.end_block = nil
+	m.addend_block = nil
 }
 
-// SetProof sets the "proof" field.
-func (m *ProofRequestMutation) SetProof(b []byte) {
-	m.proof = &b
+// SetExpiresTime sets the "expires_time" field.
+func (m *RangeLeaseMutation) SetExpiresTime(u uint64) {
+	m.expires_time = &u
+	m.addexpires_time = nil
 }
 
-// Proof returns the value of the "proof" field in the mutation.
-func (m *ProofRequestMutation) Proof() (r []byte, exists bool) {
-	v := m.proof
+// ExpiresTime returns the value of the "expires_time" field in the mutation.
+func (m *RangeLeaseMutation) ExpiresTime() (r uint64, exists bool) {
+	v := m.expires_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProof returns the old "proof" field's value of the ProofRequest entity.
-// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresTime returns the old "expires_time" field's value of the RangeLease entity.
+// If the RangeLease object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProofRequestMutation) OldProof(ctx context.Context) (v []byte, err error) {
+func (m *RangeLeaseMutation) OldExpiresTime(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProof is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProof requires an ID field in the mutation")
+		return v, errors.New("OldExpiresTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProof: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresTime: %w", err)
 	}
-	return oldValue.Proof, nil
+	return oldValue.ExpiresTime, nil
 }
 
-// ClearProof clears the value of the "proof" field.
-func (m *ProofRequestMutation) ClearProof() {
-	m.proof = nil
-	m.clearedFields[proofrequest.FieldProof] = struct{}{}
+// AddExpiresTime adds u to the "expires_time" field.
+func (m *RangeLeaseMutation) AddExpiresTime(u int64) {
+	if m.addexpires_time != nil {
+		*m.addexpires_time += u
+	} else {
+		m.addexpires_time = &u
+	}
 }
 
-// ProofCleared returns if the "proof" field was cleared in this mutation.
-func (m *ProofRequestMutation) ProofCleared() bool {
-	_, ok := m.clearedFields[proofrequest.FieldProof]
-	return ok
+// AddedExpiresTime returns the value that was added to the "expires_time" field in this mutation.
+func (m *RangeLeaseMutation) AddedExpiresTime() (r int64, exists bool) {
+	v := m.addexpires_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetProof resets all changes to the "proof" field.
-func (m *ProofRequestMutation) ResetProof() {
-	m.proof = nil
-	delete(m.clearedFields, proofrequest.FieldProof)
+// ResetExpiresTime resets all changes to the "expires_time" field.
+func (m *RangeLeaseMutation) ResetExpiresTime() {
+	m.expires_time = nil
+	m.addexpires_time = nil
 }
 
-// Where appends a list predicates to the ProofRequestMutation builder.
-func (m *ProofRequestMutation) Where(ps ...predicate.ProofRequest) {
+// Where appends a list predicates to the RangeLeaseMutation builder.
+func (m *RangeLeaseMutation) Where(ps ...predicate.RangeLease) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProofRequestMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RangeLeaseMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProofRequestMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ProofRequest, len(ps))
+func (m *RangeLeaseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RangeLease, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
 	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *ProofRequestMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *ProofRequestMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (ProofRequest).
-func (m *ProofRequestMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ProofRequestMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m._type != nil {
-		fields = append(fields, proofrequest.FieldType)
-	}
-	if m.start_block != nil {
-		fields = append(fields, proofrequest.FieldStartBlock)
-	}
-	if m.end_block != nil {
-		fields = append(fields, proofrequest.FieldEndBlock)
-	}
-	if m.status != nil {
-		fields = append(fields, proofrequest.FieldStatus)
-	}
-	if m.request_added_time != nil {
-		fields = append(fields, proofrequest.FieldRequestAddedTime)
-	}
-	if m.prover_request_id != nil {
-		fields = append(fields, proofrequest.FieldProverRequestID)
-	}
-	if m.proof_request_time != nil {
-		fields = append(fields, proofrequest.FieldProofRequestTime)
-	}
-	if m.last_updated_time != nil {
-		fields = append(fields, proofrequest.FieldLastUpdatedTime)
+}
+
+// Op returns the operation name.
+func (m *RangeLeaseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RangeLeaseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RangeLease).
+func (m *RangeLeaseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RangeLeaseMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.owner != nil {
+		fields = append(fields, rangelease.FieldOwner)
 	}
-	if m.l1_block_number != nil {
-		fields = append(fields, proofrequest.FieldL1BlockNumber)
+	if m.start_block != nil {
+		fields = append(fields, rangelease.FieldStartBlock)
 	}
-	if m.l1_block_hash != nil {
-		fields = append(fields, proofrequest.FieldL1BlockHash)
+	if m.end_block != nil {
+		fields = append(fields, rangelease.FieldEndBlock)
 	}
-	if m.proof != nil {
-		fields = append(fields, proofrequest.FieldProof)
+	if m.expires_time != nil {
+		fields = append(fields, rangelease.FieldExpiresTime)
 	}
 	return fields
 }
@@ -810,30 +5762,16 @@ func (m *ProofRequestMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProofRequestMutation) Field(name string) (ent.Value, bool) {
+func (m *RangeLeaseMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case proofrequest.FieldType:
-		return m.GetType()
-	case proofrequest.FieldStartBlock:
+	case rangelease.FieldOwner:
+		return m.Owner()
+	case rangelease.FieldStartBlock:
 		return m.StartBlock()
-	case proofrequest.FieldEndBlock:
+	case rangelease.FieldEndBlock:
 		return m.EndBlock()
-	case proofrequest.FieldStatus:
-		return m.Status()
-	case proofrequest.FieldRequestAddedTime:
-		return m.RequestAddedTime()
-	case proofrequest.FieldProverRequestID:
-		return m.ProverRequestID()
-	case proofrequest.FieldProofRequestTime:
-		return m.ProofRequestTime()
-	case proofrequest.FieldLastUpdatedTime:
-		return m.LastUpdatedTime()
-	case proofrequest.FieldL1BlockNumber:
-		return m.L1BlockNumber()
-	case proofrequest.FieldL1BlockHash:
-		return m.L1BlockHash()
-	case proofrequest.FieldProof:
-		return m.Proof()
+	case rangelease.FieldExpiresTime:
+		return m.ExpiresTime()
 	}
 	return nil, false
 }
@@ -841,141 +5779,69 @@ func (m *ProofRequestMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProofRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RangeLeaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case proofrequest.FieldType:
-		return m.OldType(ctx)
-	case proofrequest.FieldStartBlock:
+	case rangelease.FieldOwner:
+		return m.OldOwner(ctx)
+	case rangelease.FieldStartBlock:
 		return m.OldStartBlock(ctx)
-	case proofrequest.FieldEndBlock:
+	case rangelease.FieldEndBlock:
 		return m.OldEndBlock(ctx)
-	case proofrequest.FieldStatus:
-		return m.OldStatus(ctx)
-	case proofrequest.FieldRequestAddedTime:
-		return m.OldRequestAddedTime(ctx)
-	case proofrequest.FieldProverRequestID:
-		return m.OldProverRequestID(ctx)
-	case proofrequest.FieldProofRequestTime:
-		return m.OldProofRequestTime(ctx)
-	case proofrequest.FieldLastUpdatedTime:
-		return m.OldLastUpdatedTime(ctx)
-	case proofrequest.FieldL1BlockNumber:
-		return m.OldL1BlockNumber(ctx)
-	case proofrequest.FieldL1BlockHash:
-		return m.OldL1BlockHash(ctx)
-	case proofrequest.FieldProof:
-		return m.OldProof(ctx)
+	case rangelease.FieldExpiresTime:
+		return m.OldExpiresTime(ctx)
 	}
-	return nil, fmt.Errorf("unknown ProofRequest field %s", name)
+	return nil, fmt.Errorf("unknown RangeLease field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProofRequestMutation) SetField(name string, value ent.Value) error {
+func (m *RangeLeaseMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case proofrequest.FieldType:
-		v, ok := value.(proofrequest.Type)
+	case rangelease.FieldOwner:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetOwner(v)
 		return nil
-	case proofrequest.FieldStartBlock:
+	case rangelease.FieldStartBlock:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStartBlock(v)
 		return nil
-	case proofrequest.FieldEndBlock:
+	case rangelease.FieldEndBlock:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetEndBlock(v)
 		return nil
-	case proofrequest.FieldStatus:
-		v, ok := value.(proofrequest.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case proofrequest.FieldRequestAddedTime:
-		v, ok := value.(uint64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRequestAddedTime(v)
-		return nil
-	case proofrequest.FieldProverRequestID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProverRequestID(v)
-		return nil
-	case proofrequest.FieldProofRequestTime:
-		v, ok := value.(uint64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProofRequestTime(v)
-		return nil
-	case proofrequest.FieldLastUpdatedTime:
-		v, ok := value.(uint64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastUpdatedTime(v)
-		return nil
-	case proofrequest.FieldL1BlockNumber:
+	case rangelease.FieldExpiresTime:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetL1BlockNumber(v)
-		return nil
-	case proofrequest.FieldL1BlockHash:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetL1BlockHash(v)
-		return nil
-	case proofrequest.FieldProof:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProof(v)
+		m.SetExpiresTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ProofRequest field %s", name)
+	return fmt.Errorf("unknown RangeLease field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProofRequestMutation) AddedFields() []string {
+func (m *RangeLeaseMutation) AddedFields() []string {
 	var fields []string
 	if m.addstart_block != nil {
-		fields = append(fields, proofrequest.FieldStartBlock)
+		fields = append(fields, rangelease.FieldStartBlock)
 	}
 	if m.addend_block != nil {
-		fields = append(fields, proofrequest.FieldEndBlock)
-	}
-	if m.addrequest_added_time != nil {
-		fields = append(fields, proofrequest.FieldRequestAddedTime)
-	}
-	if m.addproof_request_time != nil {
-		fields = append(fields, proofrequest.FieldProofRequestTime)
-	}
-	if m.addlast_updated_time != nil {
-		fields = append(fields, proofrequest.FieldLastUpdatedTime)
+		fields = append(fields, rangelease.FieldEndBlock)
 	}
-	if m.addl1_block_number != nil {
-		fields = append(fields, proofrequest.FieldL1BlockNumber)
+	if m.addexpires_time != nil {
+		fields = append(fields, rangelease.FieldExpiresTime)
 	}
 	return fields
 }
@@ -983,20 +5849,14 @@ func (m *ProofRequestMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProofRequestMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RangeLeaseMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case proofrequest.FieldStartBlock:
+	case rangelease.FieldStartBlock:
 		return m.AddedStartBlock()
-	case proofrequest.FieldEndBlock:
+	case rangelease.FieldEndBlock:
 		return m.AddedEndBlock()
-	case proofrequest.FieldRequestAddedTime:
-		return m.AddedRequestAddedTime()
-	case proofrequest.FieldProofRequestTime:
-		return m.AddedProofRequestTime()
-	case proofrequest.FieldLastUpdatedTime:
-		return m.AddedLastUpdatedTime()
-	case proofrequest.FieldL1BlockNumber:
-		return m.AddedL1BlockNumber()
+	case rangelease.FieldExpiresTime:
+		return m.AddedExpiresTime()
 	}
 	return nil, false
 }
@@ -1004,191 +5864,116 @@ func (m *ProofRequestMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProofRequestMutation) AddField(name string, value ent.Value) error {
+func (m *RangeLeaseMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case proofrequest.FieldStartBlock:
+	case rangelease.FieldStartBlock:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddStartBlock(v)
 		return nil
-	case proofrequest.FieldEndBlock:
+	case rangelease.FieldEndBlock:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddEndBlock(v)
 		return nil
-	case proofrequest.FieldRequestAddedTime:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRequestAddedTime(v)
-		return nil
-	case proofrequest.FieldProofRequestTime:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddProofRequestTime(v)
-		return nil
-	case proofrequest.FieldLastUpdatedTime:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLastUpdatedTime(v)
-		return nil
-	case proofrequest.FieldL1BlockNumber:
+	case rangelease.FieldExpiresTime:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddL1BlockNumber(v)
+		m.AddExpiresTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ProofRequest numeric field %s", name)
+	return fmt.Errorf("unknown RangeLease numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProofRequestMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(proofrequest.FieldProverRequestID) {
-		fields = append(fields, proofrequest.FieldProverRequestID)
-	}
-	if m.FieldCleared(proofrequest.FieldProofRequestTime) {
-		fields = append(fields, proofrequest.FieldProofRequestTime)
-	}
-	if m.FieldCleared(proofrequest.FieldL1BlockNumber) {
-		fields = append(fields, proofrequest.FieldL1BlockNumber)
-	}
-	if m.FieldCleared(proofrequest.FieldL1BlockHash) {
-		fields = append(fields, proofrequest.FieldL1BlockHash)
-	}
-	if m.FieldCleared(proofrequest.FieldProof) {
-		fields = append(fields, proofrequest.FieldProof)
-	}
-	return fields
+func (m *RangeLeaseMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProofRequestMutation) FieldCleared(name string) bool {
+func (m *RangeLeaseMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProofRequestMutation) ClearField(name string) error {
-	switch name {
-	case proofrequest.FieldProverRequestID:
-		m.ClearProverRequestID()
-		return nil
-	case proofrequest.FieldProofRequestTime:
-		m.ClearProofRequestTime()
-		return nil
-	case proofrequest.FieldL1BlockNumber:
-		m.ClearL1BlockNumber()
-		return nil
-	case proofrequest.FieldL1BlockHash:
-		m.ClearL1BlockHash()
-		return nil
-	case proofrequest.FieldProof:
-		m.ClearProof()
-		return nil
-	}
-	return fmt.Errorf("unknown ProofRequest nullable field %s", name)
+func (m *RangeLeaseMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RangeLease nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProofRequestMutation) ResetField(name string) error {
+func (m *RangeLeaseMutation) ResetField(name string) error {
 	switch name {
-	case proofrequest.FieldType:
-		m.ResetType()
+	case rangelease.FieldOwner:
+		m.ResetOwner()
 		return nil
-	case proofrequest.FieldStartBlock:
+	case rangelease.FieldStartBlock:
 		m.ResetStartBlock()
 		return nil
-	case proofrequest.FieldEndBlock:
+	case rangelease.FieldEndBlock:
 		m.ResetEndBlock()
 		return nil
-	case proofrequest.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case proofrequest.FieldRequestAddedTime:
-		m.ResetRequestAddedTime()
-		return nil
-	case proofrequest.FieldProverRequestID:
-		m.ResetProverRequestID()
-		return nil
-	case proofrequest.FieldProofRequestTime:
-		m.ResetProofRequestTime()
-		return nil
-	case proofrequest.FieldLastUpdatedTime:
-		m.ResetLastUpdatedTime()
-		return nil
-	case proofrequest.FieldL1BlockNumber:
-		m.ResetL1BlockNumber()
-		return nil
-	case proofrequest.FieldL1BlockHash:
-		m.ResetL1BlockHash()
-		return nil
-	case proofrequest.FieldProof:
-		m.ResetProof()
+	case rangelease.FieldExpiresTime:
+		m.ResetExpiresTime()
 		return nil
 	}
-	return fmt.Errorf("unknown ProofRequest field %s", name)
+	return fmt.Errorf("unknown RangeLease field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProofRequestMutation) AddedEdges() []string {
+func (m *RangeLeaseMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProofRequestMutation) AddedIDs(name string) []ent.Value {
+func (m *RangeLeaseMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProofRequestMutation) RemovedEdges() []string {
+func (m *RangeLeaseMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProofRequestMutation) RemovedIDs(name string) []ent.Value {
+func (m *RangeLeaseMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProofRequestMutation) ClearedEdges() []string {
+func (m *RangeLeaseMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProofRequestMutation) EdgeCleared(name string) bool {
+func (m *RangeLeaseMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProofRequestMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ProofRequest unique edge %s", name)
+func (m *RangeLeaseMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RangeLease unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProofRequestMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ProofRequest edge %s", name)
+func (m *RangeLeaseMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RangeLease edge %s", name)
 }
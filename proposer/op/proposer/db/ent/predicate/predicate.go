@@ -6,5 +6,17 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Heartbeat is the predicate function for heartbeat builders.
+type Heartbeat func(*sql.Selector)
+
+// LimiterState is the predicate function for limiterstate builders.
+type LimiterState func(*sql.Selector)
+
 // ProofRequest is the predicate function for proofrequest builders.
 type ProofRequest func(*sql.Selector)
+
+// ProofRequestEvent is the predicate function for proofrequestevent builders.
+type ProofRequestEvent func(*sql.Selector)
+
+// RangeLease is the predicate function for rangelease builders.
+type RangeLease func(*sql.Selector)
@@ -28,19 +28,107 @@ type ProofRequest struct {
 	RequestAddedTime uint64 `json:"request_added_time,omitempty"`
 	// ProverRequestID holds the value of the "prover_request_id" field.
 	ProverRequestID string `json:"prover_request_id,omitempty"`
+	// IdempotencyKey holds the value of the "idempotency_key" field.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+	// ExternalRef holds the value of the "external_ref" field.
+	ExternalRef string `json:"external_ref,omitempty"`
+	// WitnessArtifactID holds the value of the "witness_artifact_id" field.
+	WitnessArtifactID string `json:"witness_artifact_id,omitempty"`
+	// AggRequestID holds the value of the "agg_request_id" field.
+	AggRequestID int `json:"agg_request_id,omitempty"`
 	// ProofRequestTime holds the value of the "proof_request_time" field.
 	ProofRequestTime uint64 `json:"proof_request_time,omitempty"`
 	// LastUpdatedTime holds the value of the "last_updated_time" field.
 	LastUpdatedTime uint64 `json:"last_updated_time,omitempty"`
+	// ProofTimeout holds the value of the "proof_timeout" field.
+	ProofTimeout uint64 `json:"proof_timeout,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts uint64 `json:"attempts,omitempty"`
+	// NotBefore holds the value of the "not_before" field.
+	NotBefore uint64 `json:"not_before,omitempty"`
+	// Cycles holds the value of the "cycles" field.
+	Cycles uint64 `json:"cycles,omitempty"`
+	// FulfilledTime holds the value of the "fulfilled_time" field.
+	FulfilledTime uint64 `json:"fulfilled_time,omitempty"`
+	// SubmissionReverts holds the value of the "submission_reverts" field.
+	SubmissionReverts uint64 `json:"submission_reverts,omitempty"`
+	// SubmissionResends holds the value of the "submission_resends" field.
+	SubmissionResends uint64 `json:"submission_resends,omitempty"`
+	// SubmissionNonceResyncs holds the value of the "submission_nonce_resyncs" field.
+	SubmissionNonceResyncs uint64 `json:"submission_nonce_resyncs,omitempty"`
 	// L1BlockNumber holds the value of the "l1_block_number" field.
 	L1BlockNumber uint64 `json:"l1_block_number,omitempty"`
 	// L1BlockHash holds the value of the "l1_block_hash" field.
 	L1BlockHash string `json:"l1_block_hash,omitempty"`
+	// SatisfiedByTx holds the value of the "satisfied_by_tx" field.
+	SatisfiedByTx string `json:"satisfied_by_tx,omitempty"`
 	// Proof holds the value of the "proof" field.
-	Proof        []byte `json:"proof,omitempty"`
+	Proof []byte `json:"proof,omitempty"`
+	// StorageTier holds the value of the "storage_tier" field.
+	StorageTier proofrequest.StorageTier `json:"storage_tier,omitempty"`
+	// ColdStorageKey holds the value of the "cold_storage_key" field.
+	ColdStorageKey string `json:"cold_storage_key,omitempty"`
+	// ProofRef holds the value of the "proof_ref" field.
+	ProofRef string `json:"proof_ref,omitempty"`
+	// RetrievalStatus holds the value of the "retrieval_status" field.
+	RetrievalStatus proofrequest.RetrievalStatus `json:"retrieval_status,omitempty"`
+	// IpfsCid holds the value of the "ipfs_cid" field.
+	IpfsCid string `json:"ipfs_cid,omitempty"`
+	// ProverBackend holds the value of the "prover_backend" field.
+	ProverBackend string `json:"prover_backend,omitempty"`
+	// ErrorMessage holds the value of the "error_message" field.
+	ErrorMessage string `json:"error_message,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// RequestedBy holds the value of the "requested_by" field.
+	RequestedBy string `json:"requested_by,omitempty"`
+	// CompletedBy holds the value of the "completed_by" field.
+	CompletedBy string `json:"completed_by,omitempty"`
+	// Fee holds the value of the "fee" field.
+	Fee string `json:"fee,omitempty"`
+	// Fulfiller holds the value of the "fulfiller" field.
+	Fulfiller string `json:"fulfiller,omitempty"`
+	// DowntimeCause holds the value of the "downtime_cause" field.
+	DowntimeCause string `json:"downtime_cause,omitempty"`
+	// L2BlockHash holds the value of the "l2_block_hash" field.
+	L2BlockHash string `json:"l2_block_hash,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProofRequestQuery when eager-loading is set.
+	Edges        ProofRequestEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// ProofRequestEdges holds the relations/edges for other nodes in the graph.
+type ProofRequestEdges struct {
+	// Agg holds the value of the agg edge.
+	Agg *ProofRequest `json:"agg,omitempty"`
+	// Spans holds the value of the spans edge.
+	Spans []*ProofRequest `json:"spans,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AggOrErr returns the Agg value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProofRequestEdges) AggOrErr() (*ProofRequest, error) {
+	if e.Agg != nil {
+		return e.Agg, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: proofrequest.Label}
+	}
+	return nil, &NotLoadedError{edge: "agg"}
+}
+
+// SpansOrErr returns the Spans value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProofRequestEdges) SpansOrErr() ([]*ProofRequest, error) {
+	if e.loadedTypes[1] {
+		return e.Spans, nil
+	}
+	return nil, &NotLoadedError{edge: "spans"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ProofRequest) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -48,9 +136,9 @@ func (*ProofRequest) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case proofrequest.FieldProof:
 			values[i] = new([]byte)
-		case proofrequest.FieldID, proofrequest.FieldStartBlock, proofrequest.FieldEndBlock, proofrequest.FieldRequestAddedTime, proofrequest.FieldProofRequestTime, proofrequest.FieldLastUpdatedTime, proofrequest.FieldL1BlockNumber:
+		case proofrequest.FieldID, proofrequest.FieldStartBlock, proofrequest.FieldEndBlock, proofrequest.FieldRequestAddedTime, proofrequest.FieldAggRequestID, proofrequest.FieldProofRequestTime, proofrequest.FieldLastUpdatedTime, proofrequest.FieldProofTimeout, proofrequest.FieldAttempts, proofrequest.FieldNotBefore, proofrequest.FieldCycles, proofrequest.FieldFulfilledTime, proofrequest.FieldSubmissionReverts, proofrequest.FieldSubmissionResends, proofrequest.FieldSubmissionNonceResyncs, proofrequest.FieldL1BlockNumber:
 			values[i] = new(sql.NullInt64)
-		case proofrequest.FieldType, proofrequest.FieldStatus, proofrequest.FieldProverRequestID, proofrequest.FieldL1BlockHash:
+		case proofrequest.FieldType, proofrequest.FieldStatus, proofrequest.FieldProverRequestID, proofrequest.FieldIdempotencyKey, proofrequest.FieldExternalRef, proofrequest.FieldWitnessArtifactID, proofrequest.FieldL1BlockHash, proofrequest.FieldSatisfiedByTx, proofrequest.FieldStorageTier, proofrequest.FieldColdStorageKey, proofrequest.FieldProofRef, proofrequest.FieldRetrievalStatus, proofrequest.FieldIpfsCid, proofrequest.FieldProverBackend, proofrequest.FieldErrorMessage, proofrequest.FieldCreatedBy, proofrequest.FieldRequestedBy, proofrequest.FieldCompletedBy, proofrequest.FieldFee, proofrequest.FieldFulfiller, proofrequest.FieldDowntimeCause, proofrequest.FieldL2BlockHash:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -109,6 +197,30 @@ func (pr *ProofRequest) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.ProverRequestID = value.String
 			}
+		case proofrequest.FieldIdempotencyKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idempotency_key", values[i])
+			} else if value.Valid {
+				pr.IdempotencyKey = value.String
+			}
+		case proofrequest.FieldExternalRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_ref", values[i])
+			} else if value.Valid {
+				pr.ExternalRef = value.String
+			}
+		case proofrequest.FieldWitnessArtifactID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field witness_artifact_id", values[i])
+			} else if value.Valid {
+				pr.WitnessArtifactID = value.String
+			}
+		case proofrequest.FieldAggRequestID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field agg_request_id", values[i])
+			} else if value.Valid {
+				pr.AggRequestID = int(value.Int64)
+			}
 		case proofrequest.FieldProofRequestTime:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field proof_request_time", values[i])
@@ -121,6 +233,54 @@ func (pr *ProofRequest) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.LastUpdatedTime = uint64(value.Int64)
 			}
+		case proofrequest.FieldProofTimeout:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field proof_timeout", values[i])
+			} else if value.Valid {
+				pr.ProofTimeout = uint64(value.Int64)
+			}
+		case proofrequest.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				pr.Attempts = uint64(value.Int64)
+			}
+		case proofrequest.FieldNotBefore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field not_before", values[i])
+			} else if value.Valid {
+				pr.NotBefore = uint64(value.Int64)
+			}
+		case proofrequest.FieldCycles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cycles", values[i])
+			} else if value.Valid {
+				pr.Cycles = uint64(value.Int64)
+			}
+		case proofrequest.FieldFulfilledTime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fulfilled_time", values[i])
+			} else if value.Valid {
+				pr.FulfilledTime = uint64(value.Int64)
+			}
+		case proofrequest.FieldSubmissionReverts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field submission_reverts", values[i])
+			} else if value.Valid {
+				pr.SubmissionReverts = uint64(value.Int64)
+			}
+		case proofrequest.FieldSubmissionResends:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field submission_resends", values[i])
+			} else if value.Valid {
+				pr.SubmissionResends = uint64(value.Int64)
+			}
+		case proofrequest.FieldSubmissionNonceResyncs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field submission_nonce_resyncs", values[i])
+			} else if value.Valid {
+				pr.SubmissionNonceResyncs = uint64(value.Int64)
+			}
 		case proofrequest.FieldL1BlockNumber:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field l1_block_number", values[i])
@@ -133,12 +293,102 @@ func (pr *ProofRequest) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.L1BlockHash = value.String
 			}
+		case proofrequest.FieldSatisfiedByTx:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field satisfied_by_tx", values[i])
+			} else if value.Valid {
+				pr.SatisfiedByTx = value.String
+			}
 		case proofrequest.FieldProof:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field proof", values[i])
 			} else if value != nil {
 				pr.Proof = *value
 			}
+		case proofrequest.FieldStorageTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_tier", values[i])
+			} else if value.Valid {
+				pr.StorageTier = proofrequest.StorageTier(value.String)
+			}
+		case proofrequest.FieldColdStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cold_storage_key", values[i])
+			} else if value.Valid {
+				pr.ColdStorageKey = value.String
+			}
+		case proofrequest.FieldProofRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field proof_ref", values[i])
+			} else if value.Valid {
+				pr.ProofRef = value.String
+			}
+		case proofrequest.FieldRetrievalStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field retrieval_status", values[i])
+			} else if value.Valid {
+				pr.RetrievalStatus = proofrequest.RetrievalStatus(value.String)
+			}
+		case proofrequest.FieldIpfsCid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ipfs_cid", values[i])
+			} else if value.Valid {
+				pr.IpfsCid = value.String
+			}
+		case proofrequest.FieldProverBackend:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prover_backend", values[i])
+			} else if value.Valid {
+				pr.ProverBackend = value.String
+			}
+		case proofrequest.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				pr.ErrorMessage = value.String
+			}
+		case proofrequest.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				pr.CreatedBy = value.String
+			}
+		case proofrequest.FieldRequestedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by", values[i])
+			} else if value.Valid {
+				pr.RequestedBy = value.String
+			}
+		case proofrequest.FieldCompletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_by", values[i])
+			} else if value.Valid {
+				pr.CompletedBy = value.String
+			}
+		case proofrequest.FieldFee:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fee", values[i])
+			} else if value.Valid {
+				pr.Fee = value.String
+			}
+		case proofrequest.FieldFulfiller:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fulfiller", values[i])
+			} else if value.Valid {
+				pr.Fulfiller = value.String
+			}
+		case proofrequest.FieldDowntimeCause:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field downtime_cause", values[i])
+			} else if value.Valid {
+				pr.DowntimeCause = value.String
+			}
+		case proofrequest.FieldL2BlockHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field l2_block_hash", values[i])
+			} else if value.Valid {
+				pr.L2BlockHash = value.String
+			}
 		default:
 			pr.selectValues.Set(columns[i], values[i])
 		}
@@ -152,6 +402,16 @@ func (pr *ProofRequest) Value(name string) (ent.Value, error) {
 	return pr.selectValues.Get(name)
 }
 
+// QueryAgg queries the "agg" edge of the ProofRequest entity.
+func (pr *ProofRequest) QueryAgg() *ProofRequestQuery {
+	return NewProofRequestClient(pr.config).QueryAgg(pr)
+}
+
+// QuerySpans queries the "spans" edge of the ProofRequest entity.
+func (pr *ProofRequest) QuerySpans() *ProofRequestQuery {
+	return NewProofRequestClient(pr.config).QuerySpans(pr)
+}
+
 // Update returns a builder for updating this ProofRequest.
 // Note that you need to call ProofRequest.Unwrap() before calling this method if this ProofRequest
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -193,20 +453,101 @@ func (pr *ProofRequest) String() string {
 	builder.WriteString("prover_request_id=")
 	builder.WriteString(pr.ProverRequestID)
 	builder.WriteString(", ")
+	builder.WriteString("idempotency_key=")
+	builder.WriteString(pr.IdempotencyKey)
+	builder.WriteString(", ")
+	builder.WriteString("external_ref=")
+	builder.WriteString(pr.ExternalRef)
+	builder.WriteString(", ")
+	builder.WriteString("witness_artifact_id=")
+	builder.WriteString(pr.WitnessArtifactID)
+	builder.WriteString(", ")
+	builder.WriteString("agg_request_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.AggRequestID))
+	builder.WriteString(", ")
 	builder.WriteString("proof_request_time=")
 	builder.WriteString(fmt.Sprintf("%v", pr.ProofRequestTime))
 	builder.WriteString(", ")
 	builder.WriteString("last_updated_time=")
 	builder.WriteString(fmt.Sprintf("%v", pr.LastUpdatedTime))
 	builder.WriteString(", ")
+	builder.WriteString("proof_timeout=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ProofTimeout))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("not_before=")
+	builder.WriteString(fmt.Sprintf("%v", pr.NotBefore))
+	builder.WriteString(", ")
+	builder.WriteString("cycles=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Cycles))
+	builder.WriteString(", ")
+	builder.WriteString("fulfilled_time=")
+	builder.WriteString(fmt.Sprintf("%v", pr.FulfilledTime))
+	builder.WriteString(", ")
+	builder.WriteString("submission_reverts=")
+	builder.WriteString(fmt.Sprintf("%v", pr.SubmissionReverts))
+	builder.WriteString(", ")
+	builder.WriteString("submission_resends=")
+	builder.WriteString(fmt.Sprintf("%v", pr.SubmissionResends))
+	builder.WriteString(", ")
+	builder.WriteString("submission_nonce_resyncs=")
+	builder.WriteString(fmt.Sprintf("%v", pr.SubmissionNonceResyncs))
+	builder.WriteString(", ")
 	builder.WriteString("l1_block_number=")
 	builder.WriteString(fmt.Sprintf("%v", pr.L1BlockNumber))
 	builder.WriteString(", ")
 	builder.WriteString("l1_block_hash=")
 	builder.WriteString(pr.L1BlockHash)
 	builder.WriteString(", ")
+	builder.WriteString("satisfied_by_tx=")
+	builder.WriteString(pr.SatisfiedByTx)
+	builder.WriteString(", ")
 	builder.WriteString("proof=")
 	builder.WriteString(fmt.Sprintf("%v", pr.Proof))
+	builder.WriteString(", ")
+	builder.WriteString("storage_tier=")
+	builder.WriteString(fmt.Sprintf("%v", pr.StorageTier))
+	builder.WriteString(", ")
+	builder.WriteString("cold_storage_key=")
+	builder.WriteString(pr.ColdStorageKey)
+	builder.WriteString(", ")
+	builder.WriteString("proof_ref=")
+	builder.WriteString(pr.ProofRef)
+	builder.WriteString(", ")
+	builder.WriteString("retrieval_status=")
+	builder.WriteString(fmt.Sprintf("%v", pr.RetrievalStatus))
+	builder.WriteString(", ")
+	builder.WriteString("ipfs_cid=")
+	builder.WriteString(pr.IpfsCid)
+	builder.WriteString(", ")
+	builder.WriteString("prover_backend=")
+	builder.WriteString(pr.ProverBackend)
+	builder.WriteString(", ")
+	builder.WriteString("error_message=")
+	builder.WriteString(pr.ErrorMessage)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(pr.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("requested_by=")
+	builder.WriteString(pr.RequestedBy)
+	builder.WriteString(", ")
+	builder.WriteString("completed_by=")
+	builder.WriteString(pr.CompletedBy)
+	builder.WriteString(", ")
+	builder.WriteString("fee=")
+	builder.WriteString(pr.Fee)
+	builder.WriteString(", ")
+	builder.WriteString("fulfiller=")
+	builder.WriteString(pr.Fulfiller)
+	builder.WriteString(", ")
+	builder.WriteString("downtime_cause=")
+	builder.WriteString(pr.DowntimeCause)
+	builder.WriteString(", ")
+	builder.WriteString("l2_block_hash=")
+	builder.WriteString(pr.L2BlockHash)
 	builder.WriteByte(')')
 	return builder.String()
 }
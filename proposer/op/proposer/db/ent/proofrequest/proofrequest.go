@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -25,18 +26,84 @@ const (
 	FieldRequestAddedTime = "request_added_time"
 	// FieldProverRequestID holds the string denoting the prover_request_id field in the database.
 	FieldProverRequestID = "prover_request_id"
+	// FieldIdempotencyKey holds the string denoting the idempotency_key field in the database.
+	FieldIdempotencyKey = "idempotency_key"
+	// FieldExternalRef holds the string denoting the external_ref field in the database.
+	FieldExternalRef = "external_ref"
+	// FieldWitnessArtifactID holds the string denoting the witness_artifact_id field in the database.
+	FieldWitnessArtifactID = "witness_artifact_id"
+	// FieldAggRequestID holds the string denoting the agg_request_id field in the database.
+	FieldAggRequestID = "agg_request_id"
 	// FieldProofRequestTime holds the string denoting the proof_request_time field in the database.
 	FieldProofRequestTime = "proof_request_time"
 	// FieldLastUpdatedTime holds the string denoting the last_updated_time field in the database.
 	FieldLastUpdatedTime = "last_updated_time"
+	// FieldProofTimeout holds the string denoting the proof_timeout field in the database.
+	FieldProofTimeout = "proof_timeout"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldNotBefore holds the string denoting the not_before field in the database.
+	FieldNotBefore = "not_before"
+	// FieldCycles holds the string denoting the cycles field in the database.
+	FieldCycles = "cycles"
+	// FieldFulfilledTime holds the string denoting the fulfilled_time field in the database.
+	FieldFulfilledTime = "fulfilled_time"
+	// FieldSubmissionReverts holds the string denoting the submission_reverts field in the database.
+	FieldSubmissionReverts = "submission_reverts"
+	// FieldSubmissionResends holds the string denoting the submission_resends field in the database.
+	FieldSubmissionResends = "submission_resends"
+	// FieldSubmissionNonceResyncs holds the string denoting the submission_nonce_resyncs field in the database.
+	FieldSubmissionNonceResyncs = "submission_nonce_resyncs"
 	// FieldL1BlockNumber holds the string denoting the l1_block_number field in the database.
 	FieldL1BlockNumber = "l1_block_number"
 	// FieldL1BlockHash holds the string denoting the l1_block_hash field in the database.
 	FieldL1BlockHash = "l1_block_hash"
+	// FieldSatisfiedByTx holds the string denoting the satisfied_by_tx field in the database.
+	FieldSatisfiedByTx = "satisfied_by_tx"
 	// FieldProof holds the string denoting the proof field in the database.
 	FieldProof = "proof"
+	// FieldStorageTier holds the string denoting the storage_tier field in the database.
+	FieldStorageTier = "storage_tier"
+	// FieldColdStorageKey holds the string denoting the cold_storage_key field in the database.
+	FieldColdStorageKey = "cold_storage_key"
+	// FieldProofRef holds the string denoting the proof_ref field in the database.
+	FieldProofRef = "proof_ref"
+	// FieldRetrievalStatus holds the string denoting the retrieval_status field in the database.
+	FieldRetrievalStatus = "retrieval_status"
+	// FieldIpfsCid holds the string denoting the ipfs_cid field in the database.
+	FieldIpfsCid = "ipfs_cid"
+	// FieldProverBackend holds the string denoting the prover_backend field in the database.
+	FieldProverBackend = "prover_backend"
+	// FieldErrorMessage holds the string denoting the error_message field in the database.
+	FieldErrorMessage = "error_message"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldRequestedBy holds the string denoting the requested_by field in the database.
+	FieldRequestedBy = "requested_by"
+	// FieldCompletedBy holds the string denoting the completed_by field in the database.
+	FieldCompletedBy = "completed_by"
+	// FieldFee holds the string denoting the fee field in the database.
+	FieldFee = "fee"
+	// FieldFulfiller holds the string denoting the fulfiller field in the database.
+	FieldFulfiller = "fulfiller"
+	// FieldDowntimeCause holds the string denoting the downtime_cause field in the database.
+	FieldDowntimeCause = "downtime_cause"
+	// FieldL2BlockHash holds the string denoting the l2_block_hash field in the database.
+	FieldL2BlockHash = "l2_block_hash"
+	// EdgeAgg holds the string denoting the agg edge name in mutations.
+	EdgeAgg = "agg"
+	// EdgeSpans holds the string denoting the spans edge name in mutations.
+	EdgeSpans = "spans"
 	// Table holds the table name of the proofrequest in the database.
 	Table = "proof_requests"
+	// AggTable is the table that holds the agg relation/edge.
+	AggTable = "proof_requests"
+	// AggColumn is the table column denoting the agg relation/edge.
+	AggColumn = "agg_request_id"
+	// SpansTable is the table that holds the spans relation/edge.
+	SpansTable = "proof_requests"
+	// SpansColumn is the table column denoting the spans relation/edge.
+	SpansColumn = "agg_request_id"
 )
 
 // Columns holds all SQL columns for proofrequest fields.
@@ -48,11 +115,38 @@ var Columns = []string{
 	FieldStatus,
 	FieldRequestAddedTime,
 	FieldProverRequestID,
+	FieldIdempotencyKey,
+	FieldExternalRef,
+	FieldWitnessArtifactID,
+	FieldAggRequestID,
 	FieldProofRequestTime,
 	FieldLastUpdatedTime,
+	FieldProofTimeout,
+	FieldAttempts,
+	FieldNotBefore,
+	FieldCycles,
+	FieldFulfilledTime,
+	FieldSubmissionReverts,
+	FieldSubmissionResends,
+	FieldSubmissionNonceResyncs,
 	FieldL1BlockNumber,
 	FieldL1BlockHash,
+	FieldSatisfiedByTx,
 	FieldProof,
+	FieldStorageTier,
+	FieldColdStorageKey,
+	FieldProofRef,
+	FieldRetrievalStatus,
+	FieldIpfsCid,
+	FieldProverBackend,
+	FieldErrorMessage,
+	FieldCreatedBy,
+	FieldRequestedBy,
+	FieldCompletedBy,
+	FieldFee,
+	FieldFulfiller,
+	FieldDowntimeCause,
+	FieldL2BlockHash,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -98,6 +192,8 @@ const (
 	StatusPROVING    Status = "PROVING"
 	StatusFAILED     Status = "FAILED"
 	StatusCOMPLETE   Status = "COMPLETE"
+	StatusDEADLETTER Status = "DEADLETTER"
+	StatusBLOCKED    Status = "BLOCKED"
 )
 
 func (s Status) String() string {
@@ -107,13 +203,66 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusUNREQ, StatusWITNESSGEN, StatusPROVING, StatusFAILED, StatusCOMPLETE:
+	case StatusUNREQ, StatusWITNESSGEN, StatusPROVING, StatusFAILED, StatusCOMPLETE, StatusDEADLETTER, StatusBLOCKED:
 		return nil
 	default:
 		return fmt.Errorf("proofrequest: invalid enum value for status field: %q", s)
 	}
 }
 
+// StorageTier defines the type for the "storage_tier" enum field.
+type StorageTier string
+
+// StorageTierHOT is the default value of the StorageTier enum.
+const DefaultStorageTier = StorageTierHOT
+
+// StorageTier values.
+const (
+	StorageTierHOT  StorageTier = "HOT"
+	StorageTierCOLD StorageTier = "COLD"
+)
+
+func (st StorageTier) String() string {
+	return string(st)
+}
+
+// StorageTierValidator is a validator for the "storage_tier" field enum values. It is called by the builders before save.
+func StorageTierValidator(st StorageTier) error {
+	switch st {
+	case StorageTierHOT, StorageTierCOLD:
+		return nil
+	default:
+		return fmt.Errorf("proofrequest: invalid enum value for storage_tier field: %q", st)
+	}
+}
+
+// RetrievalStatus defines the type for the "retrieval_status" enum field.
+type RetrievalStatus string
+
+// RetrievalStatusNONE is the default value of the RetrievalStatus enum.
+const DefaultRetrievalStatus = RetrievalStatusNONE
+
+// RetrievalStatus values.
+const (
+	RetrievalStatusNONE     RetrievalStatus = "NONE"
+	RetrievalStatusPENDING  RetrievalStatus = "PENDING"
+	RetrievalStatusRESTORED RetrievalStatus = "RESTORED"
+)
+
+func (rs RetrievalStatus) String() string {
+	return string(rs)
+}
+
+// RetrievalStatusValidator is a validator for the "retrieval_status" field enum values. It is called by the builders before save.
+func RetrievalStatusValidator(rs RetrievalStatus) error {
+	switch rs {
+	case RetrievalStatusNONE, RetrievalStatusPENDING, RetrievalStatusRESTORED:
+		return nil
+	default:
+		return fmt.Errorf("proofrequest: invalid enum value for retrieval_status field: %q", rs)
+	}
+}
+
 // OrderOption defines the ordering options for the ProofRequest queries.
 type OrderOption func(*sql.Selector)
 
@@ -152,6 +301,26 @@ func ByProverRequestID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProverRequestID, opts...).ToFunc()
 }
 
+// ByIdempotencyKey orders the results by the idempotency_key field.
+func ByIdempotencyKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdempotencyKey, opts...).ToFunc()
+}
+
+// ByExternalRef orders the results by the external_ref field.
+func ByExternalRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalRef, opts...).ToFunc()
+}
+
+// ByWitnessArtifactID orders the results by the witness_artifact_id field.
+func ByWitnessArtifactID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWitnessArtifactID, opts...).ToFunc()
+}
+
+// ByAggRequestID orders the results by the agg_request_id field.
+func ByAggRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAggRequestID, opts...).ToFunc()
+}
+
 // ByProofRequestTime orders the results by the proof_request_time field.
 func ByProofRequestTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProofRequestTime, opts...).ToFunc()
@@ -162,6 +331,46 @@ func ByLastUpdatedTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastUpdatedTime, opts...).ToFunc()
 }
 
+// ByProofTimeout orders the results by the proof_timeout field.
+func ByProofTimeout(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProofTimeout, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByNotBefore orders the results by the not_before field.
+func ByNotBefore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotBefore, opts...).ToFunc()
+}
+
+// ByCycles orders the results by the cycles field.
+func ByCycles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCycles, opts...).ToFunc()
+}
+
+// ByFulfilledTime orders the results by the fulfilled_time field.
+func ByFulfilledTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFulfilledTime, opts...).ToFunc()
+}
+
+// BySubmissionReverts orders the results by the submission_reverts field.
+func BySubmissionReverts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmissionReverts, opts...).ToFunc()
+}
+
+// BySubmissionResends orders the results by the submission_resends field.
+func BySubmissionResends(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmissionResends, opts...).ToFunc()
+}
+
+// BySubmissionNonceResyncs orders the results by the submission_nonce_resyncs field.
+func BySubmissionNonceResyncs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmissionNonceResyncs, opts...).ToFunc()
+}
+
 // ByL1BlockNumber orders the results by the l1_block_number field.
 func ByL1BlockNumber(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldL1BlockNumber, opts...).ToFunc()
@@ -171,3 +380,113 @@ func ByL1BlockNumber(opts ...sql.OrderTermOption) OrderOption {
 func ByL1BlockHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldL1BlockHash, opts...).ToFunc()
 }
+
+// BySatisfiedByTx orders the results by the satisfied_by_tx field.
+func BySatisfiedByTx(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSatisfiedByTx, opts...).ToFunc()
+}
+
+// ByStorageTier orders the results by the storage_tier field.
+func ByStorageTier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageTier, opts...).ToFunc()
+}
+
+// ByColdStorageKey orders the results by the cold_storage_key field.
+func ByColdStorageKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColdStorageKey, opts...).ToFunc()
+}
+
+// ByProofRef orders the results by the proof_ref field.
+func ByProofRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProofRef, opts...).ToFunc()
+}
+
+// ByRetrievalStatus orders the results by the retrieval_status field.
+func ByRetrievalStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetrievalStatus, opts...).ToFunc()
+}
+
+// ByIpfsCid orders the results by the ipfs_cid field.
+func ByIpfsCid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIpfsCid, opts...).ToFunc()
+}
+
+// ByProverBackend orders the results by the prover_backend field.
+func ByProverBackend(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProverBackend, opts...).ToFunc()
+}
+
+// ByErrorMessage orders the results by the error_message field.
+func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByRequestedBy orders the results by the requested_by field.
+func ByRequestedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestedBy, opts...).ToFunc()
+}
+
+// ByCompletedBy orders the results by the completed_by field.
+func ByCompletedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedBy, opts...).ToFunc()
+}
+
+// ByFee orders the results by the fee field.
+func ByFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFee, opts...).ToFunc()
+}
+
+// ByFulfiller orders the results by the fulfiller field.
+func ByFulfiller(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFulfiller, opts...).ToFunc()
+}
+
+// ByDowntimeCause orders the results by the downtime_cause field.
+func ByDowntimeCause(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDowntimeCause, opts...).ToFunc()
+}
+
+// ByL2BlockHash orders the results by the l2_block_hash field.
+func ByL2BlockHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldL2BlockHash, opts...).ToFunc()
+}
+
+// ByAggField orders the results by agg field.
+func ByAggField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAggStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySpansCount orders the results by spans count.
+func BySpansCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSpansStep(), opts...)
+	}
+}
+
+// BySpans orders the results by spans terms.
+func BySpans(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSpansStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newAggStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AggTable, AggColumn),
+	)
+}
+func newSpansStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SpansTable, SpansColumn),
+	)
+}
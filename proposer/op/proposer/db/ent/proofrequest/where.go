@@ -4,6 +4,7 @@ package proofrequest
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/predicate"
 )
 
@@ -72,6 +73,26 @@ func ProverRequestID(v string) predicate.ProofRequest {
 	return predicate.ProofRequest(sql.FieldEQ(FieldProverRequestID, v))
 }
 
+// IdempotencyKey applies equality check predicate on the "idempotency_key" field. It's identical to IdempotencyKeyEQ.
+func IdempotencyKey(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// ExternalRef applies equality check predicate on the "external_ref" field. It's identical to ExternalRefEQ.
+func ExternalRef(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldExternalRef, v))
+}
+
+// WitnessArtifactID applies equality check predicate on the "witness_artifact_id" field. It's identical to WitnessArtifactIDEQ.
+func WitnessArtifactID(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldWitnessArtifactID, v))
+}
+
+// AggRequestID applies equality check predicate on the "agg_request_id" field. It's identical to AggRequestIDEQ.
+func AggRequestID(v int) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldAggRequestID, v))
+}
+
 // ProofRequestTime applies equality check predicate on the "proof_request_time" field. It's identical to ProofRequestTimeEQ.
 func ProofRequestTime(v uint64) predicate.ProofRequest {
 	return predicate.ProofRequest(sql.FieldEQ(FieldProofRequestTime, v))
@@ -82,6 +103,46 @@ func LastUpdatedTime(v uint64) predicate.ProofRequest {
 	return predicate.ProofRequest(sql.FieldEQ(FieldLastUpdatedTime, v))
 }
 
+// ProofTimeout applies equality check predicate on the "proof_timeout" field. It's identical to ProofTimeoutEQ.
+func ProofTimeout(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldProofTimeout, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldAttempts, v))
+}
+
+// NotBefore applies equality check predicate on the "not_before" field. It's identical to NotBeforeEQ.
+func NotBefore(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldNotBefore, v))
+}
+
+// Cycles applies equality check predicate on the "cycles" field. It's identical to CyclesEQ.
+func Cycles(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldCycles, v))
+}
+
+// FulfilledTime applies equality check predicate on the "fulfilled_time" field. It's identical to FulfilledTimeEQ.
+func FulfilledTime(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFulfilledTime, v))
+}
+
+// SubmissionReverts applies equality check predicate on the "submission_reverts" field. It's identical to SubmissionRevertsEQ.
+func SubmissionReverts(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldSubmissionReverts, v))
+}
+
+// SubmissionResends applies equality check predicate on the "submission_resends" field. It's identical to SubmissionResendsEQ.
+func SubmissionResends(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldSubmissionResends, v))
+}
+
+// SubmissionNonceResyncs applies equality check predicate on the "submission_nonce_resyncs" field. It's identical to SubmissionNonceResyncsEQ.
+func SubmissionNonceResyncs(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldSubmissionNonceResyncs, v))
+}
+
 // L1BlockNumber applies equality check predicate on the "l1_block_number" field. It's identical to L1BlockNumberEQ.
 func L1BlockNumber(v uint64) predicate.ProofRequest {
 	return predicate.ProofRequest(sql.FieldEQ(FieldL1BlockNumber, v))
@@ -92,11 +153,76 @@ func L1BlockHash(v string) predicate.ProofRequest {
 	return predicate.ProofRequest(sql.FieldEQ(FieldL1BlockHash, v))
 }
 
+// SatisfiedByTx applies equality check predicate on the "satisfied_by_tx" field. It's identical to SatisfiedByTxEQ.
+func SatisfiedByTx(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldSatisfiedByTx, v))
+}
+
 // Proof applies equality check predicate on the "proof" field. It's identical to ProofEQ.
 func Proof(v []byte) predicate.ProofRequest {
 	return predicate.ProofRequest(sql.FieldEQ(FieldProof, v))
 }
 
+// ColdStorageKey applies equality check predicate on the "cold_storage_key" field. It's identical to ColdStorageKeyEQ.
+func ColdStorageKey(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldColdStorageKey, v))
+}
+
+// ProofRef applies equality check predicate on the "proof_ref" field. It's identical to ProofRefEQ.
+func ProofRef(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldProofRef, v))
+}
+
+// IpfsCid applies equality check predicate on the "ipfs_cid" field. It's identical to IpfsCidEQ.
+func IpfsCid(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldIpfsCid, v))
+}
+
+// ProverBackend applies equality check predicate on the "prover_backend" field. It's identical to ProverBackendEQ.
+func ProverBackend(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldProverBackend, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// RequestedBy applies equality check predicate on the "requested_by" field. It's identical to RequestedByEQ.
+func RequestedBy(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// CompletedBy applies equality check predicate on the "completed_by" field. It's identical to CompletedByEQ.
+func CompletedBy(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldCompletedBy, v))
+}
+
+// Fee applies equality check predicate on the "fee" field. It's identical to FeeEQ.
+func Fee(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFee, v))
+}
+
+// Fulfiller applies equality check predicate on the "fulfiller" field. It's identical to FulfillerEQ.
+func Fulfiller(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFulfiller, v))
+}
+
+// DowntimeCause applies equality check predicate on the "downtime_cause" field. It's identical to DowntimeCauseEQ.
+func DowntimeCause(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldDowntimeCause, v))
+}
+
+// L2BlockHash applies equality check predicate on the "l2_block_hash" field. It's identical to L2BlockHashEQ.
+func L2BlockHash(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldL2BlockHash, v))
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v Type) predicate.ProofRequest {
 	return predicate.ProofRequest(sql.FieldEQ(FieldType, v))
@@ -332,6 +458,261 @@ func ProverRequestIDContainsFold(v string) predicate.ProofRequest {
 	return predicate.ProofRequest(sql.FieldContainsFold(FieldProverRequestID, v))
 }
 
+// IdempotencyKeyEQ applies the EQ predicate on the "idempotency_key" field.
+func IdempotencyKeyEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyNEQ applies the NEQ predicate on the "idempotency_key" field.
+func IdempotencyKeyNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIn applies the In predicate on the "idempotency_key" field.
+func IdempotencyKeyIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyNotIn applies the NotIn predicate on the "idempotency_key" field.
+func IdempotencyKeyNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyGT applies the GT predicate on the "idempotency_key" field.
+func IdempotencyKeyGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyGTE applies the GTE predicate on the "idempotency_key" field.
+func IdempotencyKeyGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLT applies the LT predicate on the "idempotency_key" field.
+func IdempotencyKeyLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLTE applies the LTE predicate on the "idempotency_key" field.
+func IdempotencyKeyLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContains applies the Contains predicate on the "idempotency_key" field.
+func IdempotencyKeyContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasPrefix applies the HasPrefix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasSuffix applies the HasSuffix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIsNil applies the IsNil predicate on the "idempotency_key" field.
+func IdempotencyKeyIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyNotNil applies the NotNil predicate on the "idempotency_key" field.
+func IdempotencyKeyNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyEqualFold applies the EqualFold predicate on the "idempotency_key" field.
+func IdempotencyKeyEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContainsFold applies the ContainsFold predicate on the "idempotency_key" field.
+func IdempotencyKeyContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldIdempotencyKey, v))
+}
+
+// ExternalRefEQ applies the EQ predicate on the "external_ref" field.
+func ExternalRefEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldExternalRef, v))
+}
+
+// ExternalRefNEQ applies the NEQ predicate on the "external_ref" field.
+func ExternalRefNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldExternalRef, v))
+}
+
+// ExternalRefIn applies the In predicate on the "external_ref" field.
+func ExternalRefIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldExternalRef, vs...))
+}
+
+// ExternalRefNotIn applies the NotIn predicate on the "external_ref" field.
+func ExternalRefNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldExternalRef, vs...))
+}
+
+// ExternalRefGT applies the GT predicate on the "external_ref" field.
+func ExternalRefGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldExternalRef, v))
+}
+
+// ExternalRefGTE applies the GTE predicate on the "external_ref" field.
+func ExternalRefGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldExternalRef, v))
+}
+
+// ExternalRefLT applies the LT predicate on the "external_ref" field.
+func ExternalRefLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldExternalRef, v))
+}
+
+// ExternalRefLTE applies the LTE predicate on the "external_ref" field.
+func ExternalRefLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldExternalRef, v))
+}
+
+// ExternalRefContains applies the Contains predicate on the "external_ref" field.
+func ExternalRefContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldExternalRef, v))
+}
+
+// ExternalRefHasPrefix applies the HasPrefix predicate on the "external_ref" field.
+func ExternalRefHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldExternalRef, v))
+}
+
+// ExternalRefHasSuffix applies the HasSuffix predicate on the "external_ref" field.
+func ExternalRefHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldExternalRef, v))
+}
+
+// ExternalRefIsNil applies the IsNil predicate on the "external_ref" field.
+func ExternalRefIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldExternalRef))
+}
+
+// ExternalRefNotNil applies the NotNil predicate on the "external_ref" field.
+func ExternalRefNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldExternalRef))
+}
+
+// ExternalRefEqualFold applies the EqualFold predicate on the "external_ref" field.
+func ExternalRefEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldExternalRef, v))
+}
+
+// ExternalRefContainsFold applies the ContainsFold predicate on the "external_ref" field.
+func ExternalRefContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldExternalRef, v))
+}
+
+// WitnessArtifactIDEQ applies the EQ predicate on the "witness_artifact_id" field.
+func WitnessArtifactIDEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldWitnessArtifactID, v))
+}
+
+// WitnessArtifactIDNEQ applies the NEQ predicate on the "witness_artifact_id" field.
+func WitnessArtifactIDNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldWitnessArtifactID, v))
+}
+
+// WitnessArtifactIDIn applies the In predicate on the "witness_artifact_id" field.
+func WitnessArtifactIDIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldWitnessArtifactID, vs...))
+}
+
+// WitnessArtifactIDNotIn applies the NotIn predicate on the "witness_artifact_id" field.
+func WitnessArtifactIDNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldWitnessArtifactID, vs...))
+}
+
+// WitnessArtifactIDGT applies the GT predicate on the "witness_artifact_id" field.
+func WitnessArtifactIDGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldWitnessArtifactID, v))
+}
+
+// WitnessArtifactIDGTE applies the GTE predicate on the "witness_artifact_id" field.
+func WitnessArtifactIDGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldWitnessArtifactID, v))
+}
+
+// WitnessArtifactIDLT applies the LT predicate on the "witness_artifact_id" field.
+func WitnessArtifactIDLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldWitnessArtifactID, v))
+}
+
+// WitnessArtifactIDLTE applies the LTE predicate on the "witness_artifact_id" field.
+func WitnessArtifactIDLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldWitnessArtifactID, v))
+}
+
+// WitnessArtifactIDContains applies the Contains predicate on the "witness_artifact_id" field.
+func WitnessArtifactIDContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldWitnessArtifactID, v))
+}
+
+// WitnessArtifactIDHasPrefix applies the HasPrefix predicate on the "witness_artifact_id" field.
+func WitnessArtifactIDHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldWitnessArtifactID, v))
+}
+
+// WitnessArtifactIDHasSuffix applies the HasSuffix predicate on the "witness_artifact_id" field.
+func WitnessArtifactIDHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldWitnessArtifactID, v))
+}
+
+// WitnessArtifactIDIsNil applies the IsNil predicate on the "witness_artifact_id" field.
+func WitnessArtifactIDIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldWitnessArtifactID))
+}
+
+// WitnessArtifactIDNotNil applies the NotNil predicate on the "witness_artifact_id" field.
+func WitnessArtifactIDNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldWitnessArtifactID))
+}
+
+// WitnessArtifactIDEqualFold applies the EqualFold predicate on the "witness_artifact_id" field.
+func WitnessArtifactIDEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldWitnessArtifactID, v))
+}
+
+// WitnessArtifactIDContainsFold applies the ContainsFold predicate on the "witness_artifact_id" field.
+func WitnessArtifactIDContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldWitnessArtifactID, v))
+}
+
+// AggRequestIDEQ applies the EQ predicate on the "agg_request_id" field.
+func AggRequestIDEQ(v int) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldAggRequestID, v))
+}
+
+// AggRequestIDNEQ applies the NEQ predicate on the "agg_request_id" field.
+func AggRequestIDNEQ(v int) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldAggRequestID, v))
+}
+
+// AggRequestIDIn applies the In predicate on the "agg_request_id" field.
+func AggRequestIDIn(vs ...int) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldAggRequestID, vs...))
+}
+
+// AggRequestIDNotIn applies the NotIn predicate on the "agg_request_id" field.
+func AggRequestIDNotIn(vs ...int) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldAggRequestID, vs...))
+}
+
+// AggRequestIDIsNil applies the IsNil predicate on the "agg_request_id" field.
+func AggRequestIDIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldAggRequestID))
+}
+
+// AggRequestIDNotNil applies the NotNil predicate on the "agg_request_id" field.
+func AggRequestIDNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldAggRequestID))
+}
+
 // ProofRequestTimeEQ applies the EQ predicate on the "proof_request_time" field.
 func ProofRequestTimeEQ(v uint64) predicate.ProofRequest {
 	return predicate.ProofRequest(sql.FieldEQ(FieldProofRequestTime, v))
@@ -422,181 +803,1642 @@ func LastUpdatedTimeLTE(v uint64) predicate.ProofRequest {
 	return predicate.ProofRequest(sql.FieldLTE(FieldLastUpdatedTime, v))
 }
 
-// L1BlockNumberEQ applies the EQ predicate on the "l1_block_number" field.
-func L1BlockNumberEQ(v uint64) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldEQ(FieldL1BlockNumber, v))
+// ProofTimeoutEQ applies the EQ predicate on the "proof_timeout" field.
+func ProofTimeoutEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldProofTimeout, v))
 }
 
-// L1BlockNumberNEQ applies the NEQ predicate on the "l1_block_number" field.
-func L1BlockNumberNEQ(v uint64) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldNEQ(FieldL1BlockNumber, v))
+// ProofTimeoutNEQ applies the NEQ predicate on the "proof_timeout" field.
+func ProofTimeoutNEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldProofTimeout, v))
 }
 
-// L1BlockNumberIn applies the In predicate on the "l1_block_number" field.
-func L1BlockNumberIn(vs ...uint64) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldIn(FieldL1BlockNumber, vs...))
+// ProofTimeoutIn applies the In predicate on the "proof_timeout" field.
+func ProofTimeoutIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldProofTimeout, vs...))
 }
 
-// L1BlockNumberNotIn applies the NotIn predicate on the "l1_block_number" field.
-func L1BlockNumberNotIn(vs ...uint64) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldNotIn(FieldL1BlockNumber, vs...))
+// ProofTimeoutNotIn applies the NotIn predicate on the "proof_timeout" field.
+func ProofTimeoutNotIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldProofTimeout, vs...))
 }
 
-// L1BlockNumberGT applies the GT predicate on the "l1_block_number" field.
-func L1BlockNumberGT(v uint64) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldGT(FieldL1BlockNumber, v))
+// ProofTimeoutGT applies the GT predicate on the "proof_timeout" field.
+func ProofTimeoutGT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldProofTimeout, v))
 }
 
-// L1BlockNumberGTE applies the GTE predicate on the "l1_block_number" field.
-func L1BlockNumberGTE(v uint64) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldGTE(FieldL1BlockNumber, v))
+// ProofTimeoutGTE applies the GTE predicate on the "proof_timeout" field.
+func ProofTimeoutGTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldProofTimeout, v))
 }
 
-// L1BlockNumberLT applies the LT predicate on the "l1_block_number" field.
-func L1BlockNumberLT(v uint64) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldLT(FieldL1BlockNumber, v))
+// ProofTimeoutLT applies the LT predicate on the "proof_timeout" field.
+func ProofTimeoutLT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldProofTimeout, v))
 }
 
-// L1BlockNumberLTE applies the LTE predicate on the "l1_block_number" field.
-func L1BlockNumberLTE(v uint64) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldLTE(FieldL1BlockNumber, v))
+// ProofTimeoutLTE applies the LTE predicate on the "proof_timeout" field.
+func ProofTimeoutLTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldProofTimeout, v))
 }
 
-// L1BlockNumberIsNil applies the IsNil predicate on the "l1_block_number" field.
-func L1BlockNumberIsNil() predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldIsNull(FieldL1BlockNumber))
+// ProofTimeoutIsNil applies the IsNil predicate on the "proof_timeout" field.
+func ProofTimeoutIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldProofTimeout))
 }
 
-// L1BlockNumberNotNil applies the NotNil predicate on the "l1_block_number" field.
-func L1BlockNumberNotNil() predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldNotNull(FieldL1BlockNumber))
+// ProofTimeoutNotNil applies the NotNil predicate on the "proof_timeout" field.
+func ProofTimeoutNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldProofTimeout))
 }
 
-// L1BlockHashEQ applies the EQ predicate on the "l1_block_hash" field.
-func L1BlockHashEQ(v string) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldEQ(FieldL1BlockHash, v))
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldAttempts, v))
 }
 
-// L1BlockHashNEQ applies the NEQ predicate on the "l1_block_hash" field.
-func L1BlockHashNEQ(v string) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldNEQ(FieldL1BlockHash, v))
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldAttempts, v))
 }
 
-// L1BlockHashIn applies the In predicate on the "l1_block_hash" field.
-func L1BlockHashIn(vs ...string) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldIn(FieldL1BlockHash, vs...))
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldAttempts, vs...))
 }
 
-// L1BlockHashNotIn applies the NotIn predicate on the "l1_block_hash" field.
-func L1BlockHashNotIn(vs ...string) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldNotIn(FieldL1BlockHash, vs...))
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldAttempts, vs...))
 }
 
-// L1BlockHashGT applies the GT predicate on the "l1_block_hash" field.
-func L1BlockHashGT(v string) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldGT(FieldL1BlockHash, v))
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldAttempts, v))
 }
 
-// L1BlockHashGTE applies the GTE predicate on the "l1_block_hash" field.
-func L1BlockHashGTE(v string) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldGTE(FieldL1BlockHash, v))
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldAttempts, v))
 }
 
-// L1BlockHashLT applies the LT predicate on the "l1_block_hash" field.
-func L1BlockHashLT(v string) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldLT(FieldL1BlockHash, v))
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldAttempts, v))
 }
 
-// L1BlockHashLTE applies the LTE predicate on the "l1_block_hash" field.
-func L1BlockHashLTE(v string) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldLTE(FieldL1BlockHash, v))
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldAttempts, v))
 }
 
-// L1BlockHashContains applies the Contains predicate on the "l1_block_hash" field.
-func L1BlockHashContains(v string) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldContains(FieldL1BlockHash, v))
+// AttemptsIsNil applies the IsNil predicate on the "attempts" field.
+func AttemptsIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldAttempts))
 }
 
-// L1BlockHashHasPrefix applies the HasPrefix predicate on the "l1_block_hash" field.
-func L1BlockHashHasPrefix(v string) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldHasPrefix(FieldL1BlockHash, v))
+// AttemptsNotNil applies the NotNil predicate on the "attempts" field.
+func AttemptsNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldAttempts))
 }
 
-// L1BlockHashHasSuffix applies the HasSuffix predicate on the "l1_block_hash" field.
-func L1BlockHashHasSuffix(v string) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldHasSuffix(FieldL1BlockHash, v))
+// NotBeforeEQ applies the EQ predicate on the "not_before" field.
+func NotBeforeEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldNotBefore, v))
 }
 
-// L1BlockHashIsNil applies the IsNil predicate on the "l1_block_hash" field.
-func L1BlockHashIsNil() predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldIsNull(FieldL1BlockHash))
+// NotBeforeNEQ applies the NEQ predicate on the "not_before" field.
+func NotBeforeNEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldNotBefore, v))
 }
 
-// L1BlockHashNotNil applies the NotNil predicate on the "l1_block_hash" field.
-func L1BlockHashNotNil() predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldNotNull(FieldL1BlockHash))
+// NotBeforeIn applies the In predicate on the "not_before" field.
+func NotBeforeIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldNotBefore, vs...))
 }
 
-// L1BlockHashEqualFold applies the EqualFold predicate on the "l1_block_hash" field.
-func L1BlockHashEqualFold(v string) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldEqualFold(FieldL1BlockHash, v))
+// NotBeforeNotIn applies the NotIn predicate on the "not_before" field.
+func NotBeforeNotIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldNotBefore, vs...))
 }
 
-// L1BlockHashContainsFold applies the ContainsFold predicate on the "l1_block_hash" field.
-func L1BlockHashContainsFold(v string) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldContainsFold(FieldL1BlockHash, v))
+// NotBeforeGT applies the GT predicate on the "not_before" field.
+func NotBeforeGT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldNotBefore, v))
 }
 
-// ProofEQ applies the EQ predicate on the "proof" field.
-func ProofEQ(v []byte) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldEQ(FieldProof, v))
+// NotBeforeGTE applies the GTE predicate on the "not_before" field.
+func NotBeforeGTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldNotBefore, v))
 }
 
-// ProofNEQ applies the NEQ predicate on the "proof" field.
-func ProofNEQ(v []byte) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldNEQ(FieldProof, v))
+// NotBeforeLT applies the LT predicate on the "not_before" field.
+func NotBeforeLT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldNotBefore, v))
 }
 
-// ProofIn applies the In predicate on the "proof" field.
-func ProofIn(vs ...[]byte) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldIn(FieldProof, vs...))
+// NotBeforeLTE applies the LTE predicate on the "not_before" field.
+func NotBeforeLTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldNotBefore, v))
 }
 
-// ProofNotIn applies the NotIn predicate on the "proof" field.
-func ProofNotIn(vs ...[]byte) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldNotIn(FieldProof, vs...))
+// NotBeforeIsNil applies the IsNil predicate on the "not_before" field.
+func NotBeforeIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldNotBefore))
 }
 
-// ProofGT applies the GT predicate on the "proof" field.
-func ProofGT(v []byte) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldGT(FieldProof, v))
+// NotBeforeNotNil applies the NotNil predicate on the "not_before" field.
+func NotBeforeNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldNotBefore))
 }
 
-// ProofGTE applies the GTE predicate on the "proof" field.
-func ProofGTE(v []byte) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldGTE(FieldProof, v))
+// CyclesEQ applies the EQ predicate on the "cycles" field.
+func CyclesEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldCycles, v))
 }
 
-// ProofLT applies the LT predicate on the "proof" field.
-func ProofLT(v []byte) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldLT(FieldProof, v))
+// CyclesNEQ applies the NEQ predicate on the "cycles" field.
+func CyclesNEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldCycles, v))
 }
 
-// ProofLTE applies the LTE predicate on the "proof" field.
-func ProofLTE(v []byte) predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldLTE(FieldProof, v))
+// CyclesIn applies the In predicate on the "cycles" field.
+func CyclesIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldCycles, vs...))
 }
 
-// ProofIsNil applies the IsNil predicate on the "proof" field.
-func ProofIsNil() predicate.ProofRequest {
-	return predicate.ProofRequest(sql.FieldIsNull(FieldProof))
+// CyclesNotIn applies the NotIn predicate on the "cycles" field.
+func CyclesNotIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldCycles, vs...))
 }
 
-// ProofNotNil applies the NotNil predicate on the "proof" field.
-func ProofNotNil() predicate.ProofRequest {
+// CyclesGT applies the GT predicate on the "cycles" field.
+func CyclesGT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldCycles, v))
+}
+
+// CyclesGTE applies the GTE predicate on the "cycles" field.
+func CyclesGTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldCycles, v))
+}
+
+// CyclesLT applies the LT predicate on the "cycles" field.
+func CyclesLT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldCycles, v))
+}
+
+// CyclesLTE applies the LTE predicate on the "cycles" field.
+func CyclesLTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldCycles, v))
+}
+
+// CyclesIsNil applies the IsNil predicate on the "cycles" field.
+func CyclesIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldCycles))
+}
+
+// CyclesNotNil applies the NotNil predicate on the "cycles" field.
+func CyclesNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldCycles))
+}
+
+// FulfilledTimeEQ applies the EQ predicate on the "fulfilled_time" field.
+func FulfilledTimeEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFulfilledTime, v))
+}
+
+// FulfilledTimeNEQ applies the NEQ predicate on the "fulfilled_time" field.
+func FulfilledTimeNEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldFulfilledTime, v))
+}
+
+// FulfilledTimeIn applies the In predicate on the "fulfilled_time" field.
+func FulfilledTimeIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldFulfilledTime, vs...))
+}
+
+// FulfilledTimeNotIn applies the NotIn predicate on the "fulfilled_time" field.
+func FulfilledTimeNotIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldFulfilledTime, vs...))
+}
+
+// FulfilledTimeGT applies the GT predicate on the "fulfilled_time" field.
+func FulfilledTimeGT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldFulfilledTime, v))
+}
+
+// FulfilledTimeGTE applies the GTE predicate on the "fulfilled_time" field.
+func FulfilledTimeGTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldFulfilledTime, v))
+}
+
+// FulfilledTimeLT applies the LT predicate on the "fulfilled_time" field.
+func FulfilledTimeLT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldFulfilledTime, v))
+}
+
+// FulfilledTimeLTE applies the LTE predicate on the "fulfilled_time" field.
+func FulfilledTimeLTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldFulfilledTime, v))
+}
+
+// FulfilledTimeIsNil applies the IsNil predicate on the "fulfilled_time" field.
+func FulfilledTimeIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldFulfilledTime))
+}
+
+// FulfilledTimeNotNil applies the NotNil predicate on the "fulfilled_time" field.
+func FulfilledTimeNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldFulfilledTime))
+}
+
+// SubmissionRevertsEQ applies the EQ predicate on the "submission_reverts" field.
+func SubmissionRevertsEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldSubmissionReverts, v))
+}
+
+// SubmissionRevertsNEQ applies the NEQ predicate on the "submission_reverts" field.
+func SubmissionRevertsNEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldSubmissionReverts, v))
+}
+
+// SubmissionRevertsIn applies the In predicate on the "submission_reverts" field.
+func SubmissionRevertsIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldSubmissionReverts, vs...))
+}
+
+// SubmissionRevertsNotIn applies the NotIn predicate on the "submission_reverts" field.
+func SubmissionRevertsNotIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldSubmissionReverts, vs...))
+}
+
+// SubmissionRevertsGT applies the GT predicate on the "submission_reverts" field.
+func SubmissionRevertsGT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldSubmissionReverts, v))
+}
+
+// SubmissionRevertsGTE applies the GTE predicate on the "submission_reverts" field.
+func SubmissionRevertsGTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldSubmissionReverts, v))
+}
+
+// SubmissionRevertsLT applies the LT predicate on the "submission_reverts" field.
+func SubmissionRevertsLT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldSubmissionReverts, v))
+}
+
+// SubmissionRevertsLTE applies the LTE predicate on the "submission_reverts" field.
+func SubmissionRevertsLTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldSubmissionReverts, v))
+}
+
+// SubmissionRevertsIsNil applies the IsNil predicate on the "submission_reverts" field.
+func SubmissionRevertsIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldSubmissionReverts))
+}
+
+// SubmissionRevertsNotNil applies the NotNil predicate on the "submission_reverts" field.
+func SubmissionRevertsNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldSubmissionReverts))
+}
+
+// SubmissionResendsEQ applies the EQ predicate on the "submission_resends" field.
+func SubmissionResendsEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldSubmissionResends, v))
+}
+
+// SubmissionResendsNEQ applies the NEQ predicate on the "submission_resends" field.
+func SubmissionResendsNEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldSubmissionResends, v))
+}
+
+// SubmissionResendsIn applies the In predicate on the "submission_resends" field.
+func SubmissionResendsIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldSubmissionResends, vs...))
+}
+
+// SubmissionResendsNotIn applies the NotIn predicate on the "submission_resends" field.
+func SubmissionResendsNotIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldSubmissionResends, vs...))
+}
+
+// SubmissionResendsGT applies the GT predicate on the "submission_resends" field.
+func SubmissionResendsGT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldSubmissionResends, v))
+}
+
+// SubmissionResendsGTE applies the GTE predicate on the "submission_resends" field.
+func SubmissionResendsGTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldSubmissionResends, v))
+}
+
+// SubmissionResendsLT applies the LT predicate on the "submission_resends" field.
+func SubmissionResendsLT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldSubmissionResends, v))
+}
+
+// SubmissionResendsLTE applies the LTE predicate on the "submission_resends" field.
+func SubmissionResendsLTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldSubmissionResends, v))
+}
+
+// SubmissionResendsIsNil applies the IsNil predicate on the "submission_resends" field.
+func SubmissionResendsIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldSubmissionResends))
+}
+
+// SubmissionResendsNotNil applies the NotNil predicate on the "submission_resends" field.
+func SubmissionResendsNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldSubmissionResends))
+}
+
+// SubmissionNonceResyncsEQ applies the EQ predicate on the "submission_nonce_resyncs" field.
+func SubmissionNonceResyncsEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldSubmissionNonceResyncs, v))
+}
+
+// SubmissionNonceResyncsNEQ applies the NEQ predicate on the "submission_nonce_resyncs" field.
+func SubmissionNonceResyncsNEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldSubmissionNonceResyncs, v))
+}
+
+// SubmissionNonceResyncsIn applies the In predicate on the "submission_nonce_resyncs" field.
+func SubmissionNonceResyncsIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldSubmissionNonceResyncs, vs...))
+}
+
+// SubmissionNonceResyncsNotIn applies the NotIn predicate on the "submission_nonce_resyncs" field.
+func SubmissionNonceResyncsNotIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldSubmissionNonceResyncs, vs...))
+}
+
+// SubmissionNonceResyncsGT applies the GT predicate on the "submission_nonce_resyncs" field.
+func SubmissionNonceResyncsGT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldSubmissionNonceResyncs, v))
+}
+
+// SubmissionNonceResyncsGTE applies the GTE predicate on the "submission_nonce_resyncs" field.
+func SubmissionNonceResyncsGTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldSubmissionNonceResyncs, v))
+}
+
+// SubmissionNonceResyncsLT applies the LT predicate on the "submission_nonce_resyncs" field.
+func SubmissionNonceResyncsLT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldSubmissionNonceResyncs, v))
+}
+
+// SubmissionNonceResyncsLTE applies the LTE predicate on the "submission_nonce_resyncs" field.
+func SubmissionNonceResyncsLTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldSubmissionNonceResyncs, v))
+}
+
+// SubmissionNonceResyncsIsNil applies the IsNil predicate on the "submission_nonce_resyncs" field.
+func SubmissionNonceResyncsIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldSubmissionNonceResyncs))
+}
+
+// SubmissionNonceResyncsNotNil applies the NotNil predicate on the "submission_nonce_resyncs" field.
+func SubmissionNonceResyncsNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldSubmissionNonceResyncs))
+}
+
+// L1BlockNumberEQ applies the EQ predicate on the "l1_block_number" field.
+func L1BlockNumberEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldL1BlockNumber, v))
+}
+
+// L1BlockNumberNEQ applies the NEQ predicate on the "l1_block_number" field.
+func L1BlockNumberNEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldL1BlockNumber, v))
+}
+
+// L1BlockNumberIn applies the In predicate on the "l1_block_number" field.
+func L1BlockNumberIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldL1BlockNumber, vs...))
+}
+
+// L1BlockNumberNotIn applies the NotIn predicate on the "l1_block_number" field.
+func L1BlockNumberNotIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldL1BlockNumber, vs...))
+}
+
+// L1BlockNumberGT applies the GT predicate on the "l1_block_number" field.
+func L1BlockNumberGT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldL1BlockNumber, v))
+}
+
+// L1BlockNumberGTE applies the GTE predicate on the "l1_block_number" field.
+func L1BlockNumberGTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldL1BlockNumber, v))
+}
+
+// L1BlockNumberLT applies the LT predicate on the "l1_block_number" field.
+func L1BlockNumberLT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldL1BlockNumber, v))
+}
+
+// L1BlockNumberLTE applies the LTE predicate on the "l1_block_number" field.
+func L1BlockNumberLTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldL1BlockNumber, v))
+}
+
+// L1BlockNumberIsNil applies the IsNil predicate on the "l1_block_number" field.
+func L1BlockNumberIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldL1BlockNumber))
+}
+
+// L1BlockNumberNotNil applies the NotNil predicate on the "l1_block_number" field.
+func L1BlockNumberNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldL1BlockNumber))
+}
+
+// L1BlockHashEQ applies the EQ predicate on the "l1_block_hash" field.
+func L1BlockHashEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldL1BlockHash, v))
+}
+
+// L1BlockHashNEQ applies the NEQ predicate on the "l1_block_hash" field.
+func L1BlockHashNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldL1BlockHash, v))
+}
+
+// L1BlockHashIn applies the In predicate on the "l1_block_hash" field.
+func L1BlockHashIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldL1BlockHash, vs...))
+}
+
+// L1BlockHashNotIn applies the NotIn predicate on the "l1_block_hash" field.
+func L1BlockHashNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldL1BlockHash, vs...))
+}
+
+// L1BlockHashGT applies the GT predicate on the "l1_block_hash" field.
+func L1BlockHashGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldL1BlockHash, v))
+}
+
+// L1BlockHashGTE applies the GTE predicate on the "l1_block_hash" field.
+func L1BlockHashGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldL1BlockHash, v))
+}
+
+// L1BlockHashLT applies the LT predicate on the "l1_block_hash" field.
+func L1BlockHashLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldL1BlockHash, v))
+}
+
+// L1BlockHashLTE applies the LTE predicate on the "l1_block_hash" field.
+func L1BlockHashLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldL1BlockHash, v))
+}
+
+// L1BlockHashContains applies the Contains predicate on the "l1_block_hash" field.
+func L1BlockHashContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldL1BlockHash, v))
+}
+
+// L1BlockHashHasPrefix applies the HasPrefix predicate on the "l1_block_hash" field.
+func L1BlockHashHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldL1BlockHash, v))
+}
+
+// L1BlockHashHasSuffix applies the HasSuffix predicate on the "l1_block_hash" field.
+func L1BlockHashHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldL1BlockHash, v))
+}
+
+// L1BlockHashIsNil applies the IsNil predicate on the "l1_block_hash" field.
+func L1BlockHashIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldL1BlockHash))
+}
+
+// L1BlockHashNotNil applies the NotNil predicate on the "l1_block_hash" field.
+func L1BlockHashNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldL1BlockHash))
+}
+
+// L1BlockHashEqualFold applies the EqualFold predicate on the "l1_block_hash" field.
+func L1BlockHashEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldL1BlockHash, v))
+}
+
+// L1BlockHashContainsFold applies the ContainsFold predicate on the "l1_block_hash" field.
+func L1BlockHashContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldL1BlockHash, v))
+}
+
+// SatisfiedByTxEQ applies the EQ predicate on the "satisfied_by_tx" field.
+func SatisfiedByTxEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldSatisfiedByTx, v))
+}
+
+// SatisfiedByTxNEQ applies the NEQ predicate on the "satisfied_by_tx" field.
+func SatisfiedByTxNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldSatisfiedByTx, v))
+}
+
+// SatisfiedByTxIn applies the In predicate on the "satisfied_by_tx" field.
+func SatisfiedByTxIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldSatisfiedByTx, vs...))
+}
+
+// SatisfiedByTxNotIn applies the NotIn predicate on the "satisfied_by_tx" field.
+func SatisfiedByTxNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldSatisfiedByTx, vs...))
+}
+
+// SatisfiedByTxGT applies the GT predicate on the "satisfied_by_tx" field.
+func SatisfiedByTxGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldSatisfiedByTx, v))
+}
+
+// SatisfiedByTxGTE applies the GTE predicate on the "satisfied_by_tx" field.
+func SatisfiedByTxGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldSatisfiedByTx, v))
+}
+
+// SatisfiedByTxLT applies the LT predicate on the "satisfied_by_tx" field.
+func SatisfiedByTxLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldSatisfiedByTx, v))
+}
+
+// SatisfiedByTxLTE applies the LTE predicate on the "satisfied_by_tx" field.
+func SatisfiedByTxLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldSatisfiedByTx, v))
+}
+
+// SatisfiedByTxContains applies the Contains predicate on the "satisfied_by_tx" field.
+func SatisfiedByTxContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldSatisfiedByTx, v))
+}
+
+// SatisfiedByTxHasPrefix applies the HasPrefix predicate on the "satisfied_by_tx" field.
+func SatisfiedByTxHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldSatisfiedByTx, v))
+}
+
+// SatisfiedByTxHasSuffix applies the HasSuffix predicate on the "satisfied_by_tx" field.
+func SatisfiedByTxHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldSatisfiedByTx, v))
+}
+
+// SatisfiedByTxIsNil applies the IsNil predicate on the "satisfied_by_tx" field.
+func SatisfiedByTxIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldSatisfiedByTx))
+}
+
+// SatisfiedByTxNotNil applies the NotNil predicate on the "satisfied_by_tx" field.
+func SatisfiedByTxNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldSatisfiedByTx))
+}
+
+// SatisfiedByTxEqualFold applies the EqualFold predicate on the "satisfied_by_tx" field.
+func SatisfiedByTxEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldSatisfiedByTx, v))
+}
+
+// SatisfiedByTxContainsFold applies the ContainsFold predicate on the "satisfied_by_tx" field.
+func SatisfiedByTxContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldSatisfiedByTx, v))
+}
+
+// ProofEQ applies the EQ predicate on the "proof" field.
+func ProofEQ(v []byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldProof, v))
+}
+
+// ProofNEQ applies the NEQ predicate on the "proof" field.
+func ProofNEQ(v []byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldProof, v))
+}
+
+// ProofIn applies the In predicate on the "proof" field.
+func ProofIn(vs ...[]byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldProof, vs...))
+}
+
+// ProofNotIn applies the NotIn predicate on the "proof" field.
+func ProofNotIn(vs ...[]byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldProof, vs...))
+}
+
+// ProofGT applies the GT predicate on the "proof" field.
+func ProofGT(v []byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldProof, v))
+}
+
+// ProofGTE applies the GTE predicate on the "proof" field.
+func ProofGTE(v []byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldProof, v))
+}
+
+// ProofLT applies the LT predicate on the "proof" field.
+func ProofLT(v []byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldProof, v))
+}
+
+// ProofLTE applies the LTE predicate on the "proof" field.
+func ProofLTE(v []byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldProof, v))
+}
+
+// ProofIsNil applies the IsNil predicate on the "proof" field.
+func ProofIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldProof))
+}
+
+// ProofNotNil applies the NotNil predicate on the "proof" field.
+func ProofNotNil() predicate.ProofRequest {
 	return predicate.ProofRequest(sql.FieldNotNull(FieldProof))
 }
 
+// StorageTierEQ applies the EQ predicate on the "storage_tier" field.
+func StorageTierEQ(v StorageTier) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldStorageTier, v))
+}
+
+// StorageTierNEQ applies the NEQ predicate on the "storage_tier" field.
+func StorageTierNEQ(v StorageTier) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldStorageTier, v))
+}
+
+// StorageTierIn applies the In predicate on the "storage_tier" field.
+func StorageTierIn(vs ...StorageTier) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldStorageTier, vs...))
+}
+
+// StorageTierNotIn applies the NotIn predicate on the "storage_tier" field.
+func StorageTierNotIn(vs ...StorageTier) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldStorageTier, vs...))
+}
+
+// ColdStorageKeyEQ applies the EQ predicate on the "cold_storage_key" field.
+func ColdStorageKeyEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldColdStorageKey, v))
+}
+
+// ColdStorageKeyNEQ applies the NEQ predicate on the "cold_storage_key" field.
+func ColdStorageKeyNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldColdStorageKey, v))
+}
+
+// ColdStorageKeyIn applies the In predicate on the "cold_storage_key" field.
+func ColdStorageKeyIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldColdStorageKey, vs...))
+}
+
+// ColdStorageKeyNotIn applies the NotIn predicate on the "cold_storage_key" field.
+func ColdStorageKeyNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldColdStorageKey, vs...))
+}
+
+// ColdStorageKeyGT applies the GT predicate on the "cold_storage_key" field.
+func ColdStorageKeyGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldColdStorageKey, v))
+}
+
+// ColdStorageKeyGTE applies the GTE predicate on the "cold_storage_key" field.
+func ColdStorageKeyGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldColdStorageKey, v))
+}
+
+// ColdStorageKeyLT applies the LT predicate on the "cold_storage_key" field.
+func ColdStorageKeyLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldColdStorageKey, v))
+}
+
+// ColdStorageKeyLTE applies the LTE predicate on the "cold_storage_key" field.
+func ColdStorageKeyLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldColdStorageKey, v))
+}
+
+// ColdStorageKeyContains applies the Contains predicate on the "cold_storage_key" field.
+func ColdStorageKeyContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldColdStorageKey, v))
+}
+
+// ColdStorageKeyHasPrefix applies the HasPrefix predicate on the "cold_storage_key" field.
+func ColdStorageKeyHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldColdStorageKey, v))
+}
+
+// ColdStorageKeyHasSuffix applies the HasSuffix predicate on the "cold_storage_key" field.
+func ColdStorageKeyHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldColdStorageKey, v))
+}
+
+// ColdStorageKeyIsNil applies the IsNil predicate on the "cold_storage_key" field.
+func ColdStorageKeyIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldColdStorageKey))
+}
+
+// ColdStorageKeyNotNil applies the NotNil predicate on the "cold_storage_key" field.
+func ColdStorageKeyNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldColdStorageKey))
+}
+
+// ColdStorageKeyEqualFold applies the EqualFold predicate on the "cold_storage_key" field.
+func ColdStorageKeyEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldColdStorageKey, v))
+}
+
+// ColdStorageKeyContainsFold applies the ContainsFold predicate on the "cold_storage_key" field.
+func ColdStorageKeyContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldColdStorageKey, v))
+}
+
+// ProofRefEQ applies the EQ predicate on the "proof_ref" field.
+func ProofRefEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldProofRef, v))
+}
+
+// ProofRefNEQ applies the NEQ predicate on the "proof_ref" field.
+func ProofRefNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldProofRef, v))
+}
+
+// ProofRefIn applies the In predicate on the "proof_ref" field.
+func ProofRefIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldProofRef, vs...))
+}
+
+// ProofRefNotIn applies the NotIn predicate on the "proof_ref" field.
+func ProofRefNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldProofRef, vs...))
+}
+
+// ProofRefGT applies the GT predicate on the "proof_ref" field.
+func ProofRefGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldProofRef, v))
+}
+
+// ProofRefGTE applies the GTE predicate on the "proof_ref" field.
+func ProofRefGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldProofRef, v))
+}
+
+// ProofRefLT applies the LT predicate on the "proof_ref" field.
+func ProofRefLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldProofRef, v))
+}
+
+// ProofRefLTE applies the LTE predicate on the "proof_ref" field.
+func ProofRefLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldProofRef, v))
+}
+
+// ProofRefContains applies the Contains predicate on the "proof_ref" field.
+func ProofRefContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldProofRef, v))
+}
+
+// ProofRefHasPrefix applies the HasPrefix predicate on the "proof_ref" field.
+func ProofRefHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldProofRef, v))
+}
+
+// ProofRefHasSuffix applies the HasSuffix predicate on the "proof_ref" field.
+func ProofRefHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldProofRef, v))
+}
+
+// ProofRefIsNil applies the IsNil predicate on the "proof_ref" field.
+func ProofRefIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldProofRef))
+}
+
+// ProofRefNotNil applies the NotNil predicate on the "proof_ref" field.
+func ProofRefNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldProofRef))
+}
+
+// ProofRefEqualFold applies the EqualFold predicate on the "proof_ref" field.
+func ProofRefEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldProofRef, v))
+}
+
+// ProofRefContainsFold applies the ContainsFold predicate on the "proof_ref" field.
+func ProofRefContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldProofRef, v))
+}
+
+// RetrievalStatusEQ applies the EQ predicate on the "retrieval_status" field.
+func RetrievalStatusEQ(v RetrievalStatus) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldRetrievalStatus, v))
+}
+
+// RetrievalStatusNEQ applies the NEQ predicate on the "retrieval_status" field.
+func RetrievalStatusNEQ(v RetrievalStatus) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldRetrievalStatus, v))
+}
+
+// RetrievalStatusIn applies the In predicate on the "retrieval_status" field.
+func RetrievalStatusIn(vs ...RetrievalStatus) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldRetrievalStatus, vs...))
+}
+
+// RetrievalStatusNotIn applies the NotIn predicate on the "retrieval_status" field.
+func RetrievalStatusNotIn(vs ...RetrievalStatus) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldRetrievalStatus, vs...))
+}
+
+// IpfsCidEQ applies the EQ predicate on the "ipfs_cid" field.
+func IpfsCidEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldIpfsCid, v))
+}
+
+// IpfsCidNEQ applies the NEQ predicate on the "ipfs_cid" field.
+func IpfsCidNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldIpfsCid, v))
+}
+
+// IpfsCidIn applies the In predicate on the "ipfs_cid" field.
+func IpfsCidIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldIpfsCid, vs...))
+}
+
+// IpfsCidNotIn applies the NotIn predicate on the "ipfs_cid" field.
+func IpfsCidNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldIpfsCid, vs...))
+}
+
+// IpfsCidGT applies the GT predicate on the "ipfs_cid" field.
+func IpfsCidGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldIpfsCid, v))
+}
+
+// IpfsCidGTE applies the GTE predicate on the "ipfs_cid" field.
+func IpfsCidGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldIpfsCid, v))
+}
+
+// IpfsCidLT applies the LT predicate on the "ipfs_cid" field.
+func IpfsCidLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldIpfsCid, v))
+}
+
+// IpfsCidLTE applies the LTE predicate on the "ipfs_cid" field.
+func IpfsCidLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldIpfsCid, v))
+}
+
+// IpfsCidContains applies the Contains predicate on the "ipfs_cid" field.
+func IpfsCidContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldIpfsCid, v))
+}
+
+// IpfsCidHasPrefix applies the HasPrefix predicate on the "ipfs_cid" field.
+func IpfsCidHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldIpfsCid, v))
+}
+
+// IpfsCidHasSuffix applies the HasSuffix predicate on the "ipfs_cid" field.
+func IpfsCidHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldIpfsCid, v))
+}
+
+// IpfsCidIsNil applies the IsNil predicate on the "ipfs_cid" field.
+func IpfsCidIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldIpfsCid))
+}
+
+// IpfsCidNotNil applies the NotNil predicate on the "ipfs_cid" field.
+func IpfsCidNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldIpfsCid))
+}
+
+// IpfsCidEqualFold applies the EqualFold predicate on the "ipfs_cid" field.
+func IpfsCidEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldIpfsCid, v))
+}
+
+// IpfsCidContainsFold applies the ContainsFold predicate on the "ipfs_cid" field.
+func IpfsCidContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldIpfsCid, v))
+}
+
+// ProverBackendEQ applies the EQ predicate on the "prover_backend" field.
+func ProverBackendEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldProverBackend, v))
+}
+
+// ProverBackendNEQ applies the NEQ predicate on the "prover_backend" field.
+func ProverBackendNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldProverBackend, v))
+}
+
+// ProverBackendIn applies the In predicate on the "prover_backend" field.
+func ProverBackendIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldProverBackend, vs...))
+}
+
+// ProverBackendNotIn applies the NotIn predicate on the "prover_backend" field.
+func ProverBackendNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldProverBackend, vs...))
+}
+
+// ProverBackendGT applies the GT predicate on the "prover_backend" field.
+func ProverBackendGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldProverBackend, v))
+}
+
+// ProverBackendGTE applies the GTE predicate on the "prover_backend" field.
+func ProverBackendGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldProverBackend, v))
+}
+
+// ProverBackendLT applies the LT predicate on the "prover_backend" field.
+func ProverBackendLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldProverBackend, v))
+}
+
+// ProverBackendLTE applies the LTE predicate on the "prover_backend" field.
+func ProverBackendLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldProverBackend, v))
+}
+
+// ProverBackendContains applies the Contains predicate on the "prover_backend" field.
+func ProverBackendContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldProverBackend, v))
+}
+
+// ProverBackendHasPrefix applies the HasPrefix predicate on the "prover_backend" field.
+func ProverBackendHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldProverBackend, v))
+}
+
+// ProverBackendHasSuffix applies the HasSuffix predicate on the "prover_backend" field.
+func ProverBackendHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldProverBackend, v))
+}
+
+// ProverBackendIsNil applies the IsNil predicate on the "prover_backend" field.
+func ProverBackendIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldProverBackend))
+}
+
+// ProverBackendNotNil applies the NotNil predicate on the "prover_backend" field.
+func ProverBackendNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldProverBackend))
+}
+
+// ProverBackendEqualFold applies the EqualFold predicate on the "prover_backend" field.
+func ProverBackendEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldProverBackend, v))
+}
+
+// ProverBackendContainsFold applies the ContainsFold predicate on the "prover_backend" field.
+func ProverBackendContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldProverBackend, v))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// RequestedByEQ applies the EQ predicate on the "requested_by" field.
+func RequestedByEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// RequestedByNEQ applies the NEQ predicate on the "requested_by" field.
+func RequestedByNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldRequestedBy, v))
+}
+
+// RequestedByIn applies the In predicate on the "requested_by" field.
+func RequestedByIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByNotIn applies the NotIn predicate on the "requested_by" field.
+func RequestedByNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByGT applies the GT predicate on the "requested_by" field.
+func RequestedByGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldRequestedBy, v))
+}
+
+// RequestedByGTE applies the GTE predicate on the "requested_by" field.
+func RequestedByGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldRequestedBy, v))
+}
+
+// RequestedByLT applies the LT predicate on the "requested_by" field.
+func RequestedByLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldRequestedBy, v))
+}
+
+// RequestedByLTE applies the LTE predicate on the "requested_by" field.
+func RequestedByLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldRequestedBy, v))
+}
+
+// RequestedByContains applies the Contains predicate on the "requested_by" field.
+func RequestedByContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldRequestedBy, v))
+}
+
+// RequestedByHasPrefix applies the HasPrefix predicate on the "requested_by" field.
+func RequestedByHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldRequestedBy, v))
+}
+
+// RequestedByHasSuffix applies the HasSuffix predicate on the "requested_by" field.
+func RequestedByHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldRequestedBy, v))
+}
+
+// RequestedByIsNil applies the IsNil predicate on the "requested_by" field.
+func RequestedByIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldRequestedBy))
+}
+
+// RequestedByNotNil applies the NotNil predicate on the "requested_by" field.
+func RequestedByNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldRequestedBy))
+}
+
+// RequestedByEqualFold applies the EqualFold predicate on the "requested_by" field.
+func RequestedByEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldRequestedBy, v))
+}
+
+// RequestedByContainsFold applies the ContainsFold predicate on the "requested_by" field.
+func RequestedByContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldRequestedBy, v))
+}
+
+// CompletedByEQ applies the EQ predicate on the "completed_by" field.
+func CompletedByEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldCompletedBy, v))
+}
+
+// CompletedByNEQ applies the NEQ predicate on the "completed_by" field.
+func CompletedByNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldCompletedBy, v))
+}
+
+// CompletedByIn applies the In predicate on the "completed_by" field.
+func CompletedByIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldCompletedBy, vs...))
+}
+
+// CompletedByNotIn applies the NotIn predicate on the "completed_by" field.
+func CompletedByNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldCompletedBy, vs...))
+}
+
+// CompletedByGT applies the GT predicate on the "completed_by" field.
+func CompletedByGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldCompletedBy, v))
+}
+
+// CompletedByGTE applies the GTE predicate on the "completed_by" field.
+func CompletedByGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldCompletedBy, v))
+}
+
+// CompletedByLT applies the LT predicate on the "completed_by" field.
+func CompletedByLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldCompletedBy, v))
+}
+
+// CompletedByLTE applies the LTE predicate on the "completed_by" field.
+func CompletedByLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldCompletedBy, v))
+}
+
+// CompletedByContains applies the Contains predicate on the "completed_by" field.
+func CompletedByContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldCompletedBy, v))
+}
+
+// CompletedByHasPrefix applies the HasPrefix predicate on the "completed_by" field.
+func CompletedByHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldCompletedBy, v))
+}
+
+// CompletedByHasSuffix applies the HasSuffix predicate on the "completed_by" field.
+func CompletedByHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldCompletedBy, v))
+}
+
+// CompletedByIsNil applies the IsNil predicate on the "completed_by" field.
+func CompletedByIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldCompletedBy))
+}
+
+// CompletedByNotNil applies the NotNil predicate on the "completed_by" field.
+func CompletedByNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldCompletedBy))
+}
+
+// CompletedByEqualFold applies the EqualFold predicate on the "completed_by" field.
+func CompletedByEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldCompletedBy, v))
+}
+
+// CompletedByContainsFold applies the ContainsFold predicate on the "completed_by" field.
+func CompletedByContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldCompletedBy, v))
+}
+
+// FeeEQ applies the EQ predicate on the "fee" field.
+func FeeEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFee, v))
+}
+
+// FeeNEQ applies the NEQ predicate on the "fee" field.
+func FeeNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldFee, v))
+}
+
+// FeeIn applies the In predicate on the "fee" field.
+func FeeIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldFee, vs...))
+}
+
+// FeeNotIn applies the NotIn predicate on the "fee" field.
+func FeeNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldFee, vs...))
+}
+
+// FeeGT applies the GT predicate on the "fee" field.
+func FeeGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldFee, v))
+}
+
+// FeeGTE applies the GTE predicate on the "fee" field.
+func FeeGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldFee, v))
+}
+
+// FeeLT applies the LT predicate on the "fee" field.
+func FeeLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldFee, v))
+}
+
+// FeeLTE applies the LTE predicate on the "fee" field.
+func FeeLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldFee, v))
+}
+
+// FeeContains applies the Contains predicate on the "fee" field.
+func FeeContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldFee, v))
+}
+
+// FeeHasPrefix applies the HasPrefix predicate on the "fee" field.
+func FeeHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldFee, v))
+}
+
+// FeeHasSuffix applies the HasSuffix predicate on the "fee" field.
+func FeeHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldFee, v))
+}
+
+// FeeIsNil applies the IsNil predicate on the "fee" field.
+func FeeIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldFee))
+}
+
+// FeeNotNil applies the NotNil predicate on the "fee" field.
+func FeeNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldFee))
+}
+
+// FeeEqualFold applies the EqualFold predicate on the "fee" field.
+func FeeEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldFee, v))
+}
+
+// FeeContainsFold applies the ContainsFold predicate on the "fee" field.
+func FeeContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldFee, v))
+}
+
+// FulfillerEQ applies the EQ predicate on the "fulfiller" field.
+func FulfillerEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFulfiller, v))
+}
+
+// FulfillerNEQ applies the NEQ predicate on the "fulfiller" field.
+func FulfillerNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldFulfiller, v))
+}
+
+// FulfillerIn applies the In predicate on the "fulfiller" field.
+func FulfillerIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldFulfiller, vs...))
+}
+
+// FulfillerNotIn applies the NotIn predicate on the "fulfiller" field.
+func FulfillerNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldFulfiller, vs...))
+}
+
+// FulfillerGT applies the GT predicate on the "fulfiller" field.
+func FulfillerGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldFulfiller, v))
+}
+
+// FulfillerGTE applies the GTE predicate on the "fulfiller" field.
+func FulfillerGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldFulfiller, v))
+}
+
+// FulfillerLT applies the LT predicate on the "fulfiller" field.
+func FulfillerLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldFulfiller, v))
+}
+
+// FulfillerLTE applies the LTE predicate on the "fulfiller" field.
+func FulfillerLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldFulfiller, v))
+}
+
+// FulfillerContains applies the Contains predicate on the "fulfiller" field.
+func FulfillerContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldFulfiller, v))
+}
+
+// FulfillerHasPrefix applies the HasPrefix predicate on the "fulfiller" field.
+func FulfillerHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldFulfiller, v))
+}
+
+// FulfillerHasSuffix applies the HasSuffix predicate on the "fulfiller" field.
+func FulfillerHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldFulfiller, v))
+}
+
+// FulfillerIsNil applies the IsNil predicate on the "fulfiller" field.
+func FulfillerIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldFulfiller))
+}
+
+// FulfillerNotNil applies the NotNil predicate on the "fulfiller" field.
+func FulfillerNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldFulfiller))
+}
+
+// FulfillerEqualFold applies the EqualFold predicate on the "fulfiller" field.
+func FulfillerEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldFulfiller, v))
+}
+
+// FulfillerContainsFold applies the ContainsFold predicate on the "fulfiller" field.
+func FulfillerContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldFulfiller, v))
+}
+
+// DowntimeCauseEQ applies the EQ predicate on the "downtime_cause" field.
+func DowntimeCauseEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldDowntimeCause, v))
+}
+
+// DowntimeCauseNEQ applies the NEQ predicate on the "downtime_cause" field.
+func DowntimeCauseNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldDowntimeCause, v))
+}
+
+// DowntimeCauseIn applies the In predicate on the "downtime_cause" field.
+func DowntimeCauseIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldDowntimeCause, vs...))
+}
+
+// DowntimeCauseNotIn applies the NotIn predicate on the "downtime_cause" field.
+func DowntimeCauseNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldDowntimeCause, vs...))
+}
+
+// DowntimeCauseGT applies the GT predicate on the "downtime_cause" field.
+func DowntimeCauseGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldDowntimeCause, v))
+}
+
+// DowntimeCauseGTE applies the GTE predicate on the "downtime_cause" field.
+func DowntimeCauseGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldDowntimeCause, v))
+}
+
+// DowntimeCauseLT applies the LT predicate on the "downtime_cause" field.
+func DowntimeCauseLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldDowntimeCause, v))
+}
+
+// DowntimeCauseLTE applies the LTE predicate on the "downtime_cause" field.
+func DowntimeCauseLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldDowntimeCause, v))
+}
+
+// DowntimeCauseContains applies the Contains predicate on the "downtime_cause" field.
+func DowntimeCauseContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldDowntimeCause, v))
+}
+
+// DowntimeCauseHasPrefix applies the HasPrefix predicate on the "downtime_cause" field.
+func DowntimeCauseHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldDowntimeCause, v))
+}
+
+// DowntimeCauseHasSuffix applies the HasSuffix predicate on the "downtime_cause" field.
+func DowntimeCauseHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldDowntimeCause, v))
+}
+
+// DowntimeCauseIsNil applies the IsNil predicate on the "downtime_cause" field.
+func DowntimeCauseIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldDowntimeCause))
+}
+
+// DowntimeCauseNotNil applies the NotNil predicate on the "downtime_cause" field.
+func DowntimeCauseNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldDowntimeCause))
+}
+
+// DowntimeCauseEqualFold applies the EqualFold predicate on the "downtime_cause" field.
+func DowntimeCauseEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldDowntimeCause, v))
+}
+
+// DowntimeCauseContainsFold applies the ContainsFold predicate on the "downtime_cause" field.
+func DowntimeCauseContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldDowntimeCause, v))
+}
+
+// L2BlockHashEQ applies the EQ predicate on the "l2_block_hash" field.
+func L2BlockHashEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldL2BlockHash, v))
+}
+
+// L2BlockHashNEQ applies the NEQ predicate on the "l2_block_hash" field.
+func L2BlockHashNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldL2BlockHash, v))
+}
+
+// L2BlockHashIn applies the In predicate on the "l2_block_hash" field.
+func L2BlockHashIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldL2BlockHash, vs...))
+}
+
+// L2BlockHashNotIn applies the NotIn predicate on the "l2_block_hash" field.
+func L2BlockHashNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldL2BlockHash, vs...))
+}
+
+// L2BlockHashGT applies the GT predicate on the "l2_block_hash" field.
+func L2BlockHashGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldL2BlockHash, v))
+}
+
+// L2BlockHashGTE applies the GTE predicate on the "l2_block_hash" field.
+func L2BlockHashGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldL2BlockHash, v))
+}
+
+// L2BlockHashLT applies the LT predicate on the "l2_block_hash" field.
+func L2BlockHashLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldL2BlockHash, v))
+}
+
+// L2BlockHashLTE applies the LTE predicate on the "l2_block_hash" field.
+func L2BlockHashLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldL2BlockHash, v))
+}
+
+// L2BlockHashContains applies the Contains predicate on the "l2_block_hash" field.
+func L2BlockHashContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldL2BlockHash, v))
+}
+
+// L2BlockHashHasPrefix applies the HasPrefix predicate on the "l2_block_hash" field.
+func L2BlockHashHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldL2BlockHash, v))
+}
+
+// L2BlockHashHasSuffix applies the HasSuffix predicate on the "l2_block_hash" field.
+func L2BlockHashHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldL2BlockHash, v))
+}
+
+// L2BlockHashIsNil applies the IsNil predicate on the "l2_block_hash" field.
+func L2BlockHashIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldL2BlockHash))
+}
+
+// L2BlockHashNotNil applies the NotNil predicate on the "l2_block_hash" field.
+func L2BlockHashNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldL2BlockHash))
+}
+
+// L2BlockHashEqualFold applies the EqualFold predicate on the "l2_block_hash" field.
+func L2BlockHashEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldL2BlockHash, v))
+}
+
+// L2BlockHashContainsFold applies the ContainsFold predicate on the "l2_block_hash" field.
+func L2BlockHashContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldL2BlockHash, v))
+}
+
+// HasAgg applies the HasEdge predicate on the "agg" edge.
+func HasAgg() predicate.ProofRequest {
+	return predicate.ProofRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AggTable, AggColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAggWith applies the HasEdge predicate on the "agg" edge with a given conditions (other predicates).
+func HasAggWith(preds ...predicate.ProofRequest) predicate.ProofRequest {
+	return predicate.ProofRequest(func(s *sql.Selector) {
+		step := newAggStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSpans applies the HasEdge predicate on the "spans" edge.
+func HasSpans() predicate.ProofRequest {
+	return predicate.ProofRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SpansTable, SpansColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSpansWith applies the HasEdge predicate on the "spans" edge with a given conditions (other predicates).
+func HasSpansWith(preds ...predicate.ProofRequest) predicate.ProofRequest {
+	return predicate.ProofRequest(func(s *sql.Selector) {
+		step := newSpansStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ProofRequest) predicate.ProofRequest {
 	return predicate.ProofRequest(sql.AndPredicates(predicates...))
@@ -63,6 +63,62 @@ func (prc *ProofRequestCreate) SetNillableProverRequestID(s *string) *ProofReque
 	return prc
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (prc *ProofRequestCreate) SetIdempotencyKey(s string) *ProofRequestCreate {
+	prc.mutation.SetIdempotencyKey(s)
+	return prc
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableIdempotencyKey(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetIdempotencyKey(*s)
+	}
+	return prc
+}
+
+// SetExternalRef sets the "external_ref" field.
+func (prc *ProofRequestCreate) SetExternalRef(s string) *ProofRequestCreate {
+	prc.mutation.SetExternalRef(s)
+	return prc
+}
+
+// SetNillableExternalRef sets the "external_ref" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableExternalRef(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetExternalRef(*s)
+	}
+	return prc
+}
+
+// SetWitnessArtifactID sets the "witness_artifact_id" field.
+func (prc *ProofRequestCreate) SetWitnessArtifactID(s string) *ProofRequestCreate {
+	prc.mutation.SetWitnessArtifactID(s)
+	return prc
+}
+
+// SetNillableWitnessArtifactID sets the "witness_artifact_id" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableWitnessArtifactID(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetWitnessArtifactID(*s)
+	}
+	return prc
+}
+
+// SetAggRequestID sets the "agg_request_id" field.
+func (prc *ProofRequestCreate) SetAggRequestID(i int) *ProofRequestCreate {
+	prc.mutation.SetAggRequestID(i)
+	return prc
+}
+
+// SetNillableAggRequestID sets the "agg_request_id" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableAggRequestID(i *int) *ProofRequestCreate {
+	if i != nil {
+		prc.SetAggRequestID(*i)
+	}
+	return prc
+}
+
 // SetProofRequestTime sets the "proof_request_time" field.
 func (prc *ProofRequestCreate) SetProofRequestTime(u uint64) *ProofRequestCreate {
 	prc.mutation.SetProofRequestTime(u)
@@ -83,6 +139,118 @@ func (prc *ProofRequestCreate) SetLastUpdatedTime(u uint64) *ProofRequestCreate
 	return prc
 }
 
+// SetProofTimeout sets the "proof_timeout" field.
+func (prc *ProofRequestCreate) SetProofTimeout(u uint64) *ProofRequestCreate {
+	prc.mutation.SetProofTimeout(u)
+	return prc
+}
+
+// SetNillableProofTimeout sets the "proof_timeout" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableProofTimeout(u *uint64) *ProofRequestCreate {
+	if u != nil {
+		prc.SetProofTimeout(*u)
+	}
+	return prc
+}
+
+// SetAttempts sets the "attempts" field.
+func (prc *ProofRequestCreate) SetAttempts(u uint64) *ProofRequestCreate {
+	prc.mutation.SetAttempts(u)
+	return prc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableAttempts(u *uint64) *ProofRequestCreate {
+	if u != nil {
+		prc.SetAttempts(*u)
+	}
+	return prc
+}
+
+// SetNotBefore sets the "not_before" field.
+func (prc *ProofRequestCreate) SetNotBefore(u uint64) *ProofRequestCreate {
+	prc.mutation.SetNotBefore(u)
+	return prc
+}
+
+// SetNillableNotBefore sets the "not_before" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableNotBefore(u *uint64) *ProofRequestCreate {
+	if u != nil {
+		prc.SetNotBefore(*u)
+	}
+	return prc
+}
+
+// SetCycles sets the "cycles" field.
+func (prc *ProofRequestCreate) SetCycles(u uint64) *ProofRequestCreate {
+	prc.mutation.SetCycles(u)
+	return prc
+}
+
+// SetNillableCycles sets the "cycles" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableCycles(u *uint64) *ProofRequestCreate {
+	if u != nil {
+		prc.SetCycles(*u)
+	}
+	return prc
+}
+
+// SetFulfilledTime sets the "fulfilled_time" field.
+func (prc *ProofRequestCreate) SetFulfilledTime(u uint64) *ProofRequestCreate {
+	prc.mutation.SetFulfilledTime(u)
+	return prc
+}
+
+// SetNillableFulfilledTime sets the "fulfilled_time" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableFulfilledTime(u *uint64) *ProofRequestCreate {
+	if u != nil {
+		prc.SetFulfilledTime(*u)
+	}
+	return prc
+}
+
+// SetSubmissionReverts sets the "submission_reverts" field.
+func (prc *ProofRequestCreate) SetSubmissionReverts(u uint64) *ProofRequestCreate {
+	prc.mutation.SetSubmissionReverts(u)
+	return prc
+}
+
+// SetNillableSubmissionReverts sets the "submission_reverts" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableSubmissionReverts(u *uint64) *ProofRequestCreate {
+	if u != nil {
+		prc.SetSubmissionReverts(*u)
+	}
+	return prc
+}
+
+// SetSubmissionResends sets the "submission_resends" field.
+func (prc *ProofRequestCreate) SetSubmissionResends(u uint64) *ProofRequestCreate {
+	prc.mutation.SetSubmissionResends(u)
+	return prc
+}
+
+// SetNillableSubmissionResends sets the "submission_resends" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableSubmissionResends(u *uint64) *ProofRequestCreate {
+	if u != nil {
+		prc.SetSubmissionResends(*u)
+	}
+	return prc
+}
+
+// SetSubmissionNonceResyncs sets the "submission_nonce_resyncs" field.
+func (prc *ProofRequestCreate) SetSubmissionNonceResyncs(u uint64) *ProofRequestCreate {
+	prc.mutation.SetSubmissionNonceResyncs(u)
+	return prc
+}
+
+// SetNillableSubmissionNonceResyncs sets the "submission_nonce_resyncs" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableSubmissionNonceResyncs(u *uint64) *ProofRequestCreate {
+	if u != nil {
+		prc.SetSubmissionNonceResyncs(*u)
+	}
+	return prc
+}
+
 // SetL1BlockNumber sets the "l1_block_number" field.
 func (prc *ProofRequestCreate) SetL1BlockNumber(u uint64) *ProofRequestCreate {
 	prc.mutation.SetL1BlockNumber(u)
@@ -111,12 +279,262 @@ func (prc *ProofRequestCreate) SetNillableL1BlockHash(s *string) *ProofRequestCr
 	return prc
 }
 
+// SetSatisfiedByTx sets the "satisfied_by_tx" field.
+func (prc *ProofRequestCreate) SetSatisfiedByTx(s string) *ProofRequestCreate {
+	prc.mutation.SetSatisfiedByTx(s)
+	return prc
+}
+
+// SetNillableSatisfiedByTx sets the "satisfied_by_tx" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableSatisfiedByTx(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetSatisfiedByTx(*s)
+	}
+	return prc
+}
+
 // SetProof sets the "proof" field.
 func (prc *ProofRequestCreate) SetProof(b []byte) *ProofRequestCreate {
 	prc.mutation.SetProof(b)
 	return prc
 }
 
+// SetStorageTier sets the "storage_tier" field.
+func (prc *ProofRequestCreate) SetStorageTier(pt proofrequest.StorageTier) *ProofRequestCreate {
+	prc.mutation.SetStorageTier(pt)
+	return prc
+}
+
+// SetNillableStorageTier sets the "storage_tier" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableStorageTier(pt *proofrequest.StorageTier) *ProofRequestCreate {
+	if pt != nil {
+		prc.SetStorageTier(*pt)
+	}
+	return prc
+}
+
+// SetColdStorageKey sets the "cold_storage_key" field.
+func (prc *ProofRequestCreate) SetColdStorageKey(s string) *ProofRequestCreate {
+	prc.mutation.SetColdStorageKey(s)
+	return prc
+}
+
+// SetNillableColdStorageKey sets the "cold_storage_key" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableColdStorageKey(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetColdStorageKey(*s)
+	}
+	return prc
+}
+
+// SetProofRef sets the "proof_ref" field.
+func (prc *ProofRequestCreate) SetProofRef(s string) *ProofRequestCreate {
+	prc.mutation.SetProofRef(s)
+	return prc
+}
+
+// SetNillableProofRef sets the "proof_ref" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableProofRef(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetProofRef(*s)
+	}
+	return prc
+}
+
+// SetRetrievalStatus sets the "retrieval_status" field.
+func (prc *ProofRequestCreate) SetRetrievalStatus(ps proofrequest.RetrievalStatus) *ProofRequestCreate {
+	prc.mutation.SetRetrievalStatus(ps)
+	return prc
+}
+
+// SetNillableRetrievalStatus sets the "retrieval_status" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableRetrievalStatus(ps *proofrequest.RetrievalStatus) *ProofRequestCreate {
+	if ps != nil {
+		prc.SetRetrievalStatus(*ps)
+	}
+	return prc
+}
+
+// SetIpfsCid sets the "ipfs_cid" field.
+func (prc *ProofRequestCreate) SetIpfsCid(s string) *ProofRequestCreate {
+	prc.mutation.SetIpfsCid(s)
+	return prc
+}
+
+// SetNillableIpfsCid sets the "ipfs_cid" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableIpfsCid(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetIpfsCid(*s)
+	}
+	return prc
+}
+
+// SetProverBackend sets the "prover_backend" field.
+func (prc *ProofRequestCreate) SetProverBackend(s string) *ProofRequestCreate {
+	prc.mutation.SetProverBackend(s)
+	return prc
+}
+
+// SetNillableProverBackend sets the "prover_backend" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableProverBackend(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetProverBackend(*s)
+	}
+	return prc
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (prc *ProofRequestCreate) SetErrorMessage(s string) *ProofRequestCreate {
+	prc.mutation.SetErrorMessage(s)
+	return prc
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableErrorMessage(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetErrorMessage(*s)
+	}
+	return prc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (prc *ProofRequestCreate) SetCreatedBy(s string) *ProofRequestCreate {
+	prc.mutation.SetCreatedBy(s)
+	return prc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableCreatedBy(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetCreatedBy(*s)
+	}
+	return prc
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (prc *ProofRequestCreate) SetRequestedBy(s string) *ProofRequestCreate {
+	prc.mutation.SetRequestedBy(s)
+	return prc
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableRequestedBy(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetRequestedBy(*s)
+	}
+	return prc
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (prc *ProofRequestCreate) SetCompletedBy(s string) *ProofRequestCreate {
+	prc.mutation.SetCompletedBy(s)
+	return prc
+}
+
+// SetNillableCompletedBy sets the "completed_by" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableCompletedBy(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetCompletedBy(*s)
+	}
+	return prc
+}
+
+// SetFee sets the "fee" field.
+func (prc *ProofRequestCreate) SetFee(s string) *ProofRequestCreate {
+	prc.mutation.SetFee(s)
+	return prc
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableFee(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetFee(*s)
+	}
+	return prc
+}
+
+// SetFulfiller sets the "fulfiller" field.
+func (prc *ProofRequestCreate) SetFulfiller(s string) *ProofRequestCreate {
+	prc.mutation.SetFulfiller(s)
+	return prc
+}
+
+// SetNillableFulfiller sets the "fulfiller" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableFulfiller(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetFulfiller(*s)
+	}
+	return prc
+}
+
+// SetDowntimeCause sets the "downtime_cause" field.
+func (prc *ProofRequestCreate) SetDowntimeCause(s string) *ProofRequestCreate {
+	prc.mutation.SetDowntimeCause(s)
+	return prc
+}
+
+// SetNillableDowntimeCause sets the "downtime_cause" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableDowntimeCause(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetDowntimeCause(*s)
+	}
+	return prc
+}
+
+// SetL2BlockHash sets the "l2_block_hash" field.
+func (prc *ProofRequestCreate) SetL2BlockHash(s string) *ProofRequestCreate {
+	prc.mutation.SetL2BlockHash(s)
+	return prc
+}
+
+// SetNillableL2BlockHash sets the "l2_block_hash" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableL2BlockHash(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetL2BlockHash(*s)
+	}
+	return prc
+}
+
+// SetID sets the "id" field.
+func (prc *ProofRequestCreate) SetID(i int) *ProofRequestCreate {
+	prc.mutation.SetID(i)
+	return prc
+}
+
+// SetAggID sets the "agg" edge to the ProofRequest entity by ID.
+func (prc *ProofRequestCreate) SetAggID(id int) *ProofRequestCreate {
+	prc.mutation.SetAggID(id)
+	return prc
+}
+
+// SetNillableAggID sets the "agg" edge to the ProofRequest entity by ID if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableAggID(id *int) *ProofRequestCreate {
+	if id != nil {
+		prc = prc.SetAggID(*id)
+	}
+	return prc
+}
+
+// SetAgg sets the "agg" edge to the ProofRequest entity.
+func (prc *ProofRequestCreate) SetAgg(p *ProofRequest) *ProofRequestCreate {
+	return prc.SetAggID(p.ID)
+}
+
+// AddSpanIDs adds the "spans" edge to the ProofRequest entity by IDs.
+func (prc *ProofRequestCreate) AddSpanIDs(ids ...int) *ProofRequestCreate {
+	prc.mutation.AddSpanIDs(ids...)
+	return prc
+}
+
+// AddSpans adds the "spans" edges to the ProofRequest entity.
+func (prc *ProofRequestCreate) AddSpans(p ...*ProofRequest) *ProofRequestCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return prc.AddSpanIDs(ids...)
+}
+
 // Mutation returns the ProofRequestMutation object of the builder.
 func (prc *ProofRequestCreate) Mutation() *ProofRequestMutation {
 	return prc.mutation
@@ -124,6 +542,7 @@ func (prc *ProofRequestCreate) Mutation() *ProofRequestMutation {
 
 // Save creates the ProofRequest in the database.
 func (prc *ProofRequestCreate) Save(ctx context.Context) (*ProofRequest, error) {
+	prc.defaults()
 	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
 }
 
@@ -149,6 +568,18 @@ func (prc *ProofRequestCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (prc *ProofRequestCreate) defaults() {
+	if _, ok := prc.mutation.StorageTier(); !ok {
+		v := proofrequest.DefaultStorageTier
+		prc.mutation.SetStorageTier(v)
+	}
+	if _, ok := prc.mutation.RetrievalStatus(); !ok {
+		v := proofrequest.DefaultRetrievalStatus
+		prc.mutation.SetRetrievalStatus(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (prc *ProofRequestCreate) check() error {
 	if _, ok := prc.mutation.GetType(); !ok {
@@ -179,6 +610,22 @@ func (prc *ProofRequestCreate) check() error {
 	if _, ok := prc.mutation.LastUpdatedTime(); !ok {
 		return &ValidationError{Name: "last_updated_time", err: errors.New(`ent: missing required field "ProofRequest.last_updated_time"`)}
 	}
+	if _, ok := prc.mutation.StorageTier(); !ok {
+		return &ValidationError{Name: "storage_tier", err: errors.New(`ent: missing required field "ProofRequest.storage_tier"`)}
+	}
+	if v, ok := prc.mutation.StorageTier(); ok {
+		if err := proofrequest.StorageTierValidator(v); err != nil {
+			return &ValidationError{Name: "storage_tier", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.storage_tier": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.RetrievalStatus(); !ok {
+		return &ValidationError{Name: "retrieval_status", err: errors.New(`ent: missing required field "ProofRequest.retrieval_status"`)}
+	}
+	if v, ok := prc.mutation.RetrievalStatus(); ok {
+		if err := proofrequest.RetrievalStatusValidator(v); err != nil {
+			return &ValidationError{Name: "retrieval_status", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.retrieval_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -193,8 +640,10 @@ func (prc *ProofRequestCreate) sqlSave(ctx context.Context) (*ProofRequest, erro
 		}
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	_node.ID = int(id)
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
 	prc.mutation.id = &_node.ID
 	prc.mutation.done = true
 	return _node, nil
@@ -205,6 +654,10 @@ func (prc *ProofRequestCreate) createSpec() (*ProofRequest, *sqlgraph.CreateSpec
 		_node = &ProofRequest{config: prc.config}
 		_spec = sqlgraph.NewCreateSpec(proofrequest.Table, sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt))
 	)
+	if id, ok := prc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
 	if value, ok := prc.mutation.GetType(); ok {
 		_spec.SetField(proofrequest.FieldType, field.TypeEnum, value)
 		_node.Type = value
@@ -229,6 +682,18 @@ func (prc *ProofRequestCreate) createSpec() (*ProofRequest, *sqlgraph.CreateSpec
 		_spec.SetField(proofrequest.FieldProverRequestID, field.TypeString, value)
 		_node.ProverRequestID = value
 	}
+	if value, ok := prc.mutation.IdempotencyKey(); ok {
+		_spec.SetField(proofrequest.FieldIdempotencyKey, field.TypeString, value)
+		_node.IdempotencyKey = value
+	}
+	if value, ok := prc.mutation.ExternalRef(); ok {
+		_spec.SetField(proofrequest.FieldExternalRef, field.TypeString, value)
+		_node.ExternalRef = value
+	}
+	if value, ok := prc.mutation.WitnessArtifactID(); ok {
+		_spec.SetField(proofrequest.FieldWitnessArtifactID, field.TypeString, value)
+		_node.WitnessArtifactID = value
+	}
 	if value, ok := prc.mutation.ProofRequestTime(); ok {
 		_spec.SetField(proofrequest.FieldProofRequestTime, field.TypeUint64, value)
 		_node.ProofRequestTime = value
@@ -237,6 +702,38 @@ func (prc *ProofRequestCreate) createSpec() (*ProofRequest, *sqlgraph.CreateSpec
 		_spec.SetField(proofrequest.FieldLastUpdatedTime, field.TypeUint64, value)
 		_node.LastUpdatedTime = value
 	}
+	if value, ok := prc.mutation.ProofTimeout(); ok {
+		_spec.SetField(proofrequest.FieldProofTimeout, field.TypeUint64, value)
+		_node.ProofTimeout = value
+	}
+	if value, ok := prc.mutation.Attempts(); ok {
+		_spec.SetField(proofrequest.FieldAttempts, field.TypeUint64, value)
+		_node.Attempts = value
+	}
+	if value, ok := prc.mutation.NotBefore(); ok {
+		_spec.SetField(proofrequest.FieldNotBefore, field.TypeUint64, value)
+		_node.NotBefore = value
+	}
+	if value, ok := prc.mutation.Cycles(); ok {
+		_spec.SetField(proofrequest.FieldCycles, field.TypeUint64, value)
+		_node.Cycles = value
+	}
+	if value, ok := prc.mutation.FulfilledTime(); ok {
+		_spec.SetField(proofrequest.FieldFulfilledTime, field.TypeUint64, value)
+		_node.FulfilledTime = value
+	}
+	if value, ok := prc.mutation.SubmissionReverts(); ok {
+		_spec.SetField(proofrequest.FieldSubmissionReverts, field.TypeUint64, value)
+		_node.SubmissionReverts = value
+	}
+	if value, ok := prc.mutation.SubmissionResends(); ok {
+		_spec.SetField(proofrequest.FieldSubmissionResends, field.TypeUint64, value)
+		_node.SubmissionResends = value
+	}
+	if value, ok := prc.mutation.SubmissionNonceResyncs(); ok {
+		_spec.SetField(proofrequest.FieldSubmissionNonceResyncs, field.TypeUint64, value)
+		_node.SubmissionNonceResyncs = value
+	}
 	if value, ok := prc.mutation.L1BlockNumber(); ok {
 		_spec.SetField(proofrequest.FieldL1BlockNumber, field.TypeUint64, value)
 		_node.L1BlockNumber = value
@@ -245,10 +742,103 @@ func (prc *ProofRequestCreate) createSpec() (*ProofRequest, *sqlgraph.CreateSpec
 		_spec.SetField(proofrequest.FieldL1BlockHash, field.TypeString, value)
 		_node.L1BlockHash = value
 	}
+	if value, ok := prc.mutation.SatisfiedByTx(); ok {
+		_spec.SetField(proofrequest.FieldSatisfiedByTx, field.TypeString, value)
+		_node.SatisfiedByTx = value
+	}
 	if value, ok := prc.mutation.Proof(); ok {
 		_spec.SetField(proofrequest.FieldProof, field.TypeBytes, value)
 		_node.Proof = value
 	}
+	if value, ok := prc.mutation.StorageTier(); ok {
+		_spec.SetField(proofrequest.FieldStorageTier, field.TypeEnum, value)
+		_node.StorageTier = value
+	}
+	if value, ok := prc.mutation.ColdStorageKey(); ok {
+		_spec.SetField(proofrequest.FieldColdStorageKey, field.TypeString, value)
+		_node.ColdStorageKey = value
+	}
+	if value, ok := prc.mutation.ProofRef(); ok {
+		_spec.SetField(proofrequest.FieldProofRef, field.TypeString, value)
+		_node.ProofRef = value
+	}
+	if value, ok := prc.mutation.RetrievalStatus(); ok {
+		_spec.SetField(proofrequest.FieldRetrievalStatus, field.TypeEnum, value)
+		_node.RetrievalStatus = value
+	}
+	if value, ok := prc.mutation.IpfsCid(); ok {
+		_spec.SetField(proofrequest.FieldIpfsCid, field.TypeString, value)
+		_node.IpfsCid = value
+	}
+	if value, ok := prc.mutation.ProverBackend(); ok {
+		_spec.SetField(proofrequest.FieldProverBackend, field.TypeString, value)
+		_node.ProverBackend = value
+	}
+	if value, ok := prc.mutation.ErrorMessage(); ok {
+		_spec.SetField(proofrequest.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = value
+	}
+	if value, ok := prc.mutation.CreatedBy(); ok {
+		_spec.SetField(proofrequest.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := prc.mutation.RequestedBy(); ok {
+		_spec.SetField(proofrequest.FieldRequestedBy, field.TypeString, value)
+		_node.RequestedBy = value
+	}
+	if value, ok := prc.mutation.CompletedBy(); ok {
+		_spec.SetField(proofrequest.FieldCompletedBy, field.TypeString, value)
+		_node.CompletedBy = value
+	}
+	if value, ok := prc.mutation.Fee(); ok {
+		_spec.SetField(proofrequest.FieldFee, field.TypeString, value)
+		_node.Fee = value
+	}
+	if value, ok := prc.mutation.Fulfiller(); ok {
+		_spec.SetField(proofrequest.FieldFulfiller, field.TypeString, value)
+		_node.Fulfiller = value
+	}
+	if value, ok := prc.mutation.DowntimeCause(); ok {
+		_spec.SetField(proofrequest.FieldDowntimeCause, field.TypeString, value)
+		_node.DowntimeCause = value
+	}
+	if value, ok := prc.mutation.L2BlockHash(); ok {
+		_spec.SetField(proofrequest.FieldL2BlockHash, field.TypeString, value)
+		_node.L2BlockHash = value
+	}
+	if nodes := prc.mutation.AggIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   proofrequest.AggTable,
+			Columns: []string{proofrequest.AggColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AggRequestID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := prc.mutation.SpansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   proofrequest.SpansTable,
+			Columns: []string{proofrequest.SpansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -270,6 +860,7 @@ func (prcb *ProofRequestCreateBulk) Save(ctx context.Context) ([]*ProofRequest,
 	for i := range prcb.builders {
 		func(i int, root context.Context) {
 			builder := prcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*ProofRequestMutation)
 				if !ok {
@@ -296,7 +887,7 @@ func (prcb *ProofRequestCreateBulk) Save(ctx context.Context) ([]*ProofRequest,
 					return nil, err
 				}
 				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil {
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
 					id := specs[i].ID.Value.(int64)
 					nodes[i].ID = int(id)
 				}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -21,6 +22,8 @@ type ProofRequestQuery struct {
 	order      []proofrequest.OrderOption
 	inters     []Interceptor
 	predicates []predicate.ProofRequest
+	withAgg    *ProofRequestQuery
+	withSpans  *ProofRequestQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -57,6 +60,50 @@ func (prq *ProofRequestQuery) Order(o ...proofrequest.OrderOption) *ProofRequest
 	return prq
 }
 
+// QueryAgg chains the current query on the "agg" edge.
+func (prq *ProofRequestQuery) QueryAgg() *ProofRequestQuery {
+	query := (&ProofRequestClient{config: prq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := prq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := prq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(proofrequest.Table, proofrequest.FieldID, selector),
+			sqlgraph.To(proofrequest.Table, proofrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, proofrequest.AggTable, proofrequest.AggColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(prq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySpans chains the current query on the "spans" edge.
+func (prq *ProofRequestQuery) QuerySpans() *ProofRequestQuery {
+	query := (&ProofRequestClient{config: prq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := prq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := prq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(proofrequest.Table, proofrequest.FieldID, selector),
+			sqlgraph.To(proofrequest.Table, proofrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, proofrequest.SpansTable, proofrequest.SpansColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(prq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ProofRequest entity from the query.
 // Returns a *NotFoundError when no ProofRequest was found.
 func (prq *ProofRequestQuery) First(ctx context.Context) (*ProofRequest, error) {
@@ -249,12 +296,36 @@ func (prq *ProofRequestQuery) Clone() *ProofRequestQuery {
 		order:      append([]proofrequest.OrderOption{}, prq.order...),
 		inters:     append([]Interceptor{}, prq.inters...),
 		predicates: append([]predicate.ProofRequest{}, prq.predicates...),
+		withAgg:    prq.withAgg.Clone(),
+		withSpans:  prq.withSpans.Clone(),
 		// clone intermediate query.
 		sql:  prq.sql.Clone(),
 		path: prq.path,
 	}
 }
 
+// WithAgg tells the query-builder to eager-load the nodes that are connected to
+// the "agg" edge. The optional arguments are used to configure the query builder of the edge.
+func (prq *ProofRequestQuery) WithAgg(opts ...func(*ProofRequestQuery)) *ProofRequestQuery {
+	query := (&ProofRequestClient{config: prq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	prq.withAgg = query
+	return prq
+}
+
+// WithSpans tells the query-builder to eager-load the nodes that are connected to
+// the "spans" edge. The optional arguments are used to configure the query builder of the edge.
+func (prq *ProofRequestQuery) WithSpans(opts ...func(*ProofRequestQuery)) *ProofRequestQuery {
+	query := (&ProofRequestClient{config: prq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	prq.withSpans = query
+	return prq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -331,8 +402,12 @@ func (prq *ProofRequestQuery) prepareQuery(ctx context.Context) error {
 
 func (prq *ProofRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProofRequest, error) {
 	var (
-		nodes = []*ProofRequest{}
-		_spec = prq.querySpec()
+		nodes       = []*ProofRequest{}
+		_spec       = prq.querySpec()
+		loadedTypes = [2]bool{
+			prq.withAgg != nil,
+			prq.withSpans != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*ProofRequest).scanValues(nil, columns)
@@ -340,6 +415,7 @@ func (prq *ProofRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &ProofRequest{config: prq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -351,9 +427,82 @@ func (prq *ProofRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := prq.withAgg; query != nil {
+		if err := prq.loadAgg(ctx, query, nodes, nil,
+			func(n *ProofRequest, e *ProofRequest) { n.Edges.Agg = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := prq.withSpans; query != nil {
+		if err := prq.loadSpans(ctx, query, nodes,
+			func(n *ProofRequest) { n.Edges.Spans = []*ProofRequest{} },
+			func(n *ProofRequest, e *ProofRequest) { n.Edges.Spans = append(n.Edges.Spans, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (prq *ProofRequestQuery) loadAgg(ctx context.Context, query *ProofRequestQuery, nodes []*ProofRequest, init func(*ProofRequest), assign func(*ProofRequest, *ProofRequest)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ProofRequest)
+	for i := range nodes {
+		fk := nodes[i].AggRequestID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(proofrequest.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "agg_request_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (prq *ProofRequestQuery) loadSpans(ctx context.Context, query *ProofRequestQuery, nodes []*ProofRequest, init func(*ProofRequest), assign func(*ProofRequest, *ProofRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*ProofRequest)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(proofrequest.FieldAggRequestID)
+	}
+	query.Where(predicate.ProofRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(proofrequest.SpansColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AggRequestID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "agg_request_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (prq *ProofRequestQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := prq.querySpec()
 	_spec.Node.Columns = prq.ctx.Fields
@@ -379,6 +528,9 @@ func (prq *ProofRequestQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if prq.withAgg != nil {
+			_spec.Node.AddColumnOnce(proofrequest.FieldAggRequestID)
+		}
 	}
 	if ps := prq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
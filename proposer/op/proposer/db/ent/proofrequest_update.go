@@ -138,6 +138,86 @@ func (pru *ProofRequestUpdate) ClearProverRequestID() *ProofRequestUpdate {
 	return pru
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (pru *ProofRequestUpdate) SetIdempotencyKey(s string) *ProofRequestUpdate {
+	pru.mutation.SetIdempotencyKey(s)
+	return pru
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableIdempotencyKey(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetIdempotencyKey(*s)
+	}
+	return pru
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (pru *ProofRequestUpdate) ClearIdempotencyKey() *ProofRequestUpdate {
+	pru.mutation.ClearIdempotencyKey()
+	return pru
+}
+
+// SetExternalRef sets the "external_ref" field.
+func (pru *ProofRequestUpdate) SetExternalRef(s string) *ProofRequestUpdate {
+	pru.mutation.SetExternalRef(s)
+	return pru
+}
+
+// SetNillableExternalRef sets the "external_ref" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableExternalRef(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetExternalRef(*s)
+	}
+	return pru
+}
+
+// ClearExternalRef clears the value of the "external_ref" field.
+func (pru *ProofRequestUpdate) ClearExternalRef() *ProofRequestUpdate {
+	pru.mutation.ClearExternalRef()
+	return pru
+}
+
+// SetWitnessArtifactID sets the "witness_artifact_id" field.
+func (pru *ProofRequestUpdate) SetWitnessArtifactID(s string) *ProofRequestUpdate {
+	pru.mutation.SetWitnessArtifactID(s)
+	return pru
+}
+
+// SetNillableWitnessArtifactID sets the "witness_artifact_id" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableWitnessArtifactID(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetWitnessArtifactID(*s)
+	}
+	return pru
+}
+
+// ClearWitnessArtifactID clears the value of the "witness_artifact_id" field.
+func (pru *ProofRequestUpdate) ClearWitnessArtifactID() *ProofRequestUpdate {
+	pru.mutation.ClearWitnessArtifactID()
+	return pru
+}
+
+// SetAggRequestID sets the "agg_request_id" field.
+func (pru *ProofRequestUpdate) SetAggRequestID(i int) *ProofRequestUpdate {
+	pru.mutation.SetAggRequestID(i)
+	return pru
+}
+
+// SetNillableAggRequestID sets the "agg_request_id" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableAggRequestID(i *int) *ProofRequestUpdate {
+	if i != nil {
+		pru.SetAggRequestID(*i)
+	}
+	return pru
+}
+
+// ClearAggRequestID clears the value of the "agg_request_id" field.
+func (pru *ProofRequestUpdate) ClearAggRequestID() *ProofRequestUpdate {
+	pru.mutation.ClearAggRequestID()
+	return pru
+}
+
 // SetProofRequestTime sets the "proof_request_time" field.
 func (pru *ProofRequestUpdate) SetProofRequestTime(u uint64) *ProofRequestUpdate {
 	pru.mutation.ResetProofRequestTime()
@@ -186,6 +266,222 @@ func (pru *ProofRequestUpdate) AddLastUpdatedTime(u int64) *ProofRequestUpdate {
 	return pru
 }
 
+// SetProofTimeout sets the "proof_timeout" field.
+func (pru *ProofRequestUpdate) SetProofTimeout(u uint64) *ProofRequestUpdate {
+	pru.mutation.ResetProofTimeout()
+	pru.mutation.SetProofTimeout(u)
+	return pru
+}
+
+// SetNillableProofTimeout sets the "proof_timeout" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableProofTimeout(u *uint64) *ProofRequestUpdate {
+	if u != nil {
+		pru.SetProofTimeout(*u)
+	}
+	return pru
+}
+
+// AddProofTimeout adds u to the "proof_timeout" field.
+func (pru *ProofRequestUpdate) AddProofTimeout(u int64) *ProofRequestUpdate {
+	pru.mutation.AddProofTimeout(u)
+	return pru
+}
+
+// ClearProofTimeout clears the value of the "proof_timeout" field.
+func (pru *ProofRequestUpdate) ClearProofTimeout() *ProofRequestUpdate {
+	pru.mutation.ClearProofTimeout()
+	return pru
+}
+
+// SetAttempts sets the "attempts" field.
+func (pru *ProofRequestUpdate) SetAttempts(u uint64) *ProofRequestUpdate {
+	pru.mutation.ResetAttempts()
+	pru.mutation.SetAttempts(u)
+	return pru
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableAttempts(u *uint64) *ProofRequestUpdate {
+	if u != nil {
+		pru.SetAttempts(*u)
+	}
+	return pru
+}
+
+// AddAttempts adds u to the "attempts" field.
+func (pru *ProofRequestUpdate) AddAttempts(u int64) *ProofRequestUpdate {
+	pru.mutation.AddAttempts(u)
+	return pru
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (pru *ProofRequestUpdate) ClearAttempts() *ProofRequestUpdate {
+	pru.mutation.ClearAttempts()
+	return pru
+}
+
+// SetNotBefore sets the "not_before" field.
+func (pru *ProofRequestUpdate) SetNotBefore(u uint64) *ProofRequestUpdate {
+	pru.mutation.ResetNotBefore()
+	pru.mutation.SetNotBefore(u)
+	return pru
+}
+
+// SetNillableNotBefore sets the "not_before" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableNotBefore(u *uint64) *ProofRequestUpdate {
+	if u != nil {
+		pru.SetNotBefore(*u)
+	}
+	return pru
+}
+
+// AddNotBefore adds u to the "not_before" field.
+func (pru *ProofRequestUpdate) AddNotBefore(u int64) *ProofRequestUpdate {
+	pru.mutation.AddNotBefore(u)
+	return pru
+}
+
+// ClearNotBefore clears the value of the "not_before" field.
+func (pru *ProofRequestUpdate) ClearNotBefore() *ProofRequestUpdate {
+	pru.mutation.ClearNotBefore()
+	return pru
+}
+
+// SetCycles sets the "cycles" field.
+func (pru *ProofRequestUpdate) SetCycles(u uint64) *ProofRequestUpdate {
+	pru.mutation.ResetCycles()
+	pru.mutation.SetCycles(u)
+	return pru
+}
+
+// SetNillableCycles sets the "cycles" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableCycles(u *uint64) *ProofRequestUpdate {
+	if u != nil {
+		pru.SetCycles(*u)
+	}
+	return pru
+}
+
+// AddCycles adds u to the "cycles" field.
+func (pru *ProofRequestUpdate) AddCycles(u int64) *ProofRequestUpdate {
+	pru.mutation.AddCycles(u)
+	return pru
+}
+
+// ClearCycles clears the value of the "cycles" field.
+func (pru *ProofRequestUpdate) ClearCycles() *ProofRequestUpdate {
+	pru.mutation.ClearCycles()
+	return pru
+}
+
+// SetFulfilledTime sets the "fulfilled_time" field.
+func (pru *ProofRequestUpdate) SetFulfilledTime(u uint64) *ProofRequestUpdate {
+	pru.mutation.ResetFulfilledTime()
+	pru.mutation.SetFulfilledTime(u)
+	return pru
+}
+
+// SetNillableFulfilledTime sets the "fulfilled_time" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableFulfilledTime(u *uint64) *ProofRequestUpdate {
+	if u != nil {
+		pru.SetFulfilledTime(*u)
+	}
+	return pru
+}
+
+// AddFulfilledTime adds u to the "fulfilled_time" field.
+func (pru *ProofRequestUpdate) AddFulfilledTime(u int64) *ProofRequestUpdate {
+	pru.mutation.AddFulfilledTime(u)
+	return pru
+}
+
+// ClearFulfilledTime clears the value of the "fulfilled_time" field.
+func (pru *ProofRequestUpdate) ClearFulfilledTime() *ProofRequestUpdate {
+	pru.mutation.ClearFulfilledTime()
+	return pru
+}
+
+// SetSubmissionReverts sets the "submission_reverts" field.
+func (pru *ProofRequestUpdate) SetSubmissionReverts(u uint64) *ProofRequestUpdate {
+	pru.mutation.ResetSubmissionReverts()
+	pru.mutation.SetSubmissionReverts(u)
+	return pru
+}
+
+// SetNillableSubmissionReverts sets the "submission_reverts" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableSubmissionReverts(u *uint64) *ProofRequestUpdate {
+	if u != nil {
+		pru.SetSubmissionReverts(*u)
+	}
+	return pru
+}
+
+// AddSubmissionReverts adds u to the "submission_reverts" field.
+func (pru *ProofRequestUpdate) AddSubmissionReverts(u int64) *ProofRequestUpdate {
+	pru.mutation.AddSubmissionReverts(u)
+	return pru
+}
+
+// ClearSubmissionReverts clears the value of the "submission_reverts" field.
+func (pru *ProofRequestUpdate) ClearSubmissionReverts() *ProofRequestUpdate {
+	pru.mutation.ClearSubmissionReverts()
+	return pru
+}
+
+// SetSubmissionResends sets the "submission_resends" field.
+func (pru *ProofRequestUpdate) SetSubmissionResends(u uint64) *ProofRequestUpdate {
+	pru.mutation.ResetSubmissionResends()
+	pru.mutation.SetSubmissionResends(u)
+	return pru
+}
+
+// SetNillableSubmissionResends sets the "submission_resends" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableSubmissionResends(u *uint64) *ProofRequestUpdate {
+	if u != nil {
+		pru.SetSubmissionResends(*u)
+	}
+	return pru
+}
+
+// AddSubmissionResends adds u to the "submission_resends" field.
+func (pru *ProofRequestUpdate) AddSubmissionResends(u int64) *ProofRequestUpdate {
+	pru.mutation.AddSubmissionResends(u)
+	return pru
+}
+
+// ClearSubmissionResends clears the value of the "submission_resends" field.
+func (pru *ProofRequestUpdate) ClearSubmissionResends() *ProofRequestUpdate {
+	pru.mutation.ClearSubmissionResends()
+	return pru
+}
+
+// SetSubmissionNonceResyncs sets the "submission_nonce_resyncs" field.
+func (pru *ProofRequestUpdate) SetSubmissionNonceResyncs(u uint64) *ProofRequestUpdate {
+	pru.mutation.ResetSubmissionNonceResyncs()
+	pru.mutation.SetSubmissionNonceResyncs(u)
+	return pru
+}
+
+// SetNillableSubmissionNonceResyncs sets the "submission_nonce_resyncs" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableSubmissionNonceResyncs(u *uint64) *ProofRequestUpdate {
+	if u != nil {
+		pru.SetSubmissionNonceResyncs(*u)
+	}
+	return pru
+}
+
+// AddSubmissionNonceResyncs adds u to the "submission_nonce_resyncs" field.
+func (pru *ProofRequestUpdate) AddSubmissionNonceResyncs(u int64) *ProofRequestUpdate {
+	pru.mutation.AddSubmissionNonceResyncs(u)
+	return pru
+}
+
+// ClearSubmissionNonceResyncs clears the value of the "submission_nonce_resyncs" field.
+func (pru *ProofRequestUpdate) ClearSubmissionNonceResyncs() *ProofRequestUpdate {
+	pru.mutation.ClearSubmissionNonceResyncs()
+	return pru
+}
+
 // SetL1BlockNumber sets the "l1_block_number" field.
 func (pru *ProofRequestUpdate) SetL1BlockNumber(u uint64) *ProofRequestUpdate {
 	pru.mutation.ResetL1BlockNumber()
@@ -233,6 +529,26 @@ func (pru *ProofRequestUpdate) ClearL1BlockHash() *ProofRequestUpdate {
 	return pru
 }
 
+// SetSatisfiedByTx sets the "satisfied_by_tx" field.
+func (pru *ProofRequestUpdate) SetSatisfiedByTx(s string) *ProofRequestUpdate {
+	pru.mutation.SetSatisfiedByTx(s)
+	return pru
+}
+
+// SetNillableSatisfiedByTx sets the "satisfied_by_tx" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableSatisfiedByTx(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetSatisfiedByTx(*s)
+	}
+	return pru
+}
+
+// ClearSatisfiedByTx clears the value of the "satisfied_by_tx" field.
+func (pru *ProofRequestUpdate) ClearSatisfiedByTx() *ProofRequestUpdate {
+	pru.mutation.ClearSatisfiedByTx()
+	return pru
+}
+
 // SetProof sets the "proof" field.
 func (pru *ProofRequestUpdate) SetProof(b []byte) *ProofRequestUpdate {
 	pru.mutation.SetProof(b)
@@ -245,69 +561,408 @@ func (pru *ProofRequestUpdate) ClearProof() *ProofRequestUpdate {
 	return pru
 }
 
-// Mutation returns the ProofRequestMutation object of the builder.
-func (pru *ProofRequestUpdate) Mutation() *ProofRequestMutation {
-	return pru.mutation
+// SetStorageTier sets the "storage_tier" field.
+func (pru *ProofRequestUpdate) SetStorageTier(pt proofrequest.StorageTier) *ProofRequestUpdate {
+	pru.mutation.SetStorageTier(pt)
+	return pru
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (pru *ProofRequestUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+// SetNillableStorageTier sets the "storage_tier" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableStorageTier(pt *proofrequest.StorageTier) *ProofRequestUpdate {
+	if pt != nil {
+		pru.SetStorageTier(*pt)
+	}
+	return pru
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (pru *ProofRequestUpdate) SaveX(ctx context.Context) int {
-	affected, err := pru.Save(ctx)
-	if err != nil {
-		panic(err)
+// SetColdStorageKey sets the "cold_storage_key" field.
+func (pru *ProofRequestUpdate) SetColdStorageKey(s string) *ProofRequestUpdate {
+	pru.mutation.SetColdStorageKey(s)
+	return pru
+}
+
+// SetNillableColdStorageKey sets the "cold_storage_key" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableColdStorageKey(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetColdStorageKey(*s)
 	}
-	return affected
+	return pru
 }
 
-// Exec executes the query.
-func (pru *ProofRequestUpdate) Exec(ctx context.Context) error {
-	_, err := pru.Save(ctx)
-	return err
+// ClearColdStorageKey clears the value of the "cold_storage_key" field.
+func (pru *ProofRequestUpdate) ClearColdStorageKey() *ProofRequestUpdate {
+	pru.mutation.ClearColdStorageKey()
+	return pru
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (pru *ProofRequestUpdate) ExecX(ctx context.Context) {
-	if err := pru.Exec(ctx); err != nil {
-		panic(err)
-	}
+// SetProofRef sets the "proof_ref" field.
+func (pru *ProofRequestUpdate) SetProofRef(s string) *ProofRequestUpdate {
+	pru.mutation.SetProofRef(s)
+	return pru
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (pru *ProofRequestUpdate) check() error {
-	if v, ok := pru.mutation.GetType(); ok {
-		if err := proofrequest.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.type": %w`, err)}
-		}
+// SetNillableProofRef sets the "proof_ref" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableProofRef(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetProofRef(*s)
 	}
-	if v, ok := pru.mutation.Status(); ok {
-		if err := proofrequest.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.status": %w`, err)}
-		}
+	return pru
+}
+
+// ClearProofRef clears the value of the "proof_ref" field.
+func (pru *ProofRequestUpdate) ClearProofRef() *ProofRequestUpdate {
+	pru.mutation.ClearProofRef()
+	return pru
+}
+
+// SetRetrievalStatus sets the "retrieval_status" field.
+func (pru *ProofRequestUpdate) SetRetrievalStatus(ps proofrequest.RetrievalStatus) *ProofRequestUpdate {
+	pru.mutation.SetRetrievalStatus(ps)
+	return pru
+}
+
+// SetNillableRetrievalStatus sets the "retrieval_status" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableRetrievalStatus(ps *proofrequest.RetrievalStatus) *ProofRequestUpdate {
+	if ps != nil {
+		pru.SetRetrievalStatus(*ps)
 	}
-	return nil
+	return pru
 }
 
-func (pru *ProofRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := pru.check(); err != nil {
-		return n, err
+// SetIpfsCid sets the "ipfs_cid" field.
+func (pru *ProofRequestUpdate) SetIpfsCid(s string) *ProofRequestUpdate {
+	pru.mutation.SetIpfsCid(s)
+	return pru
+}
+
+// SetNillableIpfsCid sets the "ipfs_cid" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableIpfsCid(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetIpfsCid(*s)
 	}
-	_spec := sqlgraph.NewUpdateSpec(proofrequest.Table, proofrequest.Columns, sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt))
-	if ps := pru.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
+	return pru
+}
+
+// ClearIpfsCid clears the value of the "ipfs_cid" field.
+func (pru *ProofRequestUpdate) ClearIpfsCid() *ProofRequestUpdate {
+	pru.mutation.ClearIpfsCid()
+	return pru
+}
+
+// SetProverBackend sets the "prover_backend" field.
+func (pru *ProofRequestUpdate) SetProverBackend(s string) *ProofRequestUpdate {
+	pru.mutation.SetProverBackend(s)
+	return pru
+}
+
+// SetNillableProverBackend sets the "prover_backend" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableProverBackend(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetProverBackend(*s)
 	}
-	if value, ok := pru.mutation.GetType(); ok {
-		_spec.SetField(proofrequest.FieldType, field.TypeEnum, value)
+	return pru
+}
+
+// ClearProverBackend clears the value of the "prover_backend" field.
+func (pru *ProofRequestUpdate) ClearProverBackend() *ProofRequestUpdate {
+	pru.mutation.ClearProverBackend()
+	return pru
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (pru *ProofRequestUpdate) SetErrorMessage(s string) *ProofRequestUpdate {
+	pru.mutation.SetErrorMessage(s)
+	return pru
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableErrorMessage(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetErrorMessage(*s)
 	}
-	if value, ok := pru.mutation.StartBlock(); ok {
+	return pru
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (pru *ProofRequestUpdate) ClearErrorMessage() *ProofRequestUpdate {
+	pru.mutation.ClearErrorMessage()
+	return pru
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pru *ProofRequestUpdate) SetCreatedBy(s string) *ProofRequestUpdate {
+	pru.mutation.SetCreatedBy(s)
+	return pru
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableCreatedBy(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetCreatedBy(*s)
+	}
+	return pru
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (pru *ProofRequestUpdate) ClearCreatedBy() *ProofRequestUpdate {
+	pru.mutation.ClearCreatedBy()
+	return pru
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (pru *ProofRequestUpdate) SetRequestedBy(s string) *ProofRequestUpdate {
+	pru.mutation.SetRequestedBy(s)
+	return pru
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableRequestedBy(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetRequestedBy(*s)
+	}
+	return pru
+}
+
+// ClearRequestedBy clears the value of the "requested_by" field.
+func (pru *ProofRequestUpdate) ClearRequestedBy() *ProofRequestUpdate {
+	pru.mutation.ClearRequestedBy()
+	return pru
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (pru *ProofRequestUpdate) SetCompletedBy(s string) *ProofRequestUpdate {
+	pru.mutation.SetCompletedBy(s)
+	return pru
+}
+
+// SetNillableCompletedBy sets the "completed_by" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableCompletedBy(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetCompletedBy(*s)
+	}
+	return pru
+}
+
+// ClearCompletedBy clears the value of the "completed_by" field.
+func (pru *ProofRequestUpdate) ClearCompletedBy() *ProofRequestUpdate {
+	pru.mutation.ClearCompletedBy()
+	return pru
+}
+
+// SetFee sets the "fee" field.
+func (pru *ProofRequestUpdate) SetFee(s string) *ProofRequestUpdate {
+	pru.mutation.SetFee(s)
+	return pru
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableFee(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetFee(*s)
+	}
+	return pru
+}
+
+// ClearFee clears the value of the "fee" field.
+func (pru *ProofRequestUpdate) ClearFee() *ProofRequestUpdate {
+	pru.mutation.ClearFee()
+	return pru
+}
+
+// SetFulfiller sets the "fulfiller" field.
+func (pru *ProofRequestUpdate) SetFulfiller(s string) *ProofRequestUpdate {
+	pru.mutation.SetFulfiller(s)
+	return pru
+}
+
+// SetNillableFulfiller sets the "fulfiller" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableFulfiller(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetFulfiller(*s)
+	}
+	return pru
+}
+
+// ClearFulfiller clears the value of the "fulfiller" field.
+func (pru *ProofRequestUpdate) ClearFulfiller() *ProofRequestUpdate {
+	pru.mutation.ClearFulfiller()
+	return pru
+}
+
+// SetDowntimeCause sets the "downtime_cause" field.
+func (pru *ProofRequestUpdate) SetDowntimeCause(s string) *ProofRequestUpdate {
+	pru.mutation.SetDowntimeCause(s)
+	return pru
+}
+
+// SetNillableDowntimeCause sets the "downtime_cause" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableDowntimeCause(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetDowntimeCause(*s)
+	}
+	return pru
+}
+
+// ClearDowntimeCause clears the value of the "downtime_cause" field.
+func (pru *ProofRequestUpdate) ClearDowntimeCause() *ProofRequestUpdate {
+	pru.mutation.ClearDowntimeCause()
+	return pru
+}
+
+// SetL2BlockHash sets the "l2_block_hash" field.
+func (pru *ProofRequestUpdate) SetL2BlockHash(s string) *ProofRequestUpdate {
+	pru.mutation.SetL2BlockHash(s)
+	return pru
+}
+
+// SetNillableL2BlockHash sets the "l2_block_hash" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableL2BlockHash(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetL2BlockHash(*s)
+	}
+	return pru
+}
+
+// ClearL2BlockHash clears the value of the "l2_block_hash" field.
+func (pru *ProofRequestUpdate) ClearL2BlockHash() *ProofRequestUpdate {
+	pru.mutation.ClearL2BlockHash()
+	return pru
+}
+
+// SetAggID sets the "agg" edge to the ProofRequest entity by ID.
+func (pru *ProofRequestUpdate) SetAggID(id int) *ProofRequestUpdate {
+	pru.mutation.SetAggID(id)
+	return pru
+}
+
+// SetNillableAggID sets the "agg" edge to the ProofRequest entity by ID if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableAggID(id *int) *ProofRequestUpdate {
+	if id != nil {
+		pru = pru.SetAggID(*id)
+	}
+	return pru
+}
+
+// SetAgg sets the "agg" edge to the ProofRequest entity.
+func (pru *ProofRequestUpdate) SetAgg(p *ProofRequest) *ProofRequestUpdate {
+	return pru.SetAggID(p.ID)
+}
+
+// AddSpanIDs adds the "spans" edge to the ProofRequest entity by IDs.
+func (pru *ProofRequestUpdate) AddSpanIDs(ids ...int) *ProofRequestUpdate {
+	pru.mutation.AddSpanIDs(ids...)
+	return pru
+}
+
+// AddSpans adds the "spans" edges to the ProofRequest entity.
+func (pru *ProofRequestUpdate) AddSpans(p ...*ProofRequest) *ProofRequestUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pru.AddSpanIDs(ids...)
+}
+
+// Mutation returns the ProofRequestMutation object of the builder.
+func (pru *ProofRequestUpdate) Mutation() *ProofRequestMutation {
+	return pru.mutation
+}
+
+// ClearAgg clears the "agg" edge to the ProofRequest entity.
+func (pru *ProofRequestUpdate) ClearAgg() *ProofRequestUpdate {
+	pru.mutation.ClearAgg()
+	return pru
+}
+
+// ClearSpans clears all "spans" edges to the ProofRequest entity.
+func (pru *ProofRequestUpdate) ClearSpans() *ProofRequestUpdate {
+	pru.mutation.ClearSpans()
+	return pru
+}
+
+// RemoveSpanIDs removes the "spans" edge to ProofRequest entities by IDs.
+func (pru *ProofRequestUpdate) RemoveSpanIDs(ids ...int) *ProofRequestUpdate {
+	pru.mutation.RemoveSpanIDs(ids...)
+	return pru
+}
+
+// RemoveSpans removes "spans" edges to ProofRequest entities.
+func (pru *ProofRequestUpdate) RemoveSpans(p ...*ProofRequest) *ProofRequestUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pru.RemoveSpanIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *ProofRequestUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *ProofRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *ProofRequestUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *ProofRequestUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *ProofRequestUpdate) check() error {
+	if v, ok := pru.mutation.GetType(); ok {
+		if err := proofrequest.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.type": %w`, err)}
+		}
+	}
+	if v, ok := pru.mutation.Status(); ok {
+		if err := proofrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := pru.mutation.StorageTier(); ok {
+		if err := proofrequest.StorageTierValidator(v); err != nil {
+			return &ValidationError{Name: "storage_tier", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.storage_tier": %w`, err)}
+		}
+	}
+	if v, ok := pru.mutation.RetrievalStatus(); ok {
+		if err := proofrequest.RetrievalStatusValidator(v); err != nil {
+			return &ValidationError{Name: "retrieval_status", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.retrieval_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pru *ProofRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(proofrequest.Table, proofrequest.Columns, sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.GetType(); ok {
+		_spec.SetField(proofrequest.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := pru.mutation.StartBlock(); ok {
 		_spec.SetField(proofrequest.FieldStartBlock, field.TypeUint64, value)
 	}
 	if value, ok := pru.mutation.AddedStartBlock(); ok {
@@ -334,6 +989,24 @@ func (pru *ProofRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pru.mutation.ProverRequestIDCleared() {
 		_spec.ClearField(proofrequest.FieldProverRequestID, field.TypeString)
 	}
+	if value, ok := pru.mutation.IdempotencyKey(); ok {
+		_spec.SetField(proofrequest.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if pru.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(proofrequest.FieldIdempotencyKey, field.TypeString)
+	}
+	if value, ok := pru.mutation.ExternalRef(); ok {
+		_spec.SetField(proofrequest.FieldExternalRef, field.TypeString, value)
+	}
+	if pru.mutation.ExternalRefCleared() {
+		_spec.ClearField(proofrequest.FieldExternalRef, field.TypeString)
+	}
+	if value, ok := pru.mutation.WitnessArtifactID(); ok {
+		_spec.SetField(proofrequest.FieldWitnessArtifactID, field.TypeString, value)
+	}
+	if pru.mutation.WitnessArtifactIDCleared() {
+		_spec.ClearField(proofrequest.FieldWitnessArtifactID, field.TypeString)
+	}
 	if value, ok := pru.mutation.ProofRequestTime(); ok {
 		_spec.SetField(proofrequest.FieldProofRequestTime, field.TypeUint64, value)
 	}
@@ -349,6 +1022,78 @@ func (pru *ProofRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pru.mutation.AddedLastUpdatedTime(); ok {
 		_spec.AddField(proofrequest.FieldLastUpdatedTime, field.TypeUint64, value)
 	}
+	if value, ok := pru.mutation.ProofTimeout(); ok {
+		_spec.SetField(proofrequest.FieldProofTimeout, field.TypeUint64, value)
+	}
+	if value, ok := pru.mutation.AddedProofTimeout(); ok {
+		_spec.AddField(proofrequest.FieldProofTimeout, field.TypeUint64, value)
+	}
+	if pru.mutation.ProofTimeoutCleared() {
+		_spec.ClearField(proofrequest.FieldProofTimeout, field.TypeUint64)
+	}
+	if value, ok := pru.mutation.Attempts(); ok {
+		_spec.SetField(proofrequest.FieldAttempts, field.TypeUint64, value)
+	}
+	if value, ok := pru.mutation.AddedAttempts(); ok {
+		_spec.AddField(proofrequest.FieldAttempts, field.TypeUint64, value)
+	}
+	if pru.mutation.AttemptsCleared() {
+		_spec.ClearField(proofrequest.FieldAttempts, field.TypeUint64)
+	}
+	if value, ok := pru.mutation.NotBefore(); ok {
+		_spec.SetField(proofrequest.FieldNotBefore, field.TypeUint64, value)
+	}
+	if value, ok := pru.mutation.AddedNotBefore(); ok {
+		_spec.AddField(proofrequest.FieldNotBefore, field.TypeUint64, value)
+	}
+	if pru.mutation.NotBeforeCleared() {
+		_spec.ClearField(proofrequest.FieldNotBefore, field.TypeUint64)
+	}
+	if value, ok := pru.mutation.Cycles(); ok {
+		_spec.SetField(proofrequest.FieldCycles, field.TypeUint64, value)
+	}
+	if value, ok := pru.mutation.AddedCycles(); ok {
+		_spec.AddField(proofrequest.FieldCycles, field.TypeUint64, value)
+	}
+	if pru.mutation.CyclesCleared() {
+		_spec.ClearField(proofrequest.FieldCycles, field.TypeUint64)
+	}
+	if value, ok := pru.mutation.FulfilledTime(); ok {
+		_spec.SetField(proofrequest.FieldFulfilledTime, field.TypeUint64, value)
+	}
+	if value, ok := pru.mutation.AddedFulfilledTime(); ok {
+		_spec.AddField(proofrequest.FieldFulfilledTime, field.TypeUint64, value)
+	}
+	if pru.mutation.FulfilledTimeCleared() {
+		_spec.ClearField(proofrequest.FieldFulfilledTime, field.TypeUint64)
+	}
+	if value, ok := pru.mutation.SubmissionReverts(); ok {
+		_spec.SetField(proofrequest.FieldSubmissionReverts, field.TypeUint64, value)
+	}
+	if value, ok := pru.mutation.AddedSubmissionReverts(); ok {
+		_spec.AddField(proofrequest.FieldSubmissionReverts, field.TypeUint64, value)
+	}
+	if pru.mutation.SubmissionRevertsCleared() {
+		_spec.ClearField(proofrequest.FieldSubmissionReverts, field.TypeUint64)
+	}
+	if value, ok := pru.mutation.SubmissionResends(); ok {
+		_spec.SetField(proofrequest.FieldSubmissionResends, field.TypeUint64, value)
+	}
+	if value, ok := pru.mutation.AddedSubmissionResends(); ok {
+		_spec.AddField(proofrequest.FieldSubmissionResends, field.TypeUint64, value)
+	}
+	if pru.mutation.SubmissionResendsCleared() {
+		_spec.ClearField(proofrequest.FieldSubmissionResends, field.TypeUint64)
+	}
+	if value, ok := pru.mutation.SubmissionNonceResyncs(); ok {
+		_spec.SetField(proofrequest.FieldSubmissionNonceResyncs, field.TypeUint64, value)
+	}
+	if value, ok := pru.mutation.AddedSubmissionNonceResyncs(); ok {
+		_spec.AddField(proofrequest.FieldSubmissionNonceResyncs, field.TypeUint64, value)
+	}
+	if pru.mutation.SubmissionNonceResyncsCleared() {
+		_spec.ClearField(proofrequest.FieldSubmissionNonceResyncs, field.TypeUint64)
+	}
 	if value, ok := pru.mutation.L1BlockNumber(); ok {
 		_spec.SetField(proofrequest.FieldL1BlockNumber, field.TypeUint64, value)
 	}
@@ -364,255 +1109,1058 @@ func (pru *ProofRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pru.mutation.L1BlockHashCleared() {
 		_spec.ClearField(proofrequest.FieldL1BlockHash, field.TypeString)
 	}
+	if value, ok := pru.mutation.SatisfiedByTx(); ok {
+		_spec.SetField(proofrequest.FieldSatisfiedByTx, field.TypeString, value)
+	}
+	if pru.mutation.SatisfiedByTxCleared() {
+		_spec.ClearField(proofrequest.FieldSatisfiedByTx, field.TypeString)
+	}
 	if value, ok := pru.mutation.Proof(); ok {
 		_spec.SetField(proofrequest.FieldProof, field.TypeBytes, value)
 	}
 	if pru.mutation.ProofCleared() {
 		_spec.ClearField(proofrequest.FieldProof, field.TypeBytes)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{proofrequest.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	if value, ok := pru.mutation.StorageTier(); ok {
+		_spec.SetField(proofrequest.FieldStorageTier, field.TypeEnum, value)
+	}
+	if value, ok := pru.mutation.ColdStorageKey(); ok {
+		_spec.SetField(proofrequest.FieldColdStorageKey, field.TypeString, value)
+	}
+	if pru.mutation.ColdStorageKeyCleared() {
+		_spec.ClearField(proofrequest.FieldColdStorageKey, field.TypeString)
+	}
+	if value, ok := pru.mutation.ProofRef(); ok {
+		_spec.SetField(proofrequest.FieldProofRef, field.TypeString, value)
+	}
+	if pru.mutation.ProofRefCleared() {
+		_spec.ClearField(proofrequest.FieldProofRef, field.TypeString)
+	}
+	if value, ok := pru.mutation.RetrievalStatus(); ok {
+		_spec.SetField(proofrequest.FieldRetrievalStatus, field.TypeEnum, value)
+	}
+	if value, ok := pru.mutation.IpfsCid(); ok {
+		_spec.SetField(proofrequest.FieldIpfsCid, field.TypeString, value)
+	}
+	if pru.mutation.IpfsCidCleared() {
+		_spec.ClearField(proofrequest.FieldIpfsCid, field.TypeString)
+	}
+	if value, ok := pru.mutation.ProverBackend(); ok {
+		_spec.SetField(proofrequest.FieldProverBackend, field.TypeString, value)
+	}
+	if pru.mutation.ProverBackendCleared() {
+		_spec.ClearField(proofrequest.FieldProverBackend, field.TypeString)
+	}
+	if value, ok := pru.mutation.ErrorMessage(); ok {
+		_spec.SetField(proofrequest.FieldErrorMessage, field.TypeString, value)
+	}
+	if pru.mutation.ErrorMessageCleared() {
+		_spec.ClearField(proofrequest.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := pru.mutation.CreatedBy(); ok {
+		_spec.SetField(proofrequest.FieldCreatedBy, field.TypeString, value)
+	}
+	if pru.mutation.CreatedByCleared() {
+		_spec.ClearField(proofrequest.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := pru.mutation.RequestedBy(); ok {
+		_spec.SetField(proofrequest.FieldRequestedBy, field.TypeString, value)
+	}
+	if pru.mutation.RequestedByCleared() {
+		_spec.ClearField(proofrequest.FieldRequestedBy, field.TypeString)
+	}
+	if value, ok := pru.mutation.CompletedBy(); ok {
+		_spec.SetField(proofrequest.FieldCompletedBy, field.TypeString, value)
+	}
+	if pru.mutation.CompletedByCleared() {
+		_spec.ClearField(proofrequest.FieldCompletedBy, field.TypeString)
+	}
+	if value, ok := pru.mutation.Fee(); ok {
+		_spec.SetField(proofrequest.FieldFee, field.TypeString, value)
+	}
+	if pru.mutation.FeeCleared() {
+		_spec.ClearField(proofrequest.FieldFee, field.TypeString)
+	}
+	if value, ok := pru.mutation.Fulfiller(); ok {
+		_spec.SetField(proofrequest.FieldFulfiller, field.TypeString, value)
+	}
+	if pru.mutation.FulfillerCleared() {
+		_spec.ClearField(proofrequest.FieldFulfiller, field.TypeString)
+	}
+	if value, ok := pru.mutation.DowntimeCause(); ok {
+		_spec.SetField(proofrequest.FieldDowntimeCause, field.TypeString, value)
+	}
+	if pru.mutation.DowntimeCauseCleared() {
+		_spec.ClearField(proofrequest.FieldDowntimeCause, field.TypeString)
+	}
+	if value, ok := pru.mutation.L2BlockHash(); ok {
+		_spec.SetField(proofrequest.FieldL2BlockHash, field.TypeString, value)
+	}
+	if pru.mutation.L2BlockHashCleared() {
+		_spec.ClearField(proofrequest.FieldL2BlockHash, field.TypeString)
+	}
+	if pru.mutation.AggCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   proofrequest.AggTable,
+			Columns: []string{proofrequest.AggColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.AggIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   proofrequest.AggTable,
+			Columns: []string{proofrequest.AggColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pru.mutation.SpansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   proofrequest.SpansTable,
+			Columns: []string{proofrequest.SpansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.RemovedSpansIDs(); len(nodes) > 0 && !pru.mutation.SpansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   proofrequest.SpansTable,
+			Columns: []string{proofrequest.SpansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.SpansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   proofrequest.SpansTable,
+			Columns: []string{proofrequest.SpansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{proofrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// ProofRequestUpdateOne is the builder for updating a single ProofRequest entity.
+type ProofRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProofRequestMutation
+}
+
+// SetType sets the "type" field.
+func (pruo *ProofRequestUpdateOne) SetType(pr proofrequest.Type) *ProofRequestUpdateOne {
+	pruo.mutation.SetType(pr)
+	return pruo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableType(pr *proofrequest.Type) *ProofRequestUpdateOne {
+	if pr != nil {
+		pruo.SetType(*pr)
+	}
+	return pruo
+}
+
+// SetStartBlock sets the "start_block" field.
+func (pruo *ProofRequestUpdateOne) SetStartBlock(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetStartBlock()
+	pruo.mutation.SetStartBlock(u)
+	return pruo
+}
+
+// SetNillableStartBlock sets the "start_block" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableStartBlock(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetStartBlock(*u)
+	}
+	return pruo
+}
+
+// AddStartBlock adds u to the "start_block" field.
+func (pruo *ProofRequestUpdateOne) AddStartBlock(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddStartBlock(u)
+	return pruo
+}
+
+// SetEndBlock sets the "end_block" field.
+func (pruo *ProofRequestUpdateOne) SetEndBlock(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetEndBlock()
+	pruo.mutation.SetEndBlock(u)
+	return pruo
+}
+
+// SetNillableEndBlock sets the "end_block" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableEndBlock(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetEndBlock(*u)
+	}
+	return pruo
+}
+
+// AddEndBlock adds u to the "end_block" field.
+func (pruo *ProofRequestUpdateOne) AddEndBlock(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddEndBlock(u)
+	return pruo
+}
+
+// SetStatus sets the "status" field.
+func (pruo *ProofRequestUpdateOne) SetStatus(pr proofrequest.Status) *ProofRequestUpdateOne {
+	pruo.mutation.SetStatus(pr)
+	return pruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableStatus(pr *proofrequest.Status) *ProofRequestUpdateOne {
+	if pr != nil {
+		pruo.SetStatus(*pr)
+	}
+	return pruo
+}
+
+// SetRequestAddedTime sets the "request_added_time" field.
+func (pruo *ProofRequestUpdateOne) SetRequestAddedTime(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetRequestAddedTime()
+	pruo.mutation.SetRequestAddedTime(u)
+	return pruo
+}
+
+// SetNillableRequestAddedTime sets the "request_added_time" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableRequestAddedTime(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetRequestAddedTime(*u)
+	}
+	return pruo
+}
+
+// AddRequestAddedTime adds u to the "request_added_time" field.
+func (pruo *ProofRequestUpdateOne) AddRequestAddedTime(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddRequestAddedTime(u)
+	return pruo
+}
+
+// SetProverRequestID sets the "prover_request_id" field.
+func (pruo *ProofRequestUpdateOne) SetProverRequestID(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetProverRequestID(s)
+	return pruo
+}
+
+// SetNillableProverRequestID sets the "prover_request_id" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableProverRequestID(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetProverRequestID(*s)
+	}
+	return pruo
+}
+
+// ClearProverRequestID clears the value of the "prover_request_id" field.
+func (pruo *ProofRequestUpdateOne) ClearProverRequestID() *ProofRequestUpdateOne {
+	pruo.mutation.ClearProverRequestID()
+	return pruo
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (pruo *ProofRequestUpdateOne) SetIdempotencyKey(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetIdempotencyKey(s)
+	return pruo
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableIdempotencyKey(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetIdempotencyKey(*s)
+	}
+	return pruo
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (pruo *ProofRequestUpdateOne) ClearIdempotencyKey() *ProofRequestUpdateOne {
+	pruo.mutation.ClearIdempotencyKey()
+	return pruo
+}
+
+// SetExternalRef sets the "external_ref" field.
+func (pruo *ProofRequestUpdateOne) SetExternalRef(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetExternalRef(s)
+	return pruo
+}
+
+// SetNillableExternalRef sets the "external_ref" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableExternalRef(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetExternalRef(*s)
+	}
+	return pruo
+}
+
+// ClearExternalRef clears the value of the "external_ref" field.
+func (pruo *ProofRequestUpdateOne) ClearExternalRef() *ProofRequestUpdateOne {
+	pruo.mutation.ClearExternalRef()
+	return pruo
+}
+
+// SetWitnessArtifactID sets the "witness_artifact_id" field.
+func (pruo *ProofRequestUpdateOne) SetWitnessArtifactID(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetWitnessArtifactID(s)
+	return pruo
+}
+
+// SetNillableWitnessArtifactID sets the "witness_artifact_id" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableWitnessArtifactID(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetWitnessArtifactID(*s)
+	}
+	return pruo
+}
+
+// ClearWitnessArtifactID clears the value of the "witness_artifact_id" field.
+func (pruo *ProofRequestUpdateOne) ClearWitnessArtifactID() *ProofRequestUpdateOne {
+	pruo.mutation.ClearWitnessArtifactID()
+	return pruo
+}
+
+// SetAggRequestID sets the "agg_request_id" field.
+func (pruo *ProofRequestUpdateOne) SetAggRequestID(i int) *ProofRequestUpdateOne {
+	pruo.mutation.SetAggRequestID(i)
+	return pruo
+}
+
+// SetNillableAggRequestID sets the "agg_request_id" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableAggRequestID(i *int) *ProofRequestUpdateOne {
+	if i != nil {
+		pruo.SetAggRequestID(*i)
+	}
+	return pruo
+}
+
+// ClearAggRequestID clears the value of the "agg_request_id" field.
+func (pruo *ProofRequestUpdateOne) ClearAggRequestID() *ProofRequestUpdateOne {
+	pruo.mutation.ClearAggRequestID()
+	return pruo
+}
+
+// SetProofRequestTime sets the "proof_request_time" field.
+func (pruo *ProofRequestUpdateOne) SetProofRequestTime(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetProofRequestTime()
+	pruo.mutation.SetProofRequestTime(u)
+	return pruo
+}
+
+// SetNillableProofRequestTime sets the "proof_request_time" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableProofRequestTime(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetProofRequestTime(*u)
+	}
+	return pruo
+}
+
+// AddProofRequestTime adds u to the "proof_request_time" field.
+func (pruo *ProofRequestUpdateOne) AddProofRequestTime(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddProofRequestTime(u)
+	return pruo
+}
+
+// ClearProofRequestTime clears the value of the "proof_request_time" field.
+func (pruo *ProofRequestUpdateOne) ClearProofRequestTime() *ProofRequestUpdateOne {
+	pruo.mutation.ClearProofRequestTime()
+	return pruo
+}
+
+// SetLastUpdatedTime sets the "last_updated_time" field.
+func (pruo *ProofRequestUpdateOne) SetLastUpdatedTime(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetLastUpdatedTime()
+	pruo.mutation.SetLastUpdatedTime(u)
+	return pruo
+}
+
+// SetNillableLastUpdatedTime sets the "last_updated_time" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableLastUpdatedTime(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetLastUpdatedTime(*u)
+	}
+	return pruo
+}
+
+// AddLastUpdatedTime adds u to the "last_updated_time" field.
+func (pruo *ProofRequestUpdateOne) AddLastUpdatedTime(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddLastUpdatedTime(u)
+	return pruo
+}
+
+// SetProofTimeout sets the "proof_timeout" field.
+func (pruo *ProofRequestUpdateOne) SetProofTimeout(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetProofTimeout()
+	pruo.mutation.SetProofTimeout(u)
+	return pruo
+}
+
+// SetNillableProofTimeout sets the "proof_timeout" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableProofTimeout(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetProofTimeout(*u)
+	}
+	return pruo
+}
+
+// AddProofTimeout adds u to the "proof_timeout" field.
+func (pruo *ProofRequestUpdateOne) AddProofTimeout(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddProofTimeout(u)
+	return pruo
+}
+
+// ClearProofTimeout clears the value of the "proof_timeout" field.
+func (pruo *ProofRequestUpdateOne) ClearProofTimeout() *ProofRequestUpdateOne {
+	pruo.mutation.ClearProofTimeout()
+	return pruo
+}
+
+// SetAttempts sets the "attempts" field.
+func (pruo *ProofRequestUpdateOne) SetAttempts(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetAttempts()
+	pruo.mutation.SetAttempts(u)
+	return pruo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableAttempts(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetAttempts(*u)
+	}
+	return pruo
+}
+
+// AddAttempts adds u to the "attempts" field.
+func (pruo *ProofRequestUpdateOne) AddAttempts(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddAttempts(u)
+	return pruo
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (pruo *ProofRequestUpdateOne) ClearAttempts() *ProofRequestUpdateOne {
+	pruo.mutation.ClearAttempts()
+	return pruo
+}
+
+// SetNotBefore sets the "not_before" field.
+func (pruo *ProofRequestUpdateOne) SetNotBefore(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetNotBefore()
+	pruo.mutation.SetNotBefore(u)
+	return pruo
+}
+
+// SetNillableNotBefore sets the "not_before" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableNotBefore(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetNotBefore(*u)
+	}
+	return pruo
+}
+
+// AddNotBefore adds u to the "not_before" field.
+func (pruo *ProofRequestUpdateOne) AddNotBefore(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddNotBefore(u)
+	return pruo
+}
+
+// ClearNotBefore clears the value of the "not_before" field.
+func (pruo *ProofRequestUpdateOne) ClearNotBefore() *ProofRequestUpdateOne {
+	pruo.mutation.ClearNotBefore()
+	return pruo
+}
+
+// SetCycles sets the "cycles" field.
+func (pruo *ProofRequestUpdateOne) SetCycles(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetCycles()
+	pruo.mutation.SetCycles(u)
+	return pruo
+}
+
+// SetNillableCycles sets the "cycles" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableCycles(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetCycles(*u)
+	}
+	return pruo
+}
+
+// AddCycles adds u to the "cycles" field.
+func (pruo *ProofRequestUpdateOne) AddCycles(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddCycles(u)
+	return pruo
+}
+
+// ClearCycles clears the value of the "cycles" field.
+func (pruo *ProofRequestUpdateOne) ClearCycles() *ProofRequestUpdateOne {
+	pruo.mutation.ClearCycles()
+	return pruo
+}
+
+// SetFulfilledTime sets the "fulfilled_time" field.
+func (pruo *ProofRequestUpdateOne) SetFulfilledTime(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetFulfilledTime()
+	pruo.mutation.SetFulfilledTime(u)
+	return pruo
+}
+
+// SetNillableFulfilledTime sets the "fulfilled_time" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableFulfilledTime(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetFulfilledTime(*u)
+	}
+	return pruo
+}
+
+// AddFulfilledTime adds u to the "fulfilled_time" field.
+func (pruo *ProofRequestUpdateOne) AddFulfilledTime(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddFulfilledTime(u)
+	return pruo
+}
+
+// ClearFulfilledTime clears the value of the "fulfilled_time" field.
+func (pruo *ProofRequestUpdateOne) ClearFulfilledTime() *ProofRequestUpdateOne {
+	pruo.mutation.ClearFulfilledTime()
+	return pruo
+}
+
+// SetSubmissionReverts sets the "submission_reverts" field.
+func (pruo *ProofRequestUpdateOne) SetSubmissionReverts(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetSubmissionReverts()
+	pruo.mutation.SetSubmissionReverts(u)
+	return pruo
+}
+
+// SetNillableSubmissionReverts sets the "submission_reverts" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableSubmissionReverts(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetSubmissionReverts(*u)
+	}
+	return pruo
+}
+
+// AddSubmissionReverts adds u to the "submission_reverts" field.
+func (pruo *ProofRequestUpdateOne) AddSubmissionReverts(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddSubmissionReverts(u)
+	return pruo
+}
+
+// ClearSubmissionReverts clears the value of the "submission_reverts" field.
+func (pruo *ProofRequestUpdateOne) ClearSubmissionReverts() *ProofRequestUpdateOne {
+	pruo.mutation.ClearSubmissionReverts()
+	return pruo
+}
+
+// SetSubmissionResends sets the "submission_resends" field.
+func (pruo *ProofRequestUpdateOne) SetSubmissionResends(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetSubmissionResends()
+	pruo.mutation.SetSubmissionResends(u)
+	return pruo
+}
+
+// SetNillableSubmissionResends sets the "submission_resends" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableSubmissionResends(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetSubmissionResends(*u)
+	}
+	return pruo
+}
+
+// AddSubmissionResends adds u to the "submission_resends" field.
+func (pruo *ProofRequestUpdateOne) AddSubmissionResends(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddSubmissionResends(u)
+	return pruo
+}
+
+// ClearSubmissionResends clears the value of the "submission_resends" field.
+func (pruo *ProofRequestUpdateOne) ClearSubmissionResends() *ProofRequestUpdateOne {
+	pruo.mutation.ClearSubmissionResends()
+	return pruo
+}
+
+// SetSubmissionNonceResyncs sets the "submission_nonce_resyncs" field.
+func (pruo *ProofRequestUpdateOne) SetSubmissionNonceResyncs(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetSubmissionNonceResyncs()
+	pruo.mutation.SetSubmissionNonceResyncs(u)
+	return pruo
+}
+
+// SetNillableSubmissionNonceResyncs sets the "submission_nonce_resyncs" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableSubmissionNonceResyncs(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetSubmissionNonceResyncs(*u)
+	}
+	return pruo
+}
+
+// AddSubmissionNonceResyncs adds u to the "submission_nonce_resyncs" field.
+func (pruo *ProofRequestUpdateOne) AddSubmissionNonceResyncs(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddSubmissionNonceResyncs(u)
+	return pruo
+}
+
+// ClearSubmissionNonceResyncs clears the value of the "submission_nonce_resyncs" field.
+func (pruo *ProofRequestUpdateOne) ClearSubmissionNonceResyncs() *ProofRequestUpdateOne {
+	pruo.mutation.ClearSubmissionNonceResyncs()
+	return pruo
+}
+
+// SetL1BlockNumber sets the "l1_block_number" field.
+func (pruo *ProofRequestUpdateOne) SetL1BlockNumber(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetL1BlockNumber()
+	pruo.mutation.SetL1BlockNumber(u)
+	return pruo
+}
+
+// SetNillableL1BlockNumber sets the "l1_block_number" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableL1BlockNumber(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetL1BlockNumber(*u)
+	}
+	return pruo
+}
+
+// AddL1BlockNumber adds u to the "l1_block_number" field.
+func (pruo *ProofRequestUpdateOne) AddL1BlockNumber(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddL1BlockNumber(u)
+	return pruo
+}
+
+// ClearL1BlockNumber clears the value of the "l1_block_number" field.
+func (pruo *ProofRequestUpdateOne) ClearL1BlockNumber() *ProofRequestUpdateOne {
+	pruo.mutation.ClearL1BlockNumber()
+	return pruo
+}
+
+// SetL1BlockHash sets the "l1_block_hash" field.
+func (pruo *ProofRequestUpdateOne) SetL1BlockHash(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetL1BlockHash(s)
+	return pruo
+}
+
+// SetNillableL1BlockHash sets the "l1_block_hash" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableL1BlockHash(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetL1BlockHash(*s)
+	}
+	return pruo
+}
+
+// ClearL1BlockHash clears the value of the "l1_block_hash" field.
+func (pruo *ProofRequestUpdateOne) ClearL1BlockHash() *ProofRequestUpdateOne {
+	pruo.mutation.ClearL1BlockHash()
+	return pruo
+}
+
+// SetSatisfiedByTx sets the "satisfied_by_tx" field.
+func (pruo *ProofRequestUpdateOne) SetSatisfiedByTx(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetSatisfiedByTx(s)
+	return pruo
+}
+
+// SetNillableSatisfiedByTx sets the "satisfied_by_tx" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableSatisfiedByTx(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetSatisfiedByTx(*s)
+	}
+	return pruo
+}
+
+// ClearSatisfiedByTx clears the value of the "satisfied_by_tx" field.
+func (pruo *ProofRequestUpdateOne) ClearSatisfiedByTx() *ProofRequestUpdateOne {
+	pruo.mutation.ClearSatisfiedByTx()
+	return pruo
+}
+
+// SetProof sets the "proof" field.
+func (pruo *ProofRequestUpdateOne) SetProof(b []byte) *ProofRequestUpdateOne {
+	pruo.mutation.SetProof(b)
+	return pruo
+}
+
+// ClearProof clears the value of the "proof" field.
+func (pruo *ProofRequestUpdateOne) ClearProof() *ProofRequestUpdateOne {
+	pruo.mutation.ClearProof()
+	return pruo
+}
+
+// SetStorageTier sets the "storage_tier" field.
+func (pruo *ProofRequestUpdateOne) SetStorageTier(pt proofrequest.StorageTier) *ProofRequestUpdateOne {
+	pruo.mutation.SetStorageTier(pt)
+	return pruo
+}
+
+// SetNillableStorageTier sets the "storage_tier" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableStorageTier(pt *proofrequest.StorageTier) *ProofRequestUpdateOne {
+	if pt != nil {
+		pruo.SetStorageTier(*pt)
+	}
+	return pruo
+}
+
+// SetColdStorageKey sets the "cold_storage_key" field.
+func (pruo *ProofRequestUpdateOne) SetColdStorageKey(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetColdStorageKey(s)
+	return pruo
+}
+
+// SetNillableColdStorageKey sets the "cold_storage_key" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableColdStorageKey(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetColdStorageKey(*s)
 	}
-	pru.mutation.done = true
-	return n, nil
+	return pruo
 }
 
-// ProofRequestUpdateOne is the builder for updating a single ProofRequest entity.
-type ProofRequestUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ProofRequestMutation
+// ClearColdStorageKey clears the value of the "cold_storage_key" field.
+func (pruo *ProofRequestUpdateOne) ClearColdStorageKey() *ProofRequestUpdateOne {
+	pruo.mutation.ClearColdStorageKey()
+	return pruo
 }
 
-// SetType sets the "type" field.
-func (pruo *ProofRequestUpdateOne) SetType(pr proofrequest.Type) *ProofRequestUpdateOne {
-	pruo.mutation.SetType(pr)
+// SetProofRef sets the "proof_ref" field.
+func (pruo *ProofRequestUpdateOne) SetProofRef(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetProofRef(s)
 	return pruo
 }
 
-// SetNillableType sets the "type" field if the given value is not nil.
-func (pruo *ProofRequestUpdateOne) SetNillableType(pr *proofrequest.Type) *ProofRequestUpdateOne {
-	if pr != nil {
-		pruo.SetType(*pr)
+// SetNillableProofRef sets the "proof_ref" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableProofRef(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetProofRef(*s)
 	}
 	return pruo
 }
 
-// SetStartBlock sets the "start_block" field.
-func (pruo *ProofRequestUpdateOne) SetStartBlock(u uint64) *ProofRequestUpdateOne {
-	pruo.mutation.ResetStartBlock()
-	pruo.mutation.SetStartBlock(u)
+// ClearProofRef clears the value of the "proof_ref" field.
+func (pruo *ProofRequestUpdateOne) ClearProofRef() *ProofRequestUpdateOne {
+	pruo.mutation.ClearProofRef()
 	return pruo
 }
 
-// SetNillableStartBlock sets the "start_block" field if the given value is not nil.
-func (pruo *ProofRequestUpdateOne) SetNillableStartBlock(u *uint64) *ProofRequestUpdateOne {
-	if u != nil {
-		pruo.SetStartBlock(*u)
-	}
+// SetRetrievalStatus sets the "retrieval_status" field.
+func (pruo *ProofRequestUpdateOne) SetRetrievalStatus(ps proofrequest.RetrievalStatus) *ProofRequestUpdateOne {
+	pruo.mutation.SetRetrievalStatus(ps)
 	return pruo
 }
 
-// AddStartBlock adds u to the "start_block" field.
-func (pruo *ProofRequestUpdateOne) AddStartBlock(u int64) *ProofRequestUpdateOne {
-	pruo.mutation.AddStartBlock(u)
+// SetNillableRetrievalStatus sets the "retrieval_status" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableRetrievalStatus(ps *proofrequest.RetrievalStatus) *ProofRequestUpdateOne {
+	if ps != nil {
+		pruo.SetRetrievalStatus(*ps)
+	}
 	return pruo
 }
 
-// SetEndBlock sets the "end_block" field.
-func (pruo *ProofRequestUpdateOne) SetEndBlock(u uint64) *ProofRequestUpdateOne {
-	pruo.mutation.ResetEndBlock()
-	pruo.mutation.SetEndBlock(u)
+// SetIpfsCid sets the "ipfs_cid" field.
+func (pruo *ProofRequestUpdateOne) SetIpfsCid(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetIpfsCid(s)
 	return pruo
 }
 
-// SetNillableEndBlock sets the "end_block" field if the given value is not nil.
-func (pruo *ProofRequestUpdateOne) SetNillableEndBlock(u *uint64) *ProofRequestUpdateOne {
-	if u != nil {
-		pruo.SetEndBlock(*u)
+// SetNillableIpfsCid sets the "ipfs_cid" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableIpfsCid(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetIpfsCid(*s)
 	}
 	return pruo
 }
 
-// AddEndBlock adds u to the "end_block" field.
-func (pruo *ProofRequestUpdateOne) AddEndBlock(u int64) *ProofRequestUpdateOne {
-	pruo.mutation.AddEndBlock(u)
+// ClearIpfsCid clears the value of the "ipfs_cid" field.
+func (pruo *ProofRequestUpdateOne) ClearIpfsCid() *ProofRequestUpdateOne {
+	pruo.mutation.ClearIpfsCid()
 	return pruo
 }
 
-// SetStatus sets the "status" field.
-func (pruo *ProofRequestUpdateOne) SetStatus(pr proofrequest.Status) *ProofRequestUpdateOne {
-	pruo.mutation.SetStatus(pr)
+// SetProverBackend sets the "prover_backend" field.
+func (pruo *ProofRequestUpdateOne) SetProverBackend(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetProverBackend(s)
 	return pruo
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (pruo *ProofRequestUpdateOne) SetNillableStatus(pr *proofrequest.Status) *ProofRequestUpdateOne {
-	if pr != nil {
-		pruo.SetStatus(*pr)
+// SetNillableProverBackend sets the "prover_backend" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableProverBackend(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetProverBackend(*s)
 	}
 	return pruo
 }
 
-// SetRequestAddedTime sets the "request_added_time" field.
-func (pruo *ProofRequestUpdateOne) SetRequestAddedTime(u uint64) *ProofRequestUpdateOne {
-	pruo.mutation.ResetRequestAddedTime()
-	pruo.mutation.SetRequestAddedTime(u)
+// ClearProverBackend clears the value of the "prover_backend" field.
+func (pruo *ProofRequestUpdateOne) ClearProverBackend() *ProofRequestUpdateOne {
+	pruo.mutation.ClearProverBackend()
 	return pruo
 }
 
-// SetNillableRequestAddedTime sets the "request_added_time" field if the given value is not nil.
-func (pruo *ProofRequestUpdateOne) SetNillableRequestAddedTime(u *uint64) *ProofRequestUpdateOne {
-	if u != nil {
-		pruo.SetRequestAddedTime(*u)
+// SetErrorMessage sets the "error_message" field.
+func (pruo *ProofRequestUpdateOne) SetErrorMessage(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetErrorMessage(s)
+	return pruo
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableErrorMessage(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetErrorMessage(*s)
 	}
 	return pruo
 }
 
-// AddRequestAddedTime adds u to the "request_added_time" field.
-func (pruo *ProofRequestUpdateOne) AddRequestAddedTime(u int64) *ProofRequestUpdateOne {
-	pruo.mutation.AddRequestAddedTime(u)
+// ClearErrorMessage clears the value of the "error_message" field.
+func (pruo *ProofRequestUpdateOne) ClearErrorMessage() *ProofRequestUpdateOne {
+	pruo.mutation.ClearErrorMessage()
 	return pruo
 }
 
-// SetProverRequestID sets the "prover_request_id" field.
-func (pruo *ProofRequestUpdateOne) SetProverRequestID(s string) *ProofRequestUpdateOne {
-	pruo.mutation.SetProverRequestID(s)
+// SetCreatedBy sets the "created_by" field.
+func (pruo *ProofRequestUpdateOne) SetCreatedBy(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetCreatedBy(s)
 	return pruo
 }
 
-// SetNillableProverRequestID sets the "prover_request_id" field if the given value is not nil.
-func (pruo *ProofRequestUpdateOne) SetNillableProverRequestID(s *string) *ProofRequestUpdateOne {
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableCreatedBy(s *string) *ProofRequestUpdateOne {
 	if s != nil {
-		pruo.SetProverRequestID(*s)
+		pruo.SetCreatedBy(*s)
 	}
 	return pruo
 }
 
-// ClearProverRequestID clears the value of the "prover_request_id" field.
-func (pruo *ProofRequestUpdateOne) ClearProverRequestID() *ProofRequestUpdateOne {
-	pruo.mutation.ClearProverRequestID()
+// ClearCreatedBy clears the value of the "created_by" field.
+func (pruo *ProofRequestUpdateOne) ClearCreatedBy() *ProofRequestUpdateOne {
+	pruo.mutation.ClearCreatedBy()
 	return pruo
 }
 
-// SetProofRequestTime sets the "proof_request_time" field.
-func (pruo *ProofRequestUpdateOne) SetProofRequestTime(u uint64) *ProofRequestUpdateOne {
-	pruo.mutation.ResetProofRequestTime()
-	pruo.mutation.SetProofRequestTime(u)
+// SetRequestedBy sets the "requested_by" field.
+func (pruo *ProofRequestUpdateOne) SetRequestedBy(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetRequestedBy(s)
 	return pruo
 }
 
-// SetNillableProofRequestTime sets the "proof_request_time" field if the given value is not nil.
-func (pruo *ProofRequestUpdateOne) SetNillableProofRequestTime(u *uint64) *ProofRequestUpdateOne {
-	if u != nil {
-		pruo.SetProofRequestTime(*u)
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableRequestedBy(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetRequestedBy(*s)
 	}
 	return pruo
 }
 
-// AddProofRequestTime adds u to the "proof_request_time" field.
-func (pruo *ProofRequestUpdateOne) AddProofRequestTime(u int64) *ProofRequestUpdateOne {
-	pruo.mutation.AddProofRequestTime(u)
+// ClearRequestedBy clears the value of the "requested_by" field.
+func (pruo *ProofRequestUpdateOne) ClearRequestedBy() *ProofRequestUpdateOne {
+	pruo.mutation.ClearRequestedBy()
 	return pruo
 }
 
-// ClearProofRequestTime clears the value of the "proof_request_time" field.
-func (pruo *ProofRequestUpdateOne) ClearProofRequestTime() *ProofRequestUpdateOne {
-	pruo.mutation.ClearProofRequestTime()
+// SetCompletedBy sets the "completed_by" field.
+func (pruo *ProofRequestUpdateOne) SetCompletedBy(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetCompletedBy(s)
 	return pruo
 }
 
-// SetLastUpdatedTime sets the "last_updated_time" field.
-func (pruo *ProofRequestUpdateOne) SetLastUpdatedTime(u uint64) *ProofRequestUpdateOne {
-	pruo.mutation.ResetLastUpdatedTime()
-	pruo.mutation.SetLastUpdatedTime(u)
+// SetNillableCompletedBy sets the "completed_by" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableCompletedBy(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetCompletedBy(*s)
+	}
 	return pruo
 }
 
-// SetNillableLastUpdatedTime sets the "last_updated_time" field if the given value is not nil.
-func (pruo *ProofRequestUpdateOne) SetNillableLastUpdatedTime(u *uint64) *ProofRequestUpdateOne {
-	if u != nil {
-		pruo.SetLastUpdatedTime(*u)
+// ClearCompletedBy clears the value of the "completed_by" field.
+func (pruo *ProofRequestUpdateOne) ClearCompletedBy() *ProofRequestUpdateOne {
+	pruo.mutation.ClearCompletedBy()
+	return pruo
+}
+
+// SetFee sets the "fee" field.
+func (pruo *ProofRequestUpdateOne) SetFee(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetFee(s)
+	return pruo
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableFee(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetFee(*s)
 	}
 	return pruo
 }
 
-// AddLastUpdatedTime adds u to the "last_updated_time" field.
-func (pruo *ProofRequestUpdateOne) AddLastUpdatedTime(u int64) *ProofRequestUpdateOne {
-	pruo.mutation.AddLastUpdatedTime(u)
+// ClearFee clears the value of the "fee" field.
+func (pruo *ProofRequestUpdateOne) ClearFee() *ProofRequestUpdateOne {
+	pruo.mutation.ClearFee()
 	return pruo
 }
 
-// SetL1BlockNumber sets the "l1_block_number" field.
-func (pruo *ProofRequestUpdateOne) SetL1BlockNumber(u uint64) *ProofRequestUpdateOne {
-	pruo.mutation.ResetL1BlockNumber()
-	pruo.mutation.SetL1BlockNumber(u)
+// SetFulfiller sets the "fulfiller" field.
+func (pruo *ProofRequestUpdateOne) SetFulfiller(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetFulfiller(s)
 	return pruo
 }
 
-// SetNillableL1BlockNumber sets the "l1_block_number" field if the given value is not nil.
-func (pruo *ProofRequestUpdateOne) SetNillableL1BlockNumber(u *uint64) *ProofRequestUpdateOne {
-	if u != nil {
-		pruo.SetL1BlockNumber(*u)
+// SetNillableFulfiller sets the "fulfiller" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableFulfiller(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetFulfiller(*s)
 	}
 	return pruo
 }
 
-// AddL1BlockNumber adds u to the "l1_block_number" field.
-func (pruo *ProofRequestUpdateOne) AddL1BlockNumber(u int64) *ProofRequestUpdateOne {
-	pruo.mutation.AddL1BlockNumber(u)
+// ClearFulfiller clears the value of the "fulfiller" field.
+func (pruo *ProofRequestUpdateOne) ClearFulfiller() *ProofRequestUpdateOne {
+	pruo.mutation.ClearFulfiller()
 	return pruo
 }
 
-// ClearL1BlockNumber clears the value of the "l1_block_number" field.
-func (pruo *ProofRequestUpdateOne) ClearL1BlockNumber() *ProofRequestUpdateOne {
-	pruo.mutation.ClearL1BlockNumber()
+// SetDowntimeCause sets the "downtime_cause" field.
+func (pruo *ProofRequestUpdateOne) SetDowntimeCause(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetDowntimeCause(s)
 	return pruo
 }
 
-// SetL1BlockHash sets the "l1_block_hash" field.
-func (pruo *ProofRequestUpdateOne) SetL1BlockHash(s string) *ProofRequestUpdateOne {
-	pruo.mutation.SetL1BlockHash(s)
+// SetNillableDowntimeCause sets the "downtime_cause" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableDowntimeCause(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetDowntimeCause(*s)
+	}
 	return pruo
 }
 
-// SetNillableL1BlockHash sets the "l1_block_hash" field if the given value is not nil.
-func (pruo *ProofRequestUpdateOne) SetNillableL1BlockHash(s *string) *ProofRequestUpdateOne {
+// ClearDowntimeCause clears the value of the "downtime_cause" field.
+func (pruo *ProofRequestUpdateOne) ClearDowntimeCause() *ProofRequestUpdateOne {
+	pruo.mutation.ClearDowntimeCause()
+	return pruo
+}
+
+// SetL2BlockHash sets the "l2_block_hash" field.
+func (pruo *ProofRequestUpdateOne) SetL2BlockHash(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetL2BlockHash(s)
+	return pruo
+}
+
+// SetNillableL2BlockHash sets the "l2_block_hash" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableL2BlockHash(s *string) *ProofRequestUpdateOne {
 	if s != nil {
-		pruo.SetL1BlockHash(*s)
+		pruo.SetL2BlockHash(*s)
 	}
 	return pruo
 }
 
-// ClearL1BlockHash clears the value of the "l1_block_hash" field.
-func (pruo *ProofRequestUpdateOne) ClearL1BlockHash() *ProofRequestUpdateOne {
-	pruo.mutation.ClearL1BlockHash()
+// ClearL2BlockHash clears the value of the "l2_block_hash" field.
+func (pruo *ProofRequestUpdateOne) ClearL2BlockHash() *ProofRequestUpdateOne {
+	pruo.mutation.ClearL2BlockHash()
 	return pruo
 }
 
-// SetProof sets the "proof" field.
-func (pruo *ProofRequestUpdateOne) SetProof(b []byte) *ProofRequestUpdateOne {
-	pruo.mutation.SetProof(b)
+// SetAggID sets the "agg" edge to the ProofRequest entity by ID.
+func (pruo *ProofRequestUpdateOne) SetAggID(id int) *ProofRequestUpdateOne {
+	pruo.mutation.SetAggID(id)
 	return pruo
 }
 
-// ClearProof clears the value of the "proof" field.
-func (pruo *ProofRequestUpdateOne) ClearProof() *ProofRequestUpdateOne {
-	pruo.mutation.ClearProof()
+// SetNillableAggID sets the "agg" edge to the ProofRequest entity by ID if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableAggID(id *int) *ProofRequestUpdateOne {
+	if id != nil {
+		pruo = pruo.SetAggID(*id)
+	}
+	return pruo
+}
+
+// SetAgg sets the "agg" edge to the ProofRequest entity.
+func (pruo *ProofRequestUpdateOne) SetAgg(p *ProofRequest) *ProofRequestUpdateOne {
+	return pruo.SetAggID(p.ID)
+}
+
+// AddSpanIDs adds the "spans" edge to the ProofRequest entity by IDs.
+func (pruo *ProofRequestUpdateOne) AddSpanIDs(ids ...int) *ProofRequestUpdateOne {
+	pruo.mutation.AddSpanIDs(ids...)
 	return pruo
 }
 
+// AddSpans adds the "spans" edges to the ProofRequest entity.
+func (pruo *ProofRequestUpdateOne) AddSpans(p ...*ProofRequest) *ProofRequestUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pruo.AddSpanIDs(ids...)
+}
+
 // Mutation returns the ProofRequestMutation object of the builder.
 func (pruo *ProofRequestUpdateOne) Mutation() *ProofRequestMutation {
 	return pruo.mutation
 }
 
+// ClearAgg clears the "agg" edge to the ProofRequest entity.
+func (pruo *ProofRequestUpdateOne) ClearAgg() *ProofRequestUpdateOne {
+	pruo.mutation.ClearAgg()
+	return pruo
+}
+
+// ClearSpans clears all "spans" edges to the ProofRequest entity.
+func (pruo *ProofRequestUpdateOne) ClearSpans() *ProofRequestUpdateOne {
+	pruo.mutation.ClearSpans()
+	return pruo
+}
+
+// RemoveSpanIDs removes the "spans" edge to ProofRequest entities by IDs.
+func (pruo *ProofRequestUpdateOne) RemoveSpanIDs(ids ...int) *ProofRequestUpdateOne {
+	pruo.mutation.RemoveSpanIDs(ids...)
+	return pruo
+}
+
+// RemoveSpans removes "spans" edges to ProofRequest entities.
+func (pruo *ProofRequestUpdateOne) RemoveSpans(p ...*ProofRequest) *ProofRequestUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pruo.RemoveSpanIDs(ids...)
+}
+
 // Where appends a list predicates to the ProofRequestUpdate builder.
 func (pruo *ProofRequestUpdateOne) Where(ps ...predicate.ProofRequest) *ProofRequestUpdateOne {
 	pruo.mutation.Where(ps...)
@@ -665,6 +2213,16 @@ func (pruo *ProofRequestUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.status": %w`, err)}
 		}
 	}
+	if v, ok := pruo.mutation.StorageTier(); ok {
+		if err := proofrequest.StorageTierValidator(v); err != nil {
+			return &ValidationError{Name: "storage_tier", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.storage_tier": %w`, err)}
+		}
+	}
+	if v, ok := pruo.mutation.RetrievalStatus(); ok {
+		if err := proofrequest.RetrievalStatusValidator(v); err != nil {
+			return &ValidationError{Name: "retrieval_status", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.retrieval_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -727,6 +2285,24 @@ func (pruo *ProofRequestUpdateOne) sqlSave(ctx context.Context) (_node *ProofReq
 	if pruo.mutation.ProverRequestIDCleared() {
 		_spec.ClearField(proofrequest.FieldProverRequestID, field.TypeString)
 	}
+	if value, ok := pruo.mutation.IdempotencyKey(); ok {
+		_spec.SetField(proofrequest.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if pruo.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(proofrequest.FieldIdempotencyKey, field.TypeString)
+	}
+	if value, ok := pruo.mutation.ExternalRef(); ok {
+		_spec.SetField(proofrequest.FieldExternalRef, field.TypeString, value)
+	}
+	if pruo.mutation.ExternalRefCleared() {
+		_spec.ClearField(proofrequest.FieldExternalRef, field.TypeString)
+	}
+	if value, ok := pruo.mutation.WitnessArtifactID(); ok {
+		_spec.SetField(proofrequest.FieldWitnessArtifactID, field.TypeString, value)
+	}
+	if pruo.mutation.WitnessArtifactIDCleared() {
+		_spec.ClearField(proofrequest.FieldWitnessArtifactID, field.TypeString)
+	}
 	if value, ok := pruo.mutation.ProofRequestTime(); ok {
 		_spec.SetField(proofrequest.FieldProofRequestTime, field.TypeUint64, value)
 	}
@@ -742,6 +2318,78 @@ func (pruo *ProofRequestUpdateOne) sqlSave(ctx context.Context) (_node *ProofReq
 	if value, ok := pruo.mutation.AddedLastUpdatedTime(); ok {
 		_spec.AddField(proofrequest.FieldLastUpdatedTime, field.TypeUint64, value)
 	}
+	if value, ok := pruo.mutation.ProofTimeout(); ok {
+		_spec.SetField(proofrequest.FieldProofTimeout, field.TypeUint64, value)
+	}
+	if value, ok := pruo.mutation.AddedProofTimeout(); ok {
+		_spec.AddField(proofrequest.FieldProofTimeout, field.TypeUint64, value)
+	}
+	if pruo.mutation.ProofTimeoutCleared() {
+		_spec.ClearField(proofrequest.FieldProofTimeout, field.TypeUint64)
+	}
+	if value, ok := pruo.mutation.Attempts(); ok {
+		_spec.SetField(proofrequest.FieldAttempts, field.TypeUint64, value)
+	}
+	if value, ok := pruo.mutation.AddedAttempts(); ok {
+		_spec.AddField(proofrequest.FieldAttempts, field.TypeUint64, value)
+	}
+	if pruo.mutation.AttemptsCleared() {
+		_spec.ClearField(proofrequest.FieldAttempts, field.TypeUint64)
+	}
+	if value, ok := pruo.mutation.NotBefore(); ok {
+		_spec.SetField(proofrequest.FieldNotBefore, field.TypeUint64, value)
+	}
+	if value, ok := pruo.mutation.AddedNotBefore(); ok {
+		_spec.AddField(proofrequest.FieldNotBefore, field.TypeUint64, value)
+	}
+	if pruo.mutation.NotBeforeCleared() {
+		_spec.ClearField(proofrequest.FieldNotBefore, field.TypeUint64)
+	}
+	if value, ok := pruo.mutation.Cycles(); ok {
+		_spec.SetField(proofrequest.FieldCycles, field.TypeUint64, value)
+	}
+	if value, ok := pruo.mutation.AddedCycles(); ok {
+		_spec.AddField(proofrequest.FieldCycles, field.TypeUint64, value)
+	}
+	if pruo.mutation.CyclesCleared() {
+		_spec.ClearField(proofrequest.FieldCycles, field.TypeUint64)
+	}
+	if value, ok := pruo.mutation.FulfilledTime(); ok {
+		_spec.SetField(proofrequest.FieldFulfilledTime, field.TypeUint64, value)
+	}
+	if value, ok := pruo.mutation.AddedFulfilledTime(); ok {
+		_spec.AddField(proofrequest.FieldFulfilledTime, field.TypeUint64, value)
+	}
+	if pruo.mutation.FulfilledTimeCleared() {
+		_spec.ClearField(proofrequest.FieldFulfilledTime, field.TypeUint64)
+	}
+	if value, ok := pruo.mutation.SubmissionReverts(); ok {
+		_spec.SetField(proofrequest.FieldSubmissionReverts, field.TypeUint64, value)
+	}
+	if value, ok := pruo.mutation.AddedSubmissionReverts(); ok {
+		_spec.AddField(proofrequest.FieldSubmissionReverts, field.TypeUint64, value)
+	}
+	if pruo.mutation.SubmissionRevertsCleared() {
+		_spec.ClearField(proofrequest.FieldSubmissionReverts, field.TypeUint64)
+	}
+	if value, ok := pruo.mutation.SubmissionResends(); ok {
+		_spec.SetField(proofrequest.FieldSubmissionResends, field.TypeUint64, value)
+	}
+	if value, ok := pruo.mutation.AddedSubmissionResends(); ok {
+		_spec.AddField(proofrequest.FieldSubmissionResends, field.TypeUint64, value)
+	}
+	if pruo.mutation.SubmissionResendsCleared() {
+		_spec.ClearField(proofrequest.FieldSubmissionResends, field.TypeUint64)
+	}
+	if value, ok := pruo.mutation.SubmissionNonceResyncs(); ok {
+		_spec.SetField(proofrequest.FieldSubmissionNonceResyncs, field.TypeUint64, value)
+	}
+	if value, ok := pruo.mutation.AddedSubmissionNonceResyncs(); ok {
+		_spec.AddField(proofrequest.FieldSubmissionNonceResyncs, field.TypeUint64, value)
+	}
+	if pruo.mutation.SubmissionNonceResyncsCleared() {
+		_spec.ClearField(proofrequest.FieldSubmissionNonceResyncs, field.TypeUint64)
+	}
 	if value, ok := pruo.mutation.L1BlockNumber(); ok {
 		_spec.SetField(proofrequest.FieldL1BlockNumber, field.TypeUint64, value)
 	}
@@ -757,12 +2405,170 @@ func (pruo *ProofRequestUpdateOne) sqlSave(ctx context.Context) (_node *ProofReq
 	if pruo.mutation.L1BlockHashCleared() {
 		_spec.ClearField(proofrequest.FieldL1BlockHash, field.TypeString)
 	}
+	if value, ok := pruo.mutation.SatisfiedByTx(); ok {
+		_spec.SetField(proofrequest.FieldSatisfiedByTx, field.TypeString, value)
+	}
+	if pruo.mutation.SatisfiedByTxCleared() {
+		_spec.ClearField(proofrequest.FieldSatisfiedByTx, field.TypeString)
+	}
 	if value, ok := pruo.mutation.Proof(); ok {
 		_spec.SetField(proofrequest.FieldProof, field.TypeBytes, value)
 	}
 	if pruo.mutation.ProofCleared() {
 		_spec.ClearField(proofrequest.FieldProof, field.TypeBytes)
 	}
+	if value, ok := pruo.mutation.StorageTier(); ok {
+		_spec.SetField(proofrequest.FieldStorageTier, field.TypeEnum, value)
+	}
+	if value, ok := pruo.mutation.ColdStorageKey(); ok {
+		_spec.SetField(proofrequest.FieldColdStorageKey, field.TypeString, value)
+	}
+	if pruo.mutation.ColdStorageKeyCleared() {
+		_spec.ClearField(proofrequest.FieldColdStorageKey, field.TypeString)
+	}
+	if value, ok := pruo.mutation.ProofRef(); ok {
+		_spec.SetField(proofrequest.FieldProofRef, field.TypeString, value)
+	}
+	if pruo.mutation.ProofRefCleared() {
+		_spec.ClearField(proofrequest.FieldProofRef, field.TypeString)
+	}
+	if value, ok := pruo.mutation.RetrievalStatus(); ok {
+		_spec.SetField(proofrequest.FieldRetrievalStatus, field.TypeEnum, value)
+	}
+	if value, ok := pruo.mutation.IpfsCid(); ok {
+		_spec.SetField(proofrequest.FieldIpfsCid, field.TypeString, value)
+	}
+	if pruo.mutation.IpfsCidCleared() {
+		_spec.ClearField(proofrequest.FieldIpfsCid, field.TypeString)
+	}
+	if value, ok := pruo.mutation.ProverBackend(); ok {
+		_spec.SetField(proofrequest.FieldProverBackend, field.TypeString, value)
+	}
+	if pruo.mutation.ProverBackendCleared() {
+		_spec.ClearField(proofrequest.FieldProverBackend, field.TypeString)
+	}
+	if value, ok := pruo.mutation.ErrorMessage(); ok {
+		_spec.SetField(proofrequest.FieldErrorMessage, field.TypeString, value)
+	}
+	if pruo.mutation.ErrorMessageCleared() {
+		_spec.ClearField(proofrequest.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := pruo.mutation.CreatedBy(); ok {
+		_spec.SetField(proofrequest.FieldCreatedBy, field.TypeString, value)
+	}
+	if pruo.mutation.CreatedByCleared() {
+		_spec.ClearField(proofrequest.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := pruo.mutation.RequestedBy(); ok {
+		_spec.SetField(proofrequest.FieldRequestedBy, field.TypeString, value)
+	}
+	if pruo.mutation.RequestedByCleared() {
+		_spec.ClearField(proofrequest.FieldRequestedBy, field.TypeString)
+	}
+	if value, ok := pruo.mutation.CompletedBy(); ok {
+		_spec.SetField(proofrequest.FieldCompletedBy, field.TypeString, value)
+	}
+	if pruo.mutation.CompletedByCleared() {
+		_spec.ClearField(proofrequest.FieldCompletedBy, field.TypeString)
+	}
+	if value, ok := pruo.mutation.Fee(); ok {
+		_spec.SetField(proofrequest.FieldFee, field.TypeString, value)
+	}
+	if pruo.mutation.FeeCleared() {
+		_spec.ClearField(proofrequest.FieldFee, field.TypeString)
+	}
+	if value, ok := pruo.mutation.Fulfiller(); ok {
+		_spec.SetField(proofrequest.FieldFulfiller, field.TypeString, value)
+	}
+	if pruo.mutation.FulfillerCleared() {
+		_spec.ClearField(proofrequest.FieldFulfiller, field.TypeString)
+	}
+	if value, ok := pruo.mutation.DowntimeCause(); ok {
+		_spec.SetField(proofrequest.FieldDowntimeCause, field.TypeString, value)
+	}
+	if pruo.mutation.DowntimeCauseCleared() {
+		_spec.ClearField(proofrequest.FieldDowntimeCause, field.TypeString)
+	}
+	if value, ok := pruo.mutation.L2BlockHash(); ok {
+		_spec.SetField(proofrequest.FieldL2BlockHash, field.TypeString, value)
+	}
+	if pruo.mutation.L2BlockHashCleared() {
+		_spec.ClearField(proofrequest.FieldL2BlockHash, field.TypeString)
+	}
+	if pruo.mutation.AggCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   proofrequest.AggTable,
+			Columns: []string{proofrequest.AggColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.AggIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   proofrequest.AggTable,
+			Columns: []string{proofrequest.AggColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pruo.mutation.SpansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   proofrequest.SpansTable,
+			Columns: []string{proofrequest.SpansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.RemovedSpansIDs(); len(nodes) > 0 && !pruo.mutation.SpansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   proofrequest.SpansTable,
+			Columns: []string{proofrequest.SpansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.SpansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   proofrequest.SpansTable,
+			Columns: []string{proofrequest.SpansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(proofrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &ProofRequest{config: pruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -2,8 +2,14 @@
 
 package ent
 
+import (
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/schema"
+)
+
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	proofrequestFields := schema.ProofRequest{}.Fields()
+	_ = proofrequestFields
 }
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// Heartbeat holds the schema definition for the Heartbeat entity. Each run of a proposer instance keeps its own row up
+// to date while it runs, so the windows in which no proposer was running can be derived from the gaps between them.
+type Heartbeat struct {
+	ent.Schema
+}
+
+func (Heartbeat) Annotations() []schema.Annotation {
+	// Use STRICT mode to enforce strong typing.
+	return []schema.Annotation{
+		entsql.Annotation{Table: "heartbeats", Options: "STRICT"},
+	}
+}
+
+// Fields of the Heartbeat.
+func (Heartbeat) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("instance_id"),
+		field.Uint64("start_time"),
+		// last_time is the unix time of the run's latest heartbeat, and stopped_time the unix time it was stopped at,
+		// or 0 if it exited without stopping, e.g. because it crashed.
+		field.Uint64("last_time"),
+		field.Uint64("stopped_time"),
+	}
+}
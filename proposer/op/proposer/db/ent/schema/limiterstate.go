@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// LimiterState holds the schema definition for the LimiterState entity. It persists the state of the proposer's rate
+// limiters, so restarting the proposer doesn't reset them.
+type LimiterState struct {
+	ent.Schema
+}
+
+func (LimiterState) Annotations() []schema.Annotation {
+	// Use STRICT mode to enforce strong typing.
+	return []schema.Annotation{
+		entsql.Annotation{Table: "limiter_states", Options: "STRICT"},
+	}
+}
+
+// Fields of the LimiterState.
+func (LimiterState) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique(),
+		field.Uint64("limit"),
+		field.Uint64("updated_time"),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
@@ -22,16 +23,81 @@ func (ProofRequest) Annotations() []schema.Annotation {
 // Fields of the ProofRequest.
 func (ProofRequest) Fields() []ent.Field {
 	return []ent.Field{
+		// id is assigned by the DB, except for the proof requests that a warm standby replicates from the active
+		// proposer, which keep their IDs.
+		field.Int("id"),
 		field.Enum("type").Values("SPAN", "AGG"),
 		field.Uint64("start_block"),
 		field.Uint64("end_block"),
-		field.Enum("status").Values("UNREQ", "WITNESSGEN", "PROVING", "FAILED", "COMPLETE"),
+		field.Enum("status").Values("UNREQ", "WITNESSGEN", "PROVING", "FAILED", "COMPLETE", "DEADLETTER", "BLOCKED"),
 		field.Uint64("request_added_time"),
 		field.String("prover_request_id").Optional(),
+		field.String("idempotency_key").Optional(),
+		// external_ref is the ID of the request in an operator's external job system.
+		field.String("external_ref").Optional().Unique(),
+		field.String("witness_artifact_id").Optional(),
+		field.Int("agg_request_id").Optional(),
 		field.Uint64("proof_request_time").Optional(),
 		field.Uint64("last_updated_time"),
+		field.Uint64("proof_timeout").Optional(),
+		// attempts is the number of earlier attempts at proving the range that failed, and not_before the unix time
+		// before which the request isn't sent to the server, which backs off retries.
+		field.Uint64("attempts").Optional(),
+		field.Uint64("not_before").Optional(),
+		// cycles is the cycle count the prover network reported for the fulfilled proof, and fulfilled_time the unix
+		// time the proposer stored the proof at.
+		field.Uint64("cycles").Optional(),
+		field.Uint64("fulfilled_time").Optional(),
+		// submission_reverts, submission_resends and submission_nonce_resyncs count the failed attempts at proposing
+		// the output of a completed AGG proof, by how they failed: its transaction reverted, was underpriced or dropped,
+		// or hit a nonce conflict.
+		field.Uint64("submission_reverts").Optional(),
+		field.Uint64("submission_resends").Optional(),
+		field.Uint64("submission_nonce_resyncs").Optional(),
 		field.Uint64("l1_block_number").Optional(),
 		field.String("l1_block_hash").Optional(),
+		// satisfied_by_tx is the L1 transaction of a competing proposer whose output made the request unnecessary.
+		field.String("satisfied_by_tx").Optional(),
 		field.Bytes("proof").Optional(),
+		field.Enum("storage_tier").Values("HOT", "COLD").Default("HOT"),
+		field.String("cold_storage_key").Optional(),
+		// proof_ref is the SHA-256 hash of the proof, if its bytes were written to the proof store instead of the DB.
+		field.String("proof_ref").Optional(),
+		field.Enum("retrieval_status").Values("NONE", "PENDING", "RESTORED").Default("NONE"),
+		// ipfs_cid is the CID under which the proof was pinned to IPFS, if it was exported.
+		field.String("ipfs_cid").Optional(),
+		// prover_backend is the URL of the server the request was sent to, which its status is polled from.
+		field.String("prover_backend").Optional(),
+		// error_message is the error the server reported for the last failed attempt to send the request.
+		field.String("error_message").Optional(),
+		// created_by, requested_by and completed_by are the IDs of the proposer instances that created the request,
+		// sent it to the server, and stored its proof, for deployments where several instances share the DB.
+		field.String("created_by").Optional(),
+		field.String("requested_by").Optional(),
+		field.String("completed_by").Optional(),
+		// fee is the fee paid to the prover network for the proof, in the base units of the PROVE token, as a decimal
+		// string since it doesn't fit in 64 bits.
+		field.String("fee").Optional(),
+		// fulfiller is the address of the prover on the prover network that was assigned the request, so the statistics
+		// of each prover can be tracked. It's also set on requests the prover failed to fulfill.
+		field.String("fulfiller").Optional(),
+		// downtime_cause is the cause of the proposer downtime, MAINTENANCE or CRASH, if the request was created to
+		// catch up on the backlog of blocks that built up during it.
+		field.String("downtime_cause").Optional(),
+		// l2_block_hash is the hash of the L2 block at end_block when a span proof was sent to the server, so the proof
+		// can be invalidated if the L2 chain reorgs.
+		field.String("l2_block_hash").Optional(),
+	}
+}
+
+// Edges of the ProofRequest.
+func (ProofRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		// The span proofs aggregated by an AGG proof. A span is linked to the latest AGG request created for a range
+		// that it is part of.
+		edge.To("spans", ProofRequest.Type).
+			From("agg").
+			Field("agg_request_id").
+			Unique(),
 	}
 }
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProofRequestEvent holds the schema definition for the ProofRequestEvent entity. Events are appended whenever a proof
+// request is created or changes status, and are never updated, so the state of the pipeline at any past time can be
+// reconstructed from them.
+type ProofRequestEvent struct {
+	ent.Schema
+}
+
+func (ProofRequestEvent) Annotations() []schema.Annotation {
+	// Use STRICT mode to enforce strong typing.
+	return []schema.Annotation{
+		entsql.Annotation{Table: "proof_request_events", Options: "STRICT"},
+	}
+}
+
+// Fields of the ProofRequestEvent.
+func (ProofRequestEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("proof_request_id"),
+		field.Enum("type").Values("SPAN", "AGG"),
+		field.Uint64("start_block"),
+		field.Uint64("end_block"),
+		field.Enum("status").Values("UNREQ", "WITNESSGEN", "PROVING", "FAILED", "COMPLETE", "DEADLETTER", "BLOCKED"),
+		field.Uint64("time"),
+	}
+}
+
+// Indexes of the ProofRequestEvent.
+func (ProofRequestEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("time"),
+	}
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// RangeLease holds the schema definition for the RangeLease entity. It records the block ranges that external proving
+// jobs, like backfill scripts, have claimed, so the proposer doesn't prove them too.
+type RangeLease struct {
+	ent.Schema
+}
+
+func (RangeLease) Annotations() []schema.Annotation {
+	// Use STRICT mode to enforce strong typing.
+	return []schema.Annotation{
+		entsql.Annotation{Table: "range_leases", Options: "STRICT"},
+	}
+}
+
+// Fields of the RangeLease.
+func (RangeLease) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("owner"),
+		field.Uint64("start_block"),
+		field.Uint64("end_block"),
+		field.Uint64("expires_time"),
+	}
+}
@@ -12,8 +12,16 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Heartbeat is the client for interacting with the Heartbeat builders.
+	Heartbeat *HeartbeatClient
+	// LimiterState is the client for interacting with the LimiterState builders.
+	LimiterState *LimiterStateClient
 	// ProofRequest is the client for interacting with the ProofRequest builders.
 	ProofRequest *ProofRequestClient
+	// ProofRequestEvent is the client for interacting with the ProofRequestEvent builders.
+	ProofRequestEvent *ProofRequestEventClient
+	// RangeLease is the client for interacting with the RangeLease builders.
+	RangeLease *RangeLeaseClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +153,11 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Heartbeat = NewHeartbeatClient(tx.config)
+	tx.LimiterState = NewLimiterStateClient(tx.config)
 	tx.ProofRequest = NewProofRequestClient(tx.config)
+	tx.ProofRequestEvent = NewProofRequestEventClient(tx.config)
+	tx.RangeLease = NewRangeLeaseClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +167,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: ProofRequest.QueryXXX(), the query will be executed
+// applies a query, for example: Heartbeat.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,136 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/hook"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+)
+
+// recordProofRequestEvents appends an event to the event log for every proof request that is created or whose status
+// is set by a mutation. The events are written with the client of the mutation, so mutations made in a transaction
+// only record their events if the transaction commits.
+func recordProofRequestEvents(next ent.Mutator) ent.Mutator {
+	return hook.ProofRequestFunc(func(ctx context.Context, m *ent.ProofRequestMutation) (ent.Value, error) {
+		status, ok := m.Status()
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+
+		// The IDs of the updated requests must be queried before the update, since the update can change the fields
+		// the mutation's predicates match on.
+		var ids []int
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			if ids, err = m.IDs(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		eventTime, ok := m.LastUpdatedTime()
+		if !ok {
+			eventTime = uint64(time.Now().Unix())
+		}
+
+		var reqs []*ent.ProofRequest
+		if req, ok := v.(*ent.ProofRequest); ok {
+			reqs = []*ent.ProofRequest{req}
+		} else if len(ids) > 0 {
+			if reqs, err = m.Client().ProofRequest.Query().Where(proofrequest.IDIn(ids...)).All(ctx); err != nil {
+				return nil, fmt.Errorf("failed to query updated proof requests: %w", err)
+			}
+		}
+
+		builders := make([]*ent.ProofRequestEventCreate, len(reqs))
+		for i, req := range reqs {
+			builders[i] = m.Client().ProofRequestEvent.
+				Create().
+				SetProofRequestID(req.ID).
+				SetType(proofrequestevent.Type(req.Type)).
+				SetStartBlock(req.StartBlock).
+				SetEndBlock(req.EndBlock).
+				SetStatus(proofrequestevent.Status(status)).
+				SetTime(eventTime)
+		}
+		if err := m.Client().ProofRequestEvent.CreateBulk(builders...).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to record proof request events: %w", err)
+		}
+		return v, nil
+	})
+}
+
+// SetInstanceID attributes the proof requests that are created, sent to the server, or completed through this DB
+// handle from now on to the proposer instance with the given ID.
+func (db *ProofDB) SetInstanceID(instanceID string) {
+	db.writeClient.ProofRequest.Use(attributeToInstance(instanceID))
+}
+
+// attributeToInstance records the proposer instance that creates a proof request, sets its status to WITNESSGEN, or
+// sets its status to COMPLETE.
+func attributeToInstance(instanceID string) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.ProofRequestFunc(func(ctx context.Context, m *ent.ProofRequestMutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpCreate) {
+				m.SetCreatedBy(instanceID)
+			}
+			if status, ok := m.Status(); ok {
+				switch status {
+				case proofrequest.StatusWITNESSGEN:
+					m.SetRequestedBy(instanceID)
+				case proofrequest.StatusCOMPLETE:
+					m.SetCompletedBy(instanceID)
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// GetProofRequestsAt reconstructs the proof requests as they were at the given unix timestamp from the event log.
+// Returns the latest event of every request that existed at that time, ordered by request ID.
+func (db *ProofDB) GetProofRequestsAt(timestamp uint64) ([]*ent.ProofRequestEvent, error) {
+	events, err := db.readClient.ProofRequestEvent.Query().
+		Where(proofrequestevent.TimeLTE(timestamp)).
+		Order(ent.Asc(proofrequestevent.FieldID)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query proof request events: %w", err)
+	}
+
+	// Events are appended in order, so the last event of each request is its state at the timestamp.
+	latest := map[int]int{}
+	var reqs []*ent.ProofRequestEvent
+	for _, event := range events {
+		if i, ok := latest[event.ProofRequestID]; ok {
+			reqs[i] = event
+			continue
+		}
+		latest[event.ProofRequestID] = len(reqs)
+		reqs = append(reqs, event)
+	}
+	return reqs, nil
+}
+
+// GetFirstEventTime returns the time of the first event in the event log, before which the state of the pipeline
+// can't be reconstructed. Returns false if the event log is empty.
+func (db *ProofDB) GetFirstEventTime() (uint64, bool, error) {
+	first, err := db.readClient.ProofRequestEvent.Query().
+		Order(ent.Asc(proofrequestevent.FieldID)).
+		First(context.Background())
+	if ent.IsNotFound(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to query first proof request event: %w", err)
+	}
+	return first.Time, true, nil
+}
@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	stdsql "database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+// LeaderLock is a Postgres advisory lock that at most one of the proposer instances sharing the DB holds, which makes
+// it their leader. The lock belongs to the session of a dedicated connection, so it's released when the connection
+// closes, e.g. because the leader crashed or lost its network.
+type LeaderLock struct {
+	key  int64
+	conn *stdsql.Conn
+}
+
+// TryAcquireLeaderLock takes the advisory lock with the given key on a connection of its own. Returns nil if another
+// session holds the lock.
+func (db *ProofDB) TryAcquireLeaderLock(ctx context.Context, key int64) (*LeaderLock, error) {
+	if db.pool == nil {
+		return nil, errors.New("leader election requires a Postgres DB")
+	}
+	conn, err := db.pool.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a connection for the leader lock: %w", err)
+	}
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to take the leader lock: %w", err)
+	}
+	if !acquired {
+		conn.Close()
+		return nil, nil
+	}
+	return &LeaderLock{key: key, conn: conn}, nil
+}
+
+// Check returns an error if the session that holds the lock ended, e.g. because its connection broke, in which case
+// another instance may have taken the lock.
+func (l *LeaderLock) Check(ctx context.Context) error {
+	if _, err := l.conn.ExecContext(ctx, "SELECT 1"); err != nil {
+		return fmt.Errorf("the connection holding the leader lock broke: %w", err)
+	}
+	return nil
+}
+
+// Release releases the lock, so another instance can take over without waiting for the connection to time out.
+func (l *LeaderLock) Release() error {
+	if _, err := l.conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", l.key); err != nil {
+		// Discarding the connection ends its session, which releases the lock as well.
+		_ = l.conn.Raw(func(any) error { return driver.ErrBadConn })
+		return fmt.Errorf("failed to release the leader lock: %w", err)
+	}
+	return l.conn.Close()
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaderLock(t *testing.T) {
+	dsn := os.Getenv("OP_SUCCINCT_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("OP_SUCCINCT_TEST_POSTGRES_DSN isn't set")
+	}
+	ctx := context.Background()
+	a, err := Open(dsn)
+	require.NoError(t, err)
+	defer a.CloseDB()
+	b, err := Open(dsn)
+	require.NoError(t, err)
+	defer b.CloseDB()
+	// The lock is shared by every session of the DB, so each run takes a lock of its own.
+	key := time.Now().UnixNano()
+
+	// Only one session holds the lock.
+	lock, err := a.TryAcquireLeaderLock(ctx, key)
+	require.NoError(t, err)
+	require.NotNil(t, lock)
+	require.NoError(t, lock.Check(ctx))
+	contender, err := b.TryAcquireLeaderLock(ctx, key)
+	require.NoError(t, err)
+	require.Nil(t, contender)
+
+	// Once the connection holding the lock breaks, the leader notices, and another instance takes over.
+	var pid int
+	require.NoError(t, lock.conn.QueryRowContext(ctx, "SELECT pg_backend_pid()").Scan(&pid))
+	_, err = b.pool.ExecContext(ctx, "SELECT pg_terminate_backend($1)", pid)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return lock.Check(ctx) != nil }, 10*time.Second, 100*time.Millisecond)
+	require.Error(t, lock.Release())
+	require.Eventually(t, func() bool {
+		contender, err = b.TryAcquireLeaderLock(ctx, key)
+		return err == nil && contender != nil
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// Releasing the lock lets the next instance take it right away.
+	require.NoError(t, contender.Release())
+	lock, err = a.TryAcquireLeaderLock(ctx, key)
+	require.NoError(t, err)
+	require.NotNil(t, lock)
+	require.NoError(t, lock.Release())
+}
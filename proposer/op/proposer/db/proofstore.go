@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// ProofStore holds the bytes of fulfilled proofs outside of the DB, e.g. in S3, GCS or a local directory, so the DB
+// doesn't grow with every proof. coldstore.FileStore and coldstore.S3Store implement it.
+type ProofStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// SetProofStore makes AddFulfilledProof write proofs to the store, and keep only a reference to them in the DB. Proofs
+// that are already in the DB stay there.
+func (db *ProofDB) SetProofStore(store ProofStore) {
+	db.proofStore = store
+}
+
+// proofRef returns the content-addressed reference of a proof, which is the hex-encoded SHA-256 hash of its bytes.
+func proofRef(proof []byte) string {
+	sum := sha256.Sum256(proof)
+	return hex.EncodeToString(sum[:])
+}
+
+// proofStoreKey returns the key of the proof with the given reference in the proof store. Proofs are spread over
+// prefixes by the first byte of their hash, so the directories of a file store don't grow too large.
+func proofStoreKey(ref string) string {
+	return "proofs/" + ref[:2] + "/" + ref + ".bin"
+}
+
+// putProof writes a proof to the proof store and returns its reference. Equal proofs have the same key, so writing a
+// proof again, e.g. because its fulfillment was delivered twice, is a no-op.
+func (db *ProofDB) putProof(ctx context.Context, proof []byte) (string, error) {
+	ref := proofRef(proof)
+	if err := db.proofStore.Put(ctx, proofStoreKey(ref), proof); err != nil {
+		return "", fmt.Errorf("failed to write proof to the proof store: %w", err)
+	}
+	return ref, nil
+}
+
+// loadProofs reads the proofs of the given requests that are in the proof store, and checks that they match their
+// references. Requests whose proofs are in the DB, or that have no proof, are left as they are.
+func (db *ProofDB) loadProofs(ctx context.Context, reqs []*ent.ProofRequest) error {
+	for _, req := range reqs {
+		if req.Proof != nil || req.ProofRef == "" {
+			continue
+		}
+		if db.proofStore == nil {
+			return fmt.Errorf("the proof of request %d is in the proof store, but no proof store is configured", req.ID)
+		}
+		proof, err := db.proofStore.Get(ctx, proofStoreKey(req.ProofRef))
+		if err != nil {
+			return fmt.Errorf("failed to read the proof of request %d from the proof store: %w", req.ID, err)
+		}
+		if ref := proofRef(proof); ref != req.ProofRef {
+			return fmt.Errorf("the proof of request %d in the proof store has hash %s, expected %s", req.ID, ref, req.ProofRef)
+		}
+		req.Proof = proof
+	}
+	return nil
+}
+
+// LoadProof reads the proof of a request from the proof store, if it was written there instead of the DB.
+func (db *ProofDB) LoadProof(req *ent.ProofRequest) error {
+	return db.loadProofs(context.Background(), []*ent.ProofRequest{req})
+}
+
+// ReadProof returns the proof of a request whose proof wasn't loaded, e.g. one returned by GetConsecutiveSpanProofs,
+// from the DB or the proof store. The request is left as it is, so the proof can be released once it's used.
+func (db *ProofDB) ReadProof(req *ent.ProofRequest) ([]byte, error) {
+	if req.Proof != nil {
+		return req.Proof, nil
+	}
+	loaded := &ent.ProofRequest{ID: req.ID, ProofRef: req.ProofRef}
+	if loaded.ProofRef == "" {
+		stored, err := db.readClient.ProofRequest.Query().
+			Where(proofrequest.ID(req.ID)).
+			Select(proofrequest.FieldProof).
+			Only(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the proof of request %d: %w", req.ID, err)
+		}
+		loaded.Proof = stored.Proof
+	}
+	if err := db.loadProofs(context.Background(), []*ent.ProofRequest{loaded}); err != nil {
+		return nil, err
+	}
+	if loaded.Proof == nil {
+		return nil, fmt.Errorf("request %d has no proof", req.ID)
+	}
+	return loaded.Proof, nil
+}
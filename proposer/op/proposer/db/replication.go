@@ -0,0 +1,327 @@
+package db
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/hook"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// maxChangeFeedLength is the number of changes the change feed keeps at least. A standby that falls further behind is
+// sent a snapshot instead.
+const maxChangeFeedLength = 10000
+
+// ErrReplicationUnsupported is returned when changes are requested from a DB that doesn't keep a change feed. Only the
+// SQLite DB does: instances sharing a Postgres DB don't need to replicate it.
+var ErrReplicationUnsupported = errors.New("replication is only supported with the SQLite DB")
+
+// ReplicationBatch is a batch of changes to the proof requests of the active proposer, which a warm standby applies to
+// its own DB.
+type ReplicationBatch struct {
+	// Epoch identifies the change feed the batch is from. The feed is kept in memory, so it starts a new epoch when the
+	// active proposer restarts.
+	Epoch string `json:"epoch"`
+	// Seq is the position in the change feed that the batch brings the standby up to.
+	Seq uint64 `json:"seq"`
+	// Snapshot is whether Requests are all of the proof requests, in which case the standby deletes the ones it has
+	// that aren't included.
+	Snapshot bool                `json:"snapshot"`
+	Requests []*ent.ProofRequest `json:"requests"`
+	// Deleted are the IDs of the proof requests that were deleted, e.g. by merging span proofs.
+	Deleted []int `json:"deleted,omitempty"`
+}
+
+// changeFeed records the IDs of the proof requests that are changed through a DB handle, in order, so a standby can
+// fetch the changes it hasn't applied yet.
+type changeFeed struct {
+	epoch string
+
+	mu sync.Mutex
+	// first is the position of changes[0], and the IDs in changes are the proof requests that were changed at each
+	// position since.
+	first   uint64
+	changes []int
+	// changed is closed and replaced when changes are recorded.
+	changed chan struct{}
+}
+
+func newChangeFeed() *changeFeed {
+	epoch := make([]byte, 8)
+	rand.Read(epoch)
+	return &changeFeed{epoch: hex.EncodeToString(epoch), first: 1, changed: make(chan struct{})}
+}
+
+// record appends the changed proof requests to the feed.
+func (f *changeFeed) record(ids ...int) {
+	if len(ids) == 0 {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.changes = append(f.changes, ids...)
+	// Drop the oldest changes in chunks, so they aren't copied on every change.
+	if drop := len(f.changes) - maxChangeFeedLength; drop >= maxChangeFeedLength {
+		f.changes = append([]int(nil), f.changes[drop:]...)
+		f.first += uint64(drop)
+	}
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+// since returns the proof requests changed after the given position, and the current position. A snapshot is needed if
+// the position is from another epoch or is no longer in the feed. The returned channel is closed on the next change.
+func (f *changeFeed) since(epoch string, seq uint64) (ids []int, cur uint64, snapshot bool, changed <-chan struct{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	cur = f.first + uint64(len(f.changes)) - 1
+	if epoch != f.epoch || seq+1 < f.first || seq > cur {
+		return nil, cur, true, f.changed
+	}
+	seen := make(map[int]bool)
+	for _, id := range f.changes[seq+1-f.first:] {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, cur, false, f.changed
+}
+
+// hook records the proof requests changed by every mutation in the feed. Changes made in a transaction are recorded
+// when it commits, so a standby never fetches a row before its change is visible.
+func (f *changeFeed) hook(next ent.Mutator) ent.Mutator {
+	return hook.ProofRequestFunc(func(ctx context.Context, m *ent.ProofRequestMutation) (ent.Value, error) {
+		var ids []int
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			if ids, err = m.IDs(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if req, ok := v.(*ent.ProofRequest); ok {
+			ids = []int{req.ID}
+		}
+
+		tx, err := m.Tx()
+		if err != nil {
+			f.record(ids...)
+			return v, nil
+		}
+		tx.OnCommit(func(next ent.Committer) ent.Committer {
+			return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+				if err := next.Commit(ctx, tx); err != nil {
+					return err
+				}
+				f.record(ids...)
+				return nil
+			})
+		})
+		return v, nil
+	})
+}
+
+// ReplicationChanges returns the proof requests that changed after the given position of the change feed, as they are
+// now. If none did, it waits up to the given duration for a change. All of the proof requests are returned as a
+// snapshot instead if the position is from an earlier epoch, or the standby fell too far behind.
+func (db *ProofDB) ReplicationChanges(ctx context.Context, epoch string, since uint64, wait time.Duration) (*ReplicationBatch, error) {
+	if db.changes == nil {
+		return nil, ErrReplicationUnsupported
+	}
+
+	ids, seq, snapshot, changed := db.changes.since(epoch, since)
+	if !snapshot && len(ids) == 0 && wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-changed:
+			ids, seq, snapshot, _ = db.changes.since(epoch, since)
+		case <-timer.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	batch := &ReplicationBatch{Epoch: db.changes.epoch, Seq: seq, Snapshot: snapshot}
+	if snapshot {
+		reqs, err := db.readClient.ProofRequest.Query().
+			Order(ent.Asc(proofrequest.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query proof requests: %w", err)
+		}
+		batch.Requests = reqs
+		return batch, nil
+	}
+	if len(ids) == 0 {
+		return batch, nil
+	}
+
+	reqs, err := db.readClient.ProofRequest.Query().
+		Where(proofrequest.IDIn(ids...)).
+		Order(ent.Asc(proofrequest.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query changed proof requests: %w", err)
+	}
+	batch.Requests = reqs
+	// The changed proof requests that don't exist anymore were deleted.
+	found := make(map[int]bool, len(reqs))
+	for _, req := range reqs {
+		found[req.ID] = true
+	}
+	for _, id := range ids {
+		if !found[id] {
+			batch.Deleted = append(batch.Deleted, id)
+		}
+	}
+	return batch, nil
+}
+
+// ApplyReplicationBatch applies a batch of changes from the active proposer to the DB, in a single transaction. The
+// proof requests keep the IDs they have on the active proposer.
+func (db *ProofDB) ApplyReplicationBatch(batch *ReplicationBatch) error {
+	ctx := context.Background()
+	tx, err := db.writeTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	ids := make([]int, len(batch.Requests))
+	replicated := make(map[int]bool, len(batch.Requests))
+	for i, req := range batch.Requests {
+		ids[i] = req.ID
+		replicated[req.ID] = true
+	}
+	query := tx.ProofRequest.Query().Select(proofrequest.FieldID, proofrequest.FieldStatus)
+	if !batch.Snapshot {
+		query = query.Where(proofrequest.IDIn(ids...))
+	}
+	existing, err := query.All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query replicated proof requests: %w", err)
+	}
+	byID := make(map[int]*ent.ProofRequest, len(existing))
+	for _, req := range existing {
+		byID[req.ID] = req
+	}
+
+	deleted := batch.Deleted
+	if batch.Snapshot {
+		// The proof requests that aren't in the snapshot were deleted.
+		for id := range byID {
+			if !replicated[id] {
+				deleted = append(deleted, id)
+			}
+		}
+	}
+	if len(deleted) > 0 {
+		if _, err := tx.ProofRequest.Delete().Where(proofrequest.IDIn(deleted...)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete proof requests: %w", err)
+		}
+	}
+
+	// A span proof can be linked to an AGG request that comes later in the batch, so the links are set once all of the
+	// requests exist.
+	for _, req := range batch.Requests {
+		if old, ok := byID[req.ID]; ok {
+			update := tx.ProofRequest.UpdateOneID(req.ID)
+			setReplicatedFields(update.Mutation(), req)
+			// The status is only set if it changed, so the event log only records actual transitions.
+			if old.Status != req.Status {
+				update.SetStatus(req.Status)
+			}
+			if req.AggRequestID == 0 {
+				update.ClearAggRequestID()
+			}
+			err = update.Exec(ctx)
+		} else {
+			create := tx.ProofRequest.Create().SetID(req.ID).SetStatus(req.Status)
+			setReplicatedFields(create.Mutation(), req)
+			err = create.Exec(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to replicate proof request %d: %w", req.ID, err)
+		}
+	}
+	for _, req := range batch.Requests {
+		if req.AggRequestID == 0 {
+			continue
+		}
+		if err := tx.ProofRequest.UpdateOneID(req.ID).SetAggRequestID(req.AggRequestID).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to link proof request %d to its AGG request: %w", req.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit replication batch: %w", err)
+	}
+	return nil
+}
+
+// setReplicatedFields sets the fields of a replicated proof request, except its ID, status and AGG request, which
+// depend on whether it already exists. Fields added to the schema must be added here to be replicated.
+func setReplicatedFields(m *ent.ProofRequestMutation, req *ent.ProofRequest) {
+	m.SetType(req.Type)
+	m.SetStartBlock(req.StartBlock)
+	m.SetEndBlock(req.EndBlock)
+	m.SetRequestAddedTime(req.RequestAddedTime)
+	m.SetLastUpdatedTime(req.LastUpdatedTime)
+	m.SetStorageTier(req.StorageTier)
+	m.SetRetrievalStatus(req.RetrievalStatus)
+
+	setOrClear(req.ProverRequestID, m.SetProverRequestID, m.ClearProverRequestID)
+	setOrClear(req.IdempotencyKey, m.SetIdempotencyKey, m.ClearIdempotencyKey)
+	setOrClear(req.ExternalRef, m.SetExternalRef, m.ClearExternalRef)
+	setOrClear(req.WitnessArtifactID, m.SetWitnessArtifactID, m.ClearWitnessArtifactID)
+	setOrClear(req.ProofRequestTime, m.SetProofRequestTime, m.ClearProofRequestTime)
+	setOrClear(req.ProofTimeout, m.SetProofTimeout, m.ClearProofTimeout)
+	setOrClear(req.Attempts, m.SetAttempts, m.ClearAttempts)
+	setOrClear(req.NotBefore, m.SetNotBefore, m.ClearNotBefore)
+	setOrClear(req.Cycles, m.SetCycles, m.ClearCycles)
+	setOrClear(req.FulfilledTime, m.SetFulfilledTime, m.ClearFulfilledTime)
+	setOrClear(req.SubmissionReverts, m.SetSubmissionReverts, m.ClearSubmissionReverts)
+	setOrClear(req.SubmissionResends, m.SetSubmissionResends, m.ClearSubmissionResends)
+	setOrClear(req.SubmissionNonceResyncs, m.SetSubmissionNonceResyncs, m.ClearSubmissionNonceResyncs)
+	setOrClear(req.L1BlockNumber, m.SetL1BlockNumber, m.ClearL1BlockNumber)
+	setOrClear(req.L1BlockHash, m.SetL1BlockHash, m.ClearL1BlockHash)
+	setOrClear(req.SatisfiedByTx, m.SetSatisfiedByTx, m.ClearSatisfiedByTx)
+	setOrClear(req.ColdStorageKey, m.SetColdStorageKey, m.ClearColdStorageKey)
+	setOrClear(req.ProofRef, m.SetProofRef, m.ClearProofRef)
+	setOrClear(req.IpfsCid, m.SetIpfsCid, m.ClearIpfsCid)
+	setOrClear(req.ProverBackend, m.SetProverBackend, m.ClearProverBackend)
+	setOrClear(req.ErrorMessage, m.SetErrorMessage, m.ClearErrorMessage)
+	setOrClear(req.CreatedBy, m.SetCreatedBy, m.ClearCreatedBy)
+	setOrClear(req.RequestedBy, m.SetRequestedBy, m.ClearRequestedBy)
+	setOrClear(req.CompletedBy, m.SetCompletedBy, m.ClearCompletedBy)
+	setOrClear(req.Fee, m.SetFee, m.ClearFee)
+	setOrClear(req.Fulfiller, m.SetFulfiller, m.ClearFulfiller)
+	if req.Proof != nil {
+		m.SetProof(req.Proof)
+	} else {
+		m.ClearProof()
+	}
+}
+
+// setOrClear sets an optional field to the value, or clears it if the value is empty, the way it's stored on the active
+// proposer.
+func setOrClear[T comparable](v T, set func(T), clear func()) {
+	var zero T
+	if v == zero {
+		clear()
+		return
+	}
+	set(v)
+}
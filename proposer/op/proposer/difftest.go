@@ -0,0 +1,212 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// BootInfo mirrors the `BootInfoStruct` committed as the public values of a range proof.
+type BootInfo struct {
+	L1Head           common.Hash
+	L2PreRoot        common.Hash
+	L2PostRoot       common.Hash
+	L2BlockNumber    uint64
+	RollupConfigHash common.Hash
+}
+
+// bootInfoSize is the size of the bincode-serialized BootInfoStruct: four length-prefixed bytes32 fields and a
+// little-endian uint64.
+const bootInfoSize = 4*(8+common.HashLength) + 8
+
+// maxSP1VersionLength bounds the length of the SP1 version string that a serialized proof ends with.
+const maxSP1VersionLength = 64
+
+// decodeSpanProofBootInfo decodes the public values of a bincode-serialized SP1ProofWithPublicValues of the range
+// program. The serialized proof ends with its public values and the SP1 version:
+//
+//	... | len(data) u64 | data | ptr u64 | len(version) u64 | version
+//
+// where data is the bincode-serialized BootInfoStruct. The proof itself is skipped, since its encoding depends on the
+// proof mode.
+func decodeSpanProofBootInfo(proof []byte) (*BootInfo, error) {
+	for n := 0; n <= maxSP1VersionLength && n+8 <= len(proof); n++ {
+		versionLen := len(proof) - n - 8
+		if binary.LittleEndian.Uint64(proof[versionLen:]) != uint64(n) {
+			continue
+		}
+		// Skip the read pointer of the public values buffer, and check the length of its data.
+		dataEnd := versionLen - 8
+		dataStart := dataEnd - bootInfoSize
+		if dataStart < 8 || binary.LittleEndian.Uint64(proof[dataStart-8:]) != bootInfoSize {
+			continue
+		}
+		if info, err := decodeBootInfo(proof[dataStart:dataEnd]); err == nil {
+			return info, nil
+		}
+	}
+	return nil, errors.New("proof does not end with the public values of the range program")
+}
+
+// decodeBootInfo decodes the bincode-serialized BootInfoStruct in pv, which must be bootInfoSize bytes long.
+func decodeBootInfo(pv []byte) (*BootInfo, error) {
+	if len(pv) != bootInfoSize {
+		return nil, fmt.Errorf("boot info is %d bytes, expected %d", len(pv), bootInfoSize)
+	}
+	var hashErr error
+	readHash := func(offset int) common.Hash {
+		if binary.LittleEndian.Uint64(pv[offset:]) != common.HashLength {
+			hashErr = fmt.Errorf("boot info field at offset %d is not a bytes32", offset)
+		}
+		return common.BytesToHash(pv[offset+8 : offset+8+common.HashLength])
+	}
+	info := &BootInfo{
+		L1Head:           readHash(0),
+		L2PreRoot:        readHash(40),
+		L2PostRoot:       readHash(80),
+		L2BlockNumber:    binary.LittleEndian.Uint64(pv[120:128]),
+		RollupConfigHash: readHash(128),
+	}
+	if hashErr != nil {
+		return nil, hashErr
+	}
+	return info, nil
+}
+
+// startDifferentialCheck runs a differential check of the fulfilled span proof in the background. The mock proof of a
+// differential check generates a witness like any other request, so the checks take up witness generation slots, and
+// are skipped while all of them are in use.
+func (l *L2OutputSubmitter) startDifferentialCheck(req *ent.ProofRequest, proof []byte) {
+	limit := int64(l.witnessGenLimiter.Limit())
+	if l.differentialChecks.Add(1) > limit {
+		l.differentialChecks.Add(-1)
+		l.Log.Info("skipping differential check, all witness generation slots are in use", "start", req.StartBlock, "end", req.EndBlock)
+		return
+	}
+
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		defer l.differentialChecks.Add(-1)
+		if err := l.RunDifferentialCheck(l.ctx, req, proof); err != nil {
+			l.Log.Error("differential check failed", "start", req.StartBlock, "end", req.EndBlock, "err", err)
+			l.Metr.RecordError("differential_check", 1)
+		}
+	}()
+}
+
+// RunDifferentialCheck re-runs a fulfilled span proof through the mock (execute-only) pipeline and compares the public
+// values of both proofs against each other and against the output roots reported by the rollup node. A mismatch means
+// the mock pipeline no longer faithfully represents the real one.
+func (l *L2OutputSubmitter) RunDifferentialCheck(ctx context.Context, req *ent.ProofRequest, realProof []byte) error {
+	if req.Type != proofrequest.TypeSPAN {
+		return fmt.Errorf("differential checks are only supported for span proofs")
+	}
+
+	startOutput, err := l.FetchOutput(ctx, req.StartBlock)
+	if err != nil {
+		return fmt.Errorf("failed to fetch output at block %d: %w", req.StartBlock, err)
+	}
+	endOutput, err := l.FetchOutput(ctx, req.EndBlock)
+	if err != nil {
+		return fmt.Errorf("failed to fetch output at block %d: %w", req.EndBlock, err)
+	}
+	expected := BootInfo{
+		L2PreRoot:     common.Hash(startOutput.OutputRoot),
+		L2PostRoot:    common.Hash(endOutput.OutputRoot),
+		L2BlockNumber: req.EndBlock,
+	}
+
+	realInfo, err := decodeSpanProofBootInfo(realProof)
+	if err != nil {
+		return fmt.Errorf("real proof: %w", err)
+	}
+	if err := checkBootInfoRange(realInfo, expected); err != nil {
+		return fmt.Errorf("real proof: %w", err)
+	}
+
+	// The mock request is built like the production request, so both pipelines are given the same inputs.
+	jsonBody, err := l.prepareProofRequest(*req, false)
+	if err != nil {
+		return err
+	}
+	mockProof, err := l.requestDifferentialMockProof(ctx, l.proverServerUrl(req.Type, req.StartBlock, req.EndBlock), jsonBody)
+	if err != nil {
+		return fmt.Errorf("mock proof request failed: %w", err)
+	}
+	mockInfo, err := decodeSpanProofBootInfo(mockProof)
+	if err != nil {
+		return fmt.Errorf("mock proof: %w", err)
+	}
+	if err := checkBootInfoRange(mockInfo, expected); err != nil {
+		return fmt.Errorf("mock proof: %w", err)
+	}
+
+	if realInfo.RollupConfigHash != mockInfo.RollupConfigHash {
+		return fmt.Errorf("rollup config hash mismatch: real %s, mock %s", realInfo.RollupConfigHash, mockInfo.RollupConfigHash)
+	}
+	if realInfo.L1Head != mockInfo.L1Head {
+		// The L1 head is picked by the server per request, so a difference is suspicious but not necessarily wrong.
+		l.Log.Warn("differential check: L1 head differs between real and mock proofs",
+			"start", req.StartBlock, "end", req.EndBlock, "real", realInfo.L1Head, "mock", mockInfo.L1Head)
+	}
+
+	l.Log.Info("differential check passed", "start", req.StartBlock, "end", req.EndBlock, "postRoot", expected.L2PostRoot)
+	return nil
+}
+
+// checkBootInfoRange checks that the boot info proves the range of the expected boot info.
+func checkBootInfoRange(info *BootInfo, expected BootInfo) error {
+	if info.L2BlockNumber != expected.L2BlockNumber {
+		return fmt.Errorf("proof claims block %d, expected %d", info.L2BlockNumber, expected.L2BlockNumber)
+	}
+	if info.L2PreRoot != expected.L2PreRoot {
+		return fmt.Errorf("proof claims pre root %s, expected %s", info.L2PreRoot, expected.L2PreRoot)
+	}
+	if info.L2PostRoot != expected.L2PostRoot {
+		return fmt.Errorf("proof claims post root %s at block %d, expected %s", info.L2PostRoot, info.L2BlockNumber, expected.L2PostRoot)
+	}
+	return nil
+}
+
+// requestDifferentialMockProof requests a mock span proof for a differential check. Unlike the requests of the proof
+// pipeline, these aren't retried, and the server's responses don't adjust the witness generation limit, so that the
+// checks can't change how the pipeline is scheduled.
+func (l *L2OutputSubmitter) requestDifferentialMockProof(ctx context.Context, serverUrl string, jsonBody []byte) ([]byte, error) {
+	const endpoint = "request_mock_span_proof"
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(l.Cfg.WitnessGenTimeout)*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "POST", serverUrl+"/"+endpoint, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received non-200 status code: %d", resp.StatusCode)
+	}
+
+	var response ProofStatusResponse
+	if err := l.decodeServerResponse(endpoint, body, &response); err != nil {
+		return nil, err
+	}
+	return response.Proof, nil
+}
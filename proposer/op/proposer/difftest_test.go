@@ -0,0 +1,96 @@
+package proposer
+
+import (
+	"context"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+// encodeSpanProof serializes a span proof with the given public values like bincode serializes an
+// SP1ProofWithPublicValues, with arbitrary bytes standing in for the proof itself.
+func encodeSpanProof(info BootInfo) []byte {
+	appendHash := func(b []byte, h common.Hash) []byte {
+		b = binary.LittleEndian.AppendUint64(b, common.HashLength)
+		return append(b, h.Bytes()...)
+	}
+	var pv []byte
+	pv = appendHash(pv, info.L1Head)
+	pv = appendHash(pv, info.L2PreRoot)
+	pv = appendHash(pv, info.L2PostRoot)
+	pv = binary.LittleEndian.AppendUint64(pv, info.L2BlockNumber)
+	pv = appendHash(pv, info.RollupConfigHash)
+
+	// The pre root also appears in the proof outside of the public values.
+	proof := append([]byte{0xde, 0xad, 0xbe, 0xef}, info.L2PreRoot.Bytes()...)
+	proof = binary.LittleEndian.AppendUint64(proof, uint64(len(pv)))
+	proof = append(proof, pv...)
+	proof = binary.LittleEndian.AppendUint64(proof, 0)
+	proof = binary.LittleEndian.AppendUint64(proof, uint64(len("v4.0.0")))
+	return append(proof, "v4.0.0"...)
+}
+
+func TestDecodeSpanProofBootInfo(t *testing.T) {
+	expected := BootInfo{
+		L1Head:           common.Hash{0x01},
+		L2PreRoot:        common.Hash{0x02},
+		L2PostRoot:       common.Hash{0x03},
+		L2BlockNumber:    100,
+		RollupConfigHash: common.Hash{0x04},
+	}
+	proof := encodeSpanProof(expected)
+
+	info, err := decodeSpanProofBootInfo(proof)
+	require.NoError(t, err)
+	require.Equal(t, expected, *info)
+
+	// Truncated proofs, and proofs of other programs, are rejected.
+	_, err = decodeSpanProofBootInfo(proof[:len(proof)-1])
+	require.Error(t, err)
+	_, err = decodeSpanProofBootInfo(proof[:100])
+	require.Error(t, err)
+}
+
+func TestCheckBootInfoRange(t *testing.T) {
+	expected := BootInfo{L2PreRoot: common.Hash{0x02}, L2PostRoot: common.Hash{0x03}, L2BlockNumber: 100}
+	info := expected
+	info.L1Head = common.Hash{0x01}
+	require.NoError(t, checkBootInfoRange(&info, expected))
+
+	info.L2BlockNumber = 101
+	require.ErrorContains(t, checkBootInfoRange(&info, expected), "block 101")
+	info.L2BlockNumber = 100
+	info.L2PostRoot = common.Hash{0x05}
+	require.ErrorContains(t, checkBootInfoRange(&info, expected), "post root")
+}
+
+func TestRequestDifferentialMockProof(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{OPSuccinctServerUrl: server.URL, WitnessGenTimeout: 10, WitnessGenRetries: 3},
+		},
+		witnessGenLimiter: newWitnessGenLimiter(4),
+	}
+
+	// Differential checks are never retried, and don't lower the witness generation limit of the pipeline.
+	_, err := l.requestDifferentialMockProof(context.Background(), server.URL, []byte("{}"))
+	require.Error(t, err)
+	require.Equal(t, 1, requests)
+	require.Equal(t, uint64(4), l.witnessGenLimiter.Limit())
+}
@@ -0,0 +1,243 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+
+	altda "github.com/ethereum-optimism/optimism/op-alt-da"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	opsuccinctbindings "github.com/succinctlabs/op-succinct-go/bindings"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/flags"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/utils"
+)
+
+const doctorCheckTimeout = time.Minute
+
+// doctor runs the self-test checks and prints a report as it goes.
+type doctor struct {
+	out    io.Writer
+	failed int
+}
+
+// check runs a single check, and prints whether it passed along with a remediation hint if it didn't.
+func (d *doctor) check(name string, hint string, fn func(ctx context.Context) error) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), doctorCheckTimeout)
+	defer cancel()
+
+	if err := fn(ctx); err != nil {
+		d.failed++
+		fmt.Fprintf(d.out, "[FAIL] %s: %v\n", name, err)
+		fmt.Fprintf(d.out, "       hint: %s\n", hint)
+		return false
+	}
+	fmt.Fprintf(d.out, "[PASS] %s\n", name)
+	return true
+}
+
+func (d *doctor) skip(name string, reason string) {
+	fmt.Fprintf(d.out, "[SKIP] %s: %s\n", name, reason)
+}
+
+// Doctor exercises every dependency of the proposer with the same configuration as the service, without starting it,
+// and prints a pass/fail report with remediation hints. It is meant to be run before enabling the service in
+// production. Returns an error if any check failed.
+func Doctor(cliCtx *cli.Context) error {
+	d := &doctor{out: cliCtx.App.Writer}
+
+	if !d.check("required flags", "set the missing flag, or its "+flags.EnvVarPrefix+"_ environment variable", func(context.Context) error {
+		return flags.CheckRequired(cliCtx)
+	}) {
+		return errors.New("required flags are missing")
+	}
+
+	// The rollup config is needed to build the rest of the configuration, so nothing else can be checked without it.
+	var rollupConfig *rollup.Config
+	if !d.check("rollup node RPC (optimism_rollupConfig)", "check that --rollup-rpc points at a running op-node", func(ctx context.Context) error {
+		rollupClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, nil, cliCtx.String(flags.RollupRpcFlag.Name))
+		if err != nil {
+			return err
+		}
+		rollupConfig, err = rollupClient.RollupConfig(ctx)
+		return err
+	}) {
+		return errors.New("rollup node is unreachable")
+	}
+
+	cfg := NewConfig(cliCtx)
+	d.check("configuration", "fix the flag reported in the error", func(context.Context) error {
+		return cfg.Check()
+	})
+
+	d.check("rollup node RPC (optimism_syncStatus, optimism_outputAtBlock)", "check that the op-node is synced and serves output roots", func(ctx context.Context) error {
+		rollupClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, nil, cfg.RollupRpc)
+		if err != nil {
+			return err
+		}
+		status, err := rollupClient.SyncStatus(ctx)
+		if err != nil {
+			return err
+		}
+		if status.FinalizedL2.Number == 0 {
+			return errors.New("the rollup node has no finalized L2 blocks yet")
+		}
+		_, err = rollupClient.OutputAtBlock(ctx, status.FinalizedL2.Number)
+		return err
+	})
+
+	var l1Client *ethclient.Client
+	d.check("L1 RPC (eth_chainId, eth_getBlockByNumber)", "check that --l1-eth-rpc points at an L1 node for the rollup's L1 chain", func(ctx context.Context) error {
+		var err error
+		l1Client, err = dial.DialEthClientWithTimeout(ctx, dial.DefaultDialTimeout, log.Root(), cfg.L1EthRpc)
+		if err != nil {
+			return err
+		}
+		chainID, err := l1Client.ChainID(ctx)
+		if err != nil {
+			return err
+		}
+		if chainID.Cmp(rollupConfig.L1ChainID) != 0 {
+			return fmt.Errorf("L1 chain ID is %s, but the rollup config expects %s", chainID, rollupConfig.L1ChainID)
+		}
+		_, err = l1Client.HeaderByNumber(ctx, nil)
+		return err
+	})
+
+	d.check("L1 beacon RPC", "check that --beacon-rpc points at an L1 consensus node", func(context.Context) error {
+		_, err := utils.SetupBeacon(cfg.BeaconRpc)
+		return err
+	})
+
+	var l2oo *opsuccinctbindings.OPSuccinctL2OutputOracleCaller
+	if cfg.L2OOAddress == "" {
+		d.skip("L2OO contract reads", "no L2OO address configured")
+	} else if l1Client == nil {
+		d.skip("L2OO contract reads", "the L1 RPC is unreachable")
+	} else {
+		d.check("L2OO contract reads", "check that --l2oo-address is the OPSuccinctL2OutputOracle proxy on L1", func(ctx context.Context) error {
+			var err error
+			l2oo, err = opsuccinctbindings.NewOPSuccinctL2OutputOracleCaller(common.HexToAddress(cfg.L2OOAddress), l1Client)
+			if err != nil {
+				return err
+			}
+			opts := &bind.CallOpts{Context: ctx}
+			if _, err := l2oo.Version(opts); err != nil {
+				return err
+			}
+			if _, err := l2oo.LatestBlockNumber(opts); err != nil {
+				return err
+			}
+			_, err = l2oo.NextBlockNumber(opts)
+			return err
+		})
+	}
+
+	if cfg.WatchOnly {
+		d.skip("signer", "the proposer doesn't send transactions in watch-only mode")
+	} else {
+		d.check("signer", "check --signer.mode, the private key, mnemonic or remote signer flags, and fund the proposer account", func(ctx context.Context) error {
+			txManager, err := txmgr.NewSimpleTxManager("proposer", log.Root(), opsuccinctmetrics.NoopMetrics, cfg.TxMgrConfig)
+			if err != nil {
+				return err
+			}
+			defer txManager.Close()
+			if l1Client == nil {
+				return nil
+			}
+			balance, err := l1Client.BalanceAt(ctx, txManager.From(), nil)
+			if err != nil {
+				return err
+			}
+			if balance.Sign() == 0 {
+				return fmt.Errorf("proposer account %s has no balance", txManager.From())
+			}
+			if l2oo != nil {
+				approved, err := l2oo.ApprovedProposers(&bind.CallOpts{Context: ctx}, txManager.From())
+				if err != nil {
+					return err
+				}
+				if !approved {
+					return fmt.Errorf("proposer account %s is not an approved proposer on the L2OO", txManager.From())
+				}
+			}
+			return nil
+		})
+	}
+
+	if cfg.L2OOAddress == "" {
+		d.skip("OP Succinct server (validate_config)", "no L2OO address configured")
+	} else {
+		d.check("OP Succinct server (validate_config)", "check that every --op-succinct-server-url is reachable, and that the server's programs match the L2OO's verification keys and rollup config hash", func(ctx context.Context) error {
+			l := &L2OutputSubmitter{DriverSetup: DriverSetup{Log: log.Root(), Metr: opsuccinctmetrics.NoopMetrics, Cfg: ProposerConfig{OPSuccinctServerUrls: cfg.OPSuccinctServerUrls}}}
+			return l.ValidateConfig(ctx, cfg.L2OOAddress)
+		})
+	}
+
+	if cfg.AltDACommitmentType == "" {
+		d.skip("Alt-DA server", "the rollup config doesn't enable Alt-DA")
+	} else {
+		d.check("Alt-DA server", "check that --altda-server-url points at the DA server used by the chain's batcher", func(ctx context.Context) error {
+			// Look up a commitment that doesn't exist, a reachable DA server responds that it wasn't found.
+			client := altda.NewDAClient(cfg.AltDAServerUrl, false, false)
+			_, err := client.GetInput(ctx, altda.NewKeccak256Commitment(nil))
+			if errors.Is(err, altda.ErrNotFound) {
+				return nil
+			}
+			return err
+		})
+	}
+
+	if cfg.DbConnectionString != "" {
+		d.check("DB connection", "check --db.connection-string and that the proposer has started once to migrate the DB", func(context.Context) error {
+			return db.Ping(cfg.DbConnectionString)
+		})
+	}
+	if cfg.DbReplicaConnectionString != "" {
+		d.check("DB read replica", "check --db.replica-connection-string and that the replica follows the migrated primary", func(context.Context) error {
+			return db.Ping(cfg.DbReplicaConnectionString)
+		})
+	}
+	d.check("DB read/write", "check that the --db-path directory exists and is writable", func(context.Context) error {
+		// The DB of a running proposer must not be created or migrated by the checks, so it is only opened read-only,
+		// and writability is checked on its directory.
+		if _, err := os.Stat(cfg.DbPath); err == nil {
+			proofDB, err := db.OpenReadOnly(cfg.DbPath)
+			if err != nil {
+				return err
+			}
+			defer proofDB.CloseDB()
+			if _, err := proofDB.GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ); err != nil {
+				return err
+			}
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		f, err := os.CreateTemp(filepath.Dir(cfg.DbPath), ".doctor-*")
+		if err != nil {
+			return fmt.Errorf("DB directory is not writable: %w", err)
+		}
+		f.Close()
+		return os.Remove(f.Name())
+	})
+
+	if d.failed > 0 {
+		return fmt.Errorf("%d checks failed", d.failed)
+	}
+	fmt.Fprintln(d.out, "All checks passed.")
+	return nil
+}
@@ -0,0 +1,96 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// maxConcurrentDoubleChecks bounds the number of span proofs re-executed on the double-check server at once. Sampled
+// proofs that are fulfilled while the limit is reached aren't checked.
+const maxConcurrentDoubleChecks = 2
+
+// errDoubleCheckDivergence is returned by RunDoubleCheck when the double-check server commits to different public values
+// than the fulfilled span proof.
+var errDoubleCheckDivergence = errors.New("double-check server diverges from the span proof")
+
+// sampleDoubleCheck returns whether the fulfilled proof request is double-checked, which is the case for span proofs
+// with probability DoubleCheckSampleRate if a double-check server is set.
+func (l *L2OutputSubmitter) sampleDoubleCheck(req *ent.ProofRequest) bool {
+	if l.Cfg.DoubleCheckServerUrl == "" || req.Type != proofrequest.TypeSPAN {
+		return false
+	}
+	return rand.Float64() < l.Cfg.DoubleCheckSampleRate
+}
+
+// startDoubleCheck double-checks the fulfilled span proof in the background. A divergence is logged as an error and
+// counted in the double_check_divergence error metric, and a check that couldn't be completed in the double_check
+// error metric.
+func (l *L2OutputSubmitter) startDoubleCheck(req *ent.ProofRequest, proof []byte) {
+	if l.doubleChecks.Add(1) > maxConcurrentDoubleChecks {
+		l.doubleChecks.Add(-1)
+		l.Log.Info("skipping double-check, too many double-checks are in flight", "start", req.StartBlock, "end", req.EndBlock)
+		return
+	}
+
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		defer l.doubleChecks.Add(-1)
+		err := l.RunDoubleCheck(l.ctx, req, proof)
+		if errors.Is(err, errDoubleCheckDivergence) {
+			l.Log.Error("Span proof diverges from the double-check server, check the proving infrastructure of both servers", "id", req.ID, "start", req.StartBlock, "end", req.EndBlock, "err", err)
+			l.Metr.RecordError("double_check_divergence", 1)
+		} else if err != nil {
+			l.Log.Warn("failed to double-check span proof", "id", req.ID, "start", req.StartBlock, "end", req.EndBlock, "err", err)
+			l.Metr.RecordError("double_check", 1)
+		}
+	}()
+}
+
+// RunDoubleCheck re-executes a fulfilled span proof on the double-check server, which generates its own witness and
+// runs the range program without proving it, and compares the public values both servers committed to. Unlike a
+// differential check, which compares the mock and real pipelines of the same server, this checks the proving
+// infrastructure against an independent deployment.
+func (l *L2OutputSubmitter) RunDoubleCheck(ctx context.Context, req *ent.ProofRequest, proof []byte) error {
+	if req.Type != proofrequest.TypeSPAN {
+		return fmt.Errorf("double-checks are only supported for span proofs")
+	}
+	info, err := decodeSpanProofBootInfo(proof)
+	if err != nil {
+		return fmt.Errorf("span proof: %w", err)
+	}
+
+	jsonBody, err := l.prepareProofRequest(*req, false)
+	if err != nil {
+		return err
+	}
+	checkProof, err := l.requestDifferentialMockProof(ctx, strings.TrimSuffix(l.Cfg.DoubleCheckServerUrl, "/"), jsonBody)
+	if err != nil {
+		return fmt.Errorf("double-check proof request failed: %w", err)
+	}
+	checkInfo, err := decodeSpanProofBootInfo(checkProof)
+	if err != nil {
+		return fmt.Errorf("double-check proof: %w", err)
+	}
+
+	if err := checkBootInfoRange(checkInfo, *info); err != nil {
+		return fmt.Errorf("%w: %v", errDoubleCheckDivergence, err)
+	}
+	if checkInfo.RollupConfigHash != info.RollupConfigHash {
+		return fmt.Errorf("%w: rollup config hash %s, expected %s", errDoubleCheckDivergence, checkInfo.RollupConfigHash, info.RollupConfigHash)
+	}
+	if checkInfo.L1Head != info.L1Head {
+		// The L1 head is picked by each server per request, so a difference is expected when the servers' L1 nodes
+		// aren't in sync.
+		l.Log.Warn("double-check: L1 head differs between the span proof and the double-check server", "start", req.StartBlock, "end", req.EndBlock, "proof", info.L1Head, "doubleCheck", checkInfo.L1Head)
+	}
+
+	l.Log.Info("double-check passed", "start", req.StartBlock, "end", req.EndBlock, "postRoot", info.L2PostRoot)
+	return nil
+}
@@ -0,0 +1,64 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+func TestRunDoubleCheck(t *testing.T) {
+	info := BootInfo{
+		L1Head:           common.Hash{0x01},
+		L2PreRoot:        common.Hash{0x02},
+		L2PostRoot:       common.Hash{0x03},
+		L2BlockNumber:    200,
+		RollupConfigHash: common.Hash{0x04},
+	}
+	checkInfo := info
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/request_mock_span_proof", r.URL.Path)
+		var body SpanProofRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, SpanProofRequest{Start: 100, End: 200}, body)
+		json.NewEncoder(w).Encode(ProofStatusResponse{
+			FulfillmentStatus: SP1FulfillmentStatusFulfilled,
+			ExecutionStatus:   SP1ExecutionStatusExecuted,
+			Proof:             encodeSpanProof(checkInfo),
+		})
+	}))
+	defer server.Close()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{DoubleCheckServerUrl: server.URL + "/", DoubleCheckSampleRate: 1, WitnessGenTimeout: 10},
+		},
+	}
+	req := &ent.ProofRequest{Type: proofrequest.TypeSPAN, StartBlock: 100, EndBlock: 200}
+	require.True(t, l.sampleDoubleCheck(req))
+	require.False(t, l.sampleDoubleCheck(&ent.ProofRequest{Type: proofrequest.TypeAGG}))
+
+	// An L1 head picked by the other server isn't a divergence.
+	checkInfo.L1Head = common.Hash{0x05}
+	require.NoError(t, l.RunDoubleCheck(context.Background(), req, encodeSpanProof(info)))
+
+	checkInfo.L2PostRoot = common.Hash{0x06}
+	err := l.RunDoubleCheck(context.Background(), req, encodeSpanProof(info))
+	require.ErrorIs(t, err, errDoubleCheckDivergence)
+	require.ErrorContains(t, err, "post root")
+
+	checkInfo.L2PostRoot = info.L2PostRoot
+	checkInfo.RollupConfigHash = common.Hash{0x07}
+	require.ErrorIs(t, l.RunDoubleCheck(context.Background(), req, encodeSpanProof(info)), errDoubleCheckDivergence)
+}
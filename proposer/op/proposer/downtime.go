@@ -0,0 +1,163 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+// Causes of a proposer downtime, which the proof requests created to catch up on its backlog are annotated with, so
+// lag due to maintenance can be told apart from lag due to prover issues.
+const (
+	// DowntimeMaintenance is a downtime after the proposer was stopped, e.g. to upgrade it.
+	DowntimeMaintenance = "MAINTENANCE"
+	// DowntimeCrash is a downtime after the proposer exited without stopping, e.g. because it crashed or its host
+	// went down.
+	DowntimeCrash = "CRASH"
+)
+
+// DOWNTIME_LOOKBACK is how far back the heartbeats of earlier runs are read at startup, to find the latest downtime.
+const DOWNTIME_LOOKBACK = 7 * 24 * time.Hour
+
+// catchUp is a downtime whose backlog the proposer is catching up on.
+type catchUp struct {
+	downtime rpc.Downtime
+	// endBlock is the L2 block at the end of the downtime, i.e. the end of its backlog. 0 until it's known.
+	endBlock uint64
+}
+
+// downtimes derives the windows in which no proposer instance was running from the heartbeats of their runs, which
+// are sorted by their start time. Gaps between runs that are shorter than the threshold, e.g. quick restarts, aren't
+// downtime. The cause of a downtime is how the run before it ended.
+func downtimes(heartbeats []*ent.Heartbeat, threshold time.Duration) []rpc.Downtime {
+	var windows []rpc.Downtime
+	// last is the run that ran until the latest time so far, which can overlap later runs when instances share the DB.
+	var last *ent.Heartbeat
+	for _, hb := range heartbeats {
+		if last != nil && hb.StartTime > last.LastTime && hb.StartTime-last.LastTime >= uint64(threshold.Seconds()) {
+			cause := DowntimeCrash
+			if last.StoppedTime != 0 {
+				cause = DowntimeMaintenance
+			}
+			windows = append(windows, rpc.Downtime{
+				Start:    last.LastTime,
+				End:      hb.StartTime,
+				Cause:    cause,
+				Instance: last.InstanceID,
+			})
+		}
+		if last == nil || hb.LastTime > last.LastTime {
+			last = hb
+		}
+	}
+	return windows
+}
+
+// startHeartbeat records the start of this run. If no proposer was running for at least DOWNTIME_THRESHOLD before it,
+// the downtime is recorded, and the proposer catches up on its backlog. The latest downtime is caught up on in any
+// case, in case an earlier run was restarted before catching up on it.
+func (l *L2OutputSubmitter) startHeartbeat() error {
+	now := time.Now()
+	heartbeats, err := l.db.GetHeartbeats(uint64(now.Add(-DOWNTIME_LOOKBACK).Unix()))
+	if err != nil {
+		return err
+	}
+	hb, err := l.db.StartHeartbeat(l.Cfg.InstanceID, uint64(now.Unix()))
+	if err != nil {
+		return err
+	}
+	l.heartbeatID = hb.ID
+
+	windows := downtimes(append(heartbeats, hb), l.Cfg.DowntimeThreshold)
+	if len(windows) == 0 {
+		return nil
+	}
+	latest := windows[len(windows)-1]
+	if latest.End == hb.StartTime {
+		duration := time.Duration(latest.End-latest.Start) * time.Second
+		l.Log.Warn("Proposer was down, catching up on the backlog", "cause", latest.Cause, "since", time.Unix(int64(latest.Start), 0), "duration", duration, "lastInstance", latest.Instance)
+		l.Metr.RecordDowntime(latest.Cause, duration)
+	}
+	l.catchUp = &catchUp{downtime: latest}
+	return nil
+}
+
+// heartbeat records that this run is still running.
+func (l *L2OutputSubmitter) heartbeat() error {
+	if l.heartbeatID == 0 {
+		return nil
+	}
+	return l.db.Heartbeat(l.heartbeatID, uint64(time.Now().Unix()))
+}
+
+// stopHeartbeat records that this run was stopped, so the downtime until the next run is attributed to maintenance.
+func (l *L2OutputSubmitter) stopHeartbeat() error {
+	if l.heartbeatID == 0 {
+		return nil
+	}
+	return l.db.StopHeartbeat(l.heartbeatID, uint64(time.Now().Unix()))
+}
+
+// trackCatchUp annotates the proof requests created to catch up on the backlog of the latest downtime with its cause,
+// until the L2OO's latest output reaches the L2 block at the end of the downtime.
+func (l *L2OutputSubmitter) trackCatchUp(ctx context.Context) error {
+	c := l.catchUp
+	if c == nil {
+		return nil
+	}
+	if c.endBlock == 0 {
+		rollupClient, err := l.RollupProvider.RollupClient(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get rollup client: %w", err)
+		}
+		rollupCfg, err := rollupClient.RollupConfig(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get rollup config: %w", err)
+		}
+		endBlock, err := rollupCfg.TargetBlockNumber(c.downtime.End)
+		if err != nil {
+			return fmt.Errorf("failed to get the L2 block at the end of the downtime: %w", err)
+		}
+		c.endBlock = endBlock
+	}
+
+	latest, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get the latest L2OO block: %w", err)
+	}
+	if latest.Uint64() >= c.endBlock {
+		l.Log.Info("Caught up on the backlog of the downtime", "cause", c.downtime.Cause, "endBlock", c.endBlock, "took", time.Since(time.Unix(int64(c.downtime.End), 0)))
+		l.Metr.RecordCatchingUp(c.downtime.Cause, false)
+		l.catchUp = nil
+		return nil
+	}
+	l.Metr.RecordCatchingUp(c.downtime.Cause, true)
+	n, err := l.db.AnnotateDowntime(c.downtime.Cause, c.downtime.End, c.endBlock)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		l.Log.Info("Annotated proof requests catching up on the downtime", "cause", c.downtime.Cause, "requests", n, "endBlock", c.endBlock)
+	}
+	return nil
+}
+
+// Downtimes returns the windows in which no proposer was running that started since the given unix time.
+func (l *L2OutputSubmitter) Downtimes(ctx context.Context, since uint64) ([]rpc.Downtime, error) {
+	heartbeats, err := l.db.GetHeartbeats(since)
+	if err != nil {
+		return nil, err
+	}
+	windows := []rpc.Downtime{}
+	for _, window := range downtimes(heartbeats, l.Cfg.DowntimeThreshold) {
+		if window.Start >= since {
+			windows = append(windows, window)
+		}
+	}
+	return windows, nil
+}
@@ -0,0 +1,97 @@
+package proposer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+func TestDowntimes(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{DowntimeThreshold: time.Minute},
+		},
+		db: *proofDB,
+	}
+	run := func(instance string, start, last uint64, stopped bool) {
+		hb, err := proofDB.StartHeartbeat(instance, start)
+		require.NoError(t, err)
+		require.NoError(t, proofDB.Heartbeat(hb.ID, last))
+		if stopped {
+			require.NoError(t, proofDB.StopHeartbeat(hb.ID, last))
+		}
+	}
+
+	// A quick restart isn't downtime, a stopped proposer is down for maintenance, and one that exited without
+	// stopping crashed.
+	run("a", 1000, 1100, true)
+	run("a", 1130, 1500, true)
+	run("b", 2000, 2500, false)
+	run("b", 3000, 3100, false)
+	// A standby that overlaps the run before it doesn't hide the gap after the run.
+	run("c", 3050, 3080, false)
+	run("c", 3500, 3600, false)
+
+	windows, err := l.Downtimes(context.Background(), 0)
+	require.NoError(t, err)
+	require.Equal(t, []rpc.Downtime{
+		{Start: 1500, End: 2000, Cause: DowntimeMaintenance, Instance: "a"},
+		{Start: 2500, End: 3000, Cause: DowntimeCrash, Instance: "b"},
+		{Start: 3100, End: 3500, Cause: DowntimeCrash, Instance: "b"},
+	}, windows)
+
+	windows, err = l.Downtimes(context.Background(), 2600)
+	require.NoError(t, err)
+	require.Len(t, windows, 1)
+	require.Equal(t, uint64(3100), windows[0].Start)
+}
+
+func TestAnnotateDowntime(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 150, 0))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 150, 200, 0))
+	cause := func(start, end uint64) string {
+		reqs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, start, end, proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.Len(t, reqs, 1)
+		return reqs[0].DowntimeCause
+	}
+
+	// Requests created before the downtime ended aren't catching up on it.
+	n, err := proofDB.AnnotateDowntime(DowntimeCrash, uint64(time.Now().Add(time.Hour).Unix()), 150)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	// Only the requests for the backlog are annotated.
+	since := uint64(time.Now().Add(-time.Hour).Unix())
+	n, err = proofDB.AnnotateDowntime(DowntimeCrash, since, 150)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, DowntimeCrash, cause(100, 150))
+	require.Empty(t, cause(150, 200))
+
+	// An annotated request keeps its cause.
+	n, err = proofDB.AnnotateDowntime(DowntimeMaintenance, since, 200)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, DowntimeCrash, cause(100, 150))
+	require.Equal(t, DowntimeMaintenance, cause(150, 200))
+}
@@ -0,0 +1,105 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// inflightRequests tracks the proof requests that are being sent to a witness generation server. Their contexts aren't
+// cancelled with the proposer's, so the requests can finish while the proposer shuts down, instead of being killed
+// mid-request.
+type inflightRequests struct {
+	mu      sync.Mutex
+	wg      sync.WaitGroup
+	cancels map[int]context.CancelFunc
+}
+
+// start runs fn for the proof request in a tracked goroutine, with a context that keeps the values of parent but is
+// only cancelled by drain.
+func (r *inflightRequests) start(parent context.Context, id int, fn func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(parent))
+	r.mu.Lock()
+	if r.cancels == nil {
+		r.cancels = make(map[int]context.CancelFunc)
+	}
+	r.cancels[id] = cancel
+	r.mu.Unlock()
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer func() {
+			r.mu.Lock()
+			delete(r.cancels, id)
+			r.mu.Unlock()
+			cancel()
+		}()
+		fn(ctx)
+	}()
+}
+
+// drain waits up to the grace period for the in-flight requests to finish, and cancels the ones that don't. Returns the
+// IDs of the cancelled requests.
+func (r *inflightRequests) drain(grace time.Duration) []int {
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-time.After(grace):
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ids []int
+	for id, cancel := range r.cancels {
+		cancel()
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// count returns the number of in-flight requests.
+func (r *inflightRequests) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.cancels)
+}
+
+// drainInflightRequests waits up to SHUTDOWN_GRACE_PERIOD for the proof requests that are being sent to a witness
+// generation server, so they aren't left in WITNESSGEN halfway. The ones that don't finish in time are cancelled: those
+// that were never sent are requeued, and those that were are left in WITNESSGEN, to be resumed with their idempotency
+// key on restart.
+func (l *L2OutputSubmitter) drainInflightRequests() {
+	if n := l.inflight.count(); n > 0 {
+		l.Log.Info("Waiting for in-flight proof requests", "count", n, "gracePeriod", l.Cfg.ShutdownGracePeriod)
+	}
+	cancelled := l.inflight.drain(l.Cfg.ShutdownGracePeriod)
+	if len(cancelled) == 0 {
+		return
+	}
+	l.Log.Warn("Shutdown grace period is over, cancelling in-flight proof requests", "count", len(cancelled))
+	l.Metr.RecordError("shutdown_cancelled_request", uint64(len(cancelled)))
+	for _, id := range cancelled {
+		req, err := l.db.GetProofRequest(id)
+		if err != nil {
+			l.Log.Error("failed to get cancelled proof request", "id", id, "err", err)
+			continue
+		}
+		if req.Status != proofrequest.StatusWITNESSGEN || req.IdempotencyKey != "" {
+			continue
+		}
+		err = l.db.TransitionProofStatus(id, proofrequest.StatusWITNESSGEN, proofrequest.StatusUNREQ)
+		if err != nil && !errors.Is(err, db.ErrProofStatusChanged) {
+			l.Log.Error("failed to requeue cancelled proof request", "id", id, "err", err)
+		}
+	}
+}
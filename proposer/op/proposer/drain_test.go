@@ -0,0 +1,78 @@
+package proposer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+func TestInflightRequestsDrain(t *testing.T) {
+	var r inflightRequests
+	parent, cancelParent := context.WithCancel(context.Background())
+
+	// Requests aren't cancelled with the context they were started from, and are waited for.
+	finished := make(chan struct{})
+	r.start(parent, 1, func(ctx context.Context) {
+		cancelParent()
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, ctx.Err())
+		close(finished)
+	})
+	require.Empty(t, r.drain(time.Second))
+	<-finished
+
+	// Requests that don't finish within the grace period are cancelled.
+	cancelled := make(chan struct{})
+	r.start(context.Background(), 2, func(ctx context.Context) {
+		<-ctx.Done()
+		close(cancelled)
+	})
+	require.Equal(t, []int{2}, r.drain(10*time.Millisecond))
+	<-cancelled
+}
+
+func TestDrainInflightRequests(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	// A request that was sent to the server, and one that wasn't yet.
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 200, 300, 0))
+	reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, req := range reqs {
+		require.NoError(t, proofDB.UpdateProofStatus(req.ID, proofrequest.StatusWITNESSGEN))
+	}
+	require.NoError(t, proofDB.SetIdempotencyKey(reqs[0].ID, "key"))
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{ShutdownGracePeriod: 10 * time.Millisecond},
+		},
+		ctx: context.Background(),
+		db:  *proofDB,
+	}
+	for _, req := range reqs {
+		l.inflight.start(l.ctx, req.ID, func(ctx context.Context) { <-ctx.Done() })
+	}
+	l.drainInflightRequests()
+
+	// The sent request is resumed with its idempotency key on restart, the other one is requeued right away.
+	sent, err := proofDB.GetProofRequest(reqs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusWITNESSGEN, sent.Status)
+	unsent, err := proofDB.GetProofRequest(reqs[1].ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusUNREQ, unsent.Status)
+}
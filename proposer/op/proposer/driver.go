@@ -2,12 +2,15 @@ package proposer
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"math/big"
 	_ "net/http/pprof"
+	"path/filepath"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -17,18 +20,25 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
 	// Original Optimism Bindings
 
+	altda "github.com/ethereum-optimism/optimism/op-alt-da"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 
 	// OP Succinct
 	opsuccinctbindings "github.com/succinctlabs/op-succinct-go/bindings"
+	"github.com/succinctlabs/op-succinct-go/proposer/coldstore"
 	"github.com/succinctlabs/op-succinct-go/proposer/db"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/forecast"
+	"github.com/succinctlabs/op-succinct-go/proposer/ipfs"
 	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/telemetry"
+	"github.com/succinctlabs/op-succinct-go/proposer/tracing"
 )
 
 var (
@@ -55,6 +65,24 @@ type L2OOContract interface {
 	NextOutputIndex(*bind.CallOpts) (*big.Int, error)
 	StartingTimestamp(*bind.CallOpts) (*big.Int, error)
 	L2BLOCKTIME(*bind.CallOpts) (*big.Int, error)
+	HistoricBlockHashes(*bind.CallOpts, *big.Int) ([32]byte, error)
+	ApprovedProposers(*bind.CallOpts, common.Address) (bool, error)
+	GetL2OutputAfter(*bind.CallOpts, *big.Int) (opsuccinctbindings.TypesOutputProposal, error)
+	RangeVkeyCommitment(*bind.CallOpts) ([32]byte, error)
+	AggregationVkey(*bind.CallOpts) ([32]byte, error)
+	RollupConfigHash(*bind.CallOpts) ([32]byte, error)
+	Verifier(*bind.CallOpts) (common.Address, error)
+}
+
+// l2ooTransactor sends the proposer's transactions to the L2OO contract. The L2OutputSubmitter implements it with the
+// tx manager, and tests replace it with a fake, so the driver can be tested without an Ethereum backend.
+type l2ooTransactor interface {
+	// checkpointBlockHash checkpoints the hash of a recent L1 block on the L2OO, and returns the checkpointed block.
+	checkpointBlockHash(ctx context.Context) (uint64, common.Hash, error)
+	// sendTransaction proposes the output with its AGG proof, which was generated against the checkpointed l1BlockNum.
+	sendTransaction(ctx context.Context, output *eth.OutputResponse, proof []byte, l1BlockNum uint64) error
+	// simulateProposal simulates the proposal of the output against the latest L1 state, without sending it.
+	simulateProposal(ctx context.Context, output *eth.OutputResponse, proof []byte, l1BlockNum uint64) error
 }
 
 type RollupClient interface {
@@ -71,6 +99,14 @@ type DriverSetup struct {
 
 	// RollupProvider's RollupClient() is used to retrieve output roots from
 	RollupProvider dial.RollupProvider
+
+	// PrivateTxmgr sends the proposer's transactions through a private relay. Nil if they're sent to the public
+	// mempool.
+	PrivateTxmgr txmgr.TxManager
+
+	// SafeSigner signs the Safe transactions that output proposals are proposed as, with SAFE_ADDRESS. Nil if
+	// proposals are sent from the proposer's account.
+	SafeSigner *ecdsa.PrivateKey
 }
 
 // L2OutputSubmitter is responsible for proposing outputs
@@ -79,6 +115,9 @@ type L2OutputSubmitter struct {
 
 	wg   sync.WaitGroup
 	done chan struct{}
+	// inflight tracks the proof requests that are being sent to a witness generation server, which are drained on
+	// shutdown.
+	inflight inflightRequests
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -86,12 +125,154 @@ type L2OutputSubmitter struct {
 	mutex   sync.Mutex
 	running bool
 
+	// submissionsPaused and proofRequestsPaused pause only the L1 submissions or only new proof requests, while the
+	// rest of the pipeline keeps running.
+	submissionsPaused   atomic.Bool
+	proofRequestsPaused atomic.Bool
+	// breakGlass is the break-glass override that lifts the proof request limits during an incident, if any.
+	breakGlass atomic.Pointer[breakGlassOverride]
+	// costBudgetExhausted is whether the cost budget was exhausted when it was last checked, to alert once when it is.
+	costBudgetExhausted atomic.Bool
+
 	l2ooContract L2OOContract
-	l2ooABI      *abi.ABI
+	// transactor sends the checkpoint and proposal transactions to the L2OO. It is the L2OutputSubmitter itself, except
+	// in tests.
+	transactor l2ooTransactor
+	l2ooABI    *abi.ABI
 
 	dgfABI *abi.ABI
 
 	db db.ProofDB
+
+	witnessGenLimiter *witnessGenLimiter
+	// persistingLimit is whether the witness generation limit is written to the DB, which only the instance that runs the
+	// pipeline does.
+	persistingLimit bool
+	// backendHealth tracks which prover backends are unreachable, so new requests fail over from them.
+	backendHealth backendHealth
+	// circuitBreaker stops proof requests to the servers whose requests keep failing, until they're healthy again.
+	circuitBreaker circuitBreaker
+	// witnessGenProbe tracks the servers that failed their latest health probe.
+	witnessGenProbe witnessGenProbe
+	// leaderLock is the leader lock while this instance is the elected leader, or nil while it stands by.
+	leaderLock *db.LeaderLock
+	// statusWatches tracks the PROVING requests whose status is long-polled, and provingStatusChanged wakes up the loop
+	// once one of them was fulfilled or became unfulfillable.
+	statusWatches        statusWatches
+	provingStatusChanged chan struct{}
+	// differentialChecks is the number of differential checks in flight, which take up witness generation slots.
+	differentialChecks atomic.Int64
+	// doubleChecks is the number of double-checks on the double-check server in flight.
+	doubleChecks atomic.Int64
+	// nextOutputBlock is the L2 block of the next L2OO output as of the last AGG proof derivation, or 0 before it.
+	nextOutputBlock atomic.Uint64
+	// slaEscalationEnd is the L2 block of the next L2OO output while the oldest unproven block is older than
+	// SLA_MAX_UNPROVEN_AGE, so the proof requests up to it are escalated, or 0 while it isn't.
+	slaEscalationEnd atomic.Uint64
+
+	// appliedSpec is the raw pipeline spec that was last applied, and currentSettings the settings it resulted in. Nil
+	// until a spec is applied.
+	appliedSpec     []byte
+	currentSettings atomic.Pointer[pipelineSettings]
+
+	// coldStore is the cold storage tier for historical proofs. Nil if cold storage is disabled.
+	coldStore coldstore.Store
+	// ipfs pins completed AGG proofs to IPFS. Nil if IPFS export is disabled.
+	ipfs proofPinner
+
+	// l2ooFilterer, watchFromL1Block, lastWatchedEvent and lastWatchedL2Block track the outputs proposed on the L2OO
+	// in watch-only mode.
+	l2ooFilterer       *opsuccinctbindings.OPSuccinctL2OutputOracleFilterer
+	watchFromL1Block   uint64
+	lastWatchedEvent   watchPosition
+	lastWatchedL2Block uint64
+
+	// altdaClient resolves the batch data commitments of an Alt-DA chain. Nil if the chain doesn't use Alt-DA.
+	altdaClient         *altda.DAClient
+	altdaCommitmentType altda.CommitmentType
+	// altdaCheckedL1Block is the highest L1 block whose Alt-DA commitments are known to be available.
+	altdaCheckedL1Block uint64
+	// channelBoundaries caches the channel boundaries that spans are aligned to.
+	channelBoundaries channelBoundaryCache
+
+	// forecaster forecasts the proof throughput from the rate at which span proofs are fulfilled.
+	forecaster *forecast.Forecaster
+
+	// lastSpanCompaction is when unrequested span proofs were last compacted.
+	lastSpanCompaction time.Time
+	// lastReorgCheck is when the blocks of unproposed proofs were last checked for reorgs.
+	lastReorgCheck time.Time
+	// lastWitnessGenProbe is when the servers were last probed for their health.
+	lastWitnessGenProbe time.Time
+	// preCheckpoint is the L1 block hash that was checkpointed ahead of time for the next AGG proof, if any. It is
+	// only accessed from the driver loop.
+	preCheckpoint *preCheckpoint
+
+	// telemetry aggregates the pipeline statistics for the next telemetry report, which was last sent at
+	// lastTelemetryReport. Nil if telemetry is disabled.
+	telemetry           *telemetry.Collector
+	lastTelemetryReport time.Time
+
+	// lastMetadataExport is when the proof request metadata was last exported to METADATA_EXPORT_PATH.
+	lastMetadataExport time.Time
+
+	// lastCapacityNegotiation is when the witness generation concurrency was last negotiated with the servers.
+	lastCapacityNegotiation time.Time
+
+	// tracer exports the spans of the proof pipeline. Nil if tracing is disabled.
+	tracer *tracing.Tracer
+
+	// configContract is the OPSuccinctProposerConfig contract, whose parameters were last applied as
+	// appliedOnChainConfig. Nil if proving parameters aren't read from a contract.
+	configContract       *bind.BoundContract
+	appliedOnChainConfig OnChainConfig
+	// safe is the Gnosis Safe at SAFE_ADDRESS that output proposals are proposed to. Nil if they're sent from the
+	// proposer's account.
+	safe *bind.BoundContract
+	// programsChecked is whether the server's programs were checked against the L2OO's vkeys, and
+	// programMismatch is why they don't match, if they don't.
+	programsChecked bool
+	programMismatch atomic.Pointer[string]
+
+	// bootstrapping is whether the L2OO had no outputs but its starting output when the proposer started, until its
+	// first output is proposed. bootstrapChecked is whether the starting output and the DB were validated against it,
+	// and bootstrapMismatch is why proof requests are held until they are, if they are.
+	bootstrapping     bool
+	bootstrapChecked  bool
+	bootstrapMismatch atomic.Pointer[string]
+
+	// competitorFromL1Block is the next L1 block to check for outputs proposed by competing proposers, and
+	// competitorSeen is whether one has been seen.
+	competitorFromL1Block uint64
+	competitorSeen        bool
+
+	// planner splits new ranges into span proofs that minimize the predicted proving cost. Nil if new ranges are split
+	// into fixed-size spans.
+	planner *rangePlanner
+
+	// nonceLane sends the proposer's L1 transactions one at a time, and nonces reads the nonces of its account to
+	// detect transactions that other senders make from it.
+	nonceLane nonceLane
+	nonces    nonceReader
+
+	// gzipBackends records which prover backends accept gzip-compressed proof request bodies, by URL.
+	gzipBackends sync.Map
+	// statusBatchUnsupported records which prover backends don't serve batched proof status requests, by URL.
+	statusBatchUnsupported sync.Map
+
+	// network polls proof statuses from the SP1 prover network directly. Nil if they're polled through the servers.
+	network *networkClient
+
+	// blockedRanges are the block ranges that span proofs are never requested for.
+	blockedRanges []blockedRange
+
+	// configHash is the hash of the effective configuration that was last logged.
+	configHash string
+
+	// heartbeatID is the ID of this run's heartbeat row, or 0 before the proposer started. catchUp is the latest
+	// downtime while the proposer is catching up on its backlog, and is only accessed from the driver loop.
+	heartbeatID int
+	catchUp     *catchUp
 }
 
 // NewL2OutputSubmitter creates a new L2 Output Submitter
@@ -113,6 +294,25 @@ func NewL2OutputSubmitter(setup DriverSetup) (_ *L2OutputSubmitter, err error) {
 	}
 }
 
+// forecastPath returns the file that the forecast state is persisted to. By default, it's kept next to the SQLite DB,
+// but isn't deleted with it, so the proving history survives restarts. A Postgres DB has no local directory, so the
+// forecast is only kept in memory unless FORECAST_PATH is set.
+func forecastPath(cfg ProposerConfig) string {
+	if cfg.ForecastPath != "" || cfg.DbConnectionString != "" {
+		return cfg.ForecastPath
+	}
+	return filepath.Join(filepath.Dir(cfg.DbPath), "forecast.json")
+}
+
+// openProofDB opens the Postgres proof DB if a connection string is configured, and the SQLite DB at the DB path
+// otherwise.
+func openProofDB(cfg ProposerConfig) (*db.ProofDB, error) {
+	if cfg.DbConnectionString != "" {
+		return db.Open(cfg.DbConnectionString)
+	}
+	return db.InitDB(cfg.DbPath, cfg.UseCachedDb)
+}
+
 func newL2OOSubmitter(ctx context.Context, cancel context.CancelFunc, setup DriverSetup) (*L2OutputSubmitter, error) {
 	l2ooContract, err := opsuccinctbindings.NewOPSuccinctL2OutputOracleCaller(*setup.Cfg.L2OutputOracleAddr, setup.L1Client)
 	if err != nil {
@@ -120,6 +320,12 @@ func newL2OOSubmitter(ctx context.Context, cancel context.CancelFunc, setup Driv
 		return nil, fmt.Errorf("failed to create L2OO at address %s: %w", setup.Cfg.L2OutputOracleAddr, err)
 	}
 
+	l2ooFilterer, err := opsuccinctbindings.NewOPSuccinctL2OutputOracleFilterer(*setup.Cfg.L2OutputOracleAddr, setup.L1Client)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to create L2OO filterer at address %s: %w", setup.Cfg.L2OutputOracleAddr, err)
+	}
+
 	cCtx, cCancel := context.WithTimeout(ctx, setup.Cfg.NetworkTimeout)
 	defer cCancel()
 	version, err := l2ooContract.Version(&bind.CallOpts{Context: cCtx})
@@ -141,24 +347,176 @@ func newL2OOSubmitter(ctx context.Context, cancel context.CancelFunc, setup Driv
 		return nil, err
 	}
 
-	db, err := db.InitDB(setup.Cfg.DbPath, setup.Cfg.UseCachedDb)
+	db, err := openProofDB(setup.Cfg)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if setup.Cfg.DbReplicaConnectionString != "" {
+		if err := db.SetReplica(setup.Cfg.DbReplicaConnectionString); err != nil {
+			cancel()
+			return nil, err
+		}
+		setup.Log.Info("reading analytical queries from the DB read replica")
+	}
+	// A warm standby keeps the instances the active proposer attributed the replicated requests to.
+	if setup.Cfg.InstanceID != "" && setup.Cfg.ReplicateFrom == "" {
+		db.SetInstanceID(setup.Cfg.InstanceID)
+		setup.Log.Info("attributing proof requests to this instance", "instanceID", setup.Cfg.InstanceID)
+	}
+
+	witnessGenLimiter, err := newPersistentWitnessGenLimiter(db, setup.Log, setup.Cfg.MaxConcurrentWitnessGen)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if setup.Cfg.WitnessGenLatencyTarget > 0 {
+		witnessGenLimiter.SetLatencyTarget(setup.Cfg.WitnessGenLatencyTarget, setup.Cfg.WitnessGenMaxFailureRate)
+	}
+
+	var coldStore coldstore.Store
+	if setup.Cfg.ColdStorageDir != "" {
+		coldStore, err = coldstore.NewFileStore(setup.Cfg.ColdStorageDir)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+	} else if setup.Cfg.ColdStorageS3 != nil {
+		coldStore, err = coldstore.NewS3Store(*setup.Cfg.ColdStorageS3)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+
+	// Proofs written to the proof store are read from it by the DB, so it's set on the DB instead of the driver.
+	if setup.Cfg.ProofStoreDir != "" {
+		proofStore, err := coldstore.NewFileStore(setup.Cfg.ProofStoreDir)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		db.SetProofStore(proofStore)
+	} else if setup.Cfg.ProofStoreS3 != nil {
+		proofStore, err := coldstore.NewS3Store(*setup.Cfg.ProofStoreS3)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		db.SetProofStore(proofStore)
+	}
+
+	var pinner proofPinner
+	if setup.Cfg.IPFSApiUrl != "" {
+		pinner = ipfs.NewClient(setup.Cfg.IPFSApiUrl)
+	}
+
+	forecaster, err := forecast.Load(forecastPath(setup.Cfg), forecast.DefaultHalfLife, forecast.DefaultWindow)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	blockedRanges, err := parseBlockedRanges(setup.Cfg.BlockedRanges)
 	if err != nil {
 		cancel()
 		return nil, err
 	}
 
-	return &L2OutputSubmitter{
+	var configContract *bind.BoundContract
+	if setup.Cfg.ConfigContractAddr != nil {
+		configContract, err = newConfigContract(*setup.Cfg.ConfigContractAddr, setup.L1Client)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+
+	var safe *bind.BoundContract
+	if setup.Cfg.SafeAddress != "" {
+		safe, err = newSafeContract(common.HexToAddress(setup.Cfg.SafeAddress), setup.L1Client)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+
+	// Telemetry is opt-in. The collector passes all metrics through, so the driver's metrics are unchanged.
+	var collector *telemetry.Collector
+	if setup.Cfg.Telemetry {
+		collector = telemetry.NewCollector(setup.Metr)
+		setup.Metr = collector
+		log.Info("Telemetry enabled", "endpoint", setup.Cfg.TelemetryEndpoint, "interval", setup.Cfg.TelemetryInterval)
+	}
+
+	var tracer *tracing.Tracer
+	if setup.Cfg.TracingEndpoint != "" {
+		tracer = tracing.NewTracer(setup.Cfg.TracingEndpoint, "op-succinct-proposer", setup.Log)
+		log.Info("Tracing enabled", "endpoint", setup.Cfg.TracingEndpoint)
+	}
+
+	var planner *rangePlanner
+	if setup.Cfg.RangePlanner == RangePlannerCost {
+		l2Client, err := dial.DialEthClientWithTimeout(ctx, dial.DefaultDialTimeout, setup.Log, setup.Cfg.L2EthRpc)
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("failed to dial L2 RPC: %w", err)
+		}
+		planner = newRangePlanner(setup.Log, l2Client, setup.Cfg.SpanOverheadCycles)
+	}
+
+	var altdaClient *altda.DAClient
+	var altdaCommitmentType altda.CommitmentType
+	if setup.Cfg.AltDACommitmentType != "" {
+		altdaCommitmentType, err = altda.CommitmentTypeFromString(setup.Cfg.AltDACommitmentType)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		altdaClient = altda.NewDAClient(setup.Cfg.AltDAServerUrl, true, false)
+	}
+
+	l := &L2OutputSubmitter{
 		DriverSetup: setup,
 		done:        make(chan struct{}),
 		ctx:         ctx,
 		cancel:      cancel,
 
 		l2ooContract: l2ooContract,
+		l2ooFilterer: l2ooFilterer,
 		l2ooABI:      l2ooAbiParsed,
 		dgfABI:       dfgAbiParsed,
 
 		db: *db,
-	}, nil
+
+		witnessGenLimiter:    witnessGenLimiter,
+		provingStatusChanged: make(chan struct{}, 1),
+		coldStore:            coldStore,
+		ipfs:                 pinner,
+
+		altdaClient:         altdaClient,
+		altdaCommitmentType: altdaCommitmentType,
+
+		forecaster: forecaster,
+
+		telemetry:           collector,
+		lastTelemetryReport: time.Now(),
+		tracer:              tracer,
+
+		configContract: configContract,
+		safe:           safe,
+
+		planner:       planner,
+		blockedRanges: blockedRanges,
+	}
+	l.transactor = l
+	if setup.L1Client != nil {
+		l.nonces = setup.L1Client
+	}
+	if setup.Cfg.ProverNetworkRpcUrl != "" {
+		l.network = newNetworkClient(setup.Cfg.ProverNetworkRpcUrl)
+	}
+	return l, nil
 }
 
 func (l *L2OutputSubmitter) StartL2OutputSubmitting() error {
@@ -172,25 +530,61 @@ func (l *L2OutputSubmitter) StartL2OutputSubmitting() error {
 	}
 	l.running = true
 
-	// When restarting the proposer using a cached database, we need to mark all proofs that are in witness generation state as failed, and retry them.
-	witnessGenReqs, err := l.db.GetAllProofsWithStatus(proofrequest.StatusWITNESSGEN)
-	if err != nil {
-		return fmt.Errorf("failed to get witness generation pending proofs: %w", err)
+	// A warm standby only replicates the active proposer's DB. It's promoted by restarting it without REPLICATE_FROM,
+	// which resumes the pipeline from the replicated state.
+	if l.Cfg.ReplicateFrom != "" {
+		l.wg.Add(1)
+		go l.replicate()
+		l.Log.Info("Proposer started as a warm standby", "activeProposer", redactURL(l.Cfg.ReplicateFrom))
+		return nil
 	}
-	for _, req := range witnessGenReqs {
-		err = l.RetryRequest(req, ProofStatusResponse{})
-		if err != nil {
-			return fmt.Errorf("failed to retry request: %w", err)
+
+	// When restarting the proposer using a cached database, the requests that were in witness generation are resumed.
+	// With leader election, they're resumed once this instance is elected, and a follower leaves them to the active
+	// proposer.
+	if !l.Cfg.LeaderElection && !l.Cfg.Follower {
+		if err := l.ResumeWitnessGenRequests(); err != nil {
+			return fmt.Errorf("failed to resume witness generation requests: %w", err)
+		}
+	}
+
+	// Record this run, and whether the proposer was down before it. A follower doesn't propose, so its runs would hide
+	// the downtime of the active proposer.
+	if !l.Cfg.Follower {
+		if err := l.startHeartbeat(); err != nil {
+			return fmt.Errorf("failed to start heartbeat: %w", err)
 		}
 	}
 
+	// Apply the pipeline spec before the first loop iteration, so an invalid spec fails startup.
+	if err := l.reconcilePipelineSpec(); err != nil {
+		return fmt.Errorf("failed to apply pipeline spec: %w", err)
+	}
+	l.recordConfigHash()
+
+	// A freshly deployed L2OO is bootstrapped, and one that was never initialized can't be proposed to.
+	if err := l.checkL2OOState(l.ctx); err != nil {
+		return err
+	}
+
 	// Validate the contract's configuration of the aggregation and range verification keys as well
 	// as the rollup config hash.
-	err = l.ValidateConfig(l.Cfg.L2OutputOracleAddr.Hex())
+	err := l.ValidateConfig(l.ctx, l.Cfg.L2OutputOracleAddr.Hex())
 	if err != nil {
 		return fmt.Errorf("failed to validate config: %w", err)
 	}
 
+	// Check that the proofs the proposer requests, mock or real, can be verified by the L2OO's verifier.
+	if err := l.checkVerifier(l.ctx, l.L1Client); err != nil {
+		return fmt.Errorf("failed to check verifier: %w", err)
+	}
+
+	// A follower doesn't request witness generation, so it leaves the persisted limit to the active proposer. With leader
+	// election, the limit is persisted once this instance is elected.
+	if !l.Cfg.LeaderElection && !l.Cfg.Follower {
+		l.startLimitPersister()
+	}
+
 	l.wg.Add(1)
 	go l.loop()
 
@@ -220,8 +614,15 @@ func (l *L2OutputSubmitter) StopL2OutputSubmitting() error {
 	l.cancel()
 	close(l.done)
 	l.wg.Wait()
+	// The loop is stopped, so no new proof requests are dispatched while the in-flight ones are drained.
+	l.drainInflightRequests()
+	l.tracer.Flush()
 
 	if l.db != (db.ProofDB{}) {
+		if err := l.stopHeartbeat(); err != nil {
+			l.Log.Error("failed to stop heartbeat", "err", err)
+		}
+		l.releaseLeadership()
 		if err := l.db.CloseDB(); err != nil {
 			return fmt.Errorf("error closing database: %w", err)
 		}
@@ -254,31 +655,41 @@ func (l *L2OutputSubmitter) GetProposerMetrics(ctx context.Context) (opsuccinctm
 		return opsuccinctmetrics.ProposerMetrics{}, fmt.Errorf("failed to get latest output index: %w", err)
 	}
 
-	// Get the highest proven L2 block contiguous with the contract's latest block.
-	highestProvenContiguousL2Block, err := l.db.GetMaxContiguousSpanProofRange(latestContractL2Block.Uint64())
-	if err != nil {
-		return opsuccinctmetrics.ProposerMetrics{}, fmt.Errorf("failed to get max contiguous span proof range: %w", err)
-	}
-
 	// This fetches the next block number, which is the currentBlock + submissionInterval.
 	minBlockToProveToAgg, err := l.l2ooContract.NextBlockNumber(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		return opsuccinctmetrics.ProposerMetrics{}, fmt.Errorf("failed to get next L2OO output: %w", err)
 	}
 
-	numProving, err := l.db.GetNumberOfRequestsWithStatuses(proofrequest.StatusPROVING)
-	if err != nil {
-		return opsuccinctmetrics.ProposerMetrics{}, fmt.Errorf("failed to get number of proofs proving: %w", err)
-	}
+	// Read the DB stats from a single snapshot, so that a request changing status between two queries isn't counted
+	// twice or not at all.
+	var highestProvenContiguousL2Block uint64
+	var numProving, numWitnessgen, numUnrequested int
+	err = l.db.ReadSnapshot(func(snapshot *db.ProofDB) error {
+		// Get the highest proven L2 block contiguous with the contract's latest block.
+		highestProvenContiguousL2Block, err = snapshot.GetMaxContiguousSpanProofRange(latestContractL2Block.Uint64())
+		if err != nil {
+			return fmt.Errorf("failed to get max contiguous span proof range: %w", err)
+		}
 
-	numWitnessgen, err := l.db.GetNumberOfRequestsWithStatuses(proofrequest.StatusWITNESSGEN)
-	if err != nil {
-		return opsuccinctmetrics.ProposerMetrics{}, fmt.Errorf("failed to get number of proofs witnessgen: %w", err)
-	}
+		numProving, err = snapshot.GetNumberOfRequestsWithStatuses(proofrequest.StatusPROVING)
+		if err != nil {
+			return fmt.Errorf("failed to get number of proofs proving: %w", err)
+		}
+
+		numWitnessgen, err = snapshot.GetNumberOfRequestsWithStatuses(proofrequest.StatusWITNESSGEN)
+		if err != nil {
+			return fmt.Errorf("failed to get number of proofs witnessgen: %w", err)
+		}
 
-	numUnrequested, err := l.db.GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ)
+		numUnrequested, err = snapshot.GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ)
+		if err != nil {
+			return fmt.Errorf("failed to get number of unrequested proofs: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return opsuccinctmetrics.ProposerMetrics{}, fmt.Errorf("failed to get number of unrequested proofs: %w", err)
+		return opsuccinctmetrics.ProposerMetrics{}, err
 	}
 
 	metrics := opsuccinctmetrics.ProposerMetrics{
@@ -293,9 +704,7 @@ func (l *L2OutputSubmitter) GetProposerMetrics(ctx context.Context) (opsuccinctm
 	}
 
 	// Record the metrics
-	if m, ok := l.Metr.(*opsuccinctmetrics.OPSuccinctMetrics); ok {
-		m.RecordProposerStatus(metrics)
-	}
+	l.Metr.RecordProposerStatus(metrics)
 
 	return metrics, nil
 }
@@ -324,13 +733,78 @@ func (l *L2OutputSubmitter) SubmitAggProofs(ctx context.Context) error {
 
 	// Submit the agg proof with the highest L2 block number.
 	aggProof := completedAggProofs[0]
+
+	// Hold the proposal back while the L1 base fee spikes, for up to MAX_SUBMISSION_DELAY.
+	delayReason, err := l.submissionDelayReason(ctx, aggProof)
+	if err != nil {
+		return err
+	}
+	if delayReason != "" {
+		l.Log.Info("Delaying the proposal", "reason", delayReason, "end", aggProof.EndBlock)
+		l.Metr.RecordError("submission_delayed_base_fee", 1)
+		return nil
+	}
+
 	output, err := l.FetchOutput(ctx, aggProof.EndBlock)
 	if err != nil {
 		return fmt.Errorf("failed to fetch output at block %d: %w", aggProof.EndBlock, err)
 	}
+
+	// Require the verification service to accept the AGG proof before any L1 gas is spent on proposing it.
+	verified, err := l.verifyWithService(ctx, aggProof, output)
+	if err != nil || !verified {
+		return err
+	}
+
+	// If another proposer can propose too, yield to its pending proposal instead of racing it, since whichever
+	// proposal lands second reverts. The proposal is also cancelled if a competing output lands while it is sent.
+	competition, err := l.competitionPossible(ctx)
+	if err != nil {
+		return err
+	}
+	if competition {
+		txHash, pending, err := l.pendingCompetingProposal(ctx)
+		if err != nil {
+			return err
+		}
+		if pending {
+			l.Log.Warn("Yielding to the pending proposal of a competing proposer", "tx", txHash, "end", aggProof.EndBlock)
+			l.Metr.RecordError("yielded_to_competitor", 1)
+			return nil
+		}
+		var stop func()
+		ctx, stop = l.cancelOnCompetingOutput(ctx, latestBlockNumber.Uint64(), aggProof.EndBlock)
+		defer stop()
+	}
+
+	// A proposal that reverted isn't resent blindly, only once its simulation passes.
+	if aggProof.SubmissionReverts > 0 {
+		if err := l.transactor.simulateProposal(ctx, output, aggProof.Proof, aggProof.L1BlockNumber); err != nil {
+			if classifySubmissionError(err) != submissionReverted {
+				return fmt.Errorf("failed to simulate proposal: %w", err)
+			}
+			return l.recordSubmissionFailure(aggProof, submissionReverted, fmt.Errorf("simulation still reverts: %w", err))
+		}
+	}
+
 	err = l.proposeOutput(ctx, output, aggProof.Proof, aggProof.L1BlockNumber)
+	if errors.Is(context.Cause(ctx), errCompetingOutput) {
+		l.Log.Warn("Cancelled proposal, a competing proposer proposed an output first", "end", aggProof.EndBlock)
+		l.Metr.RecordError("yielded_to_competitor", 1)
+		return nil
+	}
 	if err != nil {
-		return fmt.Errorf("failed to propose output: %w", err)
+		failure := classifySubmissionError(err)
+		if failure == "" {
+			return fmt.Errorf("failed to propose output: %w", err)
+		}
+		if failure == submissionReverted {
+			// The simulation's error carries the revert reason, which the receipt doesn't.
+			if simErr := l.transactor.simulateProposal(ctx, output, aggProof.Proof, aggProof.L1BlockNumber); simErr != nil {
+				err = fmt.Errorf("%w, simulation: %w", err, simErr)
+			}
+		}
+		return l.recordSubmissionFailure(aggProof, failure, err)
 	}
 
 	return nil
@@ -521,48 +995,68 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 	}
 
 	l.Log.Info("Proposing output root", "output", output.OutputRoot, "block", output.BlockRef)
-	var receipt *types.Receipt
+	candidate, err := l.proposalCandidate(output, proof, l1BlockNum)
+	if err != nil {
+		return err
+	}
+	// This blocks the loop until the transaction is mined. The tx manager resubmits it with bumped fees until then, for
+	// up to L1_TX_TIMEOUT, which bounds ctx.
+	receipt, err := l.sendL1Transaction(ctx, candidate)
+	if err != nil {
+		return err
+	}
+
+	if receipt.Status == types.ReceiptStatusFailed {
+		l.Log.Error("Proposer tx successfully published but reverted", "tx_hash", receipt.TxHash)
+		return fmt.Errorf("%w: %s", errProposalReverted, receipt.TxHash)
+	}
+	l.Log.Info("Proposer tx successfully published", "tx_hash", receipt.TxHash)
+	return nil
+}
+
+// simulateProposal simulates the proposal transaction with an eth_call from the proposer's account.
+func (l *L2OutputSubmitter) simulateProposal(ctx context.Context, output *eth.OutputResponse, proof []byte, l1BlockNum uint64) error {
+	candidate, err := l.proposalCandidate(output, proof, l1BlockNum)
+	if err != nil {
+		return err
+	}
+	_, err = l.L1Client.CallContract(ctx, ethereum.CallMsg{
+		From:  l.Txmgr.From(),
+		To:    candidate.To,
+		Data:  candidate.TxData,
+		Value: candidate.Value,
+	}, nil)
+	return err
+}
+
+// proposalCandidate builds the transaction that proposes the output, through the DisputeGameFactory if it's set, and to
+// the L2OO otherwise.
+func (l *L2OutputSubmitter) proposalCandidate(output *eth.OutputResponse, proof []byte, l1BlockNum uint64) (txmgr.TxCandidate, error) {
 	if l.Cfg.DisputeGameFactoryAddr != nil {
 		bondAmount, err := l.GetBondAmount()
 		if err != nil {
-			return err
+			return txmgr.TxCandidate{}, err
 		}
 		data, err := l.ProposeL2OutputDGFTxData(output, proof, l1BlockNum)
 		if err != nil {
-			return err
+			return txmgr.TxCandidate{}, err
 		}
-		// TODO: This currently blocks the loop while it waits for the transaction to be confirmed. Up to 3 minutes.
-		receipt, err = l.Txmgr.Send(ctx, txmgr.TxCandidate{
+		return txmgr.TxCandidate{
 			TxData:   data,
 			To:       l.Cfg.DisputeGameFactoryAddr,
 			GasLimit: 0,
 			Value:    bondAmount,
-		})
-		if err != nil {
-			return err
-		}
-	} else {
-		data, err := l.ProposeL2OutputTxData(output, proof, l1BlockNum)
-		if err != nil {
-			return err
-		}
-		// TODO: This currently blocks the loop while it waits for the transaction to be confirmed. Up to 3 minutes.
-		receipt, err = l.Txmgr.Send(ctx, txmgr.TxCandidate{
-			TxData:   data,
-			To:       l.Cfg.L2OutputOracleAddr,
-			GasLimit: 0,
-		})
-		if err != nil {
-			return err
-		}
+		}, nil
 	}
-
-	if receipt.Status == types.ReceiptStatusFailed {
-		l.Log.Error("Proposer tx successfully published but reverted", "tx_hash", receipt.TxHash)
-	} else {
-		l.Log.Info("Proposer tx successfully published", "tx_hash", receipt.TxHash)
+	data, err := l.ProposeL2OutputTxData(output, proof, l1BlockNum)
+	if err != nil {
+		return txmgr.TxCandidate{}, err
 	}
-	return nil
+	return txmgr.TxCandidate{
+		TxData:   data,
+		To:       l.Cfg.L2OutputOracleAddr,
+		GasLimit: 0,
+	}, nil
 }
 
 // loop is responsible for creating & submitting the next outputs
@@ -571,6 +1065,16 @@ func (l *L2OutputSubmitter) loop() {
 	defer l.wg.Done()
 	ctx := l.ctx
 
+	// Download the proofs that were fulfilled while the proposer was stopped. Polling the prover network doesn't depend
+	// on the node being synced. If it fails, the requests are checked again on the first poll. With leader election,
+	// they're downloaded once this instance is elected. A follower leaves them to the active proposer, which may still be
+	// storing the prover request IDs of the requests that look like they have none.
+	if !l.Cfg.LeaderElection && !l.Cfg.Follower {
+		if err := l.ResumeProvingRequests(); err != nil {
+			l.Log.Error("failed to resume PROVING requests", "err", err)
+		}
+	}
+
 	if l.Cfg.WaitNodeSync {
 		err := l.waitNodeSync()
 		if err != nil {
@@ -608,77 +1112,243 @@ func (l *L2OutputSubmitter) loopL2OO(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			// Get the current metrics for the proposer.
-			metrics, err := l.GetProposerMetrics(ctx)
-			if err != nil {
-				l.Log.Error("failed to get metrics", "err", err)
-				continue
+		case <-l.provingStatusChanged:
+			// A long-polled proof request was fulfilled or became unfulfillable, so pick it up right away.
+			l.Log.Info("Proof status changed, running the loop early")
+		case <-l.done:
+			return
+		}
+
+		// A follower only polls, and leaves the pipeline spec, the heartbeat and the bootstrap to the active proposer.
+		if l.Cfg.Follower {
+			l.follow(ctx)
+			continue
+		}
+
+		if err := l.heartbeat(); err != nil {
+			l.Log.Error("failed to record heartbeat", "err", err)
+			l.Metr.RecordError("heartbeat", 1)
+		}
+		// Standbys keep their heartbeat, so a standby taking over from a failed leader isn't recorded as downtime, and
+		// leave everything else, which writes the shared DB, to the leader.
+		if l.Cfg.LeaderElection && !l.electLeader(ctx) {
+			continue
+		}
+		// Pick up any changes to the pipeline spec. If the spec is invalid, keep running with the last applied one.
+		if err := l.reconcilePipelineSpec(); err != nil {
+			l.Log.Error("failed to reconcile pipeline spec", "err", err)
+			l.Metr.RecordError("pipeline_spec", 1)
+		}
+		if err := l.trackCatchUp(ctx); err != nil {
+			l.Log.Error("failed to track the downtime catch-up", "err", err)
+		}
+		if err := l.checkPipelineAlerts(); err != nil {
+			l.Log.Error("failed to check pipeline alerts", "err", err)
+		}
+		if err := l.checkCostBudget(); err != nil {
+			l.Log.Error("failed to check the cost budget", "err", err)
+		}
+		// Pick up any changes to the on-chain config. If it can't be read, keep running with the last applied one.
+		if err := l.reconcileOnChainConfig(ctx); err != nil {
+			l.Log.Error("failed to reconcile on-chain config", "err", err)
+			l.Metr.RecordError("onchain_config", 1)
+		}
+		l.recordConfigHash()
+		if l.bootstrapping {
+			if err := l.advanceBootstrap(ctx); err != nil {
+				l.Log.Error("failed to check the bootstrap progress", "err", err)
+				l.Metr.RecordError("bootstrap", 1)
 			}
-			l.Log.Info("Proposer status", "metrics", metrics)
+		}
 
-			// 1) Queue up the range proofs that are ready to prove. Determine these range proofs based on the latest L2 finalized block,
-			// and the current L2 unsafe head.
+		// Get the current metrics for the proposer.
+		metrics, err := l.GetProposerMetrics(ctx)
+		if err != nil {
+			l.Log.Error("failed to get metrics", "err", err)
+			continue
+		}
+		l.Log.Info("Proposer status", "metrics", metrics, "configHash", l.configHash)
+
+		// 1) Queue up the range proofs that are ready to prove. Determine these range proofs based on the latest L2 finalized block,
+		// and the current L2 unsafe head.
+		// In watch-only mode, verify the outputs proposed by the watched proposer instead, and queue the sampled
+		// ranges to re-prove.
+		if l.Cfg.WatchOnly {
+			l.Log.Info("Stage 1: Watching Proposed Outputs...")
+			// The next poll resumes after the last output that was processed, so the queued re-proofs are still
+			// processed if watching fails.
+			err = l.WatchOutputs(ctx)
+			if err != nil {
+				l.Log.Error("failed to watch proposed outputs", "err", err)
+			}
+		} else {
 			l.Log.Info("Stage 1: Getting Range Proof Boundaries...")
 			err = l.GetRangeProofBoundaries(ctx)
 			if err != nil {
 				l.Log.Error("failed to get range proof boundaries", "err", err)
 				continue
 			}
+		}
 
-			// 2) Check the statuses of PROVING requests.
-			// If it's successfully returned, we validate that we have it on disk and set status = "COMPLETE".
-			// If it fails or times out, we set status = "FAILED" (and, if it's a span proof, split the request in half to try again).
-			l.Log.Info("Stage 2: Processing PROVING requests...")
-			err = l.ProcessProvingRequests()
-			if err != nil {
-				l.Log.Error("failed to update PROVING requests", "err", err)
-				continue
+		// 2) Check the statuses of PROVING requests.
+		// If it's successfully returned, we validate that we have it on disk and set status = "COMPLETE".
+		// If it fails or times out, we set status = "FAILED" (and, if it's a span proof, split the request in half to try again).
+		l.Log.Info("Stage 2: Processing PROVING requests...")
+		err = l.ProcessProvingRequests()
+		if err != nil {
+			l.Log.Error("failed to update PROVING requests", "err", err)
+			continue
+		}
+		if l.Cfg.ProofStatusLongPoll > 0 {
+			if err := l.WatchProvingRequests(ctx); err != nil {
+				l.Log.Error("failed to watch PROVING requests", "err", err)
 			}
+		}
 
-			// 3) Check the statuses of WITNESSGEN requests.
-			// If the witness generation request has been in the WITNESSGEN state for longer than the timeout, set status to FAILED and retry.
-			l.Log.Info("Stage 3: Processing WITNESSGEN requests...")
-			err = l.ProcessWitnessgenRequests()
-			if err != nil {
-				l.Log.Error("failed to update WITNESSGEN requests", "err", err)
-				continue
+		// 3) Check the statuses of WITNESSGEN requests.
+		// If the witness generation request has been in the WITNESSGEN state for longer than the timeout, set status to FAILED and retry.
+		l.Log.Info("Stage 3: Processing WITNESSGEN requests...")
+		err = l.ProcessWitnessgenRequests()
+		if err != nil {
+			l.Log.Error("failed to update WITNESSGEN requests", "err", err)
+			continue
+		}
+
+		// 4) Determine if there is a continguous chain of span proofs starting from the latest block on the L2OO contract.
+		// If there is, queue an aggregate proof for all of the span proofs.
+		// In watch-only mode, re-proven ranges are only checked with span proofs, and nothing is proposed.
+		if !l.Cfg.WatchOnly {
+			// Stop requesting AGG proofs for ranges that a competing proposer already proposed.
+			if err := l.DetectCompetingOutputs(ctx); err != nil {
+				l.Log.Error("failed to detect competing outputs", "err", err)
 			}
 
-			// 4) Determine if there is a continguous chain of span proofs starting from the latest block on the L2OO contract.
-			// If there is, queue an aggregate proof for all of the span proofs.
 			l.Log.Info("Stage 4: Deriving Agg Proofs...")
 			err = l.DeriveAggProofs(ctx)
 			if err != nil {
 				l.Log.Error("failed to generate pending agg proofs", "err", err)
 				continue
 			}
+			if l.Cfg.PreCheckpointLead > 0 && !l.submissionsPaused.Load() {
+				if err := l.PreCheckpointBlockHash(ctx); err != nil {
+					l.Log.Error("failed to pre-checkpoint block hash", "err", err)
+				}
+			}
+		}
 
-			// 5) Request all unrequested proofs from the prover network.
-			// Any DB entry with status = "UNREQ" means it's queued up and ready.
-			// We request all of these (both span and agg) from the prover network.
-			// For agg proofs, we also checkpoint the blockhash in advance.
+		// 5) Request all unrequested proofs from the prover network.
+		// Any DB entry with status = "UNREQ" means it's queued up and ready.
+		// We request all of these (both span and agg) from the prover network.
+		// For agg proofs, we also checkpoint the blockhash in advance.
+		// Before requesting, periodically merge adjacent small span proofs left behind by splits.
+		// Invalidate the proofs of reorged blocks before more proofs are requested on top of them.
+		if !l.Cfg.WatchOnly && l.Cfg.ReorgCheckInterval > 0 && time.Since(l.lastReorgCheck) >= l.Cfg.ReorgCheckInterval {
+			if err := l.checkReorgs(ctx, l.L1Client); err != nil {
+				l.Log.Error("failed to check for reorgs", "err", err)
+				l.Metr.RecordError("reorg_check", 1)
+			}
+			l.lastReorgCheck = time.Now()
+		}
+		if !l.Cfg.WatchOnly && l.Cfg.SpanCompactionInterval > 0 && time.Since(l.lastSpanCompaction) >= l.Cfg.SpanCompactionInterval {
+			if err := l.CompactSpanProofs(); err != nil {
+				l.Log.Error("failed to compact span proofs", "err", err)
+			}
+			l.lastSpanCompaction = time.Now()
+		}
+		if len(l.Cfg.ProverFallbackServerUrls) > 0 {
+			l.ProbeProverBackends(ctx)
+		}
+		if l.Cfg.CircuitBreakerThreshold > 0 {
+			l.ProbeCircuitBreakers(ctx)
+		}
+		if !l.Cfg.WatchOnly && l.Cfg.WitnessGenProbeInterval > 0 && time.Since(l.lastWitnessGenProbe) >= l.Cfg.WitnessGenProbeInterval {
+			if err := l.ProbeWitnessGen(ctx); err != nil {
+				l.Log.Warn("failed to probe the health of the servers", "err", err)
+				l.Metr.RecordError("witness_gen_probe", 1)
+			}
+			l.lastWitnessGenProbe = time.Now()
+		}
+		if !l.Cfg.WatchOnly && l.Cfg.WitnessGenCapacityInterval > 0 && time.Since(l.lastCapacityNegotiation) >= l.Cfg.WitnessGenCapacityInterval {
+			if err := l.NegotiateWitnessGenCapacity(ctx); err != nil {
+				l.Log.Warn("failed to negotiate the witness generation concurrency", "err", err)
+				l.Metr.RecordError("witness_gen_capacity", 1)
+			}
+			l.lastCapacityNegotiation = time.Now()
+		}
+		if err := l.ParkBlockedRanges(); err != nil {
+			l.Log.Error("failed to park span proofs of blocked ranges", "err", err)
+			continue
+		}
+		if reason := l.proofRequestsHeldReason(); reason != "" {
+			l.Log.Info("Stage 5: Skipped", "reason", reason)
+		} else {
 			l.Log.Info("Stage 5: Requesting Queued Proofs...")
 			err = l.RequestQueuedProofs(ctx)
 			if err != nil {
 				l.Log.Error("failed to request unrequested proofs", "err", err)
 				continue
 			}
+		}
 
-			// 6) Submit agg proofs on chain.
-			// If we have a completed agg proof waiting in the DB, we submit them on chain.
+		// 6) Submit agg proofs on chain.
+		// If we have a completed agg proof waiting in the DB, we submit them on chain.
+		if l.submissionsPaused.Load() {
+			l.Log.Info("Stage 6: Skipped, L1 submissions are paused")
+		} else if !l.Cfg.WatchOnly {
 			l.Log.Info("Stage 6: Submitting Agg Proofs...")
 			err = l.SubmitAggProofs(ctx)
 			if err != nil {
 				l.Log.Error("failed to submit agg proofs", "err", err)
 			}
-		case <-l.done:
-			return
+		}
+
+		// 7) Pin completed AGG proofs to IPFS, move proofs older than the hot window to cold storage, and restore any
+		// proofs requested for retrieval.
+		if l.coldStore != nil || l.ipfs != nil {
+			l.Log.Info("Stage 7: Managing Cold Storage...")
+			// Archived proofs can't be exported anymore, so proofs aren't archived while exporting fails.
+			if err := l.ExportProofs(ctx); err != nil {
+				l.Log.Error("failed to export proofs to IPFS", "err", err)
+				l.Metr.RecordError("ipfs_export", 1)
+			} else if err := l.ArchiveProofs(ctx); err != nil {
+				l.Log.Error("failed to archive proofs", "err", err)
+			}
+			if err := l.ProcessProofRetrievals(ctx); err != nil {
+				l.Log.Error("failed to process proof retrievals", "err", err)
+			}
+		}
+
+		// 8) Delete the witness data the server kept for proofs that completed or were abandoned.
+		l.Log.Info("Stage 8: Cleaning Up Witness Artifacts...")
+		if err := l.CleanupWitnessArtifacts(); err != nil {
+			l.Log.Error("failed to clean up witness artifacts", "err", err)
+		}
+
+		// Report the anonymized pipeline statistics if telemetry is enabled.
+		if l.telemetry != nil && time.Since(l.lastTelemetryReport) >= l.Cfg.TelemetryInterval {
+			if err := l.ReportTelemetry(ctx); err != nil {
+				l.Log.Warn("failed to send telemetry report", "err", err)
+			}
+		}
+
+		// Export the proof request metadata for offline analytics.
+		if l.Cfg.MetadataExportPath != "" && time.Since(l.lastMetadataExport) >= l.Cfg.MetadataExportInterval {
+			if err := l.ExportProofMetadata(); err != nil {
+				l.Log.Warn("failed to export proof request metadata", "err", err)
+				l.Metr.RecordError("metadata_export", 1)
+			}
 		}
 	}
 }
 
+// l1TxContext returns the context that a checkpoint or proposal transaction is sent with, which gives up on the
+// transaction once it wasn't mined for L1_TX_TIMEOUT.
+func (l *L2OutputSubmitter) l1TxContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, l.Cfg.L1TxTimeout)
+}
+
 func (l *L2OutputSubmitter) proposeOutput(ctx context.Context, output *eth.OutputResponse, proof []byte, l1BlockNum uint64) error {
-	cCtx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+	cCtx, cancel := l.l1TxContext(ctx)
 	defer cancel()
 
 	// Get the current nextBlockNumber from the L2OO contract.
@@ -688,7 +1358,12 @@ func (l *L2OutputSubmitter) proposeOutput(ctx context.Context, output *eth.Outpu
 		return err
 	}
 
-	if err := l.sendTransaction(cCtx, output, proof, l1BlockNum); err != nil {
+	// With a Safe, the proposal is only proposed to its owners here, and lands on-chain once they execute it.
+	if l.safe != nil {
+		return l.proposeToSafe(cCtx, output, proof, l1BlockNum)
+	}
+
+	if err := l.transactor.sendTransaction(cCtx, output, proof, l1BlockNum); err != nil {
 		l.Log.Error("Failed to send proposal transaction",
 			"err", err,
 			"expected_next_blocknum", nextBlockNumber.Uint64(),
@@ -703,17 +1378,51 @@ func (l *L2OutputSubmitter) proposeOutput(ctx context.Context, output *eth.Outpu
 	return nil
 }
 
-// checkpointBlockHash gets the current L1 head, and then sends a transaction to checkpoint the blockhash on
-// the L2OO contract for the aggregation proof.
-func (l *L2OutputSubmitter) checkpointBlockHash(ctx context.Context) (uint64, common.Hash, error) {
-	cCtx, cancel := context.WithTimeout(ctx, 10*time.Minute)
-	defer cancel()
+// BLOCKHASH_WINDOW is the number of recent L1 blocks whose hashes are available to the EVM, and so can be checkpointed
+// on the L2OO contract.
+const BLOCKHASH_WINDOW = 256
 
-	currBlockNum, err := l.L1Client.BlockNumber(cCtx)
+// checkpointBlockNumber returns the number of the L1 block that is the given number of confirmations deep below the
+// L1 head.
+func checkpointBlockNumber(head, confirmations uint64) (uint64, error) {
+	if confirmations > head {
+		return 0, fmt.Errorf("L1 head %d has less than %d confirmations", head, confirmations)
+	}
+	return head - confirmations, nil
+}
+
+// checkpointHeader returns the header of the L1 block whose hash is checkpointed for AGG proofs: the finalized block
+// if CHECKPOINT_FINALIZED is set, or the block CHECKPOINT_CONFIRMATIONS deep otherwise. Deeper blocks are less likely
+// to reorg out, which would invalidate the AGG proofs that reference them.
+func (l *L2OutputSubmitter) checkpointHeader(ctx context.Context) (*types.Header, error) {
+	head, err := l.L1Client.BlockNumber(ctx)
 	if err != nil {
-		return 0, common.Hash{}, err
+		return nil, err
+	}
+	if l.Cfg.CheckpointFinalized {
+		header, err := l.L1Client.HeaderByNumber(ctx, big.NewInt(int64(gethrpc.FinalizedBlockNumber)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the finalized L1 block: %w", err)
+		}
+		if head >= header.Number.Uint64()+BLOCKHASH_WINDOW {
+			return nil, fmt.Errorf("finalized L1 block %d is too far behind the L1 head %d to checkpoint its hash", header.Number, head)
+		}
+		return header, nil
 	}
-	header, err := l.L1Client.HeaderByNumber(cCtx, new(big.Int).SetUint64(currBlockNum-1))
+	blockNumber, err := checkpointBlockNumber(head, l.Cfg.CheckpointConfirmations)
+	if err != nil {
+		return nil, err
+	}
+	return l.L1Client.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+}
+
+// checkpointBlockHash gets a recent L1 block, and then sends a transaction to checkpoint the blockhash on the L2OO
+// contract for the aggregation proof.
+func (l *L2OutputSubmitter) checkpointBlockHash(ctx context.Context) (uint64, common.Hash, error) {
+	cCtx, cancel := l.l1TxContext(ctx)
+	defer cancel()
+
+	header, err := l.checkpointHeader(cCtx)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
@@ -726,8 +1435,9 @@ func (l *L2OutputSubmitter) checkpointBlockHash(ctx context.Context) (uint64, co
 		return 0, common.Hash{}, err
 	}
 
-	// TODO: This currently blocks the loop while it waits for the transaction to be confirmed. Up to 3 minutes.
-	receipt, err = l.Txmgr.Send(ctx, txmgr.TxCandidate{
+	// This blocks the loop until the transaction is mined. The tx manager resubmits it with bumped fees until then, for
+	// up to L1_TX_TIMEOUT. If it gives up, the checkpoint is retried with a new L1 head on the next poll.
+	receipt, err = l.sendL1Transaction(cCtx, txmgr.TxCandidate{
 		TxData:   data,
 		To:       l.Cfg.L2OutputOracleAddr,
 		GasLimit: 0,
@@ -736,11 +1446,23 @@ func (l *L2OutputSubmitter) checkpointBlockHash(ctx context.Context) (uint64, co
 		return 0, common.Hash{}, err
 	}
 
+	// The block hash isn't checkpointed if the transaction reverted, so it can't be used as the L1 head of a proof.
 	if receipt.Status == types.ReceiptStatusFailed {
 		l.Log.Error("checkpoint blockhash tx successfully published but reverted", "tx_hash", receipt.TxHash)
-	} else {
-		l.Log.Info("checkpoint blockhash tx successfully published",
-			"tx_hash", receipt.TxHash)
+		l.Metr.RecordError("checkpoint_reverted", 1)
+		return 0, common.Hash{}, fmt.Errorf("checkpoint blockhash tx %s reverted", receipt.TxHash)
 	}
+	l.Log.Info("checkpoint blockhash tx successfully published",
+		"tx_hash", receipt.TxHash)
 	return blockNumber.Uint64(), blockHash, nil
 }
+
+// isCheckpointed returns whether the L1 block hash is checkpointed on the L2OO contract. A checkpoint transaction that
+// was dropped, reverted or reorged out leaves a block hash behind that the contract doesn't know about.
+func (l *L2OutputSubmitter) isCheckpointed(ctx context.Context, l1BlockNumber uint64, l1BlockHash string) (bool, error) {
+	checkpointed, err := l.l2ooContract.HistoricBlockHashes(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(l1BlockNumber))
+	if err != nil {
+		return false, fmt.Errorf("failed to get checkpointed block hash of L1 block %d: %w", l1BlockNumber, err)
+	}
+	return common.Hash(checkpointed) == common.HexToHash(l1BlockHash), nil
+}
@@ -1,11 +1,22 @@
 package proposer
 
 import (
+	"context"
+	"fmt"
+	"math/big"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
 	opsuccinctbindings "github.com/succinctlabs/op-succinct-go/bindings"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
 )
 
 func TestProposeL2OutputTxData(t *testing.T) {
@@ -27,3 +38,221 @@ func TestProposeL2OutputTxData(t *testing.T) {
 	require.NoError(t, err)
 
 }
+
+// fakeL2OO is an in-memory L2OO contract. Like the contract, it only accepts proposals that reach the next block number,
+// and that were proven against a checkpointed L1 block hash.
+type fakeL2OO struct {
+	submissionInterval uint64
+	latest             uint64
+	l1Head             uint64
+	checkpoints        map[uint64]common.Hash
+	proposals          []uint64
+	outputRoots        map[uint64]common.Hash
+	rangeVkey          common.Hash
+	aggVkey            common.Hash
+	verifier           common.Address
+	// txDeadline is the deadline of the latest proposal transaction.
+	txDeadline time.Time
+}
+
+var (
+	_ L2OOContract   = (*fakeL2OO)(nil)
+	_ l2ooTransactor = (*fakeL2OO)(nil)
+)
+
+func newFakeL2OO(latest, submissionInterval uint64) *fakeL2OO {
+	return &fakeL2OO{submissionInterval: submissionInterval, latest: latest, l1Head: 1000, checkpoints: map[uint64]common.Hash{}, outputRoots: map[uint64]common.Hash{}}
+}
+
+func (f *fakeL2OO) Version(*bind.CallOpts) (string, error) { return "v1.0.0", nil }
+
+func (f *fakeL2OO) LatestBlockNumber(*bind.CallOpts) (*big.Int, error) {
+	return new(big.Int).SetUint64(f.latest), nil
+}
+
+func (f *fakeL2OO) NextBlockNumber(*bind.CallOpts) (*big.Int, error) {
+	return new(big.Int).SetUint64(f.latest + f.submissionInterval), nil
+}
+
+func (f *fakeL2OO) LatestOutputIndex(*bind.CallOpts) (*big.Int, error) {
+	return big.NewInt(int64(len(f.proposals))), nil
+}
+
+func (f *fakeL2OO) NextOutputIndex(*bind.CallOpts) (*big.Int, error) {
+	return big.NewInt(int64(len(f.proposals) + 1)), nil
+}
+
+func (f *fakeL2OO) StartingTimestamp(*bind.CallOpts) (*big.Int, error) { return big.NewInt(0), nil }
+
+func (f *fakeL2OO) L2BLOCKTIME(*bind.CallOpts) (*big.Int, error) { return big.NewInt(2), nil }
+
+func (f *fakeL2OO) HistoricBlockHashes(_ *bind.CallOpts, l1BlockNumber *big.Int) ([32]byte, error) {
+	return f.checkpoints[l1BlockNumber.Uint64()], nil
+}
+
+func (f *fakeL2OO) ApprovedProposers(*bind.CallOpts, common.Address) (bool, error) { return false, nil }
+
+func (f *fakeL2OO) RangeVkeyCommitment(*bind.CallOpts) ([32]byte, error) { return f.rangeVkey, nil }
+
+func (f *fakeL2OO) AggregationVkey(*bind.CallOpts) ([32]byte, error) { return f.aggVkey, nil }
+
+func (f *fakeL2OO) RollupConfigHash(*bind.CallOpts) ([32]byte, error) { return common.Hash{}, nil }
+
+func (f *fakeL2OO) Verifier(*bind.CallOpts) (common.Address, error) { return f.verifier, nil }
+
+func (f *fakeL2OO) GetL2OutputAfter(_ *bind.CallOpts, l2BlockNumber *big.Int) (opsuccinctbindings.TypesOutputProposal, error) {
+	for _, block := range f.proposals {
+		if block >= l2BlockNumber.Uint64() {
+			return opsuccinctbindings.TypesOutputProposal{OutputRoot: f.outputRoots[block], Timestamp: big.NewInt(0), L2BlockNumber: new(big.Int).SetUint64(block)}, nil
+		}
+	}
+	return opsuccinctbindings.TypesOutputProposal{}, fmt.Errorf("no output proposed after block %d", l2BlockNumber)
+}
+
+func (f *fakeL2OO) checkpointBlockHash(context.Context) (uint64, common.Hash, error) {
+	f.l1Head++
+	hash := common.BigToHash(new(big.Int).SetUint64(f.l1Head))
+	f.checkpoints[f.l1Head] = hash
+	return f.l1Head, hash, nil
+}
+
+func (f *fakeL2OO) sendTransaction(ctx context.Context, output *eth.OutputResponse, _ []byte, l1BlockNum uint64) error {
+	f.txDeadline, _ = ctx.Deadline()
+	if _, ok := f.checkpoints[l1BlockNum]; !ok {
+		return fmt.Errorf("L1 block %d is not checkpointed", l1BlockNum)
+	}
+	if output.BlockRef.Number < f.latest+f.submissionInterval {
+		return fmt.Errorf("block %d is before the next block number %d", output.BlockRef.Number, f.latest+f.submissionInterval)
+	}
+	f.latest = output.BlockRef.Number
+	f.proposals = append(f.proposals, output.BlockRef.Number)
+	f.outputRoots[output.BlockRef.Number] = common.Hash(output.OutputRoot)
+	return nil
+}
+
+func (f *fakeL2OO) simulateProposal(context.Context, *eth.OutputResponse, []byte, uint64) error {
+	return nil
+}
+
+func newFakeL2OODriver(t *testing.T, l2oo *fakeL2OO, proofDB *db.ProofDB) *L2OutputSubmitter {
+	client := &fakeRollupClient{roots: map[uint64]common.Hash{}}
+	for block := uint64(0); block <= 1000; block += 100 {
+		client.roots[block] = common.BigToHash(new(big.Int).SetUint64(block))
+	}
+	return &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:            log.New(),
+			Metr:           opsuccinctmetrics.NoopMetrics,
+			RollupProvider: fakeRollupProvider{client},
+		},
+		ctx:          context.Background(),
+		db:           *proofDB,
+		l2ooContract: l2oo,
+		transactor:   l2oo,
+	}
+}
+
+func TestDeriveAggProofs(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	require.NoError(t, proofDB.ImportSpanProofs(100, []db.SpanRange{{Start: 100, End: 200}, {Start: 200, End: 300}}, 10))
+	spans, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, span := range spans {
+		require.NoError(t, proofDB.UpdateProofStatus(span.ID, proofrequest.StatusPROVING))
+		require.NoError(t, proofDB.AddFulfilledProof(span.ID, []byte("proof")))
+	}
+
+	// The span proofs don't reach the next block number yet.
+	l2oo := newFakeL2OO(100, 300)
+	l := newFakeL2OODriver(t, l2oo, proofDB)
+	require.NoError(t, l.DeriveAggProofs(context.Background()))
+	aggs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Empty(t, aggs)
+
+	l2oo.submissionInterval = 150
+	require.NoError(t, l.DeriveAggProofs(context.Background()))
+	aggs, err = proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, aggs, 1)
+	require.Equal(t, proofrequest.TypeAGG, aggs[0].Type)
+	require.Equal(t, uint64(100), aggs[0].StartBlock)
+	require.Equal(t, uint64(300), aggs[0].EndBlock)
+}
+
+func TestSubmitAggProofs(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l2oo := newFakeL2OO(100, 150)
+	l := newFakeL2OODriver(t, l2oo, proofDB)
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 100, 300, 0))
+	aggs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	l1BlockNumber, l1BlockHash, err := l2oo.checkpointBlockHash(context.Background())
+	require.NoError(t, err)
+	_, err = proofDB.AddL1BlockInfoToAggRequest(100, 300, l1BlockNumber, l1BlockHash.Hex())
+	require.NoError(t, err)
+	require.NoError(t, proofDB.UpdateProofStatus(aggs[0].ID, proofrequest.StatusPROVING))
+	require.NoError(t, proofDB.AddFulfilledProof(aggs[0].ID, []byte("proof")))
+
+	require.NoError(t, l.SubmitAggProofs(context.Background()))
+	require.Equal(t, []uint64{300}, l2oo.proposals)
+
+	// There's nothing left to propose from the new latest block.
+	require.NoError(t, l.SubmitAggProofs(context.Background()))
+	require.Equal(t, []uint64{300}, l2oo.proposals)
+}
+
+func TestForecastPath(t *testing.T) {
+	// The forecast is kept next to the SQLite DB.
+	require.Equal(t, "op-proposer/1/forecast.json", forecastPath(ProposerConfig{DbPath: "op-proposer/1/proofs.db"}))
+	// A Postgres DB has no local directory to keep it in, so it's only kept in memory.
+	require.Empty(t, forecastPath(ProposerConfig{DbPath: "op-proposer/1/proofs.db", DbConnectionString: "postgres://proposer@db/proofs"}))
+	// Unless its path is set.
+	require.Equal(t, "/data/forecast.json", forecastPath(ProposerConfig{DbConnectionString: "postgres://proposer@db/proofs", ForecastPath: "/data/forecast.json"}))
+}
+
+func TestCheckpointBlockNumber(t *testing.T) {
+	blockNumber, err := checkpointBlockNumber(1000, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(999), blockNumber)
+
+	blockNumber, err = checkpointBlockNumber(1000, 64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(936), blockNumber)
+
+	// A chain shorter than the confirmation depth has no block to checkpoint.
+	_, err = checkpointBlockNumber(10, 64)
+	require.Error(t, err)
+}
+
+func TestL1TxTimeout(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l2oo := newFakeL2OO(100, 100)
+	l := newFakeL2OODriver(t, l2oo, proofDB)
+	l.Cfg.L1TxTimeout = 7 * time.Minute
+	l1Block, _, err := l2oo.checkpointBlockHash(context.Background())
+	require.NoError(t, err)
+
+	// The proposal transaction is given up on once it wasn't mined for L1_TX_TIMEOUT.
+	sent := time.Now()
+	require.NoError(t, l.proposeOutput(context.Background(), &eth.OutputResponse{BlockRef: eth.L2BlockRef{Number: 200}}, nil, l1Block))
+	require.Equal(t, []uint64{200}, l2oo.proposals)
+	require.WithinDuration(t, sent.Add(7*time.Minute), l2oo.txDeadline, time.Minute)
+
+	// So is the checkpoint transaction.
+	ctx, cancel := l.l1TxContext(context.Background())
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now().Add(7*time.Minute), deadline, time.Minute)
+}
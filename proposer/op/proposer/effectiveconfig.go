@@ -0,0 +1,133 @@
+package proposer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+// redacted replaces the values of secrets in the effective configuration.
+const redacted = "<redacted>"
+
+// isSecretField returns whether the config field with the given name holds a secret, e.g. a password or access key.
+func isSecretField(name string) bool {
+	for _, secret := range []string{"Secret", "Token", "Password", "ConnectionString", "AccessKey"} {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactURL redacts the credentials, path and query of a URL, since RPC providers often put API keys in them. Strings
+// that aren't URLs with a host are returned as they are.
+func redactURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return s
+	}
+	if u.User == nil && strings.Trim(u.Path, "/") == "" && u.RawQuery == "" {
+		return s
+	}
+	return u.Scheme + "://" + u.Host + "/" + redacted
+}
+
+// redactedConfigValue converts a config value into its JSON form for the introspection API, with secrets redacted.
+// Durations and addresses are converted to their string forms, and structs to maps of their exported fields.
+func redactedConfigValue(name string, v reflect.Value) any {
+	if isSecretField(name) {
+		if v.IsZero() {
+			return ""
+		}
+		return redacted
+	}
+	switch x := v.Interface().(type) {
+	case time.Duration:
+		return x.String()
+	case common.Address:
+		return x.Hex()
+	case string:
+		return redactURL(x)
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+		return redactedConfigValue(name, v.Elem())
+	case reflect.Struct:
+		fields := make(map[string]any)
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.IsExported() {
+				fields[field.Name] = redactedConfigValue(field.Name, v.Field(i))
+			}
+		}
+		return fields
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		values := make([]any, v.Len())
+		for i := range values {
+			values[i] = redactedConfigValue(name, v.Index(i))
+		}
+		return values
+	}
+	return v.Interface()
+}
+
+// effectiveConfig returns the configuration that the proposer is running with, with secrets redacted, and its hash.
+// The settings that the pipeline spec and the on-chain config manage are the ones currently applied, which replace
+// their CLI flags. The hash is of the redacted configuration, so it doesn't reveal the secrets, and only changes with
+// the settings that can be inspected.
+func (l *L2OutputSubmitter) effectiveConfig() (map[string]any, string, error) {
+	config := redactedConfigValue("", reflect.ValueOf(l.Cfg)).(map[string]any)
+	settings := redactedConfigValue("", reflect.ValueOf(l.settings())).(map[string]any)
+	for name, value := range settings {
+		config[name] = value
+	}
+
+	// Maps are marshalled with sorted keys, so equal configurations have equal hashes.
+	encoded, err := json.Marshal(config)
+	if err != nil {
+		return nil, "", err
+	}
+	sum := sha256.Sum256(encoded)
+	return config, hex.EncodeToString(sum[:8]), nil
+}
+
+// EffectiveConfig returns the configuration that the proposer is running with, with secrets redacted, and its hash.
+func (l *L2OutputSubmitter) EffectiveConfig(ctx context.Context) (rpc.EffectiveConfig, error) {
+	config, hash, err := l.effectiveConfig()
+	if err != nil {
+		return rpc.EffectiveConfig{}, err
+	}
+	return rpc.EffectiveConfig{Hash: hash, Config: config}, nil
+}
+
+// recordConfigHash logs the hash of the effective configuration and records it in the config_info metric whenever it
+// changes, e.g. at startup or after the pipeline spec is reloaded.
+func (l *L2OutputSubmitter) recordConfigHash() {
+	_, hash, err := l.effectiveConfig()
+	if err != nil {
+		l.Log.Warn("failed to hash the effective configuration", "err", err)
+		return
+	}
+	if hash == l.configHash {
+		return
+	}
+	l.Log.Info("Effective configuration changed", "configHash", hash, "previousConfigHash", l.configHash)
+	l.Metr.RecordConfigHash(hash)
+	l.configHash = hash
+}
@@ -0,0 +1,98 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+// maxEstimateBlocks bounds the range of an estimate, since the header of every block in it is read.
+const maxEstimateBlocks = 10_000
+
+// EstimateRange estimates the span proofs, cycles and proving time of the L2 block range from start to end. The cycles
+// are estimated from the gas used by every block, like the cost range planner does, and the range is split into span
+// proofs by the configured range planner.
+func (l *L2OutputSubmitter) EstimateRange(ctx context.Context, start, end uint64) (rpc.RangeEstimate, error) {
+	if start >= end {
+		return rpc.RangeEstimate{}, fmt.Errorf("%w: the start block must be less than the end block", rpc.ErrInvalidRequest)
+	}
+	if end-start > maxEstimateBlocks {
+		return rpc.RangeEstimate{}, fmt.Errorf("%w: at most %d blocks can be estimated at once", rpc.ErrInvalidRequest, maxEstimateBlocks)
+	}
+
+	var headers blockHeaderSource
+	if l.planner != nil {
+		headers = l.planner.client
+	} else {
+		if l.Cfg.L2EthRpc == "" {
+			return rpc.RangeEstimate{}, fmt.Errorf("estimating ranges requires the L2 execution node RPC")
+		}
+		l2Client, err := dial.DialEthClientWithTimeout(ctx, dial.DefaultDialTimeout, l.Log, l.Cfg.L2EthRpc)
+		if err != nil {
+			return rpc.RangeEstimate{}, fmt.Errorf("failed to dial L2 RPC: %w", err)
+		}
+		defer l2Client.Close()
+		headers = l2Client
+	}
+
+	gasUsed, err := blockGasUsed(ctx, headers, start, end)
+	if err != nil {
+		return rpc.RangeEstimate{}, err
+	}
+	cycles := make([]uint64, len(gasUsed))
+	estimate := rpc.RangeEstimate{Start: start, End: end}
+	for i, gas := range gasUsed {
+		cycles[i] = estimateBlockCycles(gas)
+		estimate.GasUsed += gas
+	}
+
+	maxRange := l.settings().MaxBlockRangePerSpanProof
+	var spans []Span
+	if l.Cfg.RangePlanner == RangePlannerCost {
+		spans, estimate.EstimatedCycles = planSpans(start, cycles, maxRange, l.Cfg.SpanOverheadCycles)
+	} else {
+		for spanStart := start; spanStart < end; spanStart += maxRange {
+			span := Span{Start: spanStart, End: min(spanStart+maxRange, end)}
+			spans = append(spans, span)
+			var blocksCycles uint64
+			for _, c := range cycles[span.Start-start : span.End-start] {
+				blocksCycles += c
+			}
+			estimate.EstimatedCycles += spanCycles(blocksCycles, l.Cfg.SpanOverheadCycles)
+		}
+	}
+	estimate.SpanProofs = uint64(len(spans))
+
+	if d, ok := l.forecaster.ETA(end - start); ok {
+		seconds := uint64(d.Seconds())
+		estimate.ProvingSeconds = &seconds
+	}
+	return estimate, nil
+}
+
+// blockGasUsed returns the gas used by the blocks after start, up to and including end.
+func blockGasUsed(ctx context.Context, headers blockHeaderSource, start, end uint64) ([]uint64, error) {
+	gasUsed := make([]uint64, end-start)
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(10)
+	for i := range gasUsed {
+		g.Go(func() error {
+			block := start + uint64(i) + 1
+			header, err := headers.HeaderByNumber(gCtx, new(big.Int).SetUint64(block))
+			if err != nil {
+				return fmt.Errorf("failed to get header of block %d: %w", block, err)
+			}
+			gasUsed[i] = header.GasUsed
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return gasUsed, nil
+}
@@ -0,0 +1,51 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+// ProvingETA forecasts when the given L2 block will be covered by a contiguous chain of span proofs from the latest
+// block proposed on the L2OO. If l2Block is 0, the finalized L2 block is used.
+func (l *L2OutputSubmitter) ProvingETA(ctx context.Context, l2Block uint64) (rpc.ProvingETA, error) {
+	if l2Block == 0 {
+		rollupClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, l.Log, l.Cfg.RollupRpc)
+		if err != nil {
+			return rpc.ProvingETA{}, err
+		}
+		status, err := rollupClient.SyncStatus(ctx)
+		if err != nil {
+			return rpc.ProvingETA{}, fmt.Errorf("failed to get sync status: %w", err)
+		}
+		l2Block = status.FinalizedL2.Number
+	}
+
+	latestBlock, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return rpc.ProvingETA{}, fmt.Errorf("failed to get latest L2OO block number: %w", err)
+	}
+	highestProven, err := l.db.GetMaxContiguousSpanProofRange(latestBlock.Uint64())
+	if err != nil {
+		return rpc.ProvingETA{}, err
+	}
+
+	eta := rpc.ProvingETA{
+		TargetBlock:        l2Block,
+		HighestProvenBlock: highestProven,
+		EWMARate:           l.forecaster.EWMARate(),
+		LinearRate:         l.forecaster.LinearRate(),
+	}
+	if l2Block > highestProven {
+		eta.BlocksRemaining = l2Block - highestProven
+	}
+	if d, ok := l.forecaster.ETA(eta.BlocksRemaining); ok {
+		seconds := uint64(d.Seconds())
+		eta.ETASeconds = &seconds
+	}
+	return eta, nil
+}
@@ -0,0 +1,356 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+const (
+	// replicaFailureRateWeight is the weight of the latest witness generation request in the failure rate of a backend.
+	replicaFailureRateWeight = 0.2
+	// evictionFailureRate is the failure rate above which a witness generation server is evicted from the servers that
+	// span proof requests are balanced across.
+	evictionFailureRate = 0.5
+	// evictionCooldown is how long an evicted server gets no new span proof requests. It's then readmitted with a clean
+	// failure rate, and evicted again if its requests keep failing.
+	evictionCooldown = 5 * time.Minute
+)
+
+// backendHealth tracks since when each prover backend has been unreachable, and the rate at which its witness
+// generation requests fail with server-side errors. A backend is reachable again as soon as one request to it gets a
+// response.
+type backendHealth struct {
+	mu               sync.Mutex
+	unreachableSince map[string]time.Time
+	// failureRate is the exponentially weighted moving average of the server-side failures of the witness generation
+	// requests sent to each backend, between 0 and 1.
+	failureRate map[string]float64
+	// evictedUntil is when each evicted backend is readmitted.
+	evictedUntil map[string]time.Time
+}
+
+func (h *backendHealth) onUnreachable(backend string, now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.unreachableSince == nil {
+		h.unreachableSince = make(map[string]time.Time)
+	}
+	if _, ok := h.unreachableSince[backend]; !ok {
+		h.unreachableSince[backend] = now
+	}
+}
+
+func (h *backendHealth) onReachable(backend string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.unreachableSince, backend)
+}
+
+// onWitnessGenResult records whether a witness generation request sent to the backend failed with a server-side error.
+// Returns true if the backend's failure rate crossed evictionFailureRate, and it was evicted.
+func (h *backendHealth) onWitnessGenResult(backend string, failed bool) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.failureRate == nil {
+		h.failureRate = make(map[string]float64)
+	}
+	var outcome float64
+	if failed {
+		outcome = 1
+	}
+	h.failureRate[backend] += replicaFailureRateWeight * (outcome - h.failureRate[backend])
+
+	if h.failureRate[backend] <= evictionFailureRate {
+		return false
+	}
+	if _, ok := h.evictedUntil[backend]; ok {
+		return false
+	}
+	if h.evictedUntil == nil {
+		h.evictedUntil = make(map[string]time.Time)
+	}
+	h.evictedUntil[backend] = time.Now().Add(evictionCooldown)
+	return true
+}
+
+// evicted returns whether the backend is evicted. Once its cooldown is over, it's readmitted with a clean failure rate.
+func (h *backendHealth) evicted(backend string, now time.Time) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	until, ok := h.evictedUntil[backend]
+	if !ok {
+		return false
+	}
+	if now.Before(until) {
+		return true
+	}
+	delete(h.evictedUntil, backend)
+	delete(h.failureRate, backend)
+	return false
+}
+
+// failureRateOf returns the failure rate of the backend's witness generation requests.
+func (h *backendHealth) failureRateOf(backend string) float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.failureRate[backend]
+}
+
+// unreachableFor returns how long the backend has been unreachable, or 0 if it's reachable.
+func (h *backendHealth) unreachableFor(backend string, now time.Time) time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	since, ok := h.unreachableSince[backend]
+	if !ok {
+		return 0
+	}
+	return now.Sub(since)
+}
+
+// proverBackends returns the servers that a proof for the given range can be requested from, in the order they are
+// failed over to: the primary servers, followed by the fallback servers. Each server can use a different prover
+// network, since the status of a request is polled from the server it was sent to.
+func (l *L2OutputSubmitter) proverBackends(proofType proofrequest.Type, start, end uint64) []string {
+	backends := append([]string{}, l.proverServerUrls(proofType, start, end)...)
+	return append(backends, l.Cfg.ProverFallbackServerUrls...)
+}
+
+// backendDown returns whether the backend has been unreachable for longer than the failover threshold.
+func (l *L2OutputSubmitter) backendDown(backend string) bool {
+	threshold := l.Cfg.ProverUnreachableTimeout
+	return threshold > 0 && l.backendHealth.unreachableFor(backend, time.Now()) > threshold
+}
+
+// proverBackend returns the backend that a request is sent to, and whose status it is polled from. Requests that were
+// sent already, or that were failed over to a backend, keep theirs. Otherwise, it's the least loaded of the healthy
+// primary servers, or if none of them is healthy, the first backend that isn't down, or the primary server if all of
+// them are.
+func (l *L2OutputSubmitter) proverBackend(req *ent.ProofRequest) string {
+	if req.ProverBackend != "" {
+		return req.ProverBackend
+	}
+	if backend := l.leastLoadedServer(l.proverServerUrls(req.Type, req.StartBlock, req.EndBlock)); backend != "" {
+		return backend
+	}
+	backends := l.proverBackends(req.Type, req.StartBlock, req.EndBlock)
+	for _, backend := range backends {
+		if !l.backendDown(backend) {
+			return backend
+		}
+	}
+	return backends[0]
+}
+
+// leastLoadedServer returns the healthy server with the fewest requests in witness generation, or the first one on a
+// tie, so the span proof requests are balanced across the witness generation servers. A server is healthy if it's
+// reachable, isn't evicted for failing its requests, its circuit breaker is closed, and it didn't fail its latest health
+// probe. Returns an empty string if none of them is healthy.
+func (l *L2OutputSubmitter) leastLoadedServer(servers []string) string {
+	now := time.Now()
+	var healthy []string
+	for _, server := range servers {
+		if l.backendHealth.unreachableFor(server, now) == 0 && !l.backendHealth.evicted(server, now) && !l.circuitBreaker.open(server) && l.witnessGenProbe.failed(server) == nil {
+			healthy = append(healthy, server)
+		}
+	}
+	if len(healthy) <= 1 {
+		if len(healthy) == 0 {
+			return ""
+		}
+		return healthy[0]
+	}
+
+	loads, err := l.db.GetNumberOfRequestsByBackend(proofrequest.StatusWITNESSGEN)
+	if err != nil {
+		l.Log.Warn("failed to get the load of the witness generation servers", "err", err)
+		return healthy[0]
+	}
+	best := healthy[0]
+	for _, server := range healthy[1:] {
+		if loads[server] < loads[best] {
+			best = server
+		}
+	}
+	return best
+}
+
+// nextProverBackend returns the backend after the request's backend in the failover order, or an empty string if there
+// is none.
+func (l *L2OutputSubmitter) nextProverBackend(req *ent.ProofRequest) string {
+	backend := l.proverBackend(req)
+	backends := l.proverBackends(req.Type, req.StartBlock, req.EndBlock)
+	for i, b := range backends[:len(backends)-1] {
+		if b == backend {
+			return backends[i+1]
+		}
+	}
+	return ""
+}
+
+// failoverBackend returns the backend that the retry of a failed request is pinned to, or an empty string if the retry
+// isn't failed over. A request is failed over to the backend after its own when its backend has been unreachable for
+// longer than the failover threshold, or when it timed out without being claimed by a prover, and the range wasn't
+// claimed in ProverFailoverAttempts attempts on the backend. The last backend never fails over.
+func (l *L2OutputSubmitter) failoverBackend(req *ent.ProofRequest, status ProofStatusResponse, failed []*ent.ProofRequest) string {
+	next := l.nextProverBackend(req)
+	if next == "" {
+		return ""
+	}
+	backend := l.proverBackend(req)
+
+	if l.backendDown(backend) {
+		l.Log.Warn("Prover backend is unreachable, failing over", "id", req.ID, "backend", backend, "next", next)
+		return next
+	}
+	if status.FulfillmentStatus != SP1FulfillmentStatusRequested || l.Cfg.ProverFailoverAttempts == 0 {
+		return ""
+	}
+	var attempts uint64
+	for _, f := range failed {
+		if f.ProverBackend == backend {
+			attempts++
+		}
+	}
+	if attempts < l.Cfg.ProverFailoverAttempts {
+		return ""
+	}
+	l.Log.Warn("Proof request wasn't claimed by a prover, failing over", "id", req.ID, "backend", backend, "attempts", attempts, "next", next)
+	return next
+}
+
+// replicaForRetry returns the backend that the retry of a span proof request that failed with a server-side error is
+// pinned to, so that a single bad replica doesn't fail the same range over and over. It is the backend with the lowest
+// failure rate, other than the request's own, that isn't down and hasn't failed the range before. Returns an empty
+// string if there is none, e.g. in single-server deployments, or once the range failed on every backend.
+func (l *L2OutputSubmitter) replicaForRetry(req *ent.ProofRequest, failed []*ent.ProofRequest) string {
+	if req.Type != proofrequest.TypeSPAN {
+		return ""
+	}
+	excluded := map[string]bool{l.proverBackend(req): true}
+	for _, f := range failed {
+		excluded[f.ProverBackend] = true
+	}
+
+	var replica string
+	for _, backend := range l.proverBackends(req.Type, req.StartBlock, req.EndBlock) {
+		if excluded[backend] || l.backendDown(backend) {
+			continue
+		}
+		if replica == "" || l.backendHealth.failureRateOf(backend) < l.backendHealth.failureRateOf(replica) {
+			replica = backend
+		}
+	}
+	return replica
+}
+
+// retryOnOtherReplica retries a span proof request that failed with a server-side error on the backend returned by
+// replicaForRetry. Returns false if there is no such backend, and the request should be retried with RetryRequest.
+func (l *L2OutputSubmitter) retryOnOtherReplica(req *ent.ProofRequest) (bool, error) {
+	failed, err := l.db.GetProofRequestsWithBlockRangeAndStatus(req.Type, req.StartBlock, req.EndBlock, proofrequest.StatusFAILED)
+	if err != nil {
+		return false, fmt.Errorf("failed to check for previous failures: %w", err)
+	}
+	replica := l.replicaForRetry(req, failed)
+	if replica == "" {
+		return false, nil
+	}
+
+	err = l.db.TransitionProofStatus(req.ID, req.Status, proofrequest.StatusFAILED)
+	if errors.Is(err, db.ErrProofStatusChanged) {
+		l.Log.Info("not retrying proof request whose status changed", "id", req.ID, "err", err)
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	retried, err := l.retryRange(req, replica, false)
+	if err != nil {
+		return false, fmt.Errorf("failed to retry proof request on another replica: %w", err)
+	}
+	if !retried {
+		return true, nil
+	}
+	l.Log.Warn("Proof request failed with a server error, retrying on another replica", "id", req.ID, "backend", l.proverBackend(req), "replica", replica, "failureRate", l.backendHealth.failureRateOf(l.proverBackend(req)))
+	l.Metr.RecordError("replica_failover", 1)
+	return true, nil
+}
+
+// ProverBackendStatuses returns the health of every prover backend.
+func (l *L2OutputSubmitter) ProverBackendStatuses(ctx context.Context) ([]rpc.ProverBackendStatus, error) {
+	now := time.Now()
+	var statuses []rpc.ProverBackendStatus
+	for _, backend := range l.knownProverBackends() {
+		statuses = append(statuses, rpc.ProverBackendStatus{
+			URL:                backend,
+			UnreachableSeconds: uint64(l.backendHealth.unreachableFor(backend, now).Seconds()),
+			FailureRate:        l.backendHealth.failureRateOf(backend),
+			Evicted:            l.backendHealth.evicted(backend, now),
+			CircuitBreakerOpen: l.circuitBreaker.open(backend),
+			ProbeFailing:       l.witnessGenProbe.failed(backend) != nil,
+		})
+	}
+	return statuses, nil
+}
+
+// witnessGenServers returns the OP Succinct servers that span proof requests are balanced across.
+func (c ProposerConfig) witnessGenServers() []string {
+	if len(c.OPSuccinctServerUrls) == 0 {
+		return []string{c.OPSuccinctServerUrl}
+	}
+	return c.OPSuccinctServerUrls
+}
+
+// knownProverBackends returns every configured prover backend, without duplicates.
+func (l *L2OutputSubmitter) knownProverBackends() []string {
+	backends := append(append([]string{}, l.Cfg.witnessGenServers()...), l.Cfg.ProverFallbackServerUrls...)
+	if l.Cfg.SLAPremiumServerUrl != "" {
+		backends = append(backends, l.Cfg.SLAPremiumServerUrl)
+	}
+	for _, tier := range l.settings().ProverTiers {
+		backends = append(backends, tier.ServerUrl)
+	}
+	seen := map[string]bool{}
+	var unique []string
+	for _, backend := range backends {
+		if !seen[backend] {
+			seen[backend] = true
+			unique = append(unique, backend)
+		}
+	}
+	return unique
+}
+
+// ProbeProverBackends checks whether the unreachable prover backends are back, so that new requests return to them in
+// failover order. Requests that were failed over stay on their backend.
+func (l *L2OutputSubmitter) ProbeProverBackends(ctx context.Context) {
+	now := time.Now()
+	for _, backend := range l.knownProverBackends() {
+		if l.backendHealth.unreachableFor(backend, now) == 0 {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(ctx, PROOF_STATUS_TIMEOUT)
+		req, err := http.NewRequestWithContext(ctx, "GET", backend+"/version", nil)
+		if err != nil {
+			cancel()
+			continue
+		}
+		resp, err := http.DefaultClient.Do(req)
+		cancel()
+		if err != nil {
+			l.Log.Debug("prover backend is still unreachable", "backend", backend, "err", err)
+			continue
+		}
+		resp.Body.Close()
+		l.backendHealth.onReachable(backend)
+		l.Log.Info("prover backend is reachable again", "backend", backend)
+	}
+}
@@ -0,0 +1,211 @@
+package proposer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+func TestRetryRequestFailsOver(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg: ProposerConfig{
+				OPSuccinctServerUrl:      "http://primary",
+				ProverFallbackServerUrls: []string{"http://fallback"},
+				ProverFailoverAttempts:   2,
+				ProverUnreachableTimeout: time.Minute,
+			},
+		},
+		ctx: context.Background(),
+		db:  *proofDB,
+	}
+	unclaimed := ProofStatusResponse{FulfillmentStatus: SP1FulfillmentStatusRequested}
+
+	// retry fails the only unrequested request for the range, and returns its retry.
+	retry := func(status ProofStatusResponse) string {
+		reqs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeAGG, 100, 200, proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.Len(t, reqs, 1)
+		require.Equal(t, "http://primary", l.proverBackend(reqs[0]))
+		require.NoError(t, proofDB.SetProverBackend(reqs[0].ID, l.proverBackend(reqs[0])))
+		req, err := proofDB.GetProofRequest(reqs[0].ID)
+		require.NoError(t, err)
+		require.NoError(t, l.RetryRequest(req, status))
+		reqs, err = proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeAGG, 100, 200, proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.Len(t, reqs, 1)
+		return reqs[0].ProverBackend
+	}
+
+	// The first unclaimed attempt is retried on the same backend, the second one fails over.
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 100, 200, 0))
+	require.Equal(t, "", retry(unclaimed))
+	require.Equal(t, "http://fallback", retry(unclaimed))
+
+	// A request pinned to the last backend stays there.
+	reqs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeAGG, 100, 200, proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Equal(t, "", l.nextProverBackend(reqs[0]))
+	require.Equal(t, "", l.failoverBackend(reqs[0], unclaimed, nil))
+}
+
+func TestProverBackendSkipsUnreachable(t *testing.T) {
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg: ProposerConfig{
+				OPSuccinctServerUrl:      "http://primary",
+				ProverFallbackServerUrls: []string{"http://fallback"},
+				ProverUnreachableTimeout: time.Minute,
+			},
+		},
+	}
+	req := &ent.ProofRequest{Type: proofrequest.TypeSPAN, StartBlock: 100, EndBlock: 200}
+	require.Equal(t, "http://primary", l.proverBackend(req))
+
+	// New requests skip the primary backend once it has been unreachable for longer than the threshold.
+	l.backendHealth.onUnreachable("http://primary", time.Now().Add(-2*time.Minute))
+	require.Equal(t, "http://fallback", l.proverBackend(req))
+
+	// Requests that were sent already keep their backend.
+	require.Equal(t, "http://primary", l.proverBackend(&ent.ProofRequest{ProverBackend: "http://primary"}))
+
+	l.backendHealth.onReachable("http://primary")
+	require.Equal(t, "http://primary", l.proverBackend(req))
+}
+
+func TestRetryOnOtherReplica(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg: ProposerConfig{
+				OPSuccinctServerUrl:      "http://a",
+				ProverFallbackServerUrls: []string{"http://b", "http://c"},
+			},
+		},
+		ctx: context.Background(),
+		db:  *proofDB,
+	}
+	// b fails more often than c.
+	l.backendHealth.onWitnessGenResult("http://b", true)
+	l.backendHealth.onWitnessGenResult("http://c", false)
+
+	// retry fails the only WITNESSGEN request for the range on a server error, and returns the backend of its retry.
+	retry := func() (string, bool) {
+		reqs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, 100, 200, proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.Len(t, reqs, 1)
+		require.NoError(t, proofDB.SetProverBackend(reqs[0].ID, l.proverBackend(reqs[0])))
+		require.NoError(t, proofDB.UpdateProofStatus(reqs[0].ID, proofrequest.StatusWITNESSGEN))
+		req, err := proofDB.GetProofRequest(reqs[0].ID)
+		require.NoError(t, err)
+		retried, err := l.retryOnOtherReplica(req)
+		require.NoError(t, err)
+		if !retried {
+			return "", false
+		}
+		reqs, err = proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, 100, 200, proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.Len(t, reqs, 1)
+		return reqs[0].ProverBackend, true
+	}
+
+	// The retry goes to the healthiest replica that hasn't failed the range yet, until it failed on every replica.
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+	backend, ok := retry()
+	require.True(t, ok)
+	require.Equal(t, "http://c", backend)
+	backend, ok = retry()
+	require.True(t, ok)
+	require.Equal(t, "http://b", backend)
+	_, ok = retry()
+	require.False(t, ok)
+
+	// AGG proofs aren't retried on other replicas.
+	require.Equal(t, "", l.replicaForRetry(&ent.ProofRequest{Type: proofrequest.TypeAGG, ProverBackend: "http://a"}, nil))
+
+	statuses, err := l.ProverBackendStatuses(context.Background())
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+	require.InDelta(t, replicaFailureRateWeight, statuses[1].FailureRate, 1e-9)
+}
+
+func TestBalanceWitnessGenServers(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg: ProposerConfig{
+				OPSuccinctServerUrl:      "http://a",
+				OPSuccinctServerUrls:     []string{"http://a", "http://b"},
+				ProverFallbackServerUrls: []string{"http://fallback"},
+				MaxConcurrentWitnessGen:  2,
+			},
+		},
+		ctx:               context.Background(),
+		db:                *proofDB,
+		witnessGenLimiter: newWitnessGenLimiter(2),
+	}
+	span := &ent.ProofRequest{Type: proofrequest.TypeSPAN, StartBlock: 100, EndBlock: 200}
+
+	// Span proofs go to the server with the fewest requests in witness generation, AGG proofs to the first server.
+	require.Equal(t, "http://a", l.proverBackend(span))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 0, 100, 0))
+	reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.NoError(t, proofDB.UpdateProofStatus(reqs[0].ID, proofrequest.StatusWITNESSGEN))
+	require.NoError(t, proofDB.SetProverBackend(reqs[0].ID, "http://a"))
+	require.Equal(t, "http://b", l.proverBackend(span))
+	require.Equal(t, "http://a", l.proverBackend(&ent.ProofRequest{Type: proofrequest.TypeAGG, StartBlock: 100, EndBlock: 200}))
+	require.Equal(t, []string{"http://a", "http://b", "http://fallback"}, l.proverBackends(proofrequest.TypeSPAN, 100, 200))
+
+	// The witness generation limit applies to each server.
+	reason, err := l.spanProofBlockedReason(span, 3, 0)
+	require.NoError(t, err)
+	require.Empty(t, reason)
+	reason, err = l.spanProofBlockedReason(span, 4, 0)
+	require.NoError(t, err)
+	require.Contains(t, reason, "(4/4, configured max 4)")
+
+	// Unreachable servers get no new requests.
+	l.backendHealth.onUnreachable("http://b", time.Now())
+	require.Equal(t, "http://a", l.proverBackend(span))
+	l.backendHealth.onReachable("http://b")
+
+	// Servers whose requests keep failing are evicted until their cooldown is over.
+	evicted := false
+	for i := 0; i < 4; i++ {
+		evicted = l.backendHealth.onWitnessGenResult("http://b", true) || evicted
+	}
+	require.True(t, evicted)
+	require.Equal(t, "http://a", l.proverBackend(span))
+	require.True(t, l.backendHealth.evicted("http://b", time.Now()))
+	require.False(t, l.backendHealth.evicted("http://b", time.Now().Add(evictionCooldown)))
+	require.Zero(t, l.backendHealth.failureRateOf("http://b"))
+	require.Equal(t, "http://b", l.proverBackend(span))
+}
@@ -0,0 +1,71 @@
+package proposer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// isTinySpan returns whether the request is a span proof small enough to take the fast path.
+func (l *L2OutputSubmitter) isTinySpan(req *ent.ProofRequest) bool {
+	return l.Cfg.FastPathMaxBlocks > 0 && req.Type == proofrequest.TypeSPAN && req.EndBlock-req.StartBlock <= l.Cfg.FastPathMaxBlocks
+}
+
+// skipWitnessGenLimit returns whether the request is dispatched regardless of the witness generation limit. Mock
+// requests only execute the range program to validate it, so tiny ones don't wait for witness generation slots, which
+// are sized for generating the witnesses of real proofs. Larger mock requests still generate a sizeable witness, so
+// they are held to the limit like any other request.
+func (l *L2OutputSubmitter) skipWitnessGenLimit(req *ent.ProofRequest) bool {
+	return l.Cfg.Mock && l.isTinySpan(req)
+}
+
+// batchTinySpans merges the run of adjacent tiny unrequested span proofs that starts with req into a single request,
+// right before it is dispatched. Unlike compaction, which runs on an interval, this keeps quiet chains from paying the
+// latency of a poll interval for each of their tiny spans. Returns the request to dispatch, which is req itself if
+// there's nothing to merge it with.
+func (l *L2OutputSubmitter) batchTinySpans(req *ent.ProofRequest) (*ent.ProofRequest, error) {
+	if !l.isTinySpan(req) {
+		return req, nil
+	}
+
+	unreqs, err := l.db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	if err != nil {
+		return nil, err
+	}
+	failed, err := l.db.GetFailedSpanProofsSince(uint64(time.Now().Add(-compactionFailureWindow).Unix()))
+	if err != nil {
+		return nil, err
+	}
+	var spans []*ent.ProofRequest
+	for _, span := range unreqs {
+		if l.isTinySpan(span) && span.StartBlock >= req.StartBlock {
+			spans = append(spans, span)
+		}
+	}
+
+	groups := planSpanCompaction(spans, failed, l.settings().MaxBlockRangePerSpanProof)
+	if len(groups) == 0 || groups[0][0].ID != req.ID {
+		return req, nil
+	}
+	group := groups[0]
+	start, end := group[0].StartBlock, group[len(group)-1].EndBlock
+	ids := make([]int, len(group))
+	for i, span := range group {
+		ids[i] = span.ID
+	}
+	if err := l.db.MergeUnrequestedSpanProofs(ids, start, end, l.proofTimeout(proofrequest.TypeSPAN, start, end)); err != nil {
+		return nil, err
+	}
+
+	merged, err := l.db.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, start, end, proofrequest.StatusUNREQ)
+	if err != nil {
+		return nil, err
+	}
+	if len(merged) == 0 {
+		return nil, fmt.Errorf("merged span proof request %d-%d not found", start, end)
+	}
+	l.Log.Info("batched tiny span proofs into a single request", "start", start, "end", end, "spans", len(group))
+	return merged[0], nil
+}
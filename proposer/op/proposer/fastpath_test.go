@@ -0,0 +1,73 @@
+package proposer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+func TestBatchTinySpans(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	// Three tiny spans, followed by a full span that isn't merged.
+	require.NoError(t, proofDB.ImportSpanProofs(100, []db.SpanRange{{Start: 100, End: 110}, {Start: 110, End: 120}, {Start: 120, End: 125}, {Start: 125, End: 425}}, 10))
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log: log.New(),
+			Cfg: ProposerConfig{MaxBlockRangePerSpanProof: 300, FastPathMaxBlocks: 20},
+		},
+		db: *proofDB,
+	}
+	next, err := proofDB.GetNextUnrequestedProof()
+	require.NoError(t, err)
+	batched, err := l.batchTinySpans(next)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), batched.StartBlock)
+	require.Equal(t, uint64(125), batched.EndBlock)
+
+	unreqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, unreqs, 2)
+
+	// A full span is dispatched as is.
+	require.NoError(t, proofDB.UpdateProofStatus(batched.ID, proofrequest.StatusWITNESSGEN))
+	next, err = proofDB.GetNextUnrequestedProof()
+	require.NoError(t, err)
+	batched, err = l.batchTinySpans(next)
+	require.NoError(t, err)
+	require.Equal(t, next.ID, batched.ID)
+}
+
+func TestSkipWitnessGenLimit(t *testing.T) {
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log: log.New(),
+			Cfg: ProposerConfig{MaxConcurrentWitnessGen: 1, MaxConcurrentProofRequests: 10, FastPathMaxBlocks: 20, Mock: true},
+		},
+		witnessGenLimiter: newWitnessGenLimiter(1),
+	}
+	tiny := &ent.ProofRequest{Type: proofrequest.TypeSPAN, StartBlock: 100, EndBlock: 110}
+	full := &ent.ProofRequest{Type: proofrequest.TypeSPAN, StartBlock: 100, EndBlock: 400}
+
+	// Only tiny mock requests skip the witness generation limit.
+	reason, err := l.spanProofBlockedReason(tiny, 1, 0)
+	require.NoError(t, err)
+	require.Empty(t, reason)
+	reason, err = l.spanProofBlockedReason(full, 1, 0)
+	require.NoError(t, err)
+	require.Contains(t, reason, "max concurrent witness generation reached")
+
+	l.Cfg.Mock = false
+	reason, err = l.spanProofBlockedReason(tiny, 1, 0)
+	require.NoError(t, err)
+	require.Contains(t, reason, "max concurrent witness generation reached")
+}
@@ -0,0 +1,78 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// ErrFollower is returned for the actions that a follower leaves to the active proposer.
+var ErrFollower = errors.New("a follower doesn't request proofs or send transactions")
+
+// follow runs a loop iteration of a follower, which only reads the DB and the chain: it records the proposer status and
+// polls the statuses of the PROVING requests, so its metrics are current. It leaves the rest of the pipeline to the
+// active proposer, including storing fulfilled proofs and retrying failed requests, so it never writes to the DB.
+func (l *L2OutputSubmitter) follow(ctx context.Context) {
+	metrics, err := l.GetProposerMetrics(ctx)
+	if err != nil {
+		l.Log.Error("failed to get metrics", "err", err)
+		return
+	}
+	l.Log.Info("Proposer status", "metrics", metrics, "configHash", l.configHash)
+
+	l.Log.Info("Follower: Polling PROVING requests...")
+	if err := l.PollProvingRequests(ctx); err != nil {
+		l.Log.Error("failed to poll PROVING requests", "err", err)
+	}
+}
+
+// PollProvingRequests polls the statuses of the PROVING requests and records the time remaining until they time out,
+// without updating the requests. Requests that don't have a prover request ID yet are being requested by the active
+// proposer, and are skipped.
+func (l *L2OutputSubmitter) PollProvingRequests(ctx context.Context) error {
+	all, err := l.db.GetAllProofsWithStatus(proofrequest.StatusPROVING)
+	if err != nil {
+		return err
+	}
+	var reqs []*ent.ProofRequest
+	for _, req := range all {
+		if req.ProverRequestID != "" {
+			reqs = append(reqs, req)
+		}
+	}
+
+	statuses, pollErrs := l.pollProofStatuses(ctx, reqs)
+
+	timeRemaining := make(map[string]uint64)
+	now := uint64(time.Now().Unix())
+	var fulfilled, unfulfillable int
+	for i, req := range reqs {
+		if err := pollErrs[i]; err != nil {
+			l.Log.Warn("failed to get proof status for ID", "id", req.ProverRequestID, "backend", l.proverBackend(req), "err", err)
+			l.Metr.RecordError("get_proof_status", 1)
+			continue
+		}
+		switch statuses[i].FulfillmentStatus {
+		case SP1FulfillmentStatusFulfilled:
+			fulfilled++
+			continue
+		case SP1FulfillmentStatusUnfulfillable:
+			unfulfillable++
+			continue
+		}
+		deadline := req.ProofRequestTime + l.requestProofTimeout(req)
+		if deadline <= now {
+			continue
+		}
+		if remaining, ok := timeRemaining[req.Type.String()]; !ok || deadline-now < remaining {
+			timeRemaining[req.Type.String()] = deadline - now
+		}
+	}
+	l.Metr.RecordProofTimeRemaining(timeRemaining)
+
+	l.Log.Info("Follower: Polled PROVING requests", "proving", len(reqs), "fulfilled", fulfilled, "unfulfillable", unfulfillable)
+	return nil
+}
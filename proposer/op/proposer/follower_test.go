@@ -0,0 +1,94 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+func TestFollower(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+
+	l := newFakeL2OODriver(t, newFakeL2OO(100, 100), proofDB)
+	l.Cfg.Follower = true
+
+	// Queued proofs are left to the active proposer, and shown as held.
+	require.ErrorIs(t, l.RequestQueuedProofs(context.Background()), ErrFollower)
+	unreqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, unreqs, 1)
+	require.Contains(t, l.proofRequestsHeldReason(), "follower")
+
+	// No transactions are sent, e.g. to checkpoint a block hash or propose an output.
+	_, err = l.sendL1Transaction(context.Background(), txmgr.TxCandidate{})
+	require.ErrorIs(t, err, ErrFollower)
+}
+
+func TestFollowerLeavesProvingRequests(t *testing.T) {
+	var polled atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/status/ab" {
+			http.NotFound(w, r)
+			return
+		}
+		polled.Add(1)
+		require.NoError(t, json.NewEncoder(w).Encode(ProofStatusResponse{
+			FulfillmentStatus: SP1FulfillmentStatusFulfilled,
+			Proof:             []byte("proof"),
+		}))
+	}))
+	defer server.Close()
+
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	// A request that was fulfilled, and one that the active proposer is requesting, which has no prover request ID yet.
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 200, 300, 0))
+	reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, req := range reqs {
+		require.NoError(t, proofDB.UpdateProofStatus(req.ID, proofrequest.StatusPROVING))
+	}
+	require.NoError(t, proofDB.SetProverRequestID(reqs[0].ID, []byte{0xab}))
+
+	l := newFakeL2OODriver(t, newFakeL2OO(100, 100), proofDB)
+	l.Cfg.Follower = true
+	l.Cfg.OPSuccinctServerUrl = server.URL
+	l.Cfg.PollInterval = time.Hour
+
+	// Starting the loop doesn't resume the PROVING requests, so the request without a prover request ID isn't retried.
+	l.done = make(chan struct{})
+	close(l.done)
+	l.wg.Add(1)
+	l.loop()
+
+	// Polling only reads the statuses, and leaves storing the fulfilled proof to the active proposer.
+	require.NoError(t, l.PollProvingRequests(context.Background()))
+	require.Equal(t, int32(1), polled.Load())
+
+	proving, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusPROVING)
+	require.NoError(t, err)
+	require.Len(t, proving, 2)
+	pending, err := proofDB.GetProofRequest(reqs[1].ID)
+	require.NoError(t, err)
+	require.Empty(t, pending.ProverRequestID)
+	unreqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Empty(t, unreqs)
+}
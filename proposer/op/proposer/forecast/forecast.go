@@ -0,0 +1,167 @@
+package forecast
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultHalfLife is how long it takes for an observation to lose half of its weight in the EWMA rate.
+	DefaultHalfLife = time.Hour
+	// DefaultWindow is the number of observations the linear model is fitted over.
+	DefaultWindow = 180
+)
+
+// point is the total number of blocks proven at a point in time.
+type point struct {
+	Time   int64  `json:"time"`
+	Blocks uint64 `json:"blocks"`
+}
+
+// state is the persisted state of a Forecaster.
+type state struct {
+	// EWMARate is the exponentially weighted moving average of the proving rate, in blocks per second.
+	EWMARate float64 `json:"ewma_rate"`
+	// Points are the most recent observations of the total number of blocks proven, oldest first.
+	Points []point `json:"points"`
+}
+
+// Forecaster forecasts the proof throughput from the historical proving rate, with two simple models: an EWMA of the
+// rate between observations, which reacts quickly to changes, and a least-squares line over a window of recent
+// observations, which is steadier. Its state is persisted to a file after every observation, if it has one, so
+// forecasts survive restarts.
+type Forecaster struct {
+	mu       sync.Mutex
+	path     string
+	halfLife time.Duration
+	window   int
+	state    state
+}
+
+// Load creates a Forecaster whose state is persisted at path, restoring the state from a previous run if it exists.
+// If path is empty, the state is only kept in memory.
+func Load(path string, halfLife time.Duration, window int) (*Forecaster, error) {
+	f := &Forecaster{path: path, halfLife: halfLife, window: window}
+	if path == "" {
+		return f, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return f, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read forecast state: %w", err)
+	}
+	if err := json.Unmarshal(data, &f.state); err != nil {
+		return nil, fmt.Errorf("failed to decode forecast state: %w", err)
+	}
+	return f, nil
+}
+
+// Observe records that provenBlocks more blocks were proven since the previous observation, and persists the updated
+// state. It should be called regularly, including when no blocks were proven, so that the rate decays.
+func (f *Forecaster) Observe(now time.Time, provenBlocks uint64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var total uint64
+	if n := len(f.state.Points); n > 0 {
+		last := f.state.Points[n-1]
+		dt := now.Unix() - last.Time
+		if dt <= 0 {
+			// Fold observations made within the same second into the last one.
+			f.state.Points[n-1].Blocks += provenBlocks
+			return f.persist()
+		}
+		// Weight the rate over the interval by how long the interval was, so irregular observations are handled.
+		alpha := 1 - math.Exp2(-float64(dt)/f.halfLife.Seconds())
+		rate := float64(provenBlocks) / float64(dt)
+		f.state.EWMARate += alpha * (rate - f.state.EWMARate)
+		total = last.Blocks
+	}
+
+	f.state.Points = append(f.state.Points, point{Time: now.Unix(), Blocks: total + provenBlocks})
+	if len(f.state.Points) > f.window {
+		f.state.Points = f.state.Points[len(f.state.Points)-f.window:]
+	}
+	return f.persist()
+}
+
+// persist writes the state to a temporary file first, so a crash never leaves a partially written state behind.
+func (f *Forecaster) persist() error {
+	if f.path == "" {
+		return nil
+	}
+	data, err := json.Marshal(f.state)
+	if err != nil {
+		return fmt.Errorf("failed to encode forecast state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory for forecast state: %w", err)
+	}
+	tmp := f.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write forecast state: %w", err)
+	}
+	return os.Rename(tmp, f.path)
+}
+
+// EWMARate returns the exponentially weighted moving average of the proving rate, in blocks per second.
+func (f *Forecaster) EWMARate() float64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.state.EWMARate
+}
+
+// LinearRate returns the slope of the least-squares line through the recent observations of the total number of
+// blocks proven, in blocks per second. Returns 0 until there are at least two observations.
+func (f *Forecaster) LinearRate() float64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n := float64(len(f.state.Points))
+	if n < 2 {
+		return 0
+	}
+	// Center the times on the first observation, so the sums don't lose precision.
+	t0 := f.state.Points[0].Time
+	var sumT, sumB, sumTT, sumTB float64
+	for _, p := range f.state.Points {
+		t, b := float64(p.Time-t0), float64(p.Blocks)
+		sumT += t
+		sumB += b
+		sumTT += t * t
+		sumTB += t * b
+	}
+	denom := n*sumTT - sumT*sumT
+	if denom == 0 {
+		return 0
+	}
+	return max(0, (n*sumTB-sumT*sumB)/denom)
+}
+
+// Rate returns the forecast proving rate in blocks per second. It is the lower of the two models' rates, so that
+// deadlines planned from it are conservative.
+func (f *Forecaster) Rate() float64 {
+	ewma, linear := f.EWMARate(), f.LinearRate()
+	if ewma <= 0 || linear <= 0 {
+		return max(ewma, linear)
+	}
+	return min(ewma, linear)
+}
+
+// ETA returns how long it will take to prove the given number of blocks at the forecast rate. Returns false if there
+// is no proving history to forecast from.
+func (f *Forecaster) ETA(blocks uint64) (time.Duration, bool) {
+	rate := f.Rate()
+	if rate <= 0 {
+		return 0, false
+	}
+	return time.Duration(float64(blocks) / rate * float64(time.Second)), true
+}
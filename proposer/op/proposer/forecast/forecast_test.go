@@ -0,0 +1,50 @@
+package forecast
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForecasterSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "forecast.json")
+	f, err := Load(path, 10*time.Second, 10)
+	require.NoError(t, err)
+
+	_, ok := f.ETA(100)
+	require.False(t, ok)
+
+	// Prove 10 blocks every 10 seconds.
+	start := time.Unix(1_000_000, 0)
+	for i := 0; i < 20; i++ {
+		require.NoError(t, f.Observe(start.Add(time.Duration(i)*10*time.Second), 10))
+	}
+	require.InDelta(t, 1.0, f.LinearRate(), 1e-9)
+	require.InDelta(t, 1.0, f.EWMARate(), 0.1)
+
+	restored, err := Load(path, 10*time.Second, 10)
+	require.NoError(t, err)
+	require.Equal(t, f.LinearRate(), restored.LinearRate())
+	require.Equal(t, f.EWMARate(), restored.EWMARate())
+
+	eta, ok := restored.ETA(100)
+	require.True(t, ok)
+	require.InDelta(t, 100*time.Second, eta, float64(15*time.Second))
+}
+
+func TestForecasterInMemory(t *testing.T) {
+	f, err := Load("", 10*time.Second, 10)
+	require.NoError(t, err)
+
+	// Without a path, observations are only kept in memory.
+	start := time.Unix(1_000_000, 0)
+	for i := 0; i < 20; i++ {
+		require.NoError(t, f.Observe(start.Add(time.Duration(i)*10*time.Second), 10))
+	}
+	require.InDelta(t, 1.0, f.LinearRate(), 1e-9)
+	_, err = os.Stat(".tmp")
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,141 @@
+package proposer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequestevent"
+)
+
+// StateAtCmd reconstructs the proof request queue at a past time from the event log of a proposer DB, and prints what
+// was proving, what had failed, and what was blocked. It is meant for post-incident analysis, so it only reads the DB
+// and can be run against a copy of it.
+func StateAtCmd(cliCtx *cli.Context) error {
+	if cliCtx.NArg() != 2 {
+		return errors.New("expected the path of the proofs.db file and a timestamp")
+	}
+	dbPath := cliCtx.Args().Get(0)
+	at, err := parseTimestamp(cliCtx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(dbPath); err != nil {
+		return fmt.Errorf("failed to open DB: %w", err)
+	}
+
+	proofDB, err := db.InitDB(dbPath, true)
+	if err != nil {
+		return err
+	}
+	defer proofDB.CloseDB()
+
+	var reqs []*ent.ProofRequestEvent
+	var firstEvent uint64
+	var hasEvents bool
+	err = proofDB.ReadSnapshot(func(snapshot *db.ProofDB) error {
+		if firstEvent, hasEvents, err = snapshot.GetFirstEventTime(); err != nil {
+			return err
+		}
+		reqs, err = snapshot.GetProofRequestsAt(uint64(at.Unix()))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	out := cliCtx.App.Writer
+	fmt.Fprintf(out, "Proof requests at %s\n", at.UTC().Format(time.RFC3339))
+	if !hasEvents {
+		fmt.Fprintln(out, "The event log is empty, nothing to reconstruct.")
+		return nil
+	}
+	if uint64(at.Unix()) < firstEvent {
+		fmt.Fprintf(out, "The event log starts at %s, requests from before then are missing.\n", time.Unix(int64(firstEvent), 0).UTC().Format(time.RFC3339))
+	}
+	printStateAt(out, reqs, at)
+	return nil
+}
+
+// parseTimestamp parses a unix timestamp in seconds, or an RFC 3339 time.
+func parseTimestamp(s string) (time.Time, error) {
+	if secs, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(secs, 0), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %q, expected unix seconds or RFC 3339", s)
+	}
+	return t, nil
+}
+
+func printStateAt(out io.Writer, reqs []*ent.ProofRequestEvent, at time.Time) {
+	byStatus := map[proofrequestevent.Status][]*ent.ProofRequestEvent{}
+	for _, req := range reqs {
+		byStatus[req.Status] = append(byStatus[req.Status], req)
+	}
+	fmt.Fprintf(out, "%d complete, %d proving, %d generating witnesses, %d failed, %d unrequested\n",
+		len(byStatus[proofrequestevent.StatusCOMPLETE]), len(byStatus[proofrequestevent.StatusPROVING]),
+		len(byStatus[proofrequestevent.StatusWITNESSGEN]), len(byStatus[proofrequestevent.StatusFAILED]),
+		len(byStatus[proofrequestevent.StatusUNREQ]))
+
+	for _, status := range []proofrequestevent.Status{proofrequestevent.StatusPROVING, proofrequestevent.StatusWITNESSGEN, proofrequestevent.StatusFAILED} {
+		if len(byStatus[status]) == 0 {
+			continue
+		}
+		fmt.Fprintf(out, "\n%s:\n", status)
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tTYPE\tRANGE\tSINCE")
+		for _, req := range byStatus[status] {
+			fmt.Fprintf(w, "%d\t%s\t%d-%d\t%s\n", req.ProofRequestID, req.Type, req.StartBlock, req.EndBlock, since(req.Time, at))
+		}
+		w.Flush()
+	}
+
+	if unreqs := byStatus[proofrequestevent.StatusUNREQ]; len(unreqs) > 0 {
+		inFlight := len(byStatus[proofrequestevent.StatusWITNESSGEN]) + len(byStatus[proofrequestevent.StatusPROVING])
+		fmt.Fprintln(out, "\nBLOCKED:")
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tTYPE\tRANGE\tSINCE\tREASON")
+		for _, req := range unreqs {
+			fmt.Fprintf(w, "%d\t%s\t%d-%d\t%s\t%s\n", req.ProofRequestID, req.Type, req.StartBlock, req.EndBlock, since(req.Time, at), blockedReasonAt(req, byStatus[proofrequestevent.StatusCOMPLETE], inFlight))
+		}
+		w.Flush()
+	}
+}
+
+// blockedReasonAt explains why an unrequested proof hadn't been sent to the server yet, as far as the event log can
+// tell. Unlike blockedReason, it can't know the configured limits or L1 block hash checkpoints at the time.
+func blockedReasonAt(req *ent.ProofRequestEvent, completed []*ent.ProofRequestEvent, inFlight int) string {
+	if req.Type == proofrequestevent.TypeSPAN {
+		return fmt.Sprintf("queued, %d requests were in flight", inFlight)
+	}
+
+	// Walk the completed span proofs from the start of the AGG proof to find the first gap.
+	ends := map[uint64]uint64{}
+	for _, span := range completed {
+		if span.Type == proofrequestevent.TypeSPAN {
+			ends[span.StartBlock] = span.EndBlock
+		}
+	}
+	for block := req.StartBlock; block < req.EndBlock; {
+		end, ok := ends[block]
+		if !ok {
+			return fmt.Sprintf("awaiting subproofs: no completed span proof starts at block %d", block)
+		}
+		block = end
+	}
+	return "subproofs complete, awaiting L1 block hash checkpoint or dispatch"
+}
+
+func since(eventTime uint64, at time.Time) string {
+	return at.Sub(time.Unix(int64(eventTime), 0)).Truncate(time.Second).String()
+}
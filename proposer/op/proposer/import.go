@@ -0,0 +1,151 @@
+package proposer
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/urfave/cli/v2"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/flags"
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+// ImportProofs queues span proofs for the given ranges ahead of the planner. The ranges must start after the latest
+// block proposed on the L2OO, and end at or before the finalized L2 block. Returns the ranges of the queued span
+// proofs.
+func (l *L2OutputSubmitter) ImportProofs(ctx context.Context, ranges []rpc.ProofRange) ([]rpc.ProofRange, error) {
+	if len(ranges) == 0 {
+		return nil, errors.New("no ranges to import")
+	}
+
+	latestBlock, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest L2OO block number: %w", err)
+	}
+	from := latestBlock.Uint64()
+
+	rollupClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, l.Log, l.Cfg.RollupRpc)
+	if err != nil {
+		return nil, err
+	}
+	status, err := rollupClient.SyncStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sync status: %w", err)
+	}
+	finalized := status.FinalizedL2.Number
+
+	var spanRanges []db.SpanRange
+	var queued []rpc.ProofRange
+	for _, r := range ranges {
+		if r.Start >= r.End {
+			return nil, fmt.Errorf("range %d-%d is empty", r.Start, r.End)
+		}
+		if r.Start < from {
+			return nil, fmt.Errorf("range %d-%d starts before the latest proposed block %d", r.Start, r.End, from)
+		}
+		if r.End > finalized {
+			return nil, fmt.Errorf("range %d-%d ends after the finalized L2 block %d", r.Start, r.End, finalized)
+		}
+		for _, span := range l.SplitRangeCovering(r.Start, r.End) {
+			spanRanges = append(spanRanges, db.SpanRange{
+				Start:        span.Start,
+				End:          span.End,
+				ProofTimeout: l.proofTimeout(proofrequest.TypeSPAN, span.Start, span.End),
+			})
+			queued = append(queued, rpc.ProofRange{Start: span.Start, End: span.End})
+		}
+	}
+
+	// A break-glass override lifts the budget of unrequested span proofs.
+	maxUnrequested := int(l.Cfg.MaxUnrequestedSpanProofs)
+	if o := l.activeBreakGlass(); o != nil {
+		maxUnrequested = math.MaxInt
+		l.Log.Warn("Break-glass override: importing span proofs without the unrequested span proof budget", "spans", len(spanRanges), "reason", o.reason)
+	}
+	if err := l.db.ImportSpanProofs(from, spanRanges, maxUnrequested); err != nil {
+		return nil, err
+	}
+	l.Log.Info("Imported span proof requests.", "ranges", len(ranges), "spans", len(queued))
+	return queued, nil
+}
+
+// parseProofRanges reads the ranges to import from a JSON file with an array of {"start": ..., "end": ...} objects, or
+// from a CSV file with a start,end row per range and an optional header row.
+func parseProofRanges(path string) ([]rpc.ProofRange, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var ranges []rpc.ProofRange
+		if err := json.NewDecoder(f).Decode(&ranges); err != nil {
+			return nil, fmt.Errorf("failed to decode JSON ranges: %w", err)
+		}
+		return ranges, nil
+	}
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = 2
+	r.TrimLeadingSpace = true
+	var ranges []rpc.ProofRange
+	for line := 1; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV ranges: %w", err)
+		}
+		start, startErr := strconv.ParseUint(record[0], 10, 64)
+		end, endErr := strconv.ParseUint(record[1], 10, 64)
+		if startErr != nil || endErr != nil {
+			// Skip the header row.
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: invalid range %q", line, strings.Join(record, ","))
+		}
+		ranges = append(ranges, rpc.ProofRange{Start: start, End: end})
+	}
+	return ranges, nil
+}
+
+// ImportProofsCmd sends the ranges in the given CSV or JSON file to the admin_importProofs RPC of a running proposer.
+func ImportProofsCmd(cliCtx *cli.Context) error {
+	if cliCtx.NArg() != 1 {
+		return errors.New("expected the path of a CSV or JSON file with the ranges to import")
+	}
+	ranges, err := parseProofRanges(cliCtx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	client, err := gethrpc.DialContext(cliCtx.Context, cliCtx.String(flags.AdminRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial the proposer RPC: %w", err)
+	}
+	defer client.Close()
+
+	var queued []rpc.ProofRange
+	if err := client.CallContext(cliCtx.Context, &queued, "admin_importProofs", ranges); err != nil {
+		return fmt.Errorf("import failed: %w", err)
+	}
+	fmt.Fprintf(cliCtx.App.Writer, "Queued %d span proofs for %d ranges.\n", len(queued), len(ranges))
+	return nil
+}
@@ -0,0 +1,74 @@
+package ipfs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Client pins data to an IPFS node through its RPC API, as served by Kubo on port 5001.
+type Client struct {
+	apiURL     string
+	httpClient *http.Client
+}
+
+// NewClient creates a Client for the RPC API at apiURL, e.g. "http://localhost:5001".
+func NewClient(apiURL string) *Client {
+	return &Client{
+		apiURL:     strings.TrimSuffix(apiURL, "/"),
+		httpClient: &http.Client{Timeout: 2 * time.Minute},
+	}
+}
+
+// addResponse is the response of the RPC API's add endpoint.
+type addResponse struct {
+	Name string `json:"Name"`
+	Hash string `json:"Hash"`
+}
+
+// Pin adds the data to the IPFS node and pins it, so it isn't garbage collected. Returns the CIDv1 of the data.
+func (c *Client) Pin(ctx context.Context, name string, data []byte) (string, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("file", name)
+	if err != nil {
+		return "", fmt.Errorf("failed to create multipart body: %w", err)
+	}
+	if _, err := part.Write(data); err != nil {
+		return "", fmt.Errorf("failed to write multipart body: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("failed to close multipart body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiURL+"/api/v0/add?pin=true&cid-version=1", &body)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to add %s to IPFS: %w", name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("failed to add %s to IPFS: status %d: %s", name, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	var added addResponse
+	if err := json.NewDecoder(resp.Body).Decode(&added); err != nil {
+		return "", fmt.Errorf("failed to decode IPFS add response: %w", err)
+	}
+	if added.Hash == "" {
+		return "", fmt.Errorf("IPFS add response for %s has no CID", name)
+	}
+	return added.Hash, nil
+}
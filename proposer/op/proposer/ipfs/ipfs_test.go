@@ -0,0 +1,40 @@
+package ipfs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPin(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v0/add", r.URL.Path)
+		require.Equal(t, "true", r.URL.Query().Get("pin"))
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		data, err := io.ReadAll(file)
+		require.NoError(t, err)
+		require.Equal(t, "proof.json", header.Filename)
+		require.Equal(t, "data", string(data))
+		_, _ = w.Write([]byte(`{"Name":"proof.json","Hash":"bafkcid","Size":"4"}`))
+	}))
+	defer srv.Close()
+
+	cid, err := NewClient(srv.URL+"/").Pin(context.Background(), "proof.json", []byte("data"))
+	require.NoError(t, err)
+	require.Equal(t, "bafkcid", cid)
+}
+
+func TestPinError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "repo is full", http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	_, err := NewClient(srv.URL).Pin(context.Background(), "proof.json", []byte("data"))
+	require.ErrorContains(t, err, "repo is full")
+}
@@ -0,0 +1,84 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+)
+
+// proofPinner pins exported proofs to IPFS, and returns the CID they were pinned under.
+type proofPinner interface {
+	Pin(ctx context.Context, name string, data []byte) (string, error)
+}
+
+// ExportedProof is the document pinned to IPFS for a completed AGG proof. It holds the proof along with the public
+// values it commits to, so third parties can verify it without access to the proposer.
+type ExportedProof struct {
+	L2ChainID     uint64 `json:"l2_chain_id"`
+	StartBlock    uint64 `json:"start_block"`
+	EndBlock      uint64 `json:"end_block"`
+	L1BlockNumber uint64 `json:"l1_block_number"`
+	// L1BlockHash is the L1 head the proof was derived against.
+	L1BlockHash string        `json:"l1_block_hash"`
+	L2PreRoot   common.Hash   `json:"l2_pre_root"`
+	L2PostRoot  common.Hash   `json:"l2_post_root"`
+	Proof       hexutil.Bytes `json:"proof"`
+}
+
+// ExportProofs pins the completed AGG proofs that haven't been exported yet to IPFS, and records their CIDs. Proofs
+// are exported before they are moved to cold storage, since the proof has to be in the DB to be exported.
+func (l *L2OutputSubmitter) ExportProofs(ctx context.Context) error {
+	if l.ipfs == nil {
+		return nil
+	}
+
+	proofs, err := l.db.GetProofsToExport()
+	if err != nil {
+		return err
+	}
+	for _, req := range proofs {
+		exported, err := l.exportedProof(ctx, req)
+		if err != nil {
+			return err
+		}
+		data, err := json.Marshal(exported)
+		if err != nil {
+			return fmt.Errorf("failed to marshal proof %d: %w", req.ID, err)
+		}
+		name := fmt.Sprintf("%d-%s-%d-%d.json", l.Cfg.L2ChainID, req.Type, req.StartBlock, req.EndBlock)
+		cid, err := l.ipfs.Pin(ctx, name, data)
+		if err != nil {
+			return fmt.Errorf("failed to pin proof %d: %w", req.ID, err)
+		}
+		if err := l.db.SetIPFSCID(req.ID, cid); err != nil {
+			return err
+		}
+		l.Log.Info("exported proof to IPFS", "id", req.ID, "start", req.StartBlock, "end", req.EndBlock, "cid", cid)
+	}
+	return nil
+}
+
+func (l *L2OutputSubmitter) exportedProof(ctx context.Context, req *ent.ProofRequest) (*ExportedProof, error) {
+	startOutput, err := l.FetchOutput(ctx, req.StartBlock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch output at block %d: %w", req.StartBlock, err)
+	}
+	endOutput, err := l.FetchOutput(ctx, req.EndBlock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch output at block %d: %w", req.EndBlock, err)
+	}
+	return &ExportedProof{
+		L2ChainID:     l.Cfg.L2ChainID,
+		StartBlock:    req.StartBlock,
+		EndBlock:      req.EndBlock,
+		L1BlockNumber: req.L1BlockNumber,
+		L1BlockHash:   req.L1BlockHash,
+		L2PreRoot:     common.Hash(startOutput.OutputRoot),
+		L2PostRoot:    common.Hash(endOutput.OutputRoot),
+		Proof:         req.Proof,
+	}, nil
+}
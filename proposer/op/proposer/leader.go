@@ -0,0 +1,72 @@
+package proposer
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// leaderLockKey returns the key of the advisory lock that the leader of the proposers of the L2OO holds, so the
+// proposers of different chains can share a DB without contending for the same lock.
+func leaderLockKey(l2oo common.Address) int64 {
+	return int64(binary.BigEndian.Uint64(crypto.Keccak256([]byte("op-succinct-proposer-leader"), l2oo.Bytes())[:8]))
+}
+
+// electLeader returns whether this instance is the leader of the instances sharing the DB, which is the only one that
+// runs the pipeline. The leader checks that it still holds the leader lock on every poll, and the standbys try to take
+// it, which succeeds once the leader stopped, or lost its connection to the DB. A new leader first resumes the requests
+// that were in witness generation or proving, like a restarted proposer, including those of the previous leader, and
+// starts persisting the witness generation limit.
+func (l *L2OutputSubmitter) electLeader(ctx context.Context) bool {
+	if l.leaderLock != nil {
+		err := l.leaderLock.Check(ctx)
+		if err == nil {
+			return true
+		}
+		l.Log.Error("Lost the leader lock, standing by", "err", err)
+		l.Metr.RecordError("leader_lost", 1)
+		if err := l.leaderLock.Release(); err != nil {
+			l.Log.Warn("failed to release the leader lock", "err", err)
+		}
+		l.leaderLock = nil
+	}
+
+	lock, err := l.db.TryAcquireLeaderLock(ctx, leaderLockKey(*l.Cfg.L2OutputOracleAddr))
+	if err != nil {
+		l.Log.Error("failed to take the leader lock", "err", err)
+		l.Metr.RecordError("leader_election", 1)
+		l.Metr.RecordLeader(false)
+		return false
+	}
+	if lock == nil {
+		l.Log.Info("Standing by, another instance is the leader")
+		l.Metr.RecordLeader(false)
+		return false
+	}
+	l.leaderLock = lock
+	l.Log.Info("Elected leader, resuming the pipeline", "instance", l.Cfg.InstanceID)
+	l.Metr.RecordLeader(true)
+	l.startLimitPersister()
+
+	if err := l.ResumeWitnessGenRequests(); err != nil {
+		l.Log.Error("failed to resume WITNESSGEN requests", "err", err)
+	}
+	if err := l.ResumeProvingRequests(); err != nil {
+		l.Log.Error("failed to resume PROVING requests", "err", err)
+	}
+	return true
+}
+
+// releaseLeadership releases the leader lock if this instance holds it, so a standby takes over on its next poll.
+func (l *L2OutputSubmitter) releaseLeadership() {
+	if l.leaderLock == nil {
+		return
+	}
+	if err := l.leaderLock.Release(); err != nil {
+		l.Log.Error("failed to release the leader lock", "err", err)
+	}
+	l.leaderLock = nil
+	l.Metr.RecordLeader(false)
+}
@@ -0,0 +1,107 @@
+package proposer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+func TestElectLeader(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	// The proposers of different chains contend for different locks.
+	a, b := common.HexToAddress("0x01"), common.HexToAddress("0x02")
+	require.Equal(t, leaderLockKey(a), leaderLockKey(a))
+	require.NotEqual(t, leaderLockKey(a), leaderLockKey(b))
+
+	// Without a Postgres DB, there's no lock to take, so the instance stands by rather than running the pipeline.
+	l := newFakeL2OODriver(t, newFakeL2OO(100, 100), proofDB)
+	l.Cfg.LeaderElection = true
+	l.Cfg.L2OutputOracleAddr = &a
+	require.False(t, l.electLeader(context.Background()))
+	require.Nil(t, l.leaderLock)
+}
+
+// postgresTestDSN returns the connection string of the Postgres DB at OP_SUCCINCT_TEST_POSTGRES_DSN, with a schema of
+// its own that is dropped after the test. Skips the test if the variable isn't set.
+func postgresTestDSN(t *testing.T) string {
+	dsn := os.Getenv("OP_SUCCINCT_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("OP_SUCCINCT_TEST_POSTGRES_DSN isn't set")
+	}
+	conn, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	schema := fmt.Sprintf("test_%d", time.Now().UnixNano())
+	_, err = conn.Exec("CREATE SCHEMA " + schema)
+	require.NoError(t, err)
+	t.Cleanup(func() { _, _ = conn.Exec("DROP SCHEMA " + schema + " CASCADE") })
+
+	u, err := url.Parse(dsn)
+	require.NoError(t, err)
+	query := u.Query()
+	query.Set("search_path", schema)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+func TestElectLeaderPostgres(t *testing.T) {
+	dsn := postgresTestDSN(t)
+	ctx := context.Background()
+	// The leader lock is shared by every schema of the DB, so each run elects a leader for an L2OO of its own.
+	l2oo := common.BigToAddress(big.NewInt(time.Now().UnixNano()))
+
+	var dbs []*db.ProofDB
+	var instances []*L2OutputSubmitter
+	for range 2 {
+		proofDB, err := db.Open(dsn)
+		require.NoError(t, err)
+		defer proofDB.CloseDB()
+		l := newFakeL2OODriver(t, newFakeL2OO(100, 100), proofDB)
+		l.Cfg.LeaderElection = true
+		l.Cfg.L2OutputOracleAddr = &l2oo
+		l.witnessGenLimiter = newWitnessGenLimiter(1)
+		dbs, instances = append(dbs, proofDB), append(instances, l)
+	}
+	leader, standby := instances[0], instances[1]
+
+	// Only one instance is elected.
+	require.True(t, leader.electLeader(ctx))
+	require.True(t, leader.electLeader(ctx))
+	require.False(t, standby.electLeader(ctx))
+
+	// A request that the leader is requesting, which has no prover request ID yet, is left alone by the standby while
+	// the leader runs.
+	require.NoError(t, dbs[0].NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+	reqs, err := dbs[0].GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	require.NoError(t, dbs[0].UpdateProofStatus(reqs[0].ID, proofrequest.StatusPROVING))
+	require.False(t, standby.electLeader(ctx))
+	req, err := dbs[1].GetProofRequest(reqs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusPROVING, req.Status)
+
+	// Once the leader stops, the standby takes over, and retries the request like a restarted proposer.
+	leader.releaseLeadership()
+	require.True(t, standby.electLeader(ctx))
+	require.False(t, leader.electLeader(ctx))
+	req, err = dbs[1].GetProofRequest(reqs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusFAILED, req.Status)
+	standby.releaseLeadership()
+}
@@ -0,0 +1,115 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+// MAX_RANGE_LEASE_DURATION is the longest a range lease lasts before it has to be renewed, so that the range of an
+// external job that crashed is proven by the proposer again.
+const MAX_RANGE_LEASE_DURATION = 24 * time.Hour
+
+// rangeLeaseOf returns the first of the leases that the span proof from start to end overlaps, or nil if it overlaps
+// none.
+func rangeLeaseOf(leases []*ent.RangeLease, start, end uint64) *ent.RangeLease {
+	for _, lease := range leases {
+		if start < lease.EndBlock && lease.StartBlock < end {
+			return lease
+		}
+	}
+	return nil
+}
+
+// activeRangeLeases returns the range leases that haven't expired.
+func (l *L2OutputSubmitter) activeRangeLeases() ([]*ent.RangeLease, error) {
+	return l.db.GetActiveRangeLeases(uint64(time.Now().Unix()))
+}
+
+// AcquireRangeLease leases a range of blocks to an external proving job, e.g. a backfill script, for the given number of
+// seconds. The planner doesn't queue span proofs for a leased range, and queued span proofs that overlap it are parked
+// in the BLOCKED status, until the lease expires or is released. Leases can't overlap.
+func (l *L2OutputSubmitter) AcquireRangeLease(ctx context.Context, owner string, start, end, seconds uint64) (rpc.RangeLease, error) {
+	if owner == "" {
+		return rpc.RangeLease{}, fmt.Errorf("%w: a range lease requires an owner", rpc.ErrInvalidRequest)
+	}
+	if start >= end {
+		return rpc.RangeLease{}, fmt.Errorf("%w: range %d-%d is empty", rpc.ErrInvalidRequest, start, end)
+	}
+	expires, err := rangeLeaseExpiry(seconds)
+	if err != nil {
+		return rpc.RangeLease{}, err
+	}
+	lease, err := l.db.AcquireRangeLease(owner, start, end, expires)
+	if errors.Is(err, db.ErrRangeLeased) {
+		return rpc.RangeLease{}, fmt.Errorf("%w: %w", rpc.ErrInvalidRequest, err)
+	} else if err != nil {
+		return rpc.RangeLease{}, err
+	}
+	l.Log.Info("Range leased to an external job, not proving it until the lease ends", "id", lease.ID, "owner", owner, "start", start, "end", end, "expires", expires)
+	return newRangeLease(lease), nil
+}
+
+// RenewRangeLease extends the owner's lease with the given ID to last the given number of seconds from now.
+func (l *L2OutputSubmitter) RenewRangeLease(ctx context.Context, id int, owner string, seconds uint64) (rpc.RangeLease, error) {
+	expires, err := rangeLeaseExpiry(seconds)
+	if err != nil {
+		return rpc.RangeLease{}, err
+	}
+	lease, err := l.db.RenewRangeLease(id, owner, expires)
+	if errors.Is(err, db.ErrRangeLeaseNotFound) {
+		return rpc.RangeLease{}, fmt.Errorf("%w: %w", rpc.ErrInvalidRequest, err)
+	} else if err != nil {
+		return rpc.RangeLease{}, err
+	}
+	return newRangeLease(lease), nil
+}
+
+// ReleaseRangeLease ends the owner's lease with the given ID before it expires. Span proofs that overlap its range are
+// queued again on the next poll.
+func (l *L2OutputSubmitter) ReleaseRangeLease(ctx context.Context, id int, owner string) error {
+	err := l.db.ReleaseRangeLease(id, owner)
+	if errors.Is(err, db.ErrRangeLeaseNotFound) {
+		return fmt.Errorf("%w: %w", rpc.ErrInvalidRequest, err)
+	} else if err != nil {
+		return err
+	}
+	l.Log.Info("Range lease released", "id", id, "owner", owner)
+	return nil
+}
+
+// RangeLeases returns the range leases that haven't expired.
+func (l *L2OutputSubmitter) RangeLeases(ctx context.Context) ([]rpc.RangeLease, error) {
+	leases, err := l.activeRangeLeases()
+	if err != nil {
+		return nil, err
+	}
+	ranges := make([]rpc.RangeLease, len(leases))
+	for i, lease := range leases {
+		ranges[i] = newRangeLease(lease)
+	}
+	return ranges, nil
+}
+
+// rangeLeaseExpiry returns the expiry time of a lease that lasts the given number of seconds from now.
+func rangeLeaseExpiry(seconds uint64) (uint64, error) {
+	if seconds == 0 || seconds > uint64(MAX_RANGE_LEASE_DURATION.Seconds()) {
+		return 0, fmt.Errorf("%w: a range lease must last between 1s and %s, got %ds", rpc.ErrInvalidRequest, MAX_RANGE_LEASE_DURATION, seconds)
+	}
+	return uint64(time.Now().Unix()) + seconds, nil
+}
+
+func newRangeLease(lease *ent.RangeLease) rpc.RangeLease {
+	return rpc.RangeLease{
+		ID:        lease.ID,
+		Owner:     lease.Owner,
+		Start:     lease.StartBlock,
+		End:       lease.EndBlock,
+		ExpiresAt: lease.ExpiresTime,
+	}
+}
@@ -0,0 +1,82 @@
+package proposer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+func TestRangeLeases(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 150, 0))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 150, 200, 0))
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+		},
+		db: *proofDB,
+	}
+	ctx := context.Background()
+	requireStatus := func(start, end uint64, status proofrequest.Status) {
+		reqs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, start, end, status)
+		require.NoError(t, err)
+		require.Len(t, reqs, 1)
+	}
+
+	_, err = l.AcquireRangeLease(ctx, "", 170, 300, 60)
+	require.ErrorIs(t, err, rpc.ErrInvalidRequest)
+	_, err = l.AcquireRangeLease(ctx, "backfill", 170, 300, 0)
+	require.ErrorIs(t, err, rpc.ErrInvalidRequest)
+
+	lease, err := l.AcquireRangeLease(ctx, "backfill", 170, 300, 60)
+	require.NoError(t, err)
+	require.Equal(t, "backfill", lease.Owner)
+
+	// Leases can't overlap.
+	_, err = l.AcquireRangeLease(ctx, "reprover", 250, 400, 60)
+	require.ErrorIs(t, err, rpc.ErrInvalidRequest)
+	_, err = l.AcquireRangeLease(ctx, "reprover", 300, 400, 60)
+	require.NoError(t, err)
+
+	leases, err := l.RangeLeases(ctx)
+	require.NoError(t, err)
+	require.Len(t, leases, 2)
+
+	// Only the span that overlaps the lease is parked.
+	require.NoError(t, l.ParkBlockedRanges())
+	requireStatus(100, 150, proofrequest.StatusUNREQ)
+	requireStatus(150, 200, proofrequest.StatusBLOCKED)
+
+	// Only the owner can renew or release the lease.
+	_, err = l.RenewRangeLease(ctx, lease.ID, "reprover", 60)
+	require.ErrorIs(t, err, rpc.ErrInvalidRequest)
+	renewed, err := l.RenewRangeLease(ctx, lease.ID, "backfill", 120)
+	require.NoError(t, err)
+	require.Greater(t, renewed.ExpiresAt, lease.ExpiresAt)
+	require.ErrorIs(t, l.ReleaseRangeLease(ctx, lease.ID, "reprover"), rpc.ErrInvalidRequest)
+
+	// Once the lease is released, the span is queued again.
+	require.NoError(t, l.ReleaseRangeLease(ctx, lease.ID, "backfill"))
+	require.NoError(t, l.ParkBlockedRanges())
+	requireStatus(150, 200, proofrequest.StatusUNREQ)
+
+	// Expired leases don't block the range.
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 200, 250, 0))
+	_, err = proofDB.AcquireRangeLease("crashed", 200, 250, 1)
+	require.NoError(t, err)
+	require.NoError(t, l.ParkBlockedRanges())
+	requireStatus(200, 250, proofrequest.StatusUNREQ)
+}
@@ -0,0 +1,173 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/flags"
+)
+
+// loadTest sends mock span proof requests for random spans between startBlock and endBlock to the server at a fixed
+// rate. Requests are sent on schedule whether or not the previous ones completed, like the proposer does once its
+// queue is full, so an undersized server shows up as growing latencies and errors.
+type loadTest struct {
+	serverURL  string
+	client     *http.Client
+	rate       float64
+	duration   time.Duration
+	startBlock uint64
+	endBlock   uint64
+	spanBlocks uint64
+}
+
+// loadTestReport summarizes the requests sent during a load test.
+type loadTestReport struct {
+	sent    int
+	elapsed time.Duration
+	// latencies are the latencies of the successful requests.
+	latencies []time.Duration
+	// failures are the number of failed requests, keyed by the status code or "timeout" or "error".
+	failures map[string]int
+}
+
+// LoadTestCmd runs a load test of mock span proof requests against the witness generation server, and prints the
+// latency distribution and error rates, to size the server's hardware before going live. Only the first server is
+// load tested, since the servers behind a load-balanced deployment are sized alike.
+func LoadTestCmd(cliCtx *cli.Context) error {
+	servers := cliCtx.StringSlice(flags.OPSuccinctServerUrlFlag.Name)
+	if len(servers) == 0 {
+		return errors.New("no OP Succinct server URL provided")
+	}
+	t := &loadTest{
+		serverURL:  servers[0],
+		client:     &http.Client{Timeout: time.Duration(cliCtx.Uint64(flags.WitnessGenTimeoutFlag.Name)) * time.Second},
+		rate:       cliCtx.Float64(flags.LoadTestRateFlag.Name),
+		duration:   cliCtx.Duration(flags.LoadTestDurationFlag.Name),
+		startBlock: cliCtx.Uint64(flags.LoadTestStartBlockFlag.Name),
+		endBlock:   cliCtx.Uint64(flags.LoadTestEndBlockFlag.Name),
+		spanBlocks: cliCtx.Uint64(flags.LoadTestSpanBlocksFlag.Name),
+	}
+	if t.rate <= 0 {
+		return errors.New("the rate must be positive")
+	}
+	if t.spanBlocks == 0 {
+		return errors.New("the span blocks must be positive")
+	}
+	if t.endBlock < t.startBlock+t.spanBlocks {
+		return fmt.Errorf("the range %d-%d is shorter than a span of %d blocks", t.startBlock, t.endBlock, t.spanBlocks)
+	}
+
+	fmt.Fprintf(cliCtx.App.Writer, "Sending %g span proof requests of %d blocks per second to %s for %s...\n", t.rate, t.spanBlocks, t.serverURL, t.duration)
+	report := t.run(cliCtx.Context)
+	report.print(cliCtx.App.Writer)
+	return nil
+}
+
+// run sends requests until the duration elapsed, and waits for the requests in flight to complete.
+func (t *loadTest) run(ctx context.Context) *loadTestReport {
+	report := &loadTestReport{failures: map[string]int{}}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	begin := time.Now()
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / t.rate))
+	defer ticker.Stop()
+	deadline := time.After(t.duration)
+	for done := false; !done; {
+		select {
+		case <-ctx.Done():
+			done = true
+		case <-deadline:
+			done = true
+		case <-ticker.C:
+			start := t.startBlock + rand.Uint64()%(t.endBlock-t.startBlock-t.spanBlocks+1)
+			report.sent++
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				latency, failure := t.send(ctx, start, start+t.spanBlocks)
+				mu.Lock()
+				defer mu.Unlock()
+				if failure != "" {
+					report.failures[failure]++
+				} else {
+					report.latencies = append(report.latencies, latency)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+	report.elapsed = time.Since(begin)
+	return report
+}
+
+// send sends a single mock span proof request. Returns its latency, or the reason it failed.
+func (t *loadTest) send(ctx context.Context, start, end uint64) (time.Duration, string) {
+	body, err := json.Marshal(SpanProofRequest{Start: start, End: end})
+	if err != nil {
+		return 0, "error"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.serverURL+"/request_mock_span_proof", bytes.NewReader(body))
+	if err != nil {
+		return 0, "error"
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	sent := time.Now()
+	resp, err := t.client.Do(req)
+	if err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return 0, "timeout"
+		}
+		return 0, "error"
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return 0, strconv.Itoa(resp.StatusCode)
+	}
+	return time.Since(sent), ""
+}
+
+func (r *loadTestReport) print(w io.Writer) {
+	failed := 0
+	for _, n := range r.failures {
+		failed += n
+	}
+	fmt.Fprintf(w, "Sent %d requests in %s: %d succeeded, %d failed.\n", r.sent, r.elapsed.Round(time.Second), len(r.latencies), failed)
+	if len(r.latencies) > 0 {
+		fmt.Fprintf(w, "Latency: p50 %s, p90 %s, p99 %s, max %s\n",
+			r.latency(0.5), r.latency(0.9), r.latency(0.99), r.latency(1))
+		fmt.Fprintf(w, "Throughput: %.3f successful requests per second\n", float64(len(r.latencies))/r.elapsed.Seconds())
+	}
+	reasons := make([]string, 0, len(r.failures))
+	for reason := range r.failures {
+		reasons = append(reasons, reason)
+	}
+	slices.Sort(reasons)
+	for _, reason := range reasons {
+		fmt.Fprintf(w, "Failed with %s: %d (%.1f%%)\n", reason, r.failures[reason], 100*float64(r.failures[reason])/float64(r.sent))
+	}
+}
+
+// latency returns the latency at the given quantile of the successful requests, using the nearest-rank method.
+func (r *loadTestReport) latency(q float64) time.Duration {
+	sorted := slices.Clone(r.latencies)
+	slices.Sort(sorted)
+	i := int(math.Ceil(q*float64(len(sorted)))) - 1
+	return sorted[max(0, min(i, len(sorted)-1))].Round(time.Millisecond)
+}
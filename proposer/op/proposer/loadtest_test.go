@@ -0,0 +1,57 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTest(t *testing.T) {
+	var requests atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SpanProofRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, uint64(10), req.End-req.Start)
+		require.GreaterOrEqual(t, req.Start, uint64(100))
+		require.LessOrEqual(t, req.End, uint64(200))
+
+		// Every other request is rejected as if the server were overloaded.
+		if requests.Add(1)%2 == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"proof": ""}`))
+	}))
+	defer srv.Close()
+
+	lt := &loadTest{
+		serverURL:  srv.URL,
+		client:     &http.Client{Timeout: time.Second},
+		rate:       100,
+		duration:   200 * time.Millisecond,
+		startBlock: 100,
+		endBlock:   200,
+		spanBlocks: 10,
+	}
+	report := lt.run(context.Background())
+	require.Positive(t, report.sent)
+	require.Equal(t, report.sent, len(report.latencies)+report.failures["503"])
+	require.InDelta(t, report.sent/2, report.failures["503"], 1)
+}
+
+func TestLoadTestReportLatency(t *testing.T) {
+	r := &loadTestReport{}
+	for i := 10; i >= 1; i-- {
+		r.latencies = append(r.latencies, time.Duration(i)*time.Second)
+	}
+	require.Equal(t, 5*time.Second, r.latency(0.5))
+	require.Equal(t, 9*time.Second, r.latency(0.9))
+	require.Equal(t, 10*time.Second, r.latency(0.99))
+	require.Equal(t, 10*time.Second, r.latency(1))
+}
@@ -0,0 +1,90 @@
+package proposer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// MAX_PROOF_STATUS_LONG_POLL is the longest the OP Succinct server waits for the status of a proof to change.
+const MAX_PROOF_STATUS_LONG_POLL = 5 * time.Minute
+
+// statusWatches tracks the IDs of the proof requests whose status is being long-polled.
+type statusWatches struct {
+	mu  sync.Mutex
+	ids map[int]bool
+}
+
+// start marks the request as watched, and returns false if it already was.
+func (w *statusWatches) start(id int) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.ids == nil {
+		w.ids = make(map[int]bool)
+	}
+	if w.ids[id] {
+		return false
+	}
+	w.ids[id] = true
+	return true
+}
+
+func (w *statusWatches) stop(id int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.ids, id)
+}
+
+// WatchProvingRequests long-polls the status of every PROVING request that isn't watched yet in the background. Once a
+// proof is fulfilled or becomes unfulfillable, the loop runs right away to pick it up, instead of on its next tick. The
+// status is still polled in the loop, so a watch that fails only delays the request until then.
+func (l *L2OutputSubmitter) WatchProvingRequests(ctx context.Context) error {
+	reqs, err := l.db.GetAllProofsWithStatus(proofrequest.StatusPROVING)
+	if err != nil {
+		return err
+	}
+	for _, req := range reqs {
+		if !l.statusWatches.start(req.ID) {
+			continue
+		}
+		l.wg.Add(1)
+		go func(req *ent.ProofRequest) {
+			defer l.wg.Done()
+			defer l.statusWatches.stop(req.ID)
+			l.watchProofStatus(ctx, req)
+		}(req)
+	}
+	return nil
+}
+
+// watchProofStatus long-polls the status of a proof request until it's fulfilled or unfulfillable, the request leaves
+// the PROVING state, or polling fails.
+func (l *L2OutputSubmitter) watchProofStatus(ctx context.Context, req *ent.ProofRequest) {
+	backend := l.proverBackend(req)
+	since := SP1FulfillmentStatusUnspecified
+	for {
+		status, err := l.WaitForProofStatus(ctx, backend, req.ProverRequestID, since, l.Cfg.ProofStatusLongPoll)
+		if err != nil {
+			l.Log.Debug("failed to long-poll proof status", "id", req.ProverRequestID, "backend", backend, "err", err)
+			return
+		}
+		if status.FulfillmentStatus == SP1FulfillmentStatusFulfilled || status.FulfillmentStatus == SP1FulfillmentStatusUnfulfillable {
+			l.Log.Info("Proof status changed", "id", req.ProverRequestID, "status", status.FulfillmentStatus)
+			select {
+			case l.provingStatusChanged <- struct{}{}:
+			default:
+			}
+			return
+		}
+
+		// Stop watching requests that left the PROVING state, e.g. because they timed out and were retried.
+		current, err := l.db.GetProofRequest(req.ID)
+		if err != nil || current.Status != proofrequest.StatusPROVING {
+			return
+		}
+		since = status.FulfillmentStatus
+	}
+}
@@ -0,0 +1,63 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+func TestWatchProvingRequests(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		status := SP1FulfillmentStatusAssigned
+		if len(queries) > 1 {
+			status = SP1FulfillmentStatusFulfilled
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(ProofStatusResponse{FulfillmentStatus: status}))
+	}))
+	defer server.Close()
+
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+	reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.NoError(t, proofDB.UpdateProofStatus(reqs[0].ID, proofrequest.StatusPROVING))
+	require.NoError(t, proofDB.SetProverRequestID(reqs[0].ID, []byte{0xab}))
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{OPSuccinctServerUrl: server.URL, ProofStatusLongPoll: time.Minute},
+		},
+		ctx:                  context.Background(),
+		db:                   *proofDB,
+		provingStatusChanged: make(chan struct{}, 1),
+	}
+	require.NoError(t, l.WatchProvingRequests(context.Background()))
+
+	// The loop is woken up once the proof is fulfilled.
+	select {
+	case <-l.provingStatusChanged:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the status change wasn't signaled")
+	}
+	l.wg.Wait()
+
+	// The second request waits for the status to change from the one returned by the first.
+	require.Equal(t, []string{"wait=60&since=0", "wait=60&since=2"}, queries)
+}
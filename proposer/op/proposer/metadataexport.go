@@ -0,0 +1,108 @@
+package proposer
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/analytics"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// ExportProofMetadata exports the metadata of every proof request to METADATA_EXPORT_PATH, replacing the last export,
+// so data teams can analyze the proving history without access to the DB.
+func (l *L2OutputSubmitter) ExportProofMetadata() error {
+	l.lastMetadataExport = time.Now()
+	records, err := proofMetadataRecords(l.db.Analytics())
+	if err != nil {
+		return err
+	}
+	if err := analytics.WriteFile(l.Cfg.MetadataExportPath, records); err != nil {
+		return err
+	}
+	l.Log.Info("exported proof request metadata", "path", l.Cfg.MetadataExportPath, "requests", len(records))
+	return nil
+}
+
+// ExportProofMetadataCmd exports the metadata of every proof request in a proposer DB to a .csv or .parquet file. It
+// only reads the DB, so it can be run against a copy of it.
+func ExportProofMetadataCmd(cliCtx *cli.Context) error {
+	if cliCtx.NArg() != 2 {
+		return errors.New("expected the path of the proofs.db file and of the .csv or .parquet file to export to")
+	}
+	dbPath, path := cliCtx.Args().Get(0), cliCtx.Args().Get(1)
+	if _, err := analytics.FormatOf(path); err != nil {
+		return err
+	}
+	if _, err := os.Stat(dbPath); err != nil {
+		return fmt.Errorf("failed to open DB: %w", err)
+	}
+
+	proofDB, err := db.InitDB(dbPath, true)
+	if err != nil {
+		return err
+	}
+	defer proofDB.CloseDB()
+
+	records, err := proofMetadataRecords(proofDB)
+	if err != nil {
+		return err
+	}
+	if err := analytics.WriteFile(path, records); err != nil {
+		return err
+	}
+	fmt.Fprintf(cliCtx.App.Writer, "Exported %d proof requests to %s\n", len(records), path)
+	return nil
+}
+
+func proofMetadataRecords(proofDB *db.ProofDB) ([]analytics.Record, error) {
+	reqs, err := proofDB.GetProofRequestMetadata()
+	if err != nil {
+		return nil, err
+	}
+	records := make([]analytics.Record, len(reqs))
+	for i, req := range reqs {
+		records[i] = proofMetadataRecord(req)
+	}
+	return records, nil
+}
+
+// proofMetadataRecord returns the exported metadata of a proof request. The durations are only set once the request
+// completed or failed, since its last update time is when that happened.
+func proofMetadataRecord(req *ent.ProofRequest) analytics.Record {
+	record := analytics.Record{
+		ID:               int64(req.ID),
+		Type:             req.Type.String(),
+		StartBlock:       int64(req.StartBlock),
+		EndBlock:         int64(req.EndBlock),
+		Status:           req.Status.String(),
+		RequestAddedTime: int64(req.RequestAddedTime),
+		ProofRequestTime: int64(req.ProofRequestTime),
+		LastUpdatedTime:  int64(req.LastUpdatedTime),
+		Attempts:         int64(req.Attempts),
+		ProverBackend:    req.ProverBackend,
+		ProverRequestID:  req.ProverRequestID,
+		ErrorMessage:     req.ErrorMessage,
+		AggRequestID:     int64(req.AggRequestID),
+		Cycles:           int64(req.Cycles),
+		Fee:              req.Fee,
+		FulfilledTime:    int64(req.FulfilledTime),
+		Fulfiller:        req.Fulfiller,
+		DowntimeCause:    req.DowntimeCause,
+	}
+	switch req.Status {
+	case proofrequest.StatusCOMPLETE, proofrequest.StatusFAILED, proofrequest.StatusDEADLETTER:
+		if req.ProofRequestTime != 0 && req.LastUpdatedTime >= req.ProofRequestTime {
+			record.ProvingSeconds = int64(req.LastUpdatedTime - req.ProofRequestTime)
+		}
+		if req.LastUpdatedTime >= req.RequestAddedTime {
+			record.TotalSeconds = int64(req.LastUpdatedTime - req.RequestAddedTime)
+		}
+	}
+	return record
+}
@@ -0,0 +1,45 @@
+package proposer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+func TestProofMetadataRecord(t *testing.T) {
+	req := &ent.ProofRequest{
+		ID:               7,
+		Type:             proofrequest.TypeSPAN,
+		StartBlock:       100,
+		EndBlock:         150,
+		Status:           proofrequest.StatusPROVING,
+		RequestAddedTime: 1000,
+		ProofRequestTime: 1100,
+		LastUpdatedTime:  1200,
+		ProverBackend:    "http://a",
+	}
+
+	// The durations aren't known while the request is pending.
+	record := proofMetadataRecord(req)
+	require.Equal(t, int64(7), record.ID)
+	require.Equal(t, "SPAN", record.Type)
+	require.Equal(t, "PROVING", record.Status)
+	require.Equal(t, "http://a", record.ProverBackend)
+	require.Zero(t, record.ProvingSeconds)
+	require.Zero(t, record.TotalSeconds)
+
+	req.Status = proofrequest.StatusCOMPLETE
+	record = proofMetadataRecord(req)
+	require.Equal(t, int64(100), record.ProvingSeconds)
+	require.Equal(t, int64(200), record.TotalSeconds)
+
+	// A request that failed before it was sent to the prover only has a total duration.
+	req.Status = proofrequest.StatusFAILED
+	req.ProofRequestTime = 0
+	record = proofMetadataRecord(req)
+	require.Zero(t, record.ProvingSeconds)
+	require.Equal(t, int64(200), record.TotalSeconds)
+}
@@ -0,0 +1,148 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultAsyncQueueSize is the number of metric updates that can be buffered before updates are dropped.
+const DefaultAsyncQueueSize = 1024
+
+// AsyncMetrics wraps an OPSuccinctMetricer so that the metric updates recorded from the driver loop are applied on a
+// background goroutine. Recording never blocks: if the buffer is full, the update is dropped and counted instead. All
+// other methods are passed through to the wrapped metricer.
+type AsyncMetrics struct {
+	OPSuccinctMetricer
+
+	queue     chan func()
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+var _ OPSuccinctMetricer = (*AsyncMetrics)(nil)
+
+// NewAsyncMetrics starts applying the updates recorded on the returned metricer to m, buffering up to queueSize
+// updates. Close stops the background goroutine.
+func NewAsyncMetrics(m OPSuccinctMetricer, queueSize int) *AsyncMetrics {
+	a := &AsyncMetrics{
+		OPSuccinctMetricer: m,
+		queue:              make(chan func(), queueSize),
+		done:               make(chan struct{}),
+	}
+	go a.loop()
+	return a
+}
+
+func (a *AsyncMetrics) loop() {
+	defer close(a.done)
+	for update := range a.queue {
+		start := time.Now()
+		update()
+		a.OPSuccinctMetricer.RecordInstrumentationOverhead(time.Since(start))
+	}
+}
+
+// enqueue buffers the update, or drops it if the buffer is full.
+func (a *AsyncMetrics) enqueue(update func()) {
+	select {
+	case a.queue <- update:
+	default:
+		a.OPSuccinctMetricer.RecordMetricsDropped()
+	}
+}
+
+// Close applies the buffered updates, and stops the background goroutine. No updates may be recorded after Close.
+func (a *AsyncMetrics) Close() {
+	a.closeOnce.Do(func() {
+		close(a.queue)
+	})
+	<-a.done
+}
+
+// Registry returns the registry of the wrapped metricer, so that the metrics server can serve it. Returns nil if the
+// wrapped metricer doesn't have a registry.
+func (a *AsyncMetrics) Registry() *prometheus.Registry {
+	if m, ok := a.OPSuccinctMetricer.(*OPSuccinctMetrics); ok {
+		return m.Registry()
+	}
+	return nil
+}
+
+func (a *AsyncMetrics) RecordProposerStatus(metrics ProposerMetrics) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordProposerStatus(metrics) })
+}
+
+func (a *AsyncMetrics) RecordError(label string, num uint64) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordError(label, num) })
+}
+
+func (a *AsyncMetrics) RecordProveFailure(reason string, rangeSize uint64, traceID string) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordProveFailure(reason, rangeSize, traceID) })
+}
+
+func (a *AsyncMetrics) RecordWitnessGenFailure(reason string, rangeSize uint64, traceID string) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordWitnessGenFailure(reason, rangeSize, traceID) })
+}
+
+func (a *AsyncMetrics) RecordWitnessGenDuration(proofType string, rangeSize uint64, d time.Duration) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordWitnessGenDuration(proofType, rangeSize, d) })
+}
+
+func (a *AsyncMetrics) RecordProvingDuration(proofType string, rangeSize uint64, d time.Duration, traceID string) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordProvingDuration(proofType, rangeSize, d, traceID) })
+}
+
+func (a *AsyncMetrics) RecordProofLatency(proofType string, rangeSize uint64, d time.Duration, traceID string) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordProofLatency(proofType, rangeSize, d, traceID) })
+}
+
+func (a *AsyncMetrics) RecordAggAssemblyDuration(rangeSize uint64, d time.Duration) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordAggAssemblyDuration(rangeSize, d) })
+}
+
+func (a *AsyncMetrics) RecordProofCost(proofType string, rangeSize uint64, cycles uint64, fee float64) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordProofCost(proofType, rangeSize, cycles, fee) })
+}
+
+func (a *AsyncMetrics) RecordCostBudget(spent, budget float64) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordCostBudget(spent, budget) })
+}
+
+func (a *AsyncMetrics) RecordL1BaseFee(gwei float64) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordL1BaseFee(gwei) })
+}
+
+func (a *AsyncMetrics) RecordWitnessGenLimit(limit uint64) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordWitnessGenLimit(limit) })
+}
+
+func (a *AsyncMetrics) RecordConfigHash(hash string) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordConfigHash(hash) })
+}
+
+func (a *AsyncMetrics) RecordDowntime(cause string, d time.Duration) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordDowntime(cause, d) })
+}
+
+func (a *AsyncMetrics) RecordCatchingUp(cause string, catchingUp bool) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordCatchingUp(cause, catchingUp) })
+}
+
+func (a *AsyncMetrics) RecordWitnessGenProbe(server string, d time.Duration, healthy bool) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordWitnessGenProbe(server, d, healthy) })
+}
+
+func (a *AsyncMetrics) RecordLeader(leader bool) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordLeader(leader) })
+}
+
+func (a *AsyncMetrics) RecordProofTimeRemaining(remaining map[string]uint64) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordProofTimeRemaining(remaining) })
+}
+
+func (a *AsyncMetrics) RecordL2BlocksProposed(l2ref eth.L2BlockRef) {
+	a.enqueue(func() { a.OPSuccinctMetricer.RecordL2BlocksProposed(l2ref) })
+}
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// blockingMetrics blocks every error update until unblock is closed, like a stalled metrics sink.
+type blockingMetrics struct {
+	noopMetrics
+	unblock chan struct{}
+	errors  atomic.Uint64
+	dropped atomic.Uint64
+}
+
+func (m *blockingMetrics) RecordError(label string, num uint64) {
+	<-m.unblock
+	m.errors.Add(num)
+}
+
+func (m *blockingMetrics) RecordMetricsDropped() {
+	m.dropped.Add(1)
+}
+
+func TestAsyncMetricsNeverBlocks(t *testing.T) {
+	m := &blockingMetrics{unblock: make(chan struct{})}
+	a := NewAsyncMetrics(m, 2)
+
+	// One update is taken by the stalled background goroutine, and two are buffered. The rest are dropped without
+	// blocking the caller.
+	for i := 0; i < 10; i++ {
+		a.RecordError("test", 1)
+	}
+	close(m.unblock)
+	a.Close()
+
+	require.Equal(t, uint64(10), m.errors.Load()+m.dropped.Load())
+	require.GreaterOrEqual(t, m.dropped.Load(), uint64(7))
+}
@@ -2,20 +2,29 @@ package metrics
 
 import (
 	"io"
+	"net"
+	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/log"
 
 	opproposermetrics "github.com/ethereum-optimism/optimism/op-proposer/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	txmetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 const Namespace = "op_succinct_proposer"
 
+// TraceIDLabel is the label of the exemplars that link an observation to the trace of the proof request it was
+// recorded for.
+const TraceIDLabel = "trace_id"
+
 // implements the Registry getter, for metrics HTTP server to hook into
 var _ opmetrics.RegistryMetricer = (*OPSuccinctMetrics)(nil)
 
@@ -24,8 +33,24 @@ type OPSuccinctMetricer interface {
 
 	RecordProposerStatus(metrics ProposerMetrics)
 	RecordError(label string, num uint64)
-	RecordProveFailure(reason string)
-	RecordWitnessGenFailure(reason string)
+	RecordProveFailure(reason string, rangeSize uint64, traceID string)
+	RecordWitnessGenFailure(reason string, rangeSize uint64, traceID string)
+	RecordWitnessGenDuration(proofType string, rangeSize uint64, d time.Duration)
+	RecordProvingDuration(proofType string, rangeSize uint64, d time.Duration, traceID string)
+	RecordProofLatency(proofType string, rangeSize uint64, d time.Duration, traceID string)
+	RecordAggAssemblyDuration(rangeSize uint64, d time.Duration)
+	RecordProofCost(proofType string, rangeSize uint64, cycles uint64, fee float64)
+	RecordCostBudget(spent, budget float64)
+	RecordL1BaseFee(gwei float64)
+	RecordWitnessGenLimit(limit uint64)
+	RecordProofTimeRemaining(remaining map[string]uint64)
+	RecordMetricsDropped()
+	RecordInstrumentationOverhead(d time.Duration)
+	RecordConfigHash(hash string)
+	RecordDowntime(cause string, d time.Duration)
+	RecordCatchingUp(cause string, catchingUp bool)
+	RecordWitnessGenProbe(server string, d time.Duration, healthy bool)
+	RecordLeader(leader bool)
 }
 
 type OPSuccinctMetrics struct {
@@ -40,18 +65,42 @@ type OPSuccinctMetrics struct {
 	info prometheus.GaugeVec
 	up   prometheus.Gauge
 
-	NumProving     prometheus.Gauge
-	NumWitnessGen  prometheus.Gauge
-	NumUnrequested prometheus.Gauge
+	NumProving      prometheus.Gauge
+	NumWitnessGen   prometheus.Gauge
+	NumUnrequested  prometheus.Gauge
+	WitnessGenLimit prometheus.Gauge
 
 	L2FinalizedBlock               prometheus.Gauge
 	LatestContractL2Block          prometheus.Gauge
 	HighestProvenContiguousL2Block prometheus.Gauge
 	MinBlockToProveToAgg           prometheus.Gauge
 
+	CostBudgetSpent prometheus.Gauge
+	CostBudget      prometheus.Gauge
+	L1BaseFee       prometheus.Gauge
+	Leader          prometheus.Gauge
+
+	ProofTimeRemaining *prometheus.GaugeVec
+	ConfigInfo         *prometheus.GaugeVec
+	CatchingUp         *prometheus.GaugeVec
+	WitnessGenHealthy  *prometheus.GaugeVec
+
 	ErrorCount         *prometheus.CounterVec
 	ProveFailures      *prometheus.CounterVec
 	WitnessGenFailures *prometheus.CounterVec
+	ProofCycles        *prometheus.CounterVec
+	ProofFee           *prometheus.CounterVec
+	DowntimeSeconds    *prometheus.CounterVec
+
+	WitnessGenDuration  *prometheus.HistogramVec
+	ProvingDuration     *prometheus.HistogramVec
+	ProofLatency        *prometheus.HistogramVec
+	AggAssemblyDuration *prometheus.HistogramVec
+	ProofCyclesPerBlock *prometheus.HistogramVec
+	WitnessGenProbe     *prometheus.HistogramVec
+
+	MetricsDropped         prometheus.Counter
+	InstrumentationSeconds prometheus.Histogram
 }
 
 var _ OPSuccinctMetricer = (*OPSuccinctMetrics)(nil)
@@ -101,6 +150,11 @@ func NewMetrics(procName string) *OPSuccinctMetrics {
 			Name:      "num_unrequested",
 			Help:      "Number of unrequested proofs",
 		}),
+		WitnessGenLimit: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "witness_gen_limit",
+			Help:      "Effective max number of concurrent witness generation requests, lowered when the server is overloaded",
+		}),
 		L2FinalizedBlock: factory.NewGauge(prometheus.GaugeOpts{
 			Namespace: ns,
 			Name:      "l2_finalized_block",
@@ -121,6 +175,46 @@ func NewMetrics(procName string) *OPSuccinctMetrics {
 			Name:      "min_block_to_prove_to_agg",
 			Help:      "Minimum L2 block number to prove to generate an AGG proof",
 		}),
+		CostBudgetSpent: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "cost_budget_spent",
+			Help:      "PROVE spent on the proofs fulfilled within the cost budget period",
+		}),
+		CostBudget: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "cost_budget",
+			Help:      "PROVE that can be spent per cost budget period",
+		}),
+		L1BaseFee: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "l1_base_fee_gwei",
+			Help:      "L1 base fee in gwei when a completed AGG proof was last about to be proposed",
+		}),
+		Leader: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "leader",
+			Help:      "1 while this instance is the elected leader of the instances sharing the DB, 0 while it stands by",
+		}),
+		ProofTimeRemaining: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "proof_time_remaining_seconds",
+			Help:      "Time in seconds until the PROVING request of each type that is closest to its timeout times out",
+		}, []string{"type"}),
+		ConfigInfo: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "config_info",
+			Help:      "Pseudo-metric labelled with the hash of the effective configuration",
+		}, []string{"hash"}),
+		CatchingUp: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "catching_up",
+			Help:      "1 while the proposer is catching up on the backlog of a downtime, by the downtime's cause",
+		}, []string{"cause"}),
+		WitnessGenHealthy: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "witness_gen_healthy",
+			Help:      "1 if the server passed its latest health probe, 0 if it failed it, by server",
+		}, []string{"server"}),
 		ErrorCount: factory.NewCounterVec(prometheus.CounterOpts{
 			Namespace: ns,
 			Name:      "error_count",
@@ -130,12 +224,74 @@ func NewMetrics(procName string) *OPSuccinctMetrics {
 			Namespace: ns,
 			Name:      "prove_failures",
 			Help:      "Number of prove failures by type",
-		}, []string{"reason"}),
+		}, []string{"reason", "range_size"}),
 		WitnessGenFailures: factory.NewCounterVec(prometheus.CounterOpts{
 			Namespace: ns,
 			Name:      "witness_gen_failures",
 			Help:      "Number of witness generation failures by type",
-		}, []string{"reason"}),
+		}, []string{"reason", "range_size"}),
+		ProofCycles: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "proof_cycles",
+			Help:      "Number of SP1 cycles of the fulfilled proofs by type",
+		}, []string{"type"}),
+		ProofFee: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "proof_fee",
+			Help:      "Fees paid to the prover network for the fulfilled proofs by type, in PROVE",
+		}, []string{"type"}),
+		DowntimeSeconds: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "downtime_seconds",
+			Help:      "Time no proposer was running, by the cause of the downtime",
+		}, []string{"cause"}),
+		WitnessGenProbe: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "witness_gen_probe_duration_seconds",
+			Help:      "Time the server took to execute the health probe range, by server",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		}, []string{"server"}),
+		WitnessGenDuration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "witness_gen_duration_seconds",
+			Help:      "Time from requesting a proof from the server until the server returned its prover network ID",
+			Buckets:   prometheus.ExponentialBuckets(10, 2, 10),
+		}, []string{"type", "range_size"}),
+		ProvingDuration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "proving_duration_seconds",
+			Help:      "Time from a proof being requested from the prover network until it was fulfilled",
+			Buckets:   prometheus.ExponentialBuckets(60, 2, 10),
+		}, []string{"type", "range_size"}),
+		ProofLatency: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "proof_latency_seconds",
+			Help:      "Time from a proof request being added until its proof was fulfilled, including witness generation and queueing",
+			Buckets:   prometheus.ExponentialBuckets(60, 2, 12),
+		}, []string{"type", "range_size"}),
+		AggAssemblyDuration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "agg_assembly_duration_seconds",
+			Help:      "Time from the last span proof of an AGG proof being fulfilled until the AGG proof was fulfilled",
+			Buckets:   prometheus.ExponentialBuckets(60, 2, 10),
+		}, []string{"range_size"}),
+		ProofCyclesPerBlock: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "proof_cycles_per_block",
+			Help:      "SP1 cycles per L2 block of the fulfilled proofs",
+			Buckets:   prometheus.ExponentialBuckets(1e6, 2, 14),
+		}, []string{"type"}),
+		MetricsDropped: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "metrics_dropped",
+			Help:      "Number of metric updates dropped because the metrics buffer was full",
+		}),
+		InstrumentationSeconds: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "instrumentation_seconds",
+			Help:      "Time spent applying each buffered metric update",
+			Buckets:   prometheus.ExponentialBuckets(1e-6, 4, 10),
+		}),
 	}
 }
 
@@ -143,6 +299,14 @@ func (m *OPSuccinctMetrics) Registry() *prometheus.Registry {
 	return m.registry
 }
 
+// StartServer serves the metrics of the registry like opmetrics.StartServer, but in the OpenMetrics format to scrapers
+// that accept it, since it's the only format that carries exemplars.
+func StartServer(r *prometheus.Registry, hostname string, port int) (*httputil.HTTPServer, error) {
+	addr := net.JoinHostPort(hostname, strconv.Itoa(port))
+	h := promhttp.InstrumentMetricHandler(r, promhttp.HandlerFor(r, promhttp.HandlerOpts{EnableOpenMetrics: true}))
+	return httputil.StartHTTPServer(addr, h)
+}
+
 func (m *OPSuccinctMetrics) StartBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address) io.Closer {
 	return opmetrics.LaunchBalanceMetrics(l, m.registry, m.ns, client, account)
 }
@@ -177,14 +341,152 @@ func (m *OPSuccinctMetrics) RecordError(errorType string, num uint64) {
 	m.ErrorCount.WithLabelValues(errorType).Add(float64(num))
 }
 
-// RecordProveFailure records specific prove failure types
-func (m *OPSuccinctMetrics) RecordProveFailure(reason string) {
-	m.ProveFailures.WithLabelValues(reason).Inc()
+// RangeSizeBucket returns the label of the bucket that a proof range of the given number of blocks falls into, so that
+// dashboards can compare durations and failures of ranges of similar size.
+func RangeSizeBucket(rangeSize uint64) string {
+	switch {
+	case rangeSize <= 10:
+		return "1-10"
+	case rangeSize <= 50:
+		return "11-50"
+	case rangeSize <= 200:
+		return "51-200"
+	default:
+		return "200+"
+	}
+}
+
+// incWithTraceID increments the counter, with the trace ID as an exemplar if it's set.
+func incWithTraceID(c prometheus.Counter, traceID string) {
+	if e, ok := c.(prometheus.ExemplarAdder); ok && traceID != "" {
+		e.AddWithExemplar(1, prometheus.Labels{TraceIDLabel: traceID})
+		return
+	}
+	c.Inc()
+}
+
+// observeWithTraceID observes the value, with the trace ID as an exemplar if it's set.
+func observeWithTraceID(o prometheus.Observer, v float64, traceID string) {
+	if e, ok := o.(prometheus.ExemplarObserver); ok && traceID != "" {
+		e.ObserveWithExemplar(v, prometheus.Labels{TraceIDLabel: traceID})
+		return
+	}
+	o.Observe(v)
+}
+
+// RecordProveFailure records specific prove failure types, linked to the trace of the failed proof request
+func (m *OPSuccinctMetrics) RecordProveFailure(reason string, rangeSize uint64, traceID string) {
+	incWithTraceID(m.ProveFailures.WithLabelValues(reason, RangeSizeBucket(rangeSize)), traceID)
+}
+
+// RecordWitnessGenFailure records specific witness generation failure types, linked to the trace of the failed proof
+// request
+func (m *OPSuccinctMetrics) RecordWitnessGenFailure(reason string, rangeSize uint64, traceID string) {
+	incWithTraceID(m.WitnessGenFailures.WithLabelValues(reason, RangeSizeBucket(rangeSize)), traceID)
+}
+
+// RecordWitnessGenDuration records the time witness generation took for a proof request
+func (m *OPSuccinctMetrics) RecordWitnessGenDuration(proofType string, rangeSize uint64, d time.Duration) {
+	m.WitnessGenDuration.WithLabelValues(proofType, RangeSizeBucket(rangeSize)).Observe(d.Seconds())
+}
+
+// RecordProvingDuration records the time the prover network took to fulfill a proof request, linked to its trace
+func (m *OPSuccinctMetrics) RecordProvingDuration(proofType string, rangeSize uint64, d time.Duration, traceID string) {
+	observeWithTraceID(m.ProvingDuration.WithLabelValues(proofType, RangeSizeBucket(rangeSize)), d.Seconds(), traceID)
+}
+
+// RecordProofLatency records the end-to-end time it took to fulfill a proof request, from it being added, linked to
+// its trace
+func (m *OPSuccinctMetrics) RecordProofLatency(proofType string, rangeSize uint64, d time.Duration, traceID string) {
+	observeWithTraceID(m.ProofLatency.WithLabelValues(proofType, RangeSizeBucket(rangeSize)), d.Seconds(), traceID)
+}
+
+// RecordAggAssemblyDuration records the time it took to fulfill an AGG proof once its span proofs were fulfilled
+func (m *OPSuccinctMetrics) RecordAggAssemblyDuration(rangeSize uint64, d time.Duration) {
+	m.AggAssemblyDuration.WithLabelValues(RangeSizeBucket(rangeSize)).Observe(d.Seconds())
+}
+
+// RecordProofCost records the SP1 cycles of a fulfilled proof and the fee paid for it, in PROVE
+func (m *OPSuccinctMetrics) RecordProofCost(proofType string, rangeSize uint64, cycles uint64, fee float64) {
+	m.ProofCycles.WithLabelValues(proofType).Add(float64(cycles))
+	m.ProofFee.WithLabelValues(proofType).Add(fee)
+	if cycles > 0 && rangeSize > 0 {
+		m.ProofCyclesPerBlock.WithLabelValues(proofType).Observe(float64(cycles) / float64(rangeSize))
+	}
+}
+
+// RecordCostBudget records the PROVE spent within the cost budget period, and the budget
+func (m *OPSuccinctMetrics) RecordCostBudget(spent, budget float64) {
+	m.CostBudgetSpent.Set(spent)
+	m.CostBudget.Set(budget)
+}
+
+// RecordL1BaseFee records the L1 base fee in gwei that a proposal was checked against
+func (m *OPSuccinctMetrics) RecordL1BaseFee(gwei float64) {
+	m.L1BaseFee.Set(gwei)
+}
+
+// RecordWitnessGenLimit records the effective witness generation concurrency limit
+func (m *OPSuccinctMetrics) RecordWitnessGenLimit(limit uint64) {
+	m.WitnessGenLimit.Set(float64(limit))
+}
+
+// RecordProofTimeRemaining records the time remaining before the next timeout of each proof type. Proof types with no
+// PROVING requests are cleared.
+func (m *OPSuccinctMetrics) RecordProofTimeRemaining(remaining map[string]uint64) {
+	m.ProofTimeRemaining.Reset()
+	for proofType, seconds := range remaining {
+		m.ProofTimeRemaining.WithLabelValues(proofType).Set(float64(seconds))
+	}
+}
+
+// RecordMetricsDropped records a metric update that was dropped because the metrics buffer was full
+func (m *OPSuccinctMetrics) RecordMetricsDropped() {
+	m.MetricsDropped.Inc()
+}
+
+// RecordInstrumentationOverhead records the time spent applying a buffered metric update
+func (m *OPSuccinctMetrics) RecordInstrumentationOverhead(d time.Duration) {
+	m.InstrumentationSeconds.Observe(d.Seconds())
+}
+
+// RecordDowntime records a window of the given length in which no proposer was running
+func (m *OPSuccinctMetrics) RecordDowntime(cause string, d time.Duration) {
+	m.DowntimeSeconds.WithLabelValues(cause).Add(d.Seconds())
+}
+
+// RecordCatchingUp records whether the proposer is catching up on the backlog of a downtime with the given cause
+func (m *OPSuccinctMetrics) RecordCatchingUp(cause string, catchingUp bool) {
+	if catchingUp {
+		m.CatchingUp.WithLabelValues(cause).Set(1)
+	} else {
+		m.CatchingUp.WithLabelValues(cause).Set(0)
+	}
+}
+
+// RecordWitnessGenProbe records the result of a health probe of the server, and how long it took if it passed
+func (m *OPSuccinctMetrics) RecordWitnessGenProbe(server string, d time.Duration, healthy bool) {
+	if healthy {
+		m.WitnessGenProbe.WithLabelValues(server).Observe(d.Seconds())
+		m.WitnessGenHealthy.WithLabelValues(server).Set(1)
+	} else {
+		m.WitnessGenHealthy.WithLabelValues(server).Set(0)
+	}
+}
+
+// RecordLeader records whether this instance is the elected leader
+func (m *OPSuccinctMetrics) RecordLeader(leader bool) {
+	if leader {
+		m.Leader.Set(1)
+	} else {
+		m.Leader.Set(0)
+	}
 }
 
-// RecordWitnessGenFailure records specific witness generation failure types
-func (m *OPSuccinctMetrics) RecordWitnessGenFailure(reason string) {
-	m.WitnessGenFailures.WithLabelValues(reason).Inc()
+// RecordConfigHash records the hash of the effective configuration, replacing the previous one.
+func (m *OPSuccinctMetrics) RecordConfigHash(hash string) {
+	m.ConfigInfo.Reset()
+	m.ConfigInfo.WithLabelValues(hash).Set(1)
 }
 
 // RecordProposerStatus sets the proposer Prometheus metrics to the given values.
@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeSizeBucket(t *testing.T) {
+	require.Equal(t, "1-10", RangeSizeBucket(1))
+	require.Equal(t, "1-10", RangeSizeBucket(10))
+	require.Equal(t, "11-50", RangeSizeBucket(11))
+	require.Equal(t, "51-200", RangeSizeBucket(200))
+	require.Equal(t, "200+", RangeSizeBucket(201))
+}
+
+func TestTraceIDExemplars(t *testing.T) {
+	m := NewMetrics("test")
+	m.RecordProvingDuration("SPAN", 20, 90*time.Second, "0af7651916cd43dd8448eb211c80319c")
+	m.RecordProveFailure("timeout", 20, "0af7651916cd43dd8448eb211c80319c")
+	m.RecordProveFailure("timeout", 20, "")
+
+	families, err := m.Registry().Gather()
+	require.NoError(t, err)
+	exemplars := map[string]string{}
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			if c := metric.GetCounter(); c != nil && c.GetExemplar() != nil {
+				exemplars[family.GetName()] = c.GetExemplar().GetLabel()[0].GetValue()
+			}
+			for _, b := range metric.GetHistogram().GetBucket() {
+				if b.GetExemplar() != nil {
+					exemplars[family.GetName()] = b.GetExemplar().GetLabel()[0].GetValue()
+				}
+			}
+		}
+	}
+	require.Equal(t, map[string]string{
+		"op_succinct_proposer_test_proving_duration_seconds": "0af7651916cd43dd8448eb211c80319c",
+		"op_succinct_proposer_test_prove_failures":           "0af7651916cd43dd8448eb211c80319c",
+	}, exemplars)
+}
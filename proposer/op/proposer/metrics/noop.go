@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"io"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -20,13 +21,33 @@ type noopMetrics struct {
 
 var NoopMetrics OPSuccinctMetricer = new(noopMetrics)
 
-func (*noopMetrics) RecordProposerStatus(metrics ProposerMetrics) {}
-func (*noopMetrics) RecordError(label string, num uint64)         {}
-func (*noopMetrics) RecordProveFailure(reason string)             {}
-func (*noopMetrics) RecordWitnessGenFailure(reason string)        {}
+func (*noopMetrics) RecordProposerStatus(metrics ProposerMetrics)                                 {}
+func (*noopMetrics) RecordError(label string, num uint64)                                         {}
+func (*noopMetrics) RecordProveFailure(reason string, rangeSize uint64, traceID string)           {}
+func (*noopMetrics) RecordWitnessGenFailure(reason string, rangeSize uint64, traceID string)      {}
+func (*noopMetrics) RecordWitnessGenDuration(proofType string, rangeSize uint64, d time.Duration) {}
+func (*noopMetrics) RecordProvingDuration(proofType string, rangeSize uint64, d time.Duration, traceID string) {
+}
+func (*noopMetrics) RecordProofLatency(proofType string, rangeSize uint64, d time.Duration, traceID string) {
+}
+func (*noopMetrics) RecordProofCost(proofType string, rangeSize uint64, cycles uint64, fee float64) {
+}
+func (*noopMetrics) RecordWitnessGenProbe(server string, d time.Duration, healthy bool) {
+}
+func (*noopMetrics) RecordAggAssemblyDuration(rangeSize uint64, d time.Duration) {}
+func (*noopMetrics) RecordCostBudget(spent, budget float64)                      {}
+func (*noopMetrics) RecordL1BaseFee(gwei float64)                                {}
+func (*noopMetrics) RecordWitnessGenLimit(limit uint64)                          {}
+func (*noopMetrics) RecordProofTimeRemaining(remaining map[string]uint64)        {}
+func (*noopMetrics) RecordMetricsDropped()                                       {}
+func (*noopMetrics) RecordInstrumentationOverhead(d time.Duration)               {}
+func (*noopMetrics) RecordConfigHash(hash string)                                {}
+func (*noopMetrics) RecordDowntime(cause string, d time.Duration)                {}
+func (*noopMetrics) RecordCatchingUp(cause string, catchingUp bool)              {}
 
 func (*noopMetrics) RecordInfo(version string) {}
 func (*noopMetrics) RecordUp()                 {}
+func (*noopMetrics) RecordLeader(leader bool)  {}
 
 func (*noopMetrics) RecordL2BlocksProposed(l2ref eth.L2BlockRef) {}
 
@@ -0,0 +1,288 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+)
+
+// proofStatusMethod is the gRPC method of the SP1 prover network that gets the status of a proof request.
+const proofStatusMethod = "/network.ProverNetwork/GetProofRequestStatus"
+
+// PROOF_DOWNLOAD_TIMEOUT is the timeout of downloading a proof from the prover network.
+const PROOF_DOWNLOAD_TIMEOUT = 5 * time.Minute
+
+// The variants of the SP1Proof enum of sp1-sdk, which prefix the bincode encoding of a proof.
+const (
+	sp1ProofCore uint32 = iota
+	sp1ProofCompressed
+	sp1ProofPlonk
+	sp1ProofGroth16
+)
+
+// networkProofStatus is the status of a proof request on the SP1 prover network.
+type networkProofStatus struct {
+	FulfillmentStatus SP1FulfillmentStatus
+	ExecutionStatus   SP1ExecutionStatus
+	// Deadline is the Unix time after which the request won't be fulfilled anymore.
+	Deadline uint64
+	// FulfillTxHash and ProofURI are only set once the request is fulfilled.
+	FulfillTxHash []byte
+	ProofURI      string
+}
+
+// networkClient gets the statuses and proofs of proof requests from the SP1 prover network directly, instead of through
+// the OP Succinct server. It only makes unary gRPC calls, which are plain HTTP/2 requests, so it doesn't depend on a
+// gRPC library.
+type networkClient struct {
+	rpcUrl string
+	client *http.Client
+}
+
+func newNetworkClient(rpcUrl string) *networkClient {
+	return &networkClient{
+		rpcUrl: strings.TrimSuffix(rpcUrl, "/"),
+		client: &http.Client{Timeout: PROOF_STATUS_TIMEOUT},
+	}
+}
+
+// proofStatus gets the status of the proof request with the given hex-encoded ID.
+func (c *networkClient) proofStatus(ctx context.Context, proofID string) (networkProofStatus, error) {
+	id, err := hex.DecodeString(strings.TrimPrefix(proofID, "0x"))
+	if err != nil {
+		return networkProofStatus{}, fmt.Errorf("invalid proof ID %s: %w", proofID, err)
+	}
+	msg := protowire.AppendTag(nil, 1, protowire.BytesType)
+	msg = protowire.AppendBytes(msg, id)
+
+	resp, err := c.call(ctx, proofStatusMethod, msg)
+	if err != nil {
+		return networkProofStatus{}, err
+	}
+	return decodeNetworkProofStatus(resp)
+}
+
+// call makes a unary gRPC call, and returns the response message.
+func (c *networkClient) call(ctx context.Context, method string, msg []byte) ([]byte, error) {
+	// A gRPC message is prefixed with an uncompressed flag and its length.
+	body := make([]byte, 5, 5+len(msg))
+	binary.BigEndian.PutUint32(body[1:], uint32(len(msg)))
+	body = append(body, msg...)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.rpcUrl+method, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/grpc")
+	req.Header.Set("TE", "trailers")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the response of %s: %v", method, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s failed with HTTP status %d", method, resp.StatusCode)
+	}
+
+	// Calls that fail without a response only have headers, and the status of other calls is in the trailers.
+	status, message := resp.Trailer.Get("Grpc-Status"), resp.Trailer.Get("Grpc-Message")
+	if status == "" {
+		status, message = resp.Header.Get("Grpc-Status"), resp.Header.Get("Grpc-Message")
+	}
+	if status != "0" {
+		if unescaped, err := url.PathUnescape(message); err == nil {
+			message = unescaped
+		}
+		return nil, fmt.Errorf("%s failed with gRPC status %s: %s", method, status, message)
+	}
+
+	if len(respBody) < 5 || respBody[0] != 0 {
+		return nil, fmt.Errorf("invalid response of %s", method)
+	}
+	length := binary.BigEndian.Uint32(respBody[1:5])
+	if uint32(len(respBody)-5) < length {
+		return nil, fmt.Errorf("truncated response of %s", method)
+	}
+	return respBody[5 : 5+length], nil
+}
+
+// decodeNetworkProofStatus decodes a GetProofRequestStatusResponse message.
+func decodeNetworkProofStatus(b []byte) (networkProofStatus, error) {
+	var status networkProofStatus
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return networkProofStatus{}, protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			status.FulfillmentStatus = SP1FulfillmentStatus(v)
+		case num == 2 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			status.ExecutionStatus = SP1ExecutionStatus(v)
+		case num == 4 && typ == protowire.VarintType:
+			status.Deadline, n = protowire.ConsumeVarint(b)
+		case num == 5 && typ == protowire.BytesType:
+			status.FulfillTxHash, n = protowire.ConsumeBytes(b)
+		case num == 6 && typ == protowire.BytesType:
+			status.ProofURI, n = protowire.ConsumeString(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return networkProofStatus{}, protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return status, nil
+}
+
+// downloadProof downloads the proof of a fulfilled request from its proof URI.
+func (c *networkClient) downloadProof(ctx context.Context, uri string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	client := &http.Client{Transport: c.client.Transport, Timeout: PROOF_DOWNLOAD_TIMEOUT}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download proof: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download proof: HTTP status %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// onchainProofBytes converts a proof downloaded from the prover network, which is a bincode-encoded
+// SP1ProofWithPublicValues, to the bytes the OP Succinct server returns for it: compressed span proofs are returned
+// as they are, and PLONK and Groth16 AGG proofs as the bytes that are verified onchain.
+func onchainProofBytes(artifact []byte) ([]byte, error) {
+	if len(artifact) < 4 {
+		return nil, errors.New("proof is too short")
+	}
+	switch variant := binary.LittleEndian.Uint32(artifact); variant {
+	case sp1ProofCompressed:
+		return artifact, nil
+	case sp1ProofPlonk, sp1ProofGroth16:
+		// The proof is the public inputs, the encoded proof, the raw proof and the hash of the verifying key.
+		r := bincodeReader{b: artifact[4:]}
+		r.string()
+		r.string()
+		encoded := r.string()
+		r.string()
+		vkeyHash := r.bytes(32)
+		if r.err != nil {
+			return nil, fmt.Errorf("invalid proof: %w", r.err)
+		}
+		proof, err := hex.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid encoded proof: %w", err)
+		}
+		return append(vkeyHash[:4:4], proof...), nil
+	default:
+		return nil, fmt.Errorf("unsupported proof variant %d", variant)
+	}
+}
+
+// bincodeReader reads the bincode encoding of Rust values. The first error is kept, and later reads return zero values.
+type bincodeReader struct {
+	b   []byte
+	err error
+}
+
+func (r *bincodeReader) bytes(n uint64) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if uint64(len(r.b)) < n {
+		r.err = io.ErrUnexpectedEOF
+		return nil
+	}
+	v := r.b[:n]
+	r.b = r.b[n:]
+	return v
+}
+
+// string reads a string, which is prefixed with its length as a little-endian uint64.
+func (r *bincodeReader) string() string {
+	length := r.bytes(8)
+	if length == nil {
+		return ""
+	}
+	return string(r.bytes(binary.LittleEndian.Uint64(length)))
+}
+
+// getNetworkProofStatus gets the status of a proof request from the prover network, with its proof if it's fulfilled,
+// the way the OP Succinct server would return it.
+func (l *L2OutputSubmitter) getNetworkProofStatus(ctx context.Context, req *ent.ProofRequest) (ProofStatusResponse, error) {
+	status, err := l.network.proofStatus(ctx, req.ProverRequestID)
+	if err != nil {
+		return ProofStatusResponse{}, err
+	}
+	l.Log.Debug("Proof status from the prover network", "id", req.ProverRequestID, "status", status.FulfillmentStatus, "deadline", time.Unix(int64(status.Deadline), 0))
+
+	// Like the server, treat requests past their deadline as unfulfillable.
+	if status.FulfillmentStatus != SP1FulfillmentStatusFulfilled && status.Deadline != 0 && status.Deadline < uint64(time.Now().Unix()) {
+		l.Log.Error("Proof request is past its deadline on the prover network", "id", req.ProverRequestID, "deadline", time.Unix(int64(status.Deadline), 0))
+		return ProofStatusResponse{FulfillmentStatus: SP1FulfillmentStatusUnfulfillable, ExecutionStatus: SP1ExecutionStatusExecuted}, nil
+	}
+	if status.FulfillmentStatus != SP1FulfillmentStatusFulfilled {
+		return ProofStatusResponse{FulfillmentStatus: status.FulfillmentStatus, ExecutionStatus: status.ExecutionStatus}, nil
+	}
+
+	if status.ProofURI == "" {
+		return ProofStatusResponse{}, errors.New("fulfilled proof request has no proof URI")
+	}
+	artifact, err := l.network.downloadProof(ctx, status.ProofURI)
+	if err != nil {
+		return ProofStatusResponse{}, err
+	}
+	proof, err := onchainProofBytes(artifact)
+	if err != nil {
+		return ProofStatusResponse{}, err
+	}
+	l.Log.Info("Proof fulfilled on the prover network", "id", req.ProverRequestID, "fulfillTx", hex.EncodeToString(status.FulfillTxHash))
+	return ProofStatusResponse{FulfillmentStatus: status.FulfillmentStatus, ExecutionStatus: status.ExecutionStatus, Proof: proof}, nil
+}
+
+// pollNetworkProofStatuses polls the statuses of the given proof requests from the prover network, and returns them in
+// the same order along with the error of each poll.
+func (l *L2OutputSubmitter) pollNetworkProofStatuses(ctx context.Context, reqs []*ent.ProofRequest) ([]ProofStatusResponse, []error) {
+	statuses := make([]ProofStatusResponse, len(reqs))
+	pollErrs := make([]error, len(reqs))
+	var g errgroup.Group
+	g.SetLimit(statusPollConcurrency)
+	for i, req := range reqs {
+		g.Go(func() error {
+			statuses[i], pollErrs[i] = l.getNetworkProofStatus(ctx, req)
+			return nil
+		})
+	}
+	g.Wait()
+	return statuses, pollErrs
+}
@@ -0,0 +1,126 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+// bincodeGroth16Proof encodes a Groth16 SP1ProofWithPublicValues the way the prover network stores it, up to the hash
+// of the verifying key.
+func bincodeGroth16Proof(encodedProof string, vkeyHash [32]byte) []byte {
+	b := binary.LittleEndian.AppendUint32(nil, sp1ProofGroth16)
+	for _, s := range []string{"1", "2", encodedProof, "raw"} {
+		b = binary.LittleEndian.AppendUint64(b, uint64(len(s)))
+		b = append(b, s...)
+	}
+	return append(b, vkeyHash[:]...)
+}
+
+func TestNetworkProofStatuses(t *testing.T) {
+	vkeyHash := [32]byte{0x11, 0x22, 0x33, 0x44, 0x55}
+	var server *httptest.Server
+	server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/proof" {
+			w.Write(bincodeGroth16Proof("abcd", vkeyHash))
+			return
+		}
+		require.Equal(t, proofStatusMethod, r.URL.Path)
+		require.Equal(t, "application/grpc", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		num, typ, n := protowire.ConsumeTag(body[5:])
+		require.Equal(t, protowire.Number(1), num)
+		require.Equal(t, protowire.BytesType, typ)
+		id, _ := protowire.ConsumeBytes(body[5+n:])
+
+		w.Header().Set("Content-Type", "application/grpc")
+		var msg []byte
+		switch hex.EncodeToString(id) {
+		case "01":
+			msg = protowire.AppendTag(msg, 1, protowire.VarintType)
+			msg = protowire.AppendVarint(msg, uint64(SP1FulfillmentStatusAssigned))
+			msg = protowire.AppendTag(msg, 4, protowire.VarintType)
+			msg = protowire.AppendVarint(msg, uint64(time.Now().Add(time.Hour).Unix()))
+		case "02":
+			msg = protowire.AppendTag(msg, 1, protowire.VarintType)
+			msg = protowire.AppendVarint(msg, uint64(SP1FulfillmentStatusAssigned))
+			msg = protowire.AppendTag(msg, 4, protowire.VarintType)
+			msg = protowire.AppendVarint(msg, uint64(time.Now().Add(-time.Hour).Unix()))
+		case "03":
+			msg = protowire.AppendTag(msg, 1, protowire.VarintType)
+			msg = protowire.AppendVarint(msg, uint64(SP1FulfillmentStatusFulfilled))
+			msg = protowire.AppendTag(msg, 2, protowire.VarintType)
+			msg = protowire.AppendVarint(msg, uint64(SP1ExecutionStatusExecuted))
+			msg = protowire.AppendTag(msg, 5, protowire.BytesType)
+			msg = protowire.AppendBytes(msg, []byte{0xfe})
+			msg = protowire.AppendTag(msg, 6, protowire.BytesType)
+			msg = protowire.AppendString(msg, server.URL+"/proof")
+		default:
+			// Calls that fail only respond with headers.
+			w.Header().Set("Grpc-Status", "5")
+			w.Header().Set("Grpc-Message", "request%20not%20found")
+			return
+		}
+		w.Header().Set("Trailer", "Grpc-Status")
+		frame := binary.BigEndian.AppendUint32([]byte{0}, uint32(len(msg)))
+		w.Write(append(frame, msg...))
+		w.Header().Set("Grpc-Status", "0")
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+		},
+		ctx:     context.Background(),
+		network: &networkClient{rpcUrl: server.URL, client: server.Client()},
+	}
+	reqs := []*ent.ProofRequest{{ProverRequestID: "01"}, {ProverRequestID: "02"}, {ProverRequestID: "03"}, {ProverRequestID: "04"}}
+	statuses, errs := l.pollProofStatuses(context.Background(), reqs)
+
+	require.NoError(t, errs[0])
+	require.Equal(t, SP1FulfillmentStatusAssigned, statuses[0].FulfillmentStatus)
+
+	// Requests past their deadline won't be fulfilled.
+	require.NoError(t, errs[1])
+	require.Equal(t, SP1FulfillmentStatusUnfulfillable, statuses[1].FulfillmentStatus)
+
+	// Groth16 proofs are returned as the bytes that are verified onchain.
+	require.NoError(t, errs[2])
+	require.Equal(t, SP1FulfillmentStatusFulfilled, statuses[2].FulfillmentStatus)
+	require.Equal(t, []byte{0x11, 0x22, 0x33, 0x44, 0xab, 0xcd}, statuses[2].Proof)
+
+	require.ErrorContains(t, errs[3], "gRPC status 5: request not found")
+}
+
+func TestOnchainProofBytes(t *testing.T) {
+	compressed := binary.LittleEndian.AppendUint32(nil, sp1ProofCompressed)
+	compressed = append(compressed, "span proof"...)
+	proof, err := onchainProofBytes(compressed)
+	require.NoError(t, err)
+	require.Equal(t, compressed, proof)
+
+	truncated := bincodeGroth16Proof("abcd", [32]byte{})
+	_, err = onchainProofBytes(truncated[:len(truncated)-1])
+	require.Error(t, err)
+
+	_, err = onchainProofBytes(bytes.Repeat([]byte{0}, 8))
+	require.ErrorContains(t, err, "unsupported proof variant 0")
+}
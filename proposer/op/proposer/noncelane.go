@@ -0,0 +1,105 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// NonceConflictAlert logs and counts transactions that other senders make from the proposer's account, and sends
+	// the proposer's transactions anyway.
+	NonceConflictAlert = "alert"
+	// NonceConflictWait also holds the proposer's transactions back while transactions of other senders are pending
+	// from the account, since they take the nonces the proposer's transactions would use.
+	NonceConflictWait = "wait"
+)
+
+// errForeignPendingTransactions is returned when a transaction isn't sent because transactions of other senders are
+// pending from the proposer's account.
+var errForeignPendingTransactions = errors.New("transactions of another sender are pending from the proposer's account")
+
+// nonceReader reads the nonces of an L1 account. *ethclient.Client implements it.
+type nonceReader interface {
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// nonceLane sends the proposer's L1 transactions one at a time, so the nonces of the account only advance through the
+// proposer's own transactions. Any other advance is a transaction that another sender made from the account, which can
+// take the nonce of a proposal and stall the submissions.
+type nonceLane struct {
+	mu sync.Mutex
+	// next is the nonce of the account after the proposer's last transaction was mined, if known is set. It isn't
+	// known before the first transaction, or after a transaction that the tx manager gave up on, which may still be
+	// mined later.
+	next  uint64
+	known bool
+}
+
+// conflicts describes the transactions of other senders from the account, given its mined and pending nonces. Returns
+// an empty string if there are none.
+func (n *nonceLane) conflicts(mined, pending uint64) string {
+	if n.known && mined > n.next {
+		return fmt.Sprintf("%d transactions were mined that the proposer didn't send", mined-n.next)
+	}
+	if n.known && pending > mined {
+		return fmt.Sprintf("%d transactions are pending that the proposer didn't send", pending-mined)
+	}
+	return ""
+}
+
+// sendL1Transaction sends a transaction from the proposer's account through the nonce lane, and waits for its receipt.
+// Transactions of other senders from the account are logged and counted in the nonce_conflict error metric, before and
+// after the transaction is sent. With NONCE_CONFLICT_ACTION=wait, the transaction isn't sent while any of them are
+// pending.
+func (l *L2OutputSubmitter) sendL1Transaction(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	if l.Cfg.Follower {
+		return nil, ErrFollower
+	}
+	l.nonceLane.mu.Lock()
+	defer l.nonceLane.mu.Unlock()
+
+	from := l.Txmgr.From()
+	mined, err := l.nonces.NonceAt(ctx, from, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce of the proposer's account: %w", err)
+	}
+	pending, err := l.nonces.PendingNonceAt(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending nonce of the proposer's account: %w", err)
+	}
+	if conflict := l.nonceLane.conflicts(mined, pending); conflict != "" {
+		l.Log.Error("Another sender is using the proposer's account, which can stall output submissions", "account", from, "conflict", conflict)
+		l.Metr.RecordError("nonce_conflict", 1)
+		if l.Cfg.NonceConflictAction == NonceConflictWait && pending > mined {
+			return nil, fmt.Errorf("%w: %s", errForeignPendingTransactions, conflict)
+		}
+	}
+
+	receipt, err := l.sendThroughMempool(ctx, candidate)
+	if err != nil {
+		l.nonceLane.known = false
+		return nil, err
+	}
+
+	// Any transaction mined besides the proposer's own before its block was sent by another sender.
+	after, err := l.nonces.NonceAt(ctx, from, receipt.BlockNumber)
+	if err != nil {
+		l.Log.Warn("failed to get nonce of the proposer's account after its transaction", "err", err)
+		l.nonceLane.known = false
+		return receipt, nil
+	}
+	if after > max(mined, pending)+1 {
+		l.Log.Error("Another sender is using the proposer's account, which can stall output submissions", "account", from, "conflict", fmt.Sprintf("%d transactions were mined alongside the proposer's transaction that it didn't send", after-max(mined, pending)-1))
+		l.Metr.RecordError("nonce_conflict", 1)
+	}
+	l.nonceLane.next, l.nonceLane.known = after, true
+	return receipt, nil
+}
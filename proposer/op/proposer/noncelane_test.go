@@ -0,0 +1,19 @@
+package proposer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonceLaneConflicts(t *testing.T) {
+	var lane nonceLane
+
+	// Before the proposer's first transaction, pending transactions may be its own from before a restart.
+	require.Empty(t, lane.conflicts(5, 6))
+
+	lane.next, lane.known = 6, true
+	require.Empty(t, lane.conflicts(6, 6))
+	require.Equal(t, "2 transactions were mined that the proposer didn't send", lane.conflicts(8, 8))
+	require.Equal(t, "1 transactions are pending that the proposer didn't send", lane.conflicts(6, 7))
+}
@@ -0,0 +1,133 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// proposerConfigABI is the ABI of the getConfig function of the OPSuccinctProposerConfig contract.
+const proposerConfigABI = `[{"type":"function","name":"getConfig","stateMutability":"view","inputs":[],"outputs":[
+	{"name":"maxBlockRangePerSpanProof_","type":"uint256"}
+]}]`
+
+// OnChainConfig is the proving parameters read from the OPSuccinctProposerConfig contract, and the program vkeys read
+// from the L2OO. Zero values are unset, and leave the proposer's own setting unchanged.
+type OnChainConfig struct {
+	RangeVkeyCommitment       common.Hash
+	AggregationVkey           common.Hash
+	MaxBlockRangePerSpanProof uint64
+}
+
+// newConfigContract binds the OPSuccinctProposerConfig contract at the given address.
+func newConfigContract(address common.Address, caller bind.ContractCaller) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(proposerConfigABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config contract ABI: %w", err)
+	}
+	return bind.NewBoundContract(address, parsed, caller, nil, nil), nil
+}
+
+// fetchOnChainConfig reads the proving parameters from the config contract, and the program vkeys from the L2OO. The
+// L2OO's submission interval needs no reading, since the next block number it reports already follows it.
+func (l *L2OutputSubmitter) fetchOnChainConfig(ctx context.Context) (OnChainConfig, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	var out []interface{}
+	if err := l.configContract.Call(opts, &out, "getConfig"); err != nil {
+		return OnChainConfig{}, fmt.Errorf("failed to read config contract: %w", err)
+	}
+	cfg := OnChainConfig{MaxBlockRangePerSpanProof: out[0].(*big.Int).Uint64()}
+
+	rangeVkey, err := l.l2ooContract.RangeVkeyCommitment(opts)
+	if err != nil {
+		return OnChainConfig{}, fmt.Errorf("failed to read the L2OO's range vkey commitment: %w", err)
+	}
+	aggVkey, err := l.l2ooContract.AggregationVkey(opts)
+	if err != nil {
+		return OnChainConfig{}, fmt.Errorf("failed to read the L2OO's aggregation vkey: %w", err)
+	}
+	cfg.RangeVkeyCommitment = common.Hash(rangeVkey)
+	cfg.AggregationVkey = common.Hash(aggVkey)
+	return cfg, nil
+}
+
+// reconcileOnChainConfig reads the on-chain config and, if its parameters changed since they were last applied, applies
+// them to the pipeline settings. Like the pipeline spec, this runs at the start of every loop iteration; whichever of
+// the two changed last wins for a setting that both manage.
+//
+// The vkeys can't be applied by the proposer, since the server proves with the programs it was built with. While the
+// server's programs don't match the vkeys set on the L2OO, new proof requests are held, since their proofs would be
+// rejected, until the server is upgraded.
+func (l *L2OutputSubmitter) reconcileOnChainConfig(ctx context.Context) error {
+	if l.configContract == nil {
+		return nil
+	}
+
+	cfg, err := l.fetchOnChainConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if cfg != l.appliedOnChainConfig {
+		// The settings are read concurrently by proof requests and the admin API, so they are swapped atomically.
+		next := l.settings()
+		if value := cfg.MaxBlockRangePerSpanProof; value != 0 && next.MaxBlockRangePerSpanProof != value {
+			l.Log.Info("Applying on-chain config", "setting", "max_block_range_per_span_proof", "old", next.MaxBlockRangePerSpanProof, "new", value)
+			next.MaxBlockRangePerSpanProof = value
+			l.currentSettings.Store(&next)
+		}
+		l.appliedOnChainConfig = cfg
+		l.programsChecked = false
+	}
+
+	// Only ask the server again if the vkeys changed, or the server didn't match them the last time.
+	if l.programsChecked && l.programMismatchReason() == "" {
+		return nil
+	}
+	var mismatch string
+	if cfg.RangeVkeyCommitment != (common.Hash{}) || cfg.AggregationVkey != (common.Hash{}) {
+		version, err := l.GetServerVersion(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get the server's program vkeys: %w", err)
+		}
+		if version.RangeVkeyCommitment == (common.Hash{}) || version.AggVkeyHash == (common.Hash{}) {
+			return fmt.Errorf("the server's /version response doesn't include its program vkeys: %w", ErrInvalidServerResponse)
+		}
+		mismatch = compareProgramVkeys(cfg, version)
+	}
+	if mismatch != l.programMismatchReason() {
+		if mismatch != "" {
+			l.Log.Warn("Holding proof requests, the server's programs don't match the L2OO's vkeys", "reason", mismatch)
+		} else {
+			l.Log.Info("The server's programs match the L2OO's vkeys, resuming proof requests")
+		}
+	}
+	l.programMismatch.Store(&mismatch)
+	l.programsChecked = true
+	return nil
+}
+
+// programMismatchReason returns why proof requests are held because the server's programs don't match the on-chain
+// config. Returns an empty string if they aren't held.
+func (l *L2OutputSubmitter) programMismatchReason() string {
+	if reason := l.programMismatch.Load(); reason != nil {
+		return *reason
+	}
+	return ""
+}
+
+// compareProgramVkeys describes how the server's programs differ from the vkeys set on the L2OO. Returns an empty string if
+// they match.
+func compareProgramVkeys(cfg OnChainConfig, version VersionResponse) string {
+	if cfg.RangeVkeyCommitment != (common.Hash{}) && cfg.RangeVkeyCommitment != version.RangeVkeyCommitment {
+		return fmt.Sprintf("the server's range vkey commitment %s doesn't match the on-chain %s", version.RangeVkeyCommitment, cfg.RangeVkeyCommitment)
+	}
+	if cfg.AggregationVkey != (common.Hash{}) && cfg.AggregationVkey != version.AggVkeyHash {
+		return fmt.Sprintf("the server's aggregation vkey %s doesn't match the on-chain %s", version.AggVkeyHash, cfg.AggregationVkey)
+	}
+	return ""
+}
@@ -0,0 +1,80 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+func TestCompareProgramVkeys(t *testing.T) {
+	rangeVkey, aggVkey := common.HexToHash("0x01"), common.HexToHash("0x02")
+	server := VersionResponse{RangeVkeyCommitment: rangeVkey, AggVkeyHash: aggVkey}
+
+	require.Empty(t, compareProgramVkeys(OnChainConfig{}, server))
+	require.Empty(t, compareProgramVkeys(OnChainConfig{RangeVkeyCommitment: rangeVkey, AggregationVkey: aggVkey}, server))
+	// Unset vkeys aren't checked.
+	require.Empty(t, compareProgramVkeys(OnChainConfig{AggregationVkey: aggVkey}, server))
+	require.Contains(t, compareProgramVkeys(OnChainConfig{RangeVkeyCommitment: aggVkey}, server), "range vkey commitment")
+	require.Contains(t, compareProgramVkeys(OnChainConfig{AggregationVkey: rangeVkey}, server), "aggregation vkey")
+}
+
+// fakeConfigCaller answers every call to the config contract with its max block range.
+type fakeConfigCaller struct {
+	maxBlockRange uint64
+}
+
+func (c *fakeConfigCaller) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c *fakeConfigCaller) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	return common.LeftPadBytes(new(big.Int).SetUint64(c.maxBlockRange).Bytes(), 32), nil
+}
+
+func TestReconcileOnChainConfig(t *testing.T) {
+	rangeVkey, aggVkey := common.HexToHash("0x01"), common.HexToHash("0x02")
+	version := map[string]any{"sp1_circuit_version": "v4.0.0", "range_vkey_commitment": rangeVkey, "agg_vkey_hash": aggVkey}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(version)
+	}))
+	defer server.Close()
+
+	l2oo := newFakeL2OO(0, 100)
+	l2oo.rangeVkey, l2oo.aggVkey = rangeVkey, aggVkey
+	configContract, err := newConfigContract(common.HexToAddress("0x01"), &fakeConfigCaller{maxBlockRange: 200})
+	require.NoError(t, err)
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{OPSuccinctServerUrl: server.URL, MaxBlockRangePerSpanProof: 100},
+		},
+		l2ooContract:   l2oo,
+		configContract: configContract,
+	}
+
+	// The max block range is applied to the settings, without changing the configuration the proposer started with.
+	require.NoError(t, l.reconcileOnChainConfig(context.Background()))
+	require.Equal(t, uint64(200), l.settings().MaxBlockRangePerSpanProof)
+	require.Equal(t, uint64(100), l.Cfg.MaxBlockRangePerSpanProof)
+	require.Empty(t, l.programMismatchReason())
+
+	// The vkeys are read from the L2OO, and proof requests are held while the server doesn't match them.
+	l2oo.aggVkey = common.HexToHash("0x03")
+	require.NoError(t, l.reconcileOnChainConfig(context.Background()))
+	require.Contains(t, l.programMismatchReason(), "aggregation vkey")
+
+	// A server that doesn't report its vkeys can't be checked.
+	delete(version, "agg_vkey_hash")
+	require.ErrorIs(t, l.reconcileOnChainConfig(context.Background()), ErrInvalidServerResponse)
+}
@@ -0,0 +1,47 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+)
+
+// errOutputRootDivergence is returned when a span proof claims an output root that the local rollup node disagrees
+// with.
+var errOutputRootDivergence = errors.New("output root divergence")
+
+// CheckSpanOutputRoot compares the output roots that a fulfilled span proof claims against the ones the local rollup
+// node computes for the span's blocks. A divergence means the node and the range program disagree on the chain's
+// state, e.g. because of a misconfigured node or a mismatched program, which would otherwise only surface once the AGG
+// proof over the span fails to be submitted.
+func (l *L2OutputSubmitter) CheckSpanOutputRoot(ctx context.Context, req *ent.ProofRequest, proof []byte) error {
+	startOutput, err := l.FetchOutput(ctx, req.StartBlock)
+	if err != nil {
+		return fmt.Errorf("failed to fetch output at block %d: %w", req.StartBlock, err)
+	}
+	endOutput, err := l.FetchOutput(ctx, req.EndBlock)
+	if err != nil {
+		return fmt.Errorf("failed to fetch output at block %d: %w", req.EndBlock, err)
+	}
+	expected := BootInfo{
+		L2PreRoot:     common.Hash(startOutput.OutputRoot),
+		L2PostRoot:    common.Hash(endOutput.OutputRoot),
+		L2BlockNumber: req.EndBlock,
+	}
+
+	info, err := decodeSpanProofBootInfo(proof)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errOutputRootDivergence, err)
+	}
+	if err := checkBootInfoRange(info, expected); err != nil {
+		return fmt.Errorf("%w: %w", errOutputRootDivergence, err)
+	}
+	// In watch-only mode, span proofs re-prove proposed ranges, so they are checked against the proposed outputs too.
+	if l.Cfg.WatchOnly {
+		return l.checkProposedOutputRoots(ctx, req, info)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+)
+
+// fakeRollupClient serves the output roots of a fixed set of blocks. The hash of a block is its output root, so a block
+// whose output root changes reorged.
+type fakeRollupClient struct {
+	dial.RollupClientInterface
+	roots     map[uint64]common.Hash
+	finalized uint64
+	unsafe    eth.L2BlockRef
+}
+
+func (c *fakeRollupClient) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
+	return &eth.SyncStatus{FinalizedL2: eth.L2BlockRef{Number: c.finalized}, UnsafeL2: c.unsafe}, nil
+}
+
+func (c *fakeRollupClient) OutputAtBlock(ctx context.Context, block uint64) (*eth.OutputResponse, error) {
+	root, ok := c.roots[block]
+	if !ok {
+		return nil, fmt.Errorf("no output at block %d", block)
+	}
+	return &eth.OutputResponse{OutputRoot: eth.Bytes32(root), BlockRef: eth.L2BlockRef{Number: block, Hash: root}}, nil
+}
+
+type fakeRollupProvider struct {
+	client *fakeRollupClient
+}
+
+func (p fakeRollupProvider) RollupClient(ctx context.Context) (dial.RollupClientInterface, error) {
+	return p.client, nil
+}
+
+func (p fakeRollupProvider) Close() {}
+
+func TestCheckSpanOutputRoot(t *testing.T) {
+	preRoot, postRoot := common.Hash{0x02}, common.Hash{0x03}
+	client := &fakeRollupClient{roots: map[uint64]common.Hash{100: preRoot, 200: postRoot}}
+	l := &L2OutputSubmitter{DriverSetup: DriverSetup{Log: log.New(), RollupProvider: fakeRollupProvider{client}}}
+	req := &ent.ProofRequest{StartBlock: 100, EndBlock: 200}
+
+	proof := encodeSpanProof(BootInfo{L1Head: common.Hash{0x01}, L2PreRoot: preRoot, L2PostRoot: postRoot, L2BlockNumber: 200})
+	require.NoError(t, l.CheckSpanOutputRoot(context.Background(), req, proof))
+
+	// The rollup node computed a different output root for the end block.
+	client.roots[200] = common.Hash{0x05}
+	require.ErrorIs(t, l.CheckSpanOutputRoot(context.Background(), req, proof), errOutputRootDivergence)
+
+	// The rollup node is unreachable, which isn't a divergence.
+	delete(client.roots, 200)
+	err := l.CheckSpanOutputRoot(context.Background(), req, proof)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, errOutputRootDivergence)
+}
@@ -0,0 +1,32 @@
+package proposer
+
+import (
+	"context"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+// SetSubmissionsPaused pauses or resumes L1 transactions, e.g. during contract maintenance. While paused, no outputs
+// are proposed and no L1 block hashes are checkpointed, so AGG proofs aren't requested, but span proofs keep being
+// requested and proven.
+func (l *L2OutputSubmitter) SetSubmissionsPaused(ctx context.Context, paused bool) error {
+	l.submissionsPaused.Store(paused)
+	l.Log.Info("Updated L1 submissions", "paused", paused)
+	return nil
+}
+
+// SetProofRequestsPaused pauses or resumes requesting new proofs from the server. While paused, proofs that were
+// already requested are still tracked until they complete, and completed AGG proofs are still proposed.
+func (l *L2OutputSubmitter) SetProofRequestsPaused(ctx context.Context, paused bool) error {
+	l.proofRequestsPaused.Store(paused)
+	l.Log.Info("Updated proof requests", "paused", paused)
+	return nil
+}
+
+// PauseStatus returns which parts of the pipeline are paused.
+func (l *L2OutputSubmitter) PauseStatus(ctx context.Context) (rpc.PauseStatus, error) {
+	return rpc.PauseStatus{
+		SubmissionsPaused:   l.submissionsPaused.Load(),
+		ProofRequestsPaused: l.proofRequestsPaused.Load(),
+	}, nil
+}
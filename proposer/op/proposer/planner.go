@@ -0,0 +1,217 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// RangePlannerGreedy splits new ranges into spans of MaxBlockRangePerSpanProof blocks.
+	RangePlannerGreedy = "greedy"
+	// RangePlannerCost splits new ranges into the spans that minimize the predicted proving cost.
+	RangePlannerCost = "cost"
+)
+
+// Rough estimates of the range program's cycle counts. They are only used to compare alternative partitions of a range,
+// so they don't need to match the real cycle counts closely.
+const (
+	// blockBaseCycles is the cost of deriving and executing an empty L2 block.
+	blockBaseCycles = 5_000_000
+	// cyclesPerGas is the cost of executing a unit of L2 gas.
+	cyclesPerGas = 30
+	// shardCycles is the number of cycles in an SP1 shard. Shards are proven, and paid for, in full.
+	shardCycles = 1 << 22
+	// planHorizonSpans bounds the number of blocks planned at once, in units of the max block range per span proof.
+	planHorizonSpans = 100
+)
+
+// blockHeaderSource returns the headers of L2 blocks, which the gas used by each block is read from.
+type blockHeaderSource interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// rangePlanner splits ranges into the span proofs that minimize the predicted proving cost. Planning reads the header
+// of every block in the range, so it runs in the background, and the driver queues the spans of the last plan. The
+// estimated cycles of each block are cached, so only new blocks are read when the range is replanned.
+type rangePlanner struct {
+	log            log.Logger
+	client         blockHeaderSource
+	overheadCycles uint64
+
+	mu     sync.Mutex
+	cycles map[uint64]uint64
+	// plan are the spans planned from planStart up to planEnd with at most planMaxRange blocks each.
+	plan         []Span
+	planStart    uint64
+	planEnd      uint64
+	planMaxRange uint64
+	planning     bool
+	// err is why the last planning failed, if it did. It is returned once by Spans.
+	err error
+}
+
+func newRangePlanner(log log.Logger, client blockHeaderSource, overheadCycles uint64) *rangePlanner {
+	return &rangePlanner{
+		log:            log,
+		client:         client,
+		overheadCycles: overheadCycles,
+		cycles:         map[uint64]uint64{},
+	}
+}
+
+// Spans returns the contiguous spans from start of the last plan, and starts replanning in the background if the plan
+// is stale. The last span of a plan is never returned, since it could be extended once more blocks are finalized, so
+// the spans of a new range are only returned once it was planned. Returns an error if the last planning failed.
+func (p *rangePlanner) Spans(ctx context.Context, start, end, maxRange uint64) ([]Span, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.err; err != nil {
+		p.err = nil
+		return nil, err
+	}
+
+	if !p.planning && (p.planStart != start || p.planEnd < end || p.planMaxRange != maxRange) {
+		p.planning = true
+		go p.replan(ctx, start, min(end, start+planHorizonSpans*maxRange), maxRange)
+	}
+
+	if p.planMaxRange != maxRange {
+		return nil, nil
+	}
+	var spans []Span
+	for _, span := range p.plan {
+		if span.Start == start && span.End <= end {
+			spans = append(spans, span)
+			start = span.End
+		}
+	}
+	return spans, nil
+}
+
+// replan plans the range from start to end, and replaces the current plan with it.
+func (p *rangePlanner) replan(ctx context.Context, start, end, maxRange uint64) {
+	plan, err := p.planRange(ctx, start, end, maxRange)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.planning = false
+	if err != nil {
+		p.err = fmt.Errorf("failed to plan range %d-%d: %w", start, end, err)
+		return
+	}
+	p.plan, p.planStart, p.planEnd, p.planMaxRange = plan, start, end, maxRange
+}
+
+func (p *rangePlanner) planRange(ctx context.Context, start, end, maxRange uint64) ([]Span, error) {
+	cycles, err := p.blockCycles(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	spans, cost := planSpans(start, cycles, maxRange, p.overheadCycles)
+	if len(spans) > 0 {
+		// Drop the tail of the range, which is replanned once more blocks are finalized.
+		spans = spans[:len(spans)-1]
+	}
+	p.log.Info("Planned span proofs", "start", start, "end", end, "spans", len(spans), "predicted_cycles", cost)
+	return spans, nil
+}
+
+// blockCycles returns the estimated cycles of the blocks after start, up to and including end. Blocks that aren't
+// cached yet are read from the L2 execution node.
+func (p *rangePlanner) blockCycles(ctx context.Context, start, end uint64) ([]uint64, error) {
+	p.mu.Lock()
+	// Blocks before start have been planned already, and won't be planned again.
+	for block := range p.cycles {
+		if block <= start {
+			delete(p.cycles, block)
+		}
+	}
+	var missing []uint64
+	for block := start + 1; block <= end; block++ {
+		if _, ok := p.cycles[block]; !ok {
+			missing = append(missing, block)
+		}
+	}
+	p.mu.Unlock()
+
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(10)
+	for _, block := range missing {
+		g.Go(func() error {
+			header, err := p.client.HeaderByNumber(gCtx, new(big.Int).SetUint64(block))
+			if err != nil {
+				return fmt.Errorf("failed to get header of block %d: %w", block, err)
+			}
+			p.mu.Lock()
+			p.cycles[block] = estimateBlockCycles(header.GasUsed)
+			p.mu.Unlock()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	cycles := make([]uint64, 0, end-start)
+	for block := start + 1; block <= end; block++ {
+		cycles = append(cycles, p.cycles[block])
+	}
+	return cycles, nil
+}
+
+// planSpans partitions the blocks after start into spans of at most maxRange blocks, where cycles[i] is the estimated
+// cycle count of block start+i+1. Every span costs overheadCycles, plus its cycles rounded up to whole shards. Returns
+// the spans that minimize the total cost, and the total cost.
+func planSpans(start uint64, cycles []uint64, maxRange, overheadCycles uint64) ([]Span, uint64) {
+	n := len(cycles)
+	if n == 0 || maxRange == 0 {
+		return nil, 0
+	}
+
+	// cost[j] is the min cost of the first j blocks, and prev[j] is where the last span of that partition starts.
+	cost := make([]uint64, n+1)
+	prev := make([]int, n+1)
+	for j := 1; j <= n; j++ {
+		cost[j] = math.MaxUint64
+		var blocksCycles uint64
+		for i := j - 1; i >= 0 && uint64(j-i) <= maxRange; i-- {
+			blocksCycles += cycles[i]
+			// On a tie, prefer the longer span, which leaves fewer requests to track.
+			if c := cost[i] + spanCycles(blocksCycles, overheadCycles); c <= cost[j] {
+				cost[j] = c
+				prev[j] = i
+			}
+		}
+	}
+
+	var spans []Span
+	for j := n; j > 0; j = prev[j] {
+		spans = append(spans, Span{Start: start + uint64(prev[j]), End: start + uint64(j)})
+	}
+	slices.Reverse(spans)
+	return spans, cost[n]
+}
+
+// estimateBlockCycles estimates the cycles of an L2 block from its gas used.
+func estimateBlockCycles(gasUsed uint64) uint64 {
+	return blockBaseCycles + gasUsed*cyclesPerGas
+}
+
+// spanCycles estimates the cycles of a span proof whose blocks take blocksCycles: overheadCycles, plus the cycles of the
+// blocks rounded up to whole shards.
+func spanCycles(blocksCycles, overheadCycles uint64) uint64 {
+	shards := (blocksCycles + shardCycles - 1) / shardCycles
+	return overheadCycles + shards*shardCycles
+}
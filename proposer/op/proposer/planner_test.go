@@ -0,0 +1,94 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/forecast"
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+func TestPlanSpans(t *testing.T) {
+	// Spans of the max range would leave half-full shards, so two spans of two blocks are cheaper.
+	half := uint64(shardCycles / 2)
+	spans, cost := planSpans(100, []uint64{half, half, half, half}, 3, 1000)
+	require.Equal(t, []Span{{Start: 100, End: 102}, {Start: 102, End: 104}}, spans)
+	require.Equal(t, uint64(2*1000+2*shardCycles), cost)
+
+	// With cheap blocks, the overhead is minimized by spans of the max range.
+	spans, _ = planSpans(100, []uint64{1, 1, 1, 1, 1}, 2, 1000)
+	require.Equal(t, []Span{{Start: 100, End: 101}, {Start: 101, End: 103}, {Start: 103, End: 105}}, spans)
+}
+
+type gasUsedHeaders uint64
+
+func (g gasUsedHeaders) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: number, GasUsed: uint64(g)}, nil
+}
+
+func TestRangePlannerSpans(t *testing.T) {
+	p := newRangePlanner(log.New(), gasUsedHeaders(0), 1000)
+
+	// The range is planned in the background.
+	spans, err := p.Spans(context.Background(), 100, 350, 100)
+	require.NoError(t, err)
+	require.Empty(t, spans)
+	require.Eventually(t, func() bool {
+		spans, err = p.Spans(context.Background(), 100, 350, 100)
+		return len(spans) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, err)
+
+	// The spans are the cheapest partition of the range, without its tail.
+	cycles := make([]uint64, 250)
+	for i := range cycles {
+		cycles[i] = blockBaseCycles
+	}
+	planned, _ := planSpans(100, cycles, 100, 1000)
+	require.Equal(t, planned[:len(planned)-1], spans)
+	require.Less(t, spans[len(spans)-1].End, uint64(350))
+}
+
+func TestEstimateRange(t *testing.T) {
+	forecaster, err := forecast.Load(filepath.Join(t.TempDir(), "forecast.json"), forecast.DefaultHalfLife, forecast.DefaultWindow)
+	require.NoError(t, err)
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log: log.New(),
+			Cfg: ProposerConfig{MaxBlockRangePerSpanProof: 100, SpanOverheadCycles: 1000, RangePlanner: RangePlannerGreedy},
+		},
+		planner:    newRangePlanner(log.New(), gasUsedHeaders(1_000_000), 1000),
+		forecaster: forecaster,
+	}
+	ctx := context.Background()
+
+	// Greedy spans of 100 blocks, with the last span holding the remaining 50 blocks.
+	estimate, err := l.EstimateRange(ctx, 100, 350)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), estimate.SpanProofs)
+	require.Equal(t, uint64(250*1_000_000), estimate.GasUsed)
+	block := estimateBlockCycles(1_000_000)
+	require.Equal(t, 2*spanCycles(100*block, 1000)+spanCycles(50*block, 1000), estimate.EstimatedCycles)
+	require.Nil(t, estimate.ProvingSeconds)
+
+	// With a proving rate, the proving time is forecast.
+	now := time.Now()
+	require.NoError(t, forecaster.Observe(now.Add(-100*time.Second), 0))
+	require.NoError(t, forecaster.Observe(now, 100))
+	estimate, err = l.EstimateRange(ctx, 100, 350)
+	require.NoError(t, err)
+	require.NotNil(t, estimate.ProvingSeconds)
+
+	_, err = l.EstimateRange(ctx, 350, 100)
+	require.True(t, errors.Is(err, rpc.ErrInvalidRequest))
+	_, err = l.EstimateRange(ctx, 0, maxEstimateBlocks+1)
+	require.True(t, errors.Is(err, rpc.ErrInvalidRequest))
+}
@@ -0,0 +1,118 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+)
+
+// preCheckpoint is an L1 block hash that was checkpointed for the next AGG proof before the proof was created.
+type preCheckpoint struct {
+	l1BlockNumber uint64
+	l1BlockHash   common.Hash
+	// finalizedL2 is the finalized L2 block at the time of the checkpoint. The L1 block can be the L1 head of AGG
+	// proofs up to this block, since the batch data of every L2 block up to it had landed on L1 by then.
+	finalizedL2 uint64
+	time        time.Time
+}
+
+// PreCheckpointBlockHash checkpoints an L1 block hash for the next AGG proof ahead of time, once the span proofs it
+// needs are forecast to complete within PreCheckpointLead. The AGG proof is then requested as soon as it is created,
+// without waiting for a checkpoint transaction in RequestQueuedProofs.
+func (l *L2OutputSubmitter) PreCheckpointBlockHash(ctx context.Context) error {
+	if l.preCheckpoint != nil && time.Since(l.preCheckpoint.time) < l.Cfg.PreCheckpointMaxAge {
+		return nil
+	}
+
+	latest, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get latest L2OO output: %w", err)
+	}
+	minTo, err := l.l2ooContract.NextBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get next L2OO output: %w", err)
+	}
+	// The AGG proof that was already created for the next output was checkpointed when it was requested.
+	created, err := l.db.HasAggProofRequestFrom(latest.Uint64())
+	if err != nil {
+		return err
+	}
+	if created {
+		return nil
+	}
+
+	highestProven, err := l.db.GetMaxContiguousSpanProofRange(latest.Uint64())
+	if err != nil {
+		return err
+	}
+	var remaining uint64
+	if minTo.Uint64() > highestProven {
+		remaining = minTo.Uint64() - highestProven
+	}
+	eta, ok := l.forecaster.ETA(remaining)
+	if !ok || eta > l.Cfg.PreCheckpointLead {
+		return nil
+	}
+
+	rollupClient, err := l.RollupProvider.RollupClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get rollup client: %w", err)
+	}
+	status, err := rollupClient.SyncStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get sync status: %w", err)
+	}
+	// The span proofs of the AGG proof can't have an L1 head after the checkpointed block, so the checkpoint waits
+	// until the blocks up to the next output are finalized.
+	if status.FinalizedL2.Number < minTo.Uint64() {
+		return nil
+	}
+
+	blockNumber, blockHash, err := l.transactor.checkpointBlockHash(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to pre-checkpoint block hash: %w", err)
+	}
+	l.preCheckpoint = &preCheckpoint{
+		l1BlockNumber: blockNumber,
+		l1BlockHash:   blockHash,
+		finalizedL2:   status.FinalizedL2.Number,
+		time:          time.Now(),
+	}
+	l.Log.Info("pre-checkpointed L1 block hash for the next AGG proof", "l1BlockNumber", blockNumber, "l1BlockHash", blockHash, "nextBlockNumber", minTo, "eta", eta)
+	return nil
+}
+
+// takePreCheckpoint returns the pre-checkpointed L1 block hash if it can be used as the L1 head of the given AGG
+// request, and discards it either way, since it is only checkpointed for the next AGG proof. Returns nil if there is
+// no usable pre-checkpoint.
+func (l *L2OutputSubmitter) takePreCheckpoint(ctx context.Context, req *ent.ProofRequest) (*preCheckpoint, error) {
+	cp := l.preCheckpoint
+	if cp == nil {
+		return nil, nil
+	}
+	l.preCheckpoint = nil
+
+	if age := time.Since(cp.time); age >= l.Cfg.PreCheckpointMaxAge {
+		l.Log.Info("discarding expired pre-checkpoint", "l1BlockNumber", cp.l1BlockNumber, "age", age)
+		return nil, nil
+	}
+	if req.EndBlock > cp.finalizedL2 {
+		l.Log.Info("discarding pre-checkpoint that precedes the end of the AGG request", "l1BlockNumber", cp.l1BlockNumber, "finalizedL2", cp.finalizedL2, "end", req.EndBlock)
+		return nil, nil
+	}
+	checkpointed, err := l.isCheckpointed(ctx, cp.l1BlockNumber, cp.l1BlockHash.Hex())
+	if err != nil {
+		return nil, err
+	}
+	if !checkpointed {
+		l.Log.Warn("pre-checkpointed L1 block hash is not checkpointed on-chain, discarding it", "l1BlockNumber", cp.l1BlockNumber, "l1BlockHash", cp.l1BlockHash)
+		l.Metr.RecordError("stale_checkpoint", 1)
+		return nil, nil
+	}
+	return cp, nil
+}
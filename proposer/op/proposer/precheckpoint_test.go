@@ -0,0 +1,66 @@
+package proposer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/forecast"
+)
+
+func TestPreCheckpointBlockHash(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	addCompletedSpanProofs(t, proofDB, [2]uint64{100, 150})
+
+	l2oo := newFakeL2OO(100, 100)
+	l := newFakeL2OODriver(t, l2oo, proofDB)
+	l.Cfg.PreCheckpointLead = time.Hour
+	l.Cfg.PreCheckpointMaxAge = time.Hour
+	client := l.RollupProvider.(fakeRollupProvider).client
+	ctx := context.Background()
+
+	// Without a proving rate, nothing can be forecast.
+	l.forecaster, err = forecast.Load(filepath.Join(t.TempDir(), "forecast.json"), forecast.DefaultHalfLife, forecast.DefaultWindow)
+	require.NoError(t, err)
+	require.NoError(t, l.PreCheckpointBlockHash(ctx))
+	require.Nil(t, l.preCheckpoint)
+
+	// The remaining 50 blocks up to the next output are forecast to be proven within the lead, but aren't finalized.
+	now := time.Now()
+	require.NoError(t, l.forecaster.Observe(now.Add(-100*time.Second), 0))
+	require.NoError(t, l.forecaster.Observe(now, 100))
+	eta, ok := l.forecaster.ETA(50)
+	require.True(t, ok)
+	require.Less(t, eta, time.Hour)
+	client.finalized = 150
+	require.NoError(t, l.PreCheckpointBlockHash(ctx))
+	require.Nil(t, l.preCheckpoint)
+
+	client.finalized = 250
+	require.NoError(t, l.PreCheckpointBlockHash(ctx))
+	require.NotNil(t, l.preCheckpoint)
+	require.Len(t, l2oo.checkpoints, 1)
+	// The pre-checkpoint is only sent once.
+	require.NoError(t, l.PreCheckpointBlockHash(ctx))
+	require.Len(t, l2oo.checkpoints, 1)
+
+	// It can't be used for AGG proofs past the blocks that were finalized when it was sent, and is discarded either way.
+	cp, err := l.takePreCheckpoint(ctx, &ent.ProofRequest{Type: proofrequest.TypeAGG, StartBlock: 100, EndBlock: 300})
+	require.NoError(t, err)
+	require.Nil(t, cp)
+	require.Nil(t, l.preCheckpoint)
+
+	require.NoError(t, l.PreCheckpointBlockHash(ctx))
+	cp, err = l.takePreCheckpoint(ctx, &ent.ProofRequest{Type: proofrequest.TypeAGG, StartBlock: 100, EndBlock: 200})
+	require.NoError(t, err)
+	require.NotNil(t, cp)
+	require.Equal(t, l2oo.checkpoints[cp.l1BlockNumber], cp.l1BlockHash)
+}
@@ -0,0 +1,33 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// sendThroughMempool sends the transaction through the private relay at PRIVATE_TX_RPC if it's set, so searchers
+// can't see it before it's mined, and front-run a checkpoint or proposal into reverting. If the relay doesn't get the
+// transaction mined within PRIVATE_TX_TIMEOUT, e.g. because no builder that the relay sends to won a block, it's sent to
+// the public mempool through the tx manager at the same nonce, so only one of them can be mined.
+//
+// The two tx managers track the nonce of the account separately, so the first transaction sent by one after the other
+// sent transactions can be rejected for its nonce. The tx manager then re-reads the nonce, and the transaction is sent
+// again on the next poll.
+func (l *L2OutputSubmitter) sendThroughMempool(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	if l.PrivateTxmgr == nil {
+		return l.Txmgr.Send(ctx, candidate)
+	}
+
+	pCtx, cancel := context.WithTimeout(ctx, l.Cfg.PrivateTxTimeout)
+	defer cancel()
+	receipt, err := l.PrivateTxmgr.Send(pCtx, candidate)
+	if err == nil || ctx.Err() != nil || !errors.Is(pCtx.Err(), context.DeadlineExceeded) {
+		return receipt, err
+	}
+	l.Log.Warn("Transaction wasn't mined through the private relay, sending it to the public mempool", "to", candidate.To, "timeout", l.Cfg.PrivateTxTimeout, "err", err)
+	l.Metr.RecordError("private_tx_fallback", 1)
+	return l.Txmgr.Send(ctx, candidate)
+}
@@ -0,0 +1,78 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+// fakeTxmgr mines the transactions sent to it, or fails them with err. If hang is set, transactions are never mined.
+type fakeTxmgr struct {
+	txmgr.TxManager
+	sent int
+	hang bool
+	err  error
+}
+
+func (f *fakeTxmgr) Send(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	f.sent++
+	if f.hang {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &types.Receipt{Status: types.ReceiptStatusSuccessful}, nil
+}
+
+func TestSendThroughMempool(t *testing.T) {
+	public, private := &fakeTxmgr{}, &fakeTxmgr{}
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:   log.New(),
+			Metr:  opsuccinctmetrics.NoopMetrics,
+			Cfg:   ProposerConfig{PrivateTxTimeout: 10 * time.Millisecond},
+			Txmgr: public,
+		},
+	}
+
+	// Without a private relay, transactions go to the public mempool.
+	_, err := l.sendThroughMempool(context.Background(), txmgr.TxCandidate{})
+	require.NoError(t, err)
+	require.Equal(t, 1, public.sent)
+
+	l.PrivateTxmgr = private
+	_, err = l.sendThroughMempool(context.Background(), txmgr.TxCandidate{})
+	require.NoError(t, err)
+	require.Equal(t, 1, private.sent)
+	require.Equal(t, 1, public.sent)
+
+	// Transactions that fail through the relay, e.g. because they revert, aren't sent to the public mempool.
+	private.err = errors.New("execution reverted")
+	_, err = l.sendThroughMempool(context.Background(), txmgr.TxCandidate{})
+	require.ErrorContains(t, err, "execution reverted")
+	require.Equal(t, 1, public.sent)
+
+	// Transactions that aren't mined through the relay in time fall back to the public mempool.
+	private.err, private.hang = nil, true
+	receipt, err := l.sendThroughMempool(context.Background(), txmgr.TxCandidate{})
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	require.Equal(t, 2, public.sent)
+
+	// Unless the proposer gave up on them.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = l.sendThroughMempool(ctx, txmgr.TxCandidate{})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 2, public.sent)
+}
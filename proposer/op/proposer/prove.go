@@ -3,7 +3,11 @@ package proposer
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -12,35 +16,108 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/tracing"
 )
 
 const PROOF_STATUS_TIMEOUT = 30 * time.Second
 
-// Process all of requests in PROVING state.
-func (l *L2OutputSubmitter) ProcessProvingRequests() error {
+// statusPollConcurrency bounds the number of proof statuses polled at once.
+const statusPollConcurrency = 16
+
+// Process all of requests in PROVING state. The statuses are polled in batches per server, and concurrently, so a slow
+// poll doesn't hold up the others, and a request whose status can't be polled or updated doesn't keep the remaining requests from being
+// processed. The errors of updating the requests are returned together.
+func (l *L2OutputSubmitter) ProcessProvingRequests() (err error) {
 	// Get all proof requests that are currently in the PROVING state.
 	reqs, err := l.db.GetAllProofsWithStatus(proofrequest.StatusPROVING)
 	if err != nil {
 		return err
 	}
-	for _, req := range reqs {
-		proofStatus, err := l.GetProofStatus(req.ProverRequestID)
+	ctx, span := l.tracer.Start(l.ctx, "ProcessProvingRequests", tracing.Int("requests", len(reqs)))
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
+	statuses, pollErrs := l.pollProofStatuses(ctx, reqs)
+
+	// The time remaining until the request of each type that is closest to its timeout times out.
+	timeRemaining := make(map[string]uint64)
+	now := uint64(time.Now().Unix())
+	// The number of blocks covered by the span proofs fulfilled in this call, which the throughput forecast is based on.
+	var provenBlocks uint64
+	var errs []error
+	for i, req := range reqs {
+		backend := l.proverBackend(req)
+		proofStatus, err := statuses[i], pollErrs[i]
 		if err != nil {
-			l.Log.Error("failed to get proof status for ID", "id", req.ProverRequestID, "err", err)
+			l.Log.Error("failed to get proof status for ID", "id", req.ProverRequestID, "backend", backend, "err", err)
 
 			// Record the error for the get proof status call.
 			l.Metr.RecordError("get_proof_status", 1)
-			return err
+
+			// A request on a backend that has been unreachable for too long is retried on the next backend.
+			if l.backendDown(backend) && l.nextProverBackend(req) != "" {
+				if err := l.RetryRequest(req, ProofStatusResponse{}); err != nil {
+					errs = append(errs, fmt.Errorf("failed to retry request %d: %w", req.ID, err))
+				}
+			}
+			continue
 		}
 		if proofStatus.FulfillmentStatus == SP1FulfillmentStatusFulfilled {
 			// Update the proof in the DB and update status to COMPLETE.
-			l.Log.Info("Fulfilled Proof", "id", req.ProverRequestID)
+			l.Log.Info("Fulfilled Proof", "id", req.ProverRequestID, "backend", backend)
 			err = l.db.AddFulfilledProof(req.ID, proofStatus.Proof)
+			if errors.Is(err, db.ErrProofAlreadyFulfilled) || errors.Is(err, db.ErrProofStatusChanged) {
+				// The fulfillment was already handled, or the request was cancelled while it was proving.
+				l.Log.Info("not storing fulfilled proof", "id", req.ID, "err", err)
+				continue
+			}
 			if err != nil {
 				l.Log.Error("failed to update completed proof status", "err", err)
-				return err
+				errs = append(errs, err)
+				continue
+			}
+			if req.Type == proofrequest.TypeSPAN {
+				provenBlocks += req.EndBlock - req.StartBlock
+			}
+			if req.ProofRequestTime != 0 {
+				l.Metr.RecordProvingDuration(req.Type.String(), req.EndBlock-req.StartBlock, time.Since(time.Unix(int64(req.ProofRequestTime), 0)), l.proofTraceID(req))
+			}
+			l.recordProofLatency(req)
+			l.recordFulfillment(req, proofStatus)
+			if req.ProofRequestTime != 0 {
+				_, provingSpan := l.tracer.StartAt(proofTraceContext(l.ctx, req), "proving", time.Unix(int64(req.ProofRequestTime), 0), tracing.String("prover_request_id", req.ProverRequestID), tracing.String("backend", backend))
+				provingSpan.End()
+			}
+			l.endProofTrace(req, nil)
+
+			// Check the output root claimed by the span proof against the rollup node in the background.
+			if req.Type == proofrequest.TypeSPAN {
+				go func(req *ent.ProofRequest, proof []byte) {
+					err := l.CheckSpanOutputRoot(l.ctx, req, proof)
+					if errors.Is(err, errOutputRootDivergence) {
+						l.Log.Error("Span proof diverges from the rollup node or the proposed outputs, check the node and the range program", "id", req.ID, "start", req.StartBlock, "end", req.EndBlock, "err", err)
+						l.Metr.RecordError("output_root_divergence", 1)
+					} else if err != nil {
+						l.Log.Warn("failed to check the output root of span proof", "id", req.ID, "err", err)
+						l.Metr.RecordError("output_root_check", 1)
+					}
+				}(req, proofStatus.Proof)
+			}
+
+			// Compare the real proof against the mock pipeline in the background.
+			if l.Cfg.DifferentialTest && req.Type == proofrequest.TypeSPAN {
+				l.startDifferentialCheck(req, proofStatus.Proof)
+			}
+
+			// Re-execute a sample of the span proofs on the double-check server in the background.
+			if l.sampleDoubleCheck(req) {
+				l.startDoubleCheck(req, proofStatus.Proof)
 			}
 			continue
 		}
@@ -48,18 +125,129 @@ func (l *L2OutputSubmitter) ProcessProvingRequests() error {
 		if proofStatus.FulfillmentStatus == SP1FulfillmentStatusUnfulfillable {
 			// Record the failure reason.
 			l.Log.Info("Proof is unfulfillable", "id", req.ProverRequestID)
-			l.Metr.RecordProveFailure("unfulfillable")
+			l.Metr.RecordProveFailure("unfulfillable", req.EndBlock-req.StartBlock, l.proofTraceID(req))
+			l.recordFulfillment(req, proofStatus)
 
 			err = l.RetryRequest(req, proofStatus)
 			if err != nil {
-				return fmt.Errorf("failed to retry request: %w", err)
+				errs = append(errs, fmt.Errorf("failed to retry request %d: %w", req.ID, err))
 			}
+			continue
+		}
+
+		timeout := l.requestProofTimeout(req)
+		deadline := req.ProofRequestTime + timeout
+		if deadline <= now {
+			l.Log.Info("Proof timed out", "id", req.ProverRequestID, "type", req.Type, "start", req.StartBlock, "end", req.EndBlock, "timeout", timeout)
+			l.Metr.RecordProveFailure("timeout", req.EndBlock-req.StartBlock, l.proofTraceID(req))
+			l.recordFulfillment(req, proofStatus)
+
+			err = l.RetryRequest(req, proofStatus)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to retry request %d: %w", req.ID, err))
+			}
+			continue
+		}
+		if remaining, ok := timeRemaining[req.Type.String()]; !ok || deadline-now < remaining {
+			timeRemaining[req.Type.String()] = deadline - now
+		}
+	}
+	l.Metr.RecordProofTimeRemaining(timeRemaining)
+
+	if l.forecaster != nil {
+		if err := l.forecaster.Observe(time.Now(), provenBlocks); err != nil {
+			l.Log.Warn("failed to update the throughput forecast", "err", err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ResumeProvingRequests checks the requests that were PROVING when the proposer stopped right away, instead of waiting
+// for the first poll interval, so proofs that were fulfilled during downtime are downloaded as soon as it restarts.
+// Requests that never got a prover request ID, because the proposer stopped before it was stored, can't be polled and
+// are retried.
+func (l *L2OutputSubmitter) ResumeProvingRequests() error {
+	reqs, err := l.db.GetAllProofsWithStatus(proofrequest.StatusPROVING)
+	if err != nil {
+		return err
+	}
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	for _, req := range reqs {
+		if req.ProverRequestID != "" {
+			continue
+		}
+		l.Log.Info("Retrying PROVING request without a prover request ID", "id", req.ID, "type", req.Type, "start", req.StartBlock, "end", req.EndBlock)
+		if err := l.RetryRequest(req, ProofStatusResponse{}); err != nil {
+			return fmt.Errorf("failed to retry request: %w", err)
 		}
 	}
 
+	l.Log.Info("Resuming PROVING requests", "count", len(reqs))
+	return l.ProcessProvingRequests()
+}
+
+// ResumeWitnessGenRequests picks up the requests that were in WITNESSGEN when the proposer stopped, which no goroutine
+// is waiting on anymore. A request that was sent to the server is sent again with the same idempotency key, so the
+// server returns the result of the witness generation that is still running or already done, instead of starting over.
+// A request that was never sent is put back in the queue, and one that is past the witness generation timeout is
+// retried. With a shared DB, the requests of other proposer instances are left to them, unless this instance was
+// elected leader, which takes over the requests of the previous one.
+func (l *L2OutputSubmitter) ResumeWitnessGenRequests() error {
+	reqs, err := l.db.GetAllProofsWithStatus(proofrequest.StatusWITNESSGEN)
+	if err != nil {
+		return err
+	}
+
+	now := uint64(time.Now().Unix())
+	for _, req := range reqs {
+		if l.Cfg.DbConnectionString != "" && !l.Cfg.LeaderElection && req.RequestedBy != "" && req.RequestedBy != l.Cfg.InstanceID {
+			continue
+		}
+		switch {
+		case req.LastUpdatedTime+uint64(l.Cfg.WitnessGenTimeout) < now:
+			l.Log.Info("Retrying WITNESSGEN request past the witness generation timeout", "id", req.ID, "type", req.Type, "start", req.StartBlock, "end", req.EndBlock)
+			if err := l.RetryRequest(req, ProofStatusResponse{}); err != nil {
+				return fmt.Errorf("failed to retry request: %w", err)
+			}
+		case req.IdempotencyKey == "":
+			l.Log.Info("Requeuing WITNESSGEN request that was never sent to the server", "id", req.ID, "type", req.Type, "start", req.StartBlock, "end", req.EndBlock)
+			err := l.db.TransitionProofStatus(req.ID, proofrequest.StatusWITNESSGEN, proofrequest.StatusUNREQ)
+			if err != nil && !errors.Is(err, db.ErrProofStatusChanged) {
+				return fmt.Errorf("failed to requeue request: %w", err)
+			}
+		default:
+			l.Log.Info("Resuming WITNESSGEN request", "id", req.ID, "type", req.Type, "start", req.StartBlock, "end", req.EndBlock, "backend", req.ProverBackend)
+			l.inflight.start(proofTraceContext(l.ctx, req), req.ID, func(ctx context.Context) {
+				l.requestProofFromServer(ctx, *req)
+			})
+		}
+	}
 	return nil
 }
 
+// proofTimeout returns the time in seconds a new proof request for the given range is given to be generated. Span
+// proof timeouts scale with the number of blocks in the range.
+func (l *L2OutputSubmitter) proofTimeout(proofType proofrequest.Type, start, end uint64) uint64 {
+	settings := l.settings()
+	if proofType == proofrequest.TypeAGG {
+		return settings.AggProofTimeout
+	}
+	return settings.SpanProofTimeout + settings.SpanProofTimeoutPerBlock*(end-start)
+}
+
+// requestProofTimeout returns the time in seconds the proof request is given to be generated. Requests created before
+// proof timeouts were persisted have no timeout, and are given the timeout of a new request for their range.
+func (l *L2OutputSubmitter) requestProofTimeout(req *ent.ProofRequest) uint64 {
+	if req.ProofTimeout != 0 {
+		return req.ProofTimeout
+	}
+	return l.proofTimeout(req.Type, req.StartBlock, req.EndBlock)
+}
+
 // Process all of requests in WITNESSGEN state.
 func (l *L2OutputSubmitter) ProcessWitnessgenRequests() error {
 	// Get all proof requests that are currently in the WITNESSGEN state.
@@ -79,20 +267,58 @@ func (l *L2OutputSubmitter) ProcessWitnessgenRequests() error {
 	return nil
 }
 
+// recordProofLatency records the end-to-end latency of a fulfilled proof request, from it being added until its proof
+// was fulfilled. For AGG proofs, the time from the last of its span proofs being fulfilled is recorded too, which is
+// how long assembling the AGG proof took, including waiting for the L1 checkpoint. A retried request is a new request,
+// so its latency only covers the last attempt.
+func (l *L2OutputSubmitter) recordProofLatency(req *ent.ProofRequest) {
+	now := time.Now()
+	rangeSize := req.EndBlock - req.StartBlock
+	l.Metr.RecordProofLatency(req.Type.String(), rangeSize, now.Sub(time.Unix(int64(req.RequestAddedTime), 0)), l.proofTraceID(req))
+	if req.Type != proofrequest.TypeAGG {
+		return
+	}
+
+	spans, err := l.db.GetAggSpans(req.ID)
+	if err != nil {
+		l.Log.Warn("failed to get the span proofs of AGG proof request", "id", req.ID, "err", err)
+		return
+	}
+	// Completed span proofs aren't updated until they're archived, which they aren't while their AGG proof is pending.
+	var lastFulfilled uint64
+	for _, span := range spans {
+		lastFulfilled = max(lastFulfilled, span.LastUpdatedTime)
+	}
+	if lastFulfilled != 0 {
+		l.Metr.RecordAggAssemblyDuration(rangeSize, now.Sub(time.Unix(int64(lastFulfilled), 0)))
+	}
+}
+
 // Retry a proof request. Sets the status of a proof to FAILED and retries the proof based on the optional proof status response.
 // If an error response is received:
-// - Range Proof: Split in two if the block range is > 1 AND the proof is unexecutable OR has failed before. Retry the same request if range is 1 block.
-// - Agg Proof: Retry the same request.
+// - Range Proof: Split in two if both halves have at least MIN_SPAN_PROOF_BLOCKS blocks AND the proof is unexecutable OR has failed before.
+// The halves are split again if they fail too, down to MIN_SPAN_PROOF_BLOCKS. Retry the same request if the range can't be split.
+// - Agg Proof: Aggregate a shorter range if the proof is unexecutable OR has failed before, and the contract's submission interval allows it, or else re-prove its range with fewer span proofs.
+// Otherwise, retry the same request.
+//
+// Before any of that, a request that should be failed over is retried on the next prover backend. Other retries are
+// sent to the first backend that isn't down.
 func (l *L2OutputSubmitter) RetryRequest(req *ent.ProofRequest, status ProofStatusResponse) error {
-	err := l.db.UpdateProofStatus(req.ID, proofrequest.StatusFAILED)
+	// A request that an admin cancelled or retried in the meantime isn't retried again.
+	err := l.db.TransitionProofStatus(req.ID, req.Status, proofrequest.StatusFAILED)
+	if errors.Is(err, db.ErrProofStatusChanged) {
+		l.Log.Info("not retrying proof request whose status changed", "id", req.ID, "err", err)
+		return nil
+	}
 	if err != nil {
 		l.Log.Error("failed to update proof status", "err", err)
 		return err
 	}
+	l.endProofTrace(req, errors.New("proof request failed"))
 
 	unexecutable := status.ExecutionStatus == SP1ExecutionStatusUnexecutable
 	spanProof := req.Type == proofrequest.TypeSPAN
-	multiBlockRange := req.EndBlock-req.StartBlock > 1
+	splittable := req.EndBlock-req.StartBlock >= 2*max(l.Cfg.MinSpanProofBlocks, 1)
 
 	// Get the number of failed requests with the same block range and status.
 	prevFailedReq, err := l.db.GetProofRequestsWithBlockRangeAndStatus(req.Type, req.StartBlock, req.EndBlock, proofrequest.StatusFAILED)
@@ -104,8 +330,22 @@ func (l *L2OutputSubmitter) RetryRequest(req *ent.ProofRequest, status ProofStat
 	// Check if there is another proof (besides the one marked as failed above) with the same block range that also failed.
 	severalFailedRequests := len(prevFailedReq) > 1
 
-	// If there's an execution error OR several failed requests AND the request is a SPAN proof AND the block range is > 1,
-	// split the request into two requests.
+	// Fail the request over to the next prover backend before splitting it, since a range that no prover claimed, or
+	// whose backend is unreachable, isn't any easier to prove in smaller parts.
+	if backend := l.failoverBackend(req, status, prevFailedReq); backend != "" {
+		retried, err := l.retryRange(req, backend, false)
+		if err != nil {
+			l.Log.Error("failed to fail over proof request", "err", err)
+			return err
+		}
+		if retried {
+			l.Metr.RecordError("prover_failover", 1)
+		}
+		return nil
+	}
+
+	// If there's an execution error OR several failed requests AND the request is a SPAN proof AND both halves of the
+	// block range have at least MIN_SPAN_PROOF_BLOCKS blocks, split the request into two requests.
 	//
 	// If the embedded allocator is enabled, the proof will never be unexecutable. Instead, the issue is because there's a limit on the number
 	// of shards in V4. This will be fixed in V5 when the cycle limit is removed.
@@ -113,23 +353,39 @@ func (l *L2OutputSubmitter) RetryRequest(req *ent.ProofRequest, status ProofStat
 	// If the embedded allocator is not enabled, the trigger for unexecutable is the SP1 OOM.
 	//
 	// The reason why we only split with multiple failed requests is to avoid transient errors causing unnecessary splits.
-	if spanProof && (unexecutable || severalFailedRequests) && multiBlockRange {
+	if spanProof && (unexecutable || severalFailedRequests) && splittable {
 		// Split the request into two requests.
 		midBlock := (req.StartBlock + req.EndBlock) / 2
-		err = l.db.NewEntry(req.Type, req.StartBlock, midBlock)
+		err = l.db.NewEntry(req.Type, req.StartBlock, midBlock, l.proofTimeout(req.Type, req.StartBlock, midBlock))
 		if err != nil {
 			l.Log.Error("failed to retry first half of proof request", "err", err)
 			return err
 		}
-		err = l.db.NewEntry(req.Type, midBlock, req.EndBlock)
+		err = l.db.NewEntry(req.Type, midBlock, req.EndBlock, l.proofTimeout(req.Type, midBlock, req.EndBlock))
 		if err != nil {
 			l.Log.Error("failed to retry second half of proof request", "err", err)
 			return err
 		}
+	} else if !spanProof && (unexecutable || severalFailedRequests) && l.splitAggRequest(req) {
+		// The AGG proof was replaced by a smaller one that covers a prefix of its range.
+		return nil
+	} else if !spanProof && (unexecutable || severalFailedRequests) && l.coarsenAggRequest(req) {
+		// The AGG proof is derived again by DeriveAggProofs once the larger span proofs are complete.
+		return nil
 	} else {
+		// A span that fails to execute but can't be split any further won't execute on a retry either, unless the
+		// failure was caused by the prover, so it needs an operator to look into it.
+		if spanProof && unexecutable {
+			l.Log.Error("Span proof is unexecutable and can't be split any further", "id", req.ID, "start", req.StartBlock, "end", req.EndBlock, "minBlocks", l.Cfg.MinSpanProofBlocks)
+			if req.EndBlock-req.StartBlock == 1 {
+				l.Metr.RecordError("unexecutable_single_block", 1)
+			} else {
+				l.Metr.RecordError("unexecutable_min_range", 1)
+			}
+		}
+
 		// Retry the same request.
-		err = l.db.NewEntry(req.Type, req.StartBlock, req.EndBlock)
-		if err != nil {
+		if _, err := l.retryRange(req, "", true); err != nil {
 			l.Log.Error("failed to retry proof request", "err", err)
 			return err
 		}
@@ -138,16 +394,188 @@ func (l *L2OutputSubmitter) RetryRequest(req *ent.ProofRequest, status ProofStat
 	return nil
 }
 
-func (l *L2OutputSubmitter) RequestQueuedProofs(ctx context.Context) error {
-	nextProofToRequest, err := l.db.GetNextUnrequestedProof()
+// maxProofRetryBackoff caps the time a retry of a proof request is backed off.
+const maxProofRetryBackoff = time.Hour
+
+// retryRange queues a new request for the range of the failed proof request on the given backend, which is picked when
+// the request is sent if it's empty. Retries that back off aren't requested before PROOF_RETRY_BACKOFF has passed,
+// doubled for every earlier retry of the range. Once the range was retried MAX_PROOF_RETRIES times, the request is
+// dead-lettered instead, so that it waits for an admin to retry it. Returns whether the range was retried.
+func (l *L2OutputSubmitter) retryRange(req *ent.ProofRequest, backend string, backoff bool) (bool, error) {
+	if l.Cfg.MaxProofRetries > 0 && req.Attempts >= l.Cfg.MaxProofRetries {
+		err := l.db.TransitionProofStatus(req.ID, proofrequest.StatusFAILED, proofrequest.StatusDEADLETTER)
+		if errors.Is(err, db.ErrProofStatusChanged) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		l.Log.Error("Proof request was retried too often, moved it to the dead-letter status", "id", req.ID, "type", req.Type, "start", req.StartBlock, "end", req.EndBlock, "retries", req.Attempts)
+		l.Metr.RecordError("proof_request_deadlettered", 1)
+		return false, nil
+	}
+
+	var notBefore uint64
+	if backoff {
+		notBefore = uint64(time.Now().Add(l.proofRetryBackoff(req.Attempts)).Unix())
+	}
+	if err := l.db.RetryEntry(req, l.proofTimeout(req.Type, req.StartBlock, req.EndBlock), backend, notBefore); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// proofRetryBackoff returns the time to wait before re-requesting a range that was retried the given number of times.
+func (l *L2OutputSubmitter) proofRetryBackoff(retries uint64) time.Duration {
+	backoff := l.Cfg.ProofRetryBackoff
+	for i := uint64(0); i < retries && backoff < maxProofRetryBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxProofRetryBackoff)
+}
+
+// splitAggRequest replaces a failing AGG request with one that aggregates roughly half as many span proofs. This
+// handles AGG proofs that can't be fulfilled because they exceed the aggregation program's limits.
+//
+// The smaller AGG proof covers a prefix of the original range ending at a span proof boundary. The prefix must still
+// reach the L2OO's next block number, otherwise the contract would reject it. The rest of the range is aggregated by
+// DeriveAggProofs once the smaller proof has been submitted on-chain. Returns false if no valid split exists.
+func (l *L2OutputSubmitter) splitAggRequest(req *ent.ProofRequest) bool {
+	minTo, err := l.l2ooContract.NextBlockNumber(&bind.CallOpts{Context: l.ctx})
+	if err != nil {
+		l.Log.Error("failed to get next L2OO output", "err", err)
+		return false
+	}
+	boundaries, err := l.db.GetContiguousSpanProofBoundaries(req.StartBlock, req.EndBlock)
+	if err != nil {
+		l.Log.Error("failed to get span proof boundaries", "err", err)
+		return false
+	}
+
+	// Pick the boundary closest to the middle of the range that still satisfies the contract's submission interval.
+	midBlock := (req.StartBlock + req.EndBlock) / 2
+	var splitBlock uint64
+	for _, boundary := range boundaries {
+		if boundary < minTo.Uint64() || boundary >= req.EndBlock {
+			continue
+		}
+		if splitBlock == 0 || absDiff(boundary, midBlock) < absDiff(splitBlock, midBlock) {
+			splitBlock = boundary
+		}
+	}
+	if splitBlock == 0 {
+		l.Log.Info("AGG proof range can't be split within the contract's submission interval", "start", req.StartBlock, "end", req.EndBlock, "minTo", minTo)
+		return false
+	}
+
+	if err := l.db.NewEntry(proofrequest.TypeAGG, req.StartBlock, splitBlock, l.proofTimeout(proofrequest.TypeAGG, req.StartBlock, splitBlock)); err != nil {
+		l.Log.Error("failed to create split AGG proof request", "err", err)
+		return false
+	}
+	l.Log.Info("split failing AGG proof request", "start", req.StartBlock, "end", req.EndBlock, "newEnd", splitBlock)
+	return true
+}
+
+// coarsenAggRequest handles a failing AGG request whose range can't be split, by re-proving runs of adjacent span
+// proofs in its range as single span proofs of at most MaxBlockRangePerSpanProof blocks. The aggregation program only
+// verifies span proofs, so rather than aggregating AGG proofs hierarchically, this lowers the number of span proofs
+// that the AGG proof aggregates. Returns false if the span proofs can't be merged any further.
+func (l *L2OutputSubmitter) coarsenAggRequest(req *ent.ProofRequest) bool {
+	chain, err := l.db.GetSpanProofChain(req.StartBlock, req.EndBlock)
+	if err != nil {
+		l.Log.Error("failed to get span proof chain", "err", err)
+		return false
+	}
+	failed, err := l.db.GetFailedSpanProofsSince(uint64(time.Now().Add(-compactionFailureWindow).Unix()))
 	if err != nil {
-		return fmt.Errorf("failed to get unrequested proofs: %w", err)
+		l.Log.Error("failed to get failed span proofs", "err", err)
+		return false
+	}
+	// Earlier failed attempts of a span in the chain were retried successfully, so only failures of larger ranges rule
+	// out a merge.
+	proven := make(map[[2]uint64]bool, len(chain))
+	for _, span := range chain {
+		proven[[2]uint64{span.StartBlock, span.EndBlock}] = true
+	}
+	var mergeFailures []*ent.ProofRequest
+	for _, f := range failed {
+		if !proven[[2]uint64{f.StartBlock, f.EndBlock}] {
+			mergeFailures = append(mergeFailures, f)
+		}
+	}
+
+	groups := planSpanCompaction(chain, mergeFailures, l.settings().MaxBlockRangePerSpanProof)
+	if len(groups) == 0 {
+		l.Log.Info("span proofs of AGG proof range can't be merged", "start", req.StartBlock, "end", req.EndBlock, "spans", len(chain))
+		return false
+	}
+	for _, group := range groups {
+		start, end := group[0].StartBlock, group[len(group)-1].EndBlock
+		if err := l.db.NewEntry(proofrequest.TypeSPAN, start, end, l.proofTimeout(proofrequest.TypeSPAN, start, end)); err != nil {
+			l.Log.Error("failed to create merged span proof request", "err", err)
+			return false
+		}
+	}
+	l.Log.Info("re-proving AGG proof range with fewer span proofs", "start", req.StartBlock, "end", req.EndBlock, "spans", len(chain), "merged", len(groups))
+	return true
+}
+
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+func (l *L2OutputSubmitter) RequestQueuedProofs(ctx context.Context) (err error) {
+	if l.Cfg.Follower {
+		return ErrFollower
+	}
+	nextProofToRequest, err := l.nextProofToRequest(&l.db)
+	if err != nil {
+		return err
 	}
 	if nextProofToRequest == nil {
 		return nil
 	}
+	ctx, span := l.tracer.Start(proofTraceContext(ctx, nextProofToRequest), "RequestQueuedProofs", proofSpanAttributes(nextProofToRequest)...)
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
+	// A request to a server whose circuit breaker is open would only fail, so it waits until the server is healthy.
+	if backend := l.proverBackend(nextProofToRequest); l.circuitBreaker.open(backend) {
+		l.Log.Info("not requesting proof, the circuit breaker of its server is open", "id", nextProofToRequest.ID, "server", backend)
+		return nil
+	}
+	if backend := l.proverBackend(nextProofToRequest); l.witnessGenProbe.failed(backend) != nil {
+		l.Log.Info("not requesting proof, its server failed its health probe", "id", nextProofToRequest.ID, "server", backend)
+		return nil
+	}
 
 	if nextProofToRequest.Type == proofrequest.TypeAGG {
+		// Validate the AGG proof's range against the L2OO before checkpointing its L1 block hash.
+		if cancelled, err := l.cancelMisalignedAgg(ctx, nextProofToRequest); err != nil || cancelled {
+			return err
+		}
+
+		// Clear the L1 block info if the checkpoint never landed on-chain, so that the block hash is checkpointed again.
+		if nextProofToRequest.L1BlockHash != "" {
+			checkpointed, err := l.isCheckpointed(ctx, nextProofToRequest.L1BlockNumber, nextProofToRequest.L1BlockHash)
+			if err != nil {
+				return err
+			}
+			if !checkpointed {
+				l.Log.Warn("L1 block hash of AGG request is not checkpointed on-chain, clearing it", "start", nextProofToRequest.StartBlock, "end", nextProofToRequest.EndBlock, "l1BlockNumber", nextProofToRequest.L1BlockNumber, "l1BlockHash", nextProofToRequest.L1BlockHash)
+				l.Metr.RecordError("stale_checkpoint", 1)
+				nextProofToRequest, err = l.db.ClearL1BlockInfo(nextProofToRequest.ID)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
 		if nextProofToRequest.L1BlockHash == "" {
 			// Check if there's an existing agg proof with the same block range that's already failed.
 			existingProofs, err := l.db.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeAGG, nextProofToRequest.StartBlock, nextProofToRequest.EndBlock, proofrequest.StatusFAILED)
@@ -158,6 +586,13 @@ func (l *L2OutputSubmitter) RequestQueuedProofs(ctx context.Context) error {
 			// Loop over existing proofs and if any of them have a checkpointed L1BlockHash, add it to the next proof to request.
 			for _, proof := range existingProofs {
 				if proof.L1BlockHash != "" {
+					checkpointed, err := l.isCheckpointed(ctx, proof.L1BlockNumber, proof.L1BlockHash)
+					if err != nil {
+						return err
+					}
+					if !checkpointed {
+						continue
+					}
 					nextProofToRequest, err = l.db.AddL1BlockInfoToAggRequest(nextProofToRequest.StartBlock, nextProofToRequest.EndBlock, proof.L1BlockNumber, proof.L1BlockHash)
 					if err != nil {
 						l.Log.Error("failed to add L1 block info from existing checkpointed proof to AGG request", "err", err)
@@ -167,10 +602,19 @@ func (l *L2OutputSubmitter) RequestQueuedProofs(ctx context.Context) error {
 				}
 			}
 
-			// If the proof still doesn't have a L1BlockHash, checkpoint the block hash and add it to the request.
+			// If the proof still doesn't have a L1BlockHash, use the block hash that was checkpointed ahead of time,
+			// or checkpoint the block hash, and add it to the request.
 			if nextProofToRequest.L1BlockHash == "" {
-				blockNumber, blockHash, err := l.checkpointBlockHash(ctx)
+				cp, err := l.takePreCheckpoint(ctx, nextProofToRequest)
 				if err != nil {
+					return err
+				}
+				var blockNumber uint64
+				var blockHash common.Hash
+				if cp != nil {
+					blockNumber, blockHash = cp.l1BlockNumber, cp.l1BlockHash
+					l.Log.Info("using pre-checkpointed L1 block hash for AGG request", "start", nextProofToRequest.StartBlock, "end", nextProofToRequest.EndBlock, "l1BlockNumber", blockNumber)
+				} else if blockNumber, blockHash, err = l.transactor.checkpointBlockHash(ctx); err != nil {
 					l.Log.Error("failed to checkpoint block hash", "err", err)
 					return err
 				}
@@ -193,41 +637,123 @@ func (l *L2OutputSubmitter) RequestQueuedProofs(ctx context.Context) error {
 			return fmt.Errorf("failed to count proving proofs: %w", err)
 		}
 
-		// The number of witness generation requests is capped at MAX_CONCURRENT_WITNESS_GEN. This prevents overloading the machine with processes spawned by the witness generation server.
-		// Once https://github.com/anton-rs/kona/issues/553 is fixed, we may be able to remove this check.
-		if witnessGenProofs >= int(l.Cfg.MaxConcurrentWitnessGen) {
-			l.Log.Info("max witness generation reached, waiting for next cycle")
-			return nil
+		// Tiny spans are batched first, so that the limits apply to the request that is dispatched.
+		nextProofToRequest, err = l.batchTinySpans(nextProofToRequest)
+		if err != nil {
+			return fmt.Errorf("failed to batch tiny span proofs: %w", err)
 		}
 
-		// The total number of concurrent proofs is capped at MAX_CONCURRENT_PROOF_REQUESTS.
-		if (witnessGenProofs + provingProofs) >= int(l.Cfg.MaxConcurrentProofRequests) {
-			l.Log.Info("max concurrent proof requests reached, waiting for next cycle")
+		reason, err := l.spanProofBlockedReason(nextProofToRequest, witnessGenProofs, provingProofs)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			l.Log.Info("not requesting span proof, waiting for next cycle", "reason", reason)
 			return nil
 		}
+		if limit, err := l.spanProofLimitReason(nextProofToRequest, witnessGenProofs, provingProofs); err == nil && limit != "" {
+			if o := l.activeBreakGlass(); o != nil {
+				l.Log.Warn("Break-glass override: requesting span proof past its limit", "id", nextProofToRequest.ID, "start", nextProofToRequest.StartBlock, "end", nextProofToRequest.EndBlock, "limit", limit, "reason", o.reason)
+				l.Metr.RecordError("break_glass_override", 1)
+			} else if l.slaEscalated(nextProofToRequest.StartBlock) {
+				l.Log.Warn("Requesting escalated span proof past its limit", "id", nextProofToRequest.ID, "start", nextProofToRequest.StartBlock, "end", nextProofToRequest.EndBlock, "limit", limit, "nextOutputBlock", l.slaEscalationEnd.Load())
+			} else {
+				l.Log.Info("Preempting limit for span proof the next AGG proof waits for", "id", nextProofToRequest.ID, "start", nextProofToRequest.StartBlock, "end", nextProofToRequest.EndBlock, "limit", limit, "nextOutputBlock", l.nextOutputBlock.Load())
+			}
+		}
 	}
-	go func(p ent.ProofRequest) {
-		l.Log.Info("requesting proof from server", "type", p.Type, "start", p.StartBlock, "end", p.EndBlock, "id", p.ID)
-		// Set the proof status to WITNESSGEN.
-		err = l.db.UpdateProofStatus(p.ID, proofrequest.StatusWITNESSGEN)
-		if err != nil {
-			l.Log.Error("failed to update proof status", "err", err)
+	// The request outlives the poll, so it isn't cancelled with ctx, but its spans are added to the poll's trace. It's
+	// drained on shutdown.
+	req := *nextProofToRequest
+	l.inflight.start(tracing.ContextWithSpanContext(l.ctx, tracing.SpanContextFromContext(ctx)), req.ID, func(ctx context.Context) {
+		l.dispatchProofRequest(ctx, req)
+	})
+
+	return nil
+}
+
+// dispatchProofRequest requests the proof from the server, and retries the request if it fails. A request that is
+// turned away because the server is overloaded is put back in the queue instead, since the server never started on it,
+// and a retry would count it as a failure of the range.
+func (l *L2OutputSubmitter) dispatchProofRequest(ctx context.Context, p ent.ProofRequest) {
+	l.Log.Info("requesting proof from server", "type", p.Type, "start", p.StartBlock, "end", p.EndBlock, "id", p.ID)
+	// Set the proof status to WITNESSGEN.
+	err := l.db.UpdateProofStatus(p.ID, proofrequest.StatusWITNESSGEN)
+	if err != nil {
+		l.Log.Error("failed to update proof status", "err", err)
+		return
+	}
+	p.Status = proofrequest.StatusWITNESSGEN
+
+	// Record the backend the request is sent to, which its status is polled from.
+	if backend := l.proverBackend(&p); backend != p.ProverBackend {
+		if err := l.db.SetProverBackend(p.ID, backend); err != nil {
+			l.Log.Error("failed to set prover backend", "err", err)
 			return
 		}
+		p.ProverBackend = backend
+	}
+	l.recordL2BlockHash(ctx, &p)
 
-		// Request the type of proof depending on the mock configuration.
-		err = l.RequestProof(p, l.Cfg.Mock)
-		if err != nil {
-			// If the proof fails to be requested, we should add it to the queue to be retried.
-			err = l.RetryRequest(nextProofToRequest, ProofStatusResponse{})
-			if err != nil {
-				l.Log.Error("failed to retry request", "err", err)
-			}
+	l.requestProofFromServer(ctx, p)
+}
 
+// requestProofFromServer sends the proof request, which is in WITNESSGEN, to its backend, and retries it if it fails.
+func (l *L2OutputSubmitter) requestProofFromServer(ctx context.Context, p ent.ProofRequest) {
+	ctx, span := l.tracer.Start(ctx, "requestProofFromServer", append(proofSpanAttributes(&p), tracing.String("backend", l.proverBackend(&p)))...)
+	defer span.End()
+
+	// Request the type of proof depending on the mock configuration.
+	err := l.RequestProof(ctx, p, l.Cfg.Mock)
+	span.RecordError(err)
+	if err != nil && ctx.Err() != nil {
+		// The request was cancelled at the end of the shutdown grace period. It's left in WITNESSGEN, and resumed or
+		// requeued on restart.
+		l.Log.Info("proof request was interrupted by shutdown", "type", p.Type, "start", p.StartBlock, "end", p.EndBlock, "id", p.ID, "err", err)
+		return
+	}
+	if errors.Is(err, ErrServerOverloaded) {
+		l.Log.Info("server is overloaded, requeuing proof request", "type", p.Type, "start", p.StartBlock, "end", p.EndBlock, "id", p.ID)
+		if err := l.db.TransitionProofStatus(p.ID, proofrequest.StatusWITNESSGEN, proofrequest.StatusUNREQ); err != nil {
+			l.Log.Error("failed to requeue proof request", "err", err)
 		}
-	}(*nextProofToRequest)
-
-	return nil
+		return
+	}
+	if errors.Is(err, db.ErrProofStatusChanged) {
+		l.Log.Info("proof request was cancelled while it was being requested", "type", p.Type, "start", p.StartBlock, "end", p.EndBlock, "id", p.ID)
+		return
+	}
+	if err != nil {
+		// If the proof fails to be requested, we should add it to the queue to be retried.
+		status := ProofStatusResponse{}
+		var serverErr *ServerError
+		if errors.As(err, &serverErr) {
+			// Keep the server's error on the request, since the log is gone by the time someone looks into it.
+			if err := l.db.SetErrorMessage(p.ID, serverErr.Message); err != nil {
+				l.Log.Error("failed to set error message", "err", err)
+			}
+			if serverErr.Permanent() {
+				l.Log.Error("proof request failed permanently, not retrying", "type", p.Type, "start", p.StartBlock, "end", p.EndBlock, "id", p.ID, "code", serverErr.Code, "err", serverErr.Message)
+				l.Metr.RecordError("proof_request_failed_permanently", 1)
+				if err := l.db.TransitionProofStatus(p.ID, proofrequest.StatusWITNESSGEN, proofrequest.StatusFAILED); err != nil {
+					l.Log.Error("failed to update proof status", "err", err)
+				}
+				return
+			}
+			// Retrying the same request won't succeed, so it's split right away, as if it were unexecutable.
+			if !serverErr.Retryable {
+				status.ExecutionStatus = SP1ExecutionStatusUnexecutable
+			} else if retried, err := l.retryOnOtherReplica(&p); err != nil {
+				l.Log.Error("failed to retry request on another replica", "err", err)
+				return
+			} else if retried {
+				return
+			}
+		}
+		if err := l.RetryRequest(&p, status); err != nil {
+			l.Log.Error("failed to retry request", "err", err)
+		}
+	}
 }
 
 // Use the L2OO contract to look up the range of blocks that the next proof must cover.
@@ -244,8 +770,17 @@ func (l *L2OutputSubmitter) DeriveAggProofs(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to get next L2OO output: %w", err)
 	}
+	l.nextOutputBlock.Store(minTo.Uint64())
+	if l.Cfg.SLAMaxUnprovenAge > 0 {
+		if err := l.updateSLAEscalation(ctx, latest.Uint64(), minTo.Uint64()); err != nil {
+			l.Log.Warn("failed to check the age of the oldest unproven block", "err", err)
+		}
+	}
 
-	created, end, err := l.db.TryCreateAggProofFromSpanProofs(latest.Uint64(), minTo.Uint64())
+	if l.Cfg.AggBoundaryPolicy == AggBoundaryAlign {
+		return l.deriveAlignedAggProof(latest.Uint64(), minTo.Uint64())
+	}
+	created, end, err := l.db.TryCreateAggProofFromSpanProofs(latest.Uint64(), minTo.Uint64(), l.settings().AggProofTimeout)
 	if err != nil {
 		return fmt.Errorf("failed to create agg proof from span proofs: %w", err)
 	}
@@ -256,7 +791,9 @@ func (l *L2OutputSubmitter) DeriveAggProofs(ctx context.Context) error {
 	return nil
 }
 
-func (l *L2OutputSubmitter) prepareProofRequest(p ent.ProofRequest) ([]byte, error) {
+// prepareProofRequest builds the body of the request for a proof. With byReference, the subproofs of an AGG proof are
+// sent by their prover network request IDs where possible, so their proofs aren't loaded into memory.
+func (l *L2OutputSubmitter) prepareProofRequest(p ent.ProofRequest, byReference bool) ([]byte, error) {
 	if p.Type == proofrequest.TypeSPAN {
 		if p.StartBlock >= p.EndBlock {
 			return nil, fmt.Errorf("l2Start must be less than l2End")
@@ -272,13 +809,16 @@ func (l *L2OutputSubmitter) prepareProofRequest(p ent.ProofRequest) ([]byte, err
 		}
 		return jsonBody, nil
 	} else {
-		subproofs, err := l.db.GetConsecutiveSpanProofs(p.StartBlock, p.EndBlock)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get subproofs: %w", err)
+		requestBody := AggProofRequest{L1Head: p.L1BlockHash}
+		if byReference {
+			ids, err := l.subproofIDs(p)
+			if err != nil {
+				return nil, err
+			}
+			requestBody.SubproofIDs = ids
 		}
-		requestBody := AggProofRequest{
-			Subproofs: subproofs,
-			L1Head:    p.L1BlockHash,
+		if requestBody.SubproofIDs == nil {
+			return l.encodeAggProofRequest(p)
 		}
 		jsonBody, err := json.Marshal(requestBody)
 		if err != nil {
@@ -288,21 +828,96 @@ func (l *L2OutputSubmitter) prepareProofRequest(p ent.ProofRequest) ([]byte, err
 	}
 }
 
+// encodeAggProofRequest builds the body of an AGG proof request that embeds its subproofs. The body is encoded like an
+// AggProofRequest, but the subproofs are read and encoded one at a time, so only the body and a single span proof are
+// held in memory, rather than every span proof on top of the body.
+func (l *L2OutputSubmitter) encodeAggProofRequest(p ent.ProofRequest) ([]byte, error) {
+	chain, err := l.db.GetConsecutiveSpanProofs(p.StartBlock, p.EndBlock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subproofs: %w", err)
+	}
+	head, err := json.Marshal(p.L1BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	var body bytes.Buffer
+	body.WriteString("{")
+	if len(chain) > 0 {
+		body.WriteString(`"subproofs":[`)
+		for i, span := range chain {
+			proof, err := l.db.ReadProof(span)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get subproof %d-%d: %w", span.StartBlock, span.EndBlock, err)
+			}
+			if i > 0 {
+				body.WriteString(",")
+			}
+			// Byte slices are encoded as base64 strings in JSON.
+			body.WriteString(`"`)
+			enc := base64.NewEncoder(base64.StdEncoding, &body)
+			if _, err := enc.Write(proof); err != nil {
+				return nil, err
+			}
+			if err := enc.Close(); err != nil {
+				return nil, err
+			}
+			body.WriteString(`"`)
+		}
+		body.WriteString("],")
+	}
+	body.WriteString(`"head":`)
+	body.Write(head)
+	body.WriteString("}")
+	return body.Bytes(), nil
+}
+
+// subproofIDs returns the prover network request IDs of the span proofs that an AGG proof aggregates, or nil if any of
+// them has none, e.g. because it was imported, in which case the subproofs must be sent by value.
+func (l *L2OutputSubmitter) subproofIDs(p ent.ProofRequest) ([]string, error) {
+	chain, err := l.db.GetSpanProofChainRefs(p.StartBlock, p.EndBlock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subproofs: %w", err)
+	}
+	ids := make([]string, len(chain))
+	for i, span := range chain {
+		if span.ProverRequestID == "" {
+			l.Log.Info("Sending the subproofs of the AGG proof by value, since a span proof has no prover request ID", "start", p.StartBlock, "end", p.EndBlock, "spanStart", span.StartBlock, "spanEnd", span.EndBlock)
+			return nil, nil
+		}
+		ids[i] = span.ProverRequestID
+	}
+	return ids, nil
+}
+
 // RequestProof handles both mock and real proof requests
-func (l *L2OutputSubmitter) RequestProof(p ent.ProofRequest, isMock bool) error {
-	jsonBody, err := l.prepareProofRequest(p)
+func (l *L2OutputSubmitter) RequestProof(ctx context.Context, p ent.ProofRequest, isMock bool) (err error) {
+	// Mock span proofs aren't on the prover network, so mock AGG proof requests always embed their subproofs.
+	byReference := l.Cfg.AggSubproofsByReference && !isMock
+	ctx, span := l.tracer.Start(ctx, "RequestProof", tracing.Bool("mock", isMock), tracing.Bool("subproofs_by_reference", byReference))
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+	jsonBody, err := l.prepareProofRequest(p, byReference)
+	if err != nil {
+		return err
+	}
+	idempotencyKey, err := l.idempotencyKey(p)
 	if err != nil {
 		return err
 	}
 
+	start := time.Now()
 	if isMock {
-		proofData, err := l.requestMockProof(p.Type, jsonBody)
+		proofData, err := l.requestMockProof(ctx, p, jsonBody, idempotencyKey)
 		if err != nil {
 			return fmt.Errorf("mock proof request failed: %w", err)
 		}
+		l.Metr.RecordWitnessGenDuration(p.Type.String(), p.EndBlock-p.StartBlock, time.Since(start))
 
 		// For mock proofs, once the "mock proof" has been generated, set the status to PROVING. AddFulfilledProof expects the proof to be in the PROVING status.
-		err = l.db.UpdateProofStatus(p.ID, proofrequest.StatusPROVING)
+		err = l.db.TransitionProofStatus(p.ID, proofrequest.StatusWITNESSGEN, proofrequest.StatusPROVING)
 		if err != nil {
 			return fmt.Errorf("failed to set proof status to proving: %w", err)
 		}
@@ -310,93 +925,219 @@ func (l *L2OutputSubmitter) RequestProof(p ent.ProofRequest, isMock bool) error
 	}
 
 	// Request a real proof from the witness generation server. Returns the proof ID from the network.
-	proofID, err := l.requestRealProof(p.Type, jsonBody)
+	response, err := l.requestRealProof(ctx, p, jsonBody, idempotencyKey)
+	var serverErr *ServerError
+	if byReference && errors.As(err, &serverErr) && serverErr.Code == "subproof_unavailable" {
+		l.Log.Warn("Server couldn't fetch the subproofs of the AGG proof, sending them by value", "start", p.StartBlock, "end", p.EndBlock, "err", serverErr.Message)
+		if jsonBody, err = l.prepareProofRequest(p, false); err != nil {
+			return err
+		}
+		response, err = l.requestRealProof(ctx, p, jsonBody, idempotencyKey)
+	}
 	if err != nil {
 		return fmt.Errorf("real proof request failed: %w", err)
 	}
+	l.Metr.RecordWitnessGenDuration(p.Type.String(), p.EndBlock-p.StartBlock, time.Since(start))
 
 	// Set the proof status to PROVING once the prover ID has been retrieved. Only proofs with status PROVING, SUCCESS or FAILED have a prover request ID.
-	err = l.db.UpdateProofStatus(p.ID, proofrequest.StatusPROVING)
+	err = l.db.TransitionProofStatus(p.ID, proofrequest.StatusWITNESSGEN, proofrequest.StatusPROVING)
 	if err != nil {
 		return fmt.Errorf("failed to set proof status to proving: %w", err)
 	}
 
-	return l.db.SetProverRequestID(p.ID, proofID)
+	if response.ArtifactID != "" {
+		if err := l.db.SetWitnessArtifactID(p.ID, response.ArtifactID); err != nil {
+			return err
+		}
+	}
+	return l.db.SetProverRequestID(p.ID, response.ProofID)
+}
+
+// idempotencyKey returns the key that the HTTP requests for a proof request are sent with, generating and persisting one
+// if the request doesn't have one yet. The key belongs to the DB row, so retried HTTP requests share it, while a failed
+// proof is retried with a new row and gets a new key.
+func (l *L2OutputSubmitter) idempotencyKey(p ent.ProofRequest) (string, error) {
+	if p.IdempotencyKey != "" {
+		return p.IdempotencyKey, nil
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate idempotency key: %w", err)
+	}
+	key := hex.EncodeToString(b)
+	if err := l.db.SetIdempotencyKey(p.ID, key); err != nil {
+		return "", err
+	}
+	return key, nil
 }
 
-func (l *L2OutputSubmitter) requestRealProof(proofType proofrequest.Type, jsonBody []byte) ([]byte, error) {
-	resp, err := l.makeProofRequest(proofType, jsonBody)
+func (l *L2OutputSubmitter) requestRealProof(ctx context.Context, p ent.ProofRequest, jsonBody []byte, idempotencyKey string) (WitnessGenerationResponse, error) {
+	resp, err := l.makeProofRequest(ctx, p, jsonBody, idempotencyKey)
 	if err != nil {
-		return nil, err
+		return WitnessGenerationResponse{}, err
 	}
 
 	var response WitnessGenerationResponse
-	if err := json.Unmarshal(resp, &response); err != nil {
-		return nil, fmt.Errorf("error decoding JSON response: %w", err)
+	if err := l.decodeServerResponse(l.getProofEndpoint(p.Type), resp, &response); err != nil {
+		return WitnessGenerationResponse{}, err
 	}
 	// Format the proof ID as a hex string.
 	proofIdHex := fmt.Sprintf("%x", response.ProofID)
 	l.Log.Info("successfully submitted proof", "proofID", proofIdHex)
-	return response.ProofID, nil
+	return response, nil
 }
 
 // Request a mock proof from the witness generation server.
-func (l *L2OutputSubmitter) requestMockProof(proofType proofrequest.Type, jsonBody []byte) ([]byte, error) {
-	resp, err := l.makeProofRequest(proofType, jsonBody)
+func (l *L2OutputSubmitter) requestMockProof(ctx context.Context, p ent.ProofRequest, jsonBody []byte, idempotencyKey string) ([]byte, error) {
+	resp, err := l.makeProofRequest(ctx, p, jsonBody, idempotencyKey)
 	if err != nil {
 		return nil, err
 	}
 
 	var response ProofStatusResponse
-	if err := json.Unmarshal(resp, &response); err != nil {
-		return nil, fmt.Errorf("error decoding JSON response: %w", err)
+	if err := l.decodeServerResponse(l.getProofEndpoint(p.Type), resp, &response); err != nil {
+		return nil, err
 	}
 
 	return response.Proof, nil
 }
 
 // Make a proof request to the witness generation server for the correct proof type.
-func (l *L2OutputSubmitter) makeProofRequest(proofType proofrequest.Type, jsonBody []byte) ([]byte, error) {
-	urlPath := l.getProofEndpoint(proofType)
-	req, err := http.NewRequest("POST", l.Cfg.OPSuccinctServerUrl+"/"+urlPath, bytes.NewBuffer(jsonBody))
+func (l *L2OutputSubmitter) makeProofRequest(ctx context.Context, p ent.ProofRequest, jsonBody []byte, idempotencyKey string) ([]byte, error) {
+	return l.makeProofRequestToEndpoint(ctx, l.proverBackend(&p), l.getProofEndpoint(p.Type), jsonBody, idempotencyKey, p.EndBlock-p.StartBlock)
+}
+
+// Make a proof request to a specific endpoint of a witness generation server. Requests with an idempotency key are
+// retried with exponential backoff after network errors and gateway errors, which the server deduplicates by the key.
+// Requests without a key are never retried, since a retry could start a duplicate witness generation run. Failures are
+// recorded by the number of blocks in the requested range. The current span in ctx is propagated to the server.
+func (l *L2OutputSubmitter) makeProofRequestToEndpoint(ctx context.Context, serverUrl, urlPath string, jsonBody []byte, idempotencyKey string, rangeSize uint64) ([]byte, error) {
+	backoff := l.Cfg.WitnessGenRetryBackoff
+	for attempt := uint64(1); ; attempt++ {
+		body, retryable, err := l.sendProofRequest(ctx, serverUrl, urlPath, jsonBody, idempotencyKey, rangeSize)
+		if err == nil || !retryable || idempotencyKey == "" || attempt > l.Cfg.WitnessGenRetries {
+			return body, err
+		}
+
+		l.Log.Warn("Witness generation request failed, retrying", "endpoint", urlPath, "attempt", attempt, "backoff", backoff, "err", err)
+		l.Metr.RecordWitnessGenFailure("Retried", rangeSize, l.traceID(ctx))
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		backoff *= 2
+	}
+}
+
+// sendProofRequest sends a single proof request to the witness generation server. Returns whether the request failed
+// in a way that is safe to retry with the same idempotency key.
+func (l *L2OutputSubmitter) sendProofRequest(ctx context.Context, serverUrl, urlPath string, jsonBody []byte, idempotencyKey string, rangeSize uint64) ([]byte, bool, error) {
+	compress := l.compressesProofRequest(serverUrl, jsonBody)
+	body := jsonBody
+	if compress {
+		var err error
+		if body, err = gzipBody(jsonBody); err != nil {
+			return nil, false, fmt.Errorf("failed to compress request: %w", err)
+		}
+		l.Log.Debug("Compressed proof request", "endpoint", urlPath, "size", len(jsonBody), "compressedSize", len(body))
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", serverUrl+"/"+urlPath, bytes.NewBuffer(body))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	if compress {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	tracing.Inject(ctx, req.Header)
 
 	timeout := time.Duration(l.Cfg.WitnessGenTimeout) * time.Second
 	client := &http.Client{Timeout: timeout}
+	sent := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
+		// A request cancelled by the proposer says nothing about the server, so it isn't counted as a failure.
+		if ctx.Err() != nil {
+			return nil, false, fmt.Errorf("request cancelled: %w", ctx.Err())
+		}
 		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
 			l.Log.Error("Witness generation request timed out", "err", err)
-			l.Metr.RecordWitnessGenFailure("Timeout")
-			return nil, fmt.Errorf("request timed out after %s: %w", timeout, err)
+			l.Metr.RecordWitnessGenFailure("Timeout", rangeSize, l.traceID(ctx))
+			l.onServerRequestFailed(serverUrl)
+			l.observeWitnessGen(time.Since(sent), true)
+			// The server may still be generating the witness, which the WITNESSGEN timeout catches, so don't retry.
+			return nil, false, fmt.Errorf("request timed out after %s: %w", timeout, err)
 		}
 		l.Log.Error("Witness generation request failed", "err", err)
-		return nil, fmt.Errorf("failed to send request: %w", err)
+		l.backendHealth.onUnreachable(serverUrl, time.Now())
+		l.onServerRequestFailed(serverUrl)
+		return nil, true, fmt.Errorf("failed to send request: %w", err)
 	}
 	defer resp.Body.Close()
+	l.backendHealth.onReachable(serverUrl)
+	l.recordRequestEncodings(serverUrl, resp.Header)
+
+	// A server that stopped accepting compressed bodies, e.g. because it was downgraded, rejects them with 415, so the
+	// request is sent again uncompressed.
+	if compress && resp.StatusCode == http.StatusUnsupportedMediaType {
+		l.Log.Warn("Server doesn't accept compressed proof requests, sending the request uncompressed", "server", serverUrl)
+		l.gzipBackends.Store(serverUrl, false)
+		return l.sendProofRequest(ctx, serverUrl, urlPath, jsonBody, idempotencyKey, rangeSize)
+	}
+
+	// Treat 503 and 429 responses as back-pressure from the server and temporarily lower the witness generation limit.
+	if resp.StatusCode == http.StatusServiceUnavailable || resp.StatusCode == http.StatusTooManyRequests {
+		limit := l.witnessGenLimiter.OnOverloaded()
+		l.Log.Warn("Witness generation server is overloaded, reducing concurrency",
+			"status", resp.StatusCode,
+			"limit", limit)
+		l.Metr.RecordWitnessGenFailure("Overloaded", rangeSize, l.traceID(ctx))
+		l.Metr.RecordWitnessGenLimit(limit)
+		l.observeWitnessGen(time.Since(sent), true)
+		return nil, false, fmt.Errorf("%w: received status code %d", ErrServerOverloaded, resp.StatusCode)
+	}
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		var errResp struct {
-			Error string `json:"error"`
+		serverErr := parseServerError(resp.StatusCode, body)
+		l.Log.Error("Witness generation request failed",
+			"status", resp.StatusCode,
+			"code", serverErr.Code,
+			"error", serverErr.Message,
+			"retryable", serverErr.Retryable)
+		l.Metr.RecordWitnessGenFailure("Failed", rangeSize, l.traceID(ctx))
+		if l.backendHealth.onWitnessGenResult(serverUrl, serverErr.Retryable) {
+			l.Log.Warn("Evicting witness generation server, its requests keep failing", "server", serverUrl, "cooldown", evictionCooldown)
+			l.Metr.RecordError("witness_gen_server_evicted", 1)
 		}
-		if err := json.Unmarshal(body, &errResp); err == nil {
-			l.Log.Error("Witness generation request failed",
-				"status", resp.StatusCode,
-				"error", errResp.Error)
+		// Requests rejected for being invalid show the server is up, so only server-side failures trip the breaker.
+		if serverErr.Retryable {
+			l.onServerRequestFailed(serverUrl)
+			l.observeWitnessGen(time.Since(sent), true)
 		} else {
-			l.Log.Error("Witness generation request failed",
-				"status", resp.StatusCode,
-				"body", string(body))
+			l.circuitBreaker.onSuccess(serverUrl)
 		}
-		l.Metr.RecordWitnessGenFailure("Failed")
-		return nil, fmt.Errorf("received non-200 status code: %d", resp.StatusCode)
+		// Gateway errors come from a proxy in front of the server, so the request may not have reached it, and can be
+		// sent again with the same idempotency key right away.
+		resend := resp.StatusCode == http.StatusBadGateway || resp.StatusCode == http.StatusGatewayTimeout
+		return nil, resend, serverErr
 	}
 
-	return io.ReadAll(resp.Body)
+	// The server accepted the request, so gradually recover the witness generation limit.
+	l.Metr.RecordWitnessGenLimit(l.witnessGenLimiter.OnAccepted())
+	l.observeWitnessGen(time.Since(sent), false)
+	l.backendHealth.onWitnessGenResult(serverUrl, false)
+	l.circuitBreaker.onSuccess(serverUrl)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return body, false, nil
 }
 
 func (l *L2OutputSubmitter) getProofEndpoint(proofType proofrequest.Type) string {
@@ -412,41 +1153,51 @@ func (l *L2OutputSubmitter) getProofEndpoint(proofType proofrequest.Type) string
 	}
 }
 
-// Get the status of a proof given its ID.
-func (l *L2OutputSubmitter) GetProofStatus(proofId string) (ProofStatusResponse, error) {
-	req, err := http.NewRequest("GET", l.Cfg.OPSuccinctServerUrl+"/status/"+proofId, nil)
+// Get the status of a proof given its ID from the server it was requested from. The request is cancelled with ctx.
+func (l *L2OutputSubmitter) GetProofStatus(ctx context.Context, serverUrl, proofId string) (ProofStatusResponse, error) {
+	return l.getProofStatus(ctx, serverUrl, "/status/"+proofId, PROOF_STATUS_TIMEOUT)
+}
+
+// WaitForProofStatus long-polls the status of a proof: the server only responds once the fulfillment status differs
+// from since, or after wait.
+func (l *L2OutputSubmitter) WaitForProofStatus(ctx context.Context, serverUrl, proofId string, since SP1FulfillmentStatus, wait time.Duration) (ProofStatusResponse, error) {
+	urlPath := fmt.Sprintf("/status/%s?wait=%d&since=%d", proofId, int64(wait.Seconds()), since)
+	return l.getProofStatus(ctx, serverUrl, urlPath, wait+PROOF_STATUS_TIMEOUT)
+}
+
+func (l *L2OutputSubmitter) getProofStatus(ctx context.Context, serverUrl, urlPath string, timeout time.Duration) (ProofStatusResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", serverUrl+urlPath, nil)
 	if err != nil {
 		return ProofStatusResponse{}, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	client := &http.Client{
-		Timeout: PROOF_STATUS_TIMEOUT,
+		Timeout: timeout,
 	}
 	resp, err := client.Do(req)
 	if err != nil {
+		if ctx.Err() != nil {
+			return ProofStatusResponse{}, ctx.Err()
+		}
+		l.backendHealth.onUnreachable(serverUrl, time.Now())
 		if err, ok := err.(net.Error); ok && err.Timeout() {
-			return ProofStatusResponse{}, fmt.Errorf("request timed out after %s: %w", PROOF_STATUS_TIMEOUT, err)
+			return ProofStatusResponse{}, fmt.Errorf("request timed out after %s: %w", timeout, err)
 		}
 		return ProofStatusResponse{}, fmt.Errorf("failed to send request: %w", err)
 	}
 	defer resp.Body.Close()
+	l.backendHealth.onReachable(serverUrl)
+	l.recordRequestEncodings(serverUrl, resp.Header)
 
 	// If the response status code is not 200, return an error.
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(body, &errResp); err == nil {
-			l.Log.Error("Failed to get proof status",
-				"status", resp.StatusCode,
-				"error", errResp.Error)
-		} else {
-			l.Log.Error("Failed to get unmarshal proof status error message",
-				"status", resp.StatusCode,
-				"body", body)
-		}
-		return ProofStatusResponse{}, fmt.Errorf("received non-200 status code: %d", resp.StatusCode)
+		serverErr := parseServerError(resp.StatusCode, body)
+		l.Log.Error("Failed to get proof status",
+			"status", resp.StatusCode,
+			"code", serverErr.Code,
+			"error", serverErr.Message)
+		return ProofStatusResponse{}, serverErr
 	}
 
 	// Read the response body
@@ -455,21 +1206,18 @@ func (l *L2OutputSubmitter) GetProofStatus(proofId string) (ProofStatusResponse,
 		return ProofStatusResponse{}, fmt.Errorf("error reading the response body: %v", err)
 	}
 
-	// Create a variable of the Response type
+	// Decode the response, and check that it matches the expected schema.
 	var proofStatus ProofStatusResponse
-
-	// Unmarshal the JSON into the response variable
-	err = json.Unmarshal(body, &proofStatus)
-	if err != nil {
-		return ProofStatusResponse{}, fmt.Errorf("error decoding JSON response: %v", err)
+	if err := l.decodeServerResponse("status", body, &proofStatus); err != nil {
+		return ProofStatusResponse{}, err
 	}
 
 	return proofStatus, nil
 }
 
 // Validate the contract's configuration of the aggregation and range verification keys as well
-// as the rollup config hash.
-func (l *L2OutputSubmitter) ValidateConfig(address string) error {
+// as the rollup config hash, on every OP Succinct server. Retries stop when ctx is done.
+func (l *L2OutputSubmitter) ValidateConfig(ctx context.Context, address string) error {
 	l.Log.Info("requesting config validation", "address", address)
 	requestBody := ValidateConfigRequest{
 		Address: address,
@@ -479,12 +1227,20 @@ func (l *L2OutputSubmitter) ValidateConfig(address string) error {
 		return fmt.Errorf("failed to marshal request body: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", l.Cfg.OPSuccinctServerUrl+"/validate_config", bytes.NewBuffer(jsonBody))
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+	servers := l.Cfg.witnessGenServers()
+	for _, server := range servers {
+		if err := l.validateServerConfig(ctx, server, jsonBody); err != nil {
+			if len(servers) > 1 {
+				return fmt.Errorf("server %s: %w", server, err)
+			}
+			return err
+		}
 	}
-	req.Header.Set("Content-Type", "application/json")
+	return nil
+}
 
+// validateServerConfig requests the validation of the contract's configuration from one OP Succinct server.
+func (l *L2OutputSubmitter) validateServerConfig(ctx context.Context, server string, jsonBody []byte) error {
 	client := &http.Client{
 		Timeout: PROOF_STATUS_TIMEOUT,
 	}
@@ -495,10 +1251,20 @@ func (l *L2OutputSubmitter) ValidateConfig(address string) error {
 	var resp *http.Response
 
 	for i := 0; i < maxRetries; i++ {
+		// The request is created for each attempt, since sending it consumes its body.
+		req, err := http.NewRequestWithContext(ctx, "POST", server+"/validate_config", bytes.NewBuffer(jsonBody))
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
 		resp, err = client.Do(req)
 		if err == nil && resp.StatusCode == http.StatusOK {
 			break
 		}
+		if err == nil {
+			resp.Body.Close()
+		}
 		if i == maxRetries-1 {
 			if err != nil {
 				if err, ok := err.(net.Error); ok && err.Timeout() {
@@ -509,8 +1275,12 @@ func (l *L2OutputSubmitter) ValidateConfig(address string) error {
 			return fmt.Errorf("server not healthy after %d retries", maxRetries)
 		}
 
-		l.Log.Info("server not ready, retrying", "attempt", i+1, "backoff", backoff)
-		time.Sleep(backoff)
+		l.Log.Info("server not ready, retrying", "server", server, "attempt", i+1, "backoff", backoff)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return fmt.Errorf("server not healthy after %d attempts: %w", i+1, ctx.Err())
+		}
 		backoff *= 2
 	}
 	defer resp.Body.Close()
@@ -521,13 +1291,10 @@ func (l *L2OutputSubmitter) ValidateConfig(address string) error {
 		return fmt.Errorf("error reading the response body: %v", err)
 	}
 
-	// Create a variable of the ValidateConfigResponse type
+	// Decode the response, and check that it matches the expected schema.
 	var response ValidateConfigResponse
-
-	// Unmarshal the JSON into the response variable
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return fmt.Errorf("error decoding JSON response: %v", err)
+	if err := l.decodeServerResponse("validate_config", body, &response); err != nil {
+		return err
 	}
 
 	var invalidConfigs []string
@@ -546,3 +1313,30 @@ func (l *L2OutputSubmitter) ValidateConfig(address string) error {
 
 	return nil
 }
+
+// GetServerVersion returns the SP1 circuit version and the program vkeys of the op-succinct-server.
+func (l *L2OutputSubmitter) GetServerVersion(ctx context.Context) (VersionResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", l.Cfg.OPSuccinctServerUrl+"/version", nil)
+	if err != nil {
+		return VersionResponse{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	client := &http.Client{Timeout: PROOF_STATUS_TIMEOUT}
+	resp, err := client.Do(req)
+	if err != nil {
+		return VersionResponse{}, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return VersionResponse{}, fmt.Errorf("received non-200 status code: %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return VersionResponse{}, fmt.Errorf("error reading the response body: %v", err)
+	}
+
+	var version VersionResponse
+	if err := l.decodeServerResponse("version", body, &version); err != nil {
+		return VersionResponse{}, err
+	}
+	return version, nil
+}
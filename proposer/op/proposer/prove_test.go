@@ -0,0 +1,587 @@
+package proposer
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+func TestMakeProofRequestRetries(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if len(keys) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg: ProposerConfig{
+				OPSuccinctServerUrl: server.URL,
+				WitnessGenTimeout:   10,
+				WitnessGenRetries:   2,
+			},
+		},
+		ctx:               context.Background(),
+		witnessGenLimiter: newWitnessGenLimiter(1),
+	}
+
+	// Retried with the same key until the server accepts the request.
+	body, err := l.makeProofRequestToEndpoint(context.Background(), server.URL, "request_span_proof", nil, "key", 10)
+	require.NoError(t, err)
+	require.Equal(t, "ok", string(body))
+	require.Equal(t, []string{"key", "key", "key"}, keys)
+
+	// Requests without a key are never retried.
+	keys = nil
+	_, err = l.makeProofRequestToEndpoint(context.Background(), server.URL, "request_span_proof", nil, "", 10)
+	require.Error(t, err)
+	require.Len(t, keys, 1)
+}
+
+func TestProofRequestCompression(t *testing.T) {
+	var encodings []string
+	acceptGzip := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			if !acceptGzip {
+				w.WriteHeader(http.StatusUnsupportedMediaType)
+				return
+			}
+			zr, err := gzip.NewReader(r.Body)
+			require.NoError(t, err)
+			r.Body = io.NopCloser(zr)
+		}
+		if acceptGzip {
+			w.Header().Set("Accept-Encoding", "gzip")
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{WitnessGenTimeout: 10, ProofRequestGzipThreshold: 8},
+		},
+		ctx:               context.Background(),
+		witnessGenLimiter: newWitnessGenLimiter(1),
+	}
+	large := []byte(`{"subproofs":["0123456789abcdef"]}`)
+
+	// Bodies are only compressed once the server advertised that it accepts them, and only from the threshold.
+	for _, body := range [][]byte{large, []byte(`{}`), large} {
+		echoed, _, err := l.sendProofRequest(context.Background(), server.URL, "request_agg_proof", body, "", 10)
+		require.NoError(t, err)
+		require.Equal(t, body, echoed)
+	}
+	require.Equal(t, []string{"", "", "gzip"}, encodings)
+
+	// A server that rejects compressed bodies gets the request again uncompressed.
+	encodings, acceptGzip = nil, false
+	echoed, _, err := l.sendProofRequest(context.Background(), server.URL, "request_agg_proof", large, "", 10)
+	require.NoError(t, err)
+	require.Equal(t, large, echoed)
+	require.Equal(t, []string{"gzip", ""}, encodings)
+}
+
+func TestProofRequestCancellation(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{WitnessGenTimeout: 10, CircuitBreakerThreshold: 1, CircuitBreakerBackoff: time.Minute},
+		},
+		ctx:               context.Background(),
+		witnessGenLimiter: newWitnessGenLimiter(1),
+	}
+
+	// The request is aborted once its context is cancelled, without waiting for the witness generation timeout.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, retryable, err := l.sendProofRequest(ctx, server.URL, "request_span_proof", nil, "key", 10)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.False(t, retryable)
+
+	// A cancelled request isn't held against the server.
+	require.False(t, l.circuitBreaker.open(server.URL))
+	require.Zero(t, l.backendHealth.unreachableFor(server.URL, time.Now().Add(time.Second)))
+	_, err = l.GetProofStatus(ctx, server.URL, "id")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestPrepareAggProofRequestByReference(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 150, 0))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 150, 200, 0))
+	spans, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, span := range spans {
+		require.NoError(t, proofDB.UpdateProofStatus(span.ID, proofrequest.StatusPROVING))
+		require.NoError(t, proofDB.SetProverRequestID(span.ID, []byte{byte(span.StartBlock)}))
+		require.NoError(t, proofDB.AddFulfilledProof(span.ID, []byte{byte(span.StartBlock)}))
+	}
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 200, 250, 0))
+	imported, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, 200, 250, proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.NoError(t, proofDB.UpdateProofStatus(imported[0].ID, proofrequest.StatusPROVING))
+	require.NoError(t, proofDB.AddFulfilledProof(imported[0].ID, []byte{200}))
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+		},
+		db: *proofDB,
+	}
+	prepare := func(end uint64, byReference bool) AggProofRequest {
+		body, err := l.prepareProofRequest(ent.ProofRequest{Type: proofrequest.TypeAGG, StartBlock: 100, EndBlock: end, L1BlockHash: "0x01"}, byReference)
+		require.NoError(t, err)
+		var req AggProofRequest
+		require.NoError(t, json.Unmarshal(body, &req))
+		return req
+	}
+
+	// The subproofs are referenced by their prover request IDs instead of being embedded.
+	req := prepare(200, true)
+	require.Equal(t, []string{"64", "96"}, req.SubproofIDs)
+	require.Nil(t, req.Subproofs)
+
+	// Span proofs without a prover request ID, e.g. imported ones, are only sent by value.
+	req = prepare(250, true)
+	require.Nil(t, req.SubproofIDs)
+	require.Equal(t, [][]byte{{100}, {150}, {200}}, req.Subproofs)
+
+	req = prepare(200, false)
+	require.Nil(t, req.SubproofIDs)
+	require.Equal(t, [][]byte{{100}, {150}}, req.Subproofs)
+
+	// Subproofs that are encoded one at a time give the same body as encoding them at once.
+	body, err := l.prepareProofRequest(ent.ProofRequest{Type: proofrequest.TypeAGG, StartBlock: 100, EndBlock: 250, L1BlockHash: "0x01"}, false)
+	require.NoError(t, err)
+	expected, err := json.Marshal(AggProofRequest{Subproofs: [][]byte{{100}, {150}, {200}}, L1Head: "0x01"})
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(body))
+}
+
+func TestResumeProvingRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/status/ab", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(ProofStatusResponse{
+			FulfillmentStatus: SP1FulfillmentStatusFulfilled,
+			Proof:             []byte("proof"),
+		}))
+	}))
+	defer server.Close()
+
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	// A request that was fulfilled while the proposer was stopped, and one that was stopped before its prover request
+	// ID was stored.
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 200, 300, 0))
+	reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, req := range reqs {
+		require.NoError(t, proofDB.UpdateProofStatus(req.ID, proofrequest.StatusPROVING))
+	}
+	require.NoError(t, proofDB.SetProverRequestID(reqs[0].ID, []byte{0xab}))
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{OPSuccinctServerUrl: server.URL},
+			// The output root of the fulfilled span proof is checked against the rollup node.
+			RollupProvider: fakeRollupProvider{&fakeRollupClient{}},
+		},
+		ctx: context.Background(),
+		db:  *proofDB,
+	}
+	require.NoError(t, l.ResumeProvingRequests())
+
+	fulfilled, err := proofDB.GetProofRequest(reqs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusCOMPLETE, fulfilled.Status)
+	require.Equal(t, []byte("proof"), fulfilled.Proof)
+
+	// The request without a prover request ID is retried.
+	retried, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, 200, 300, proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, retried, 1)
+}
+
+func TestProcessProvingRequestsContinuesAfterPollError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/status/aa" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(ProofStatusResponse{
+			FulfillmentStatus: SP1FulfillmentStatusFulfilled,
+			Proof:             []byte("proof"),
+		}))
+	}))
+	defer server.Close()
+
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	for i, id := range []byte{0xaa, 0xab} {
+		start := uint64(100 * (i + 1))
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, start, start+100, 0))
+		reqs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, start, start+100, proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.NoError(t, proofDB.UpdateProofStatus(reqs[0].ID, proofrequest.StatusPROVING))
+		require.NoError(t, proofDB.SetProverRequestID(reqs[0].ID, []byte{id}))
+	}
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:            log.New(),
+			Metr:           opsuccinctmetrics.NoopMetrics,
+			Cfg:            ProposerConfig{OPSuccinctServerUrl: server.URL},
+			RollupProvider: fakeRollupProvider{&fakeRollupClient{}},
+		},
+		ctx: context.Background(),
+		db:  *proofDB,
+	}
+
+	// The request whose status can't be polled stays PROVING, and the other one is still fulfilled.
+	require.NoError(t, l.ProcessProvingRequests())
+	proving, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusPROVING)
+	require.NoError(t, err)
+	require.Len(t, proving, 1)
+	require.Equal(t, uint64(100), proving[0].StartBlock)
+	complete, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusCOMPLETE)
+	require.NoError(t, err)
+	require.Len(t, complete, 1)
+}
+
+func TestResumeWitnessGenRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Only the request that was sent before the restart is sent again, with its idempotency key.
+		require.Equal(t, "/request_span_proof", r.URL.Path)
+		require.Equal(t, "key", r.Header.Get("Idempotency-Key"))
+		require.NoError(t, json.NewEncoder(w).Encode(WitnessGenerationResponse{ProofID: []byte{0xab}}))
+	}))
+	defer server.Close()
+
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	// A request that was sent to the server before the proposer stopped, and one that was stopped before it was sent.
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 200, 300, 0))
+	reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, req := range reqs {
+		require.NoError(t, proofDB.UpdateProofStatus(req.ID, proofrequest.StatusWITNESSGEN))
+	}
+	require.NoError(t, proofDB.SetIdempotencyKey(reqs[0].ID, "key"))
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{OPSuccinctServerUrl: server.URL, WitnessGenTimeout: 3600},
+		},
+		ctx:               context.Background(),
+		db:                *proofDB,
+		witnessGenLimiter: newWitnessGenLimiter(4),
+	}
+	require.NoError(t, l.ResumeWitnessGenRequests())
+
+	require.Eventually(t, func() bool {
+		resumed, err := proofDB.GetProofRequest(reqs[0].ID)
+		require.NoError(t, err)
+		return resumed.Status == proofrequest.StatusPROVING && resumed.ProverRequestID == "ab"
+	}, 5*time.Second, 10*time.Millisecond)
+	requeued, err := proofDB.GetProofRequest(reqs[1].ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusUNREQ, requeued.Status)
+	failed, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusFAILED)
+	require.NoError(t, err)
+	require.Empty(t, failed)
+}
+
+func TestDispatchProofRequestOverloaded(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+	reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{OPSuccinctServerUrl: server.URL, WitnessGenTimeout: 10, Mock: true},
+		},
+		ctx:               context.Background(),
+		db:                *proofDB,
+		witnessGenLimiter: newWitnessGenLimiter(4),
+	}
+
+	// An overloaded server puts the request back in the queue, without failing it, however often it happens.
+	for i := 0; i < 3; i++ {
+		l.dispatchProofRequest(context.Background(), *reqs[0])
+	}
+	req, err := proofDB.GetProofRequest(reqs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusUNREQ, req.Status)
+	failed, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusFAILED)
+	require.NoError(t, err)
+	require.Empty(t, failed)
+	require.Equal(t, uint64(1), l.witnessGenLimiter.Limit())
+}
+
+func TestDispatchProofRequestServerErrors(t *testing.T) {
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{OPSuccinctServerUrl: server.URL, WitnessGenTimeout: 10, Mock: true},
+		},
+		ctx:               context.Background(),
+		db:                *proofDB,
+		witnessGenLimiter: newWitnessGenLimiter(4),
+	}
+
+	// A non-retryable error splits the span right away, and the error is kept on the failed request.
+	body = `{"code":"execution_failed","message":"out of memory","retryable":false}`
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200, 0))
+	reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	l.dispatchProofRequest(context.Background(), *reqs[0])
+	failed, err := proofDB.GetProofRequest(reqs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusFAILED, failed.Status)
+	require.Equal(t, "out of memory", failed.ErrorMessage)
+	halves, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, 100, 150, proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, halves, 1)
+
+	// A permanent error fails the request without retrying it.
+	body = `{"code":"unsupported","message":"Alt-DA","retryable":false}`
+	l.dispatchProofRequest(context.Background(), *halves[0])
+	unreqs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, 100, 150, proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Empty(t, unreqs)
+	failed, err = proofDB.GetProofRequest(halves[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusFAILED, failed.Status)
+}
+
+// addCompletedSpanProofs adds a completed span proof for each of the ranges.
+func addCompletedSpanProofs(t *testing.T, proofDB *db.ProofDB, ranges ...[2]uint64) {
+	for _, r := range ranges {
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, r[0], r[1], 0))
+		reqs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, r[0], r[1], proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.NoError(t, proofDB.UpdateProofStatus(reqs[0].ID, proofrequest.StatusPROVING))
+		require.NoError(t, proofDB.AddFulfilledProof(reqs[0].ID, []byte("proof")))
+	}
+}
+
+func TestSplitAggRequest(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	// The span proof 100-200 was also proven in parts, which adds a boundary at 150.
+	addCompletedSpanProofs(t, proofDB, [2]uint64{100, 150}, [2]uint64{150, 200}, [2]uint64{100, 200}, [2]uint64{200, 250}, [2]uint64{250, 300})
+
+	l2oo := newFakeL2OO(100, 100)
+	l := newFakeL2OODriver(t, l2oo, proofDB)
+	req := &ent.ProofRequest{Type: proofrequest.TypeAGG, StartBlock: 100, EndBlock: 300}
+
+	// The boundary closest to the middle of the range that reaches the next block number.
+	require.True(t, l.splitAggRequest(req))
+	aggs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeAGG, 100, 200, proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, aggs, 1)
+
+	// The only boundary that reaches the next block number is the end of the range.
+	l2oo.submissionInterval = 180
+	require.False(t, l.splitAggRequest(req))
+}
+
+func TestRetryAggRequestCoarsensSpans(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	addCompletedSpanProofs(t, proofDB, [2]uint64{100, 150}, [2]uint64{150, 200}, [2]uint64{200, 250}, [2]uint64{250, 300})
+
+	l := newFakeL2OODriver(t, newFakeL2OO(100, 200), proofDB)
+	l.Cfg.MaxBlockRangePerSpanProof = 100
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 100, 300, 0))
+	aggs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeAGG, 100, 300, proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+
+	// The AGG range can't be split, so its span proofs are re-proven as two larger ones instead of retrying it.
+	require.NoError(t, l.RetryRequest(aggs[0], ProofStatusResponse{ExecutionStatus: SP1ExecutionStatusUnexecutable}))
+	unreqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, unreqs, 2)
+	for _, req := range unreqs {
+		require.Equal(t, proofrequest.TypeSPAN, req.Type)
+	}
+
+	// The AGG proof isn't derived again until the larger span proofs are complete.
+	created, _, err := proofDB.TryCreateAggProofFromSpanProofs(100, 300, 0)
+	require.NoError(t, err)
+	require.False(t, created)
+	for _, req := range unreqs {
+		require.NoError(t, proofDB.UpdateProofStatus(req.ID, proofrequest.StatusPROVING))
+		require.NoError(t, proofDB.AddFulfilledProof(req.ID, []byte("merged")))
+	}
+	chain, err := proofDB.GetConsecutiveSpanProofs(100, 300)
+	require.NoError(t, err)
+	require.Len(t, chain, 2)
+	for _, span := range chain {
+		proof, err := proofDB.ReadProof(span)
+		require.NoError(t, err)
+		require.Equal(t, []byte("merged"), proof)
+	}
+
+	// The span proofs can't be merged any further.
+	req := &ent.ProofRequest{Type: proofrequest.TypeAGG, StartBlock: 100, EndBlock: 300}
+	require.False(t, l.coarsenAggRequest(req))
+}
+
+func TestRetryRequestBacksOffAndDeadLetters(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l := newFakeL2OODriver(t, newFakeL2OO(100, 200), proofDB)
+	l.Cfg.MaxProofRetries = 1
+	l.Cfg.ProofRetryBackoff = time.Minute
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 101, 0))
+	reqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+
+	// A single block range can't be split, so it is retried, and the retry isn't requested until it backed off.
+	require.NoError(t, l.RetryRequest(reqs[0], ProofStatusResponse{}))
+	retries, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, retries, 1)
+	require.Equal(t, uint64(1), retries[0].Attempts)
+	require.Greater(t, retries[0].NotBefore, uint64(time.Now().Unix()))
+	next, err := proofDB.GetNextUnrequestedProof()
+	require.NoError(t, err)
+	require.Nil(t, next)
+
+	// The retry failing too moves it to the dead-letter status instead of retrying it again.
+	require.NoError(t, l.RetryRequest(retries[0], ProofStatusResponse{}))
+	unreqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Empty(t, unreqs)
+	deadLettered, err := proofDB.GetProofRequest(retries[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusDEADLETTER, deadLettered.Status)
+
+	// An admin retry starts over without backing off.
+	retry, err := l.RetryProofRequest(context.Background(), retries[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), retry.Attempts)
+	next, err = proofDB.GetNextUnrequestedProof()
+	require.NoError(t, err)
+	require.Equal(t, retry.ID, next.ID)
+}
+
+func TestRetryRequestSplitsDownToMinBlocks(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l := newFakeL2OODriver(t, newFakeL2OO(100, 200), proofDB)
+	l.Cfg.MinSpanProofBlocks = 2
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 108, 0))
+	unexecutable := ProofStatusResponse{ExecutionStatus: SP1ExecutionStatusUnexecutable}
+
+	// The failing half is split again, until its halves would be smaller than the minimum.
+	for _, end := range []uint64{108, 104} {
+		reqs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, 100, end, proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.NoError(t, l.RetryRequest(reqs[0], unexecutable))
+		half, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, 100, (100+end)/2, proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.Len(t, half, 1)
+	}
+
+	// A range at the minimum is retried as it is.
+	reqs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, 100, 102, proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.NoError(t, l.RetryRequest(reqs[0], unexecutable))
+	retries, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, 100, 102, proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, retries, 1)
+	require.Equal(t, uint64(1), retries[0].Attempts)
+	unreqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, unreqs, 3)
+}
+
+func TestProofRetryBackoff(t *testing.T) {
+	l := &L2OutputSubmitter{DriverSetup: DriverSetup{Cfg: ProposerConfig{ProofRetryBackoff: 30 * time.Second}}}
+	require.Equal(t, 30*time.Second, l.proofRetryBackoff(0))
+	require.Equal(t, 2*time.Minute, l.proofRetryBackoff(2))
+	require.Equal(t, maxProofRetryBackoff, l.proofRetryBackoff(100))
+}
@@ -0,0 +1,68 @@
+package proposer
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+// proverTotals accumulates the statistics of a prover.
+type proverTotals struct {
+	stats              rpc.ProverStats
+	fee                *big.Int
+	fulfillmentSeconds uint64
+	timed              uint64
+	timedCycles        uint64
+}
+
+// ProverStats aggregates the proof requests that were assigned to a prover on the prover network by prover, so
+// operators can spot provers that are slow or often fail to fulfill their requests, and report them to the network.
+// Requests are counted as failed if the prover was assigned them but they didn't complete, i.e. they were
+// unfulfillable or timed out. The provers are ordered by address.
+func (l *L2OutputSubmitter) ProverStats(ctx context.Context, since uint64) ([]rpc.ProverStats, error) {
+	reqs, err := l.db.Analytics().GetFulfillerRequests(since)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[string]*proverTotals)
+	for _, req := range reqs {
+		t, ok := totals[req.Fulfiller]
+		if !ok {
+			t = &proverTotals{stats: rpc.ProverStats{Prover: req.Fulfiller}, fee: new(big.Int)}
+			totals[req.Fulfiller] = t
+		}
+		if req.Status != proofrequest.StatusCOMPLETE {
+			t.stats.Failed++
+			continue
+		}
+		t.stats.Fulfilled++
+		t.stats.Cycles += req.Cycles
+		if fee, ok := new(big.Int).SetString(req.Fee, 10); ok {
+			t.fee.Add(t.fee, fee)
+		}
+		if req.FulfilledTime != 0 && req.ProofRequestTime != 0 && req.FulfilledTime >= req.ProofRequestTime {
+			t.fulfillmentSeconds += req.FulfilledTime - req.ProofRequestTime
+			t.timed++
+			t.timedCycles += req.Cycles
+		}
+	}
+
+	stats := make([]rpc.ProverStats, 0, len(totals))
+	for _, t := range totals {
+		t.stats.Fee = t.fee.String()
+		t.stats.FailureRate = float64(t.stats.Failed) / float64(t.stats.Fulfilled+t.stats.Failed)
+		if t.timed > 0 {
+			t.stats.FulfillmentSeconds = float64(t.fulfillmentSeconds) / float64(t.timed)
+		}
+		if t.fulfillmentSeconds > 0 {
+			t.stats.CyclesPerSecond = float64(t.timedCycles) / float64(t.fulfillmentSeconds)
+		}
+		stats = append(stats, t.stats)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Prover < stats[j].Prover })
+	return stats, nil
+}
@@ -0,0 +1,68 @@
+package proposer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+func TestProverStats(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+		},
+		ctx: context.Background(),
+		db:  *proofDB,
+	}
+
+	const fast, slow = "0x00000000000000000000000000000000000000aa", "0x00000000000000000000000000000000000000bb"
+	for _, start := range []uint64{100, 200, 300, 400} {
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, start, start+100, 0))
+	}
+	spans, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, span := range spans {
+		require.NoError(t, proofDB.UpdateProofStatus(span.ID, proofrequest.StatusPROVING))
+		require.NoError(t, proofDB.SetProverRequestID(span.ID, []byte{byte(span.StartBlock / 100)}))
+		switch span.StartBlock {
+		case 100, 200:
+			require.NoError(t, proofDB.AddFulfilledProof(span.ID, []byte("proof")))
+			l.recordFulfillment(span, ProofStatusResponse{Fulfiller: fast, Cycles: 1_000_000, Fee: "500"})
+		case 300:
+			// The slow prover failed to fulfill the request.
+			require.NoError(t, proofDB.UpdateProofStatus(span.ID, proofrequest.StatusFAILED))
+			l.recordFulfillment(span, ProofStatusResponse{Fulfiller: slow})
+		}
+	}
+
+	// The request that no prover was assigned isn't counted.
+	stats, err := l.ProverStats(context.Background(), 0)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	require.Equal(t, fast, stats[0].Prover)
+	require.Equal(t, uint64(2), stats[0].Fulfilled)
+	require.Zero(t, stats[0].Failed)
+	require.Equal(t, uint64(2_000_000), stats[0].Cycles)
+	require.Equal(t, "1000", stats[0].Fee)
+	require.Equal(t, slow, stats[1].Prover)
+	require.Equal(t, uint64(1), stats[1].Failed)
+	require.Equal(t, float64(1), stats[1].FailureRate)
+	require.Equal(t, "0", stats[1].Fee)
+
+	// Requests last updated before the window are left out.
+	stats, err = l.ProverStats(context.Background(), 1<<40)
+	require.NoError(t, err)
+	require.Empty(t, stats)
+}
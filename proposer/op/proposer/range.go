@@ -3,14 +3,19 @@ package proposer
 import (
 	"context"
 	"fmt"
+	"math"
+	"os"
+	"path/filepath"
 	"slices"
 	"sync"
 
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/utils"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -120,15 +125,16 @@ func (l *L2OutputSubmitter) SplitRangeBasedOnSafeHeads(ctx context.Context, l2St
 	slices.Sort(uniqueSafeHeads)
 
 	// Loop over all of the safe heads and create spans.
+	maxRange := l.settings().MaxBlockRangePerSpanProof
 	for _, safeHead := range uniqueSafeHeads {
 		if safeHead > currentStart {
 			rangeStart := currentStart
-			for rangeStart+l.Cfg.MaxBlockRangePerSpanProof < min(l2End, safeHead) {
+			for rangeStart+maxRange < min(l2End, safeHead) {
 				spans = append(spans, Span{
 					Start: rangeStart,
-					End:   rangeStart + l.Cfg.MaxBlockRangePerSpanProof,
+					End:   rangeStart + maxRange,
 				})
-				rangeStart += l.Cfg.MaxBlockRangePerSpanProof
+				rangeStart += maxRange
 			}
 			spans = append(spans, Span{
 				Start: rangeStart,
@@ -147,8 +153,121 @@ func (l *L2OutputSubmitter) SplitRangeBasic(start, end uint64) []Span {
 	// Create spans of size MaxBlockRangePerSpanProof from start to end.
 	// Each span starts where the previous one ended.
 	// Continue until we can't fit another full span before reaching end.
-	for i := start; i+l.Cfg.MaxBlockRangePerSpanProof <= end; i += l.Cfg.MaxBlockRangePerSpanProof {
-		spans = append(spans, Span{Start: i, End: i + l.Cfg.MaxBlockRangePerSpanProof})
+	maxRange := l.settings().MaxBlockRangePerSpanProof
+	for i := start; i+maxRange <= end; i += maxRange {
+		spans = append(spans, Span{Start: i, End: i + maxRange})
+	}
+	return spans
+}
+
+// SplitRangeCovering creates spans of at most MaxBlockRangePerSpanProof blocks that cover the whole range from start to
+// end. Unlike SplitRangeBasic, the tail of the range that doesn't fill a full span is covered by a shorter span.
+func (l *L2OutputSubmitter) SplitRangeCovering(start, end uint64) []Span {
+	spans := l.SplitRangeBasic(start, end)
+	lastEnd := start
+	if len(spans) > 0 {
+		lastEnd = spans[len(spans)-1].End
+	}
+	if lastEnd < end {
+		spans = append(spans, Span{Start: lastEnd, End: end})
+	}
+	return spans
+}
+
+// SplitRangeAlignedToChannels creates spans of at most MaxBlockRangePerSpanProof blocks from start to end, which end
+// on the last block of a batcher channel where possible. A span that splits a channel forces the witness generator to
+// process the L1 data of the channel for both spans that share it.
+func (l *L2OutputSubmitter) SplitRangeAlignedToChannels(ctx context.Context, start, end uint64) ([]Span, error) {
+	// No full span fits in the range, so there's no need to fetch the channels.
+	maxRange := l.settings().MaxBlockRangePerSpanProof
+	if start+maxRange > end {
+		return nil, nil
+	}
+
+	rollupClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, l.Log, l.Cfg.RollupRpc)
+	if err != nil {
+		return nil, err
+	}
+	rollupCfg, err := rollupClient.RollupConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rollup config: %w", err)
+	}
+	l1Beacon, err := utils.SetupBeacon(l.Cfg.BeaconRpc)
+	if err != nil {
+		return nil, err
+	}
+
+	l1Start, l1End, err := utils.GetL1SearchBoundaries(rollupClient, *l.L1Client, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L1 search boundaries: %w", err)
+	}
+	// Only the L1 blocks after the ones fetched by the previous call are fetched, plus a channel timeout of blocks
+	// before them, which completes the channels that were still open.
+	cache := &l.channelBoundaries
+	if cache.l1Block < l1Start || cache.l1Block > l1End {
+		*cache = channelBoundaryCache{}
+	}
+	if cache.l1Block == l1End {
+		return alignSpansToBoundaries(start, end, maxRange, cache.boundaries), nil
+	}
+	fetchFrom := l1Start
+	if cache.l1Block > 0 {
+		fetchFrom = max(l1Start, cache.l1Block-min(cache.l1Block, rollupCfg.ChannelTimeoutBedrock))
+	}
+
+	// Boundaries past the end are kept for later calls, since their L1 blocks aren't fetched again.
+	fetched, err := utils.GetChannelBoundaries(utils.BatchDecoderConfig{
+		L2GenesisTime:     rollupCfg.Genesis.L2Time,
+		L2GenesisBlock:    rollupCfg.Genesis.L2.Number,
+		L2BlockTime:       rollupCfg.BlockTime,
+		BatchInboxAddress: rollupCfg.BatchInboxAddress,
+		L2StartBlock:      start,
+		L2EndBlock:        math.MaxUint64,
+		L2ChainID:         rollupCfg.L2ChainID,
+		L2Node:            rollupClient,
+		L1RPC:             *l.L1Client,
+		L1Beacon:          l1Beacon,
+		BatchSender:       rollupCfg.Genesis.SystemConfig.BatcherAddr,
+		DataDir:           filepath.Join(os.TempDir(), "batch_decoder", fmt.Sprintf("%d", rollupCfg.L2ChainID), "channels_cache"),
+	}, rollupCfg, fetchFrom, l1End)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get channel boundaries: %w", err)
+	}
+	cache.add(start, l1End, fetched)
+
+	return alignSpansToBoundaries(start, end, maxRange, cache.boundaries), nil
+}
+
+// channelBoundaryCache keeps the channel boundaries found in the L1 blocks fetched so far, so that each poll only
+// fetches the batches posted since the previous one.
+type channelBoundaryCache struct {
+	// l1Block is the last L1 block that was fetched.
+	l1Block uint64
+	// boundaries are the sorted channel boundaries after the start of the range that spans are created for.
+	boundaries []uint64
+}
+
+// add records the boundaries fetched up to l1Block, and drops the ones at or before start, which no span can end on.
+func (c *channelBoundaryCache) add(start, l1Block uint64, boundaries []uint64) {
+	c.l1Block = l1Block
+	c.boundaries = slices.DeleteFunc(append(c.boundaries, boundaries...), func(b uint64) bool { return b <= start })
+	slices.Sort(c.boundaries)
+	c.boundaries = slices.Compact(c.boundaries)
+}
+
+// alignSpansToBoundaries creates a span whenever a full span of maxRange blocks fits in the range, like SplitRangeBasic,
+// but ends it on the highest of the sorted boundaries that fits in it. If no boundary fits, the span is cut at maxRange.
+func alignSpansToBoundaries(start, end, maxRange uint64, boundaries []uint64) []Span {
+	spans := []Span{}
+	for i := start; i+maxRange <= end; {
+		spanEnd := i + maxRange
+		// The index of the first boundary past the end of a full span.
+		idx, _ := slices.BinarySearch(boundaries, spanEnd+1)
+		if idx > 0 && boundaries[idx-1] > i {
+			spanEnd = boundaries[idx-1]
+		}
+		spans = append(spans, Span{Start: i, End: spanEnd})
+		i = spanEnd
 	}
 	return spans
 }
@@ -185,16 +304,58 @@ func (l *L2OutputSubmitter) GetRangeProofBoundaries(ctx context.Context) error {
 	// Note: Originally, this used the L1 finalized block. However, to satisfy the new API, we now use the L2 finalized block.
 	newL2EndBlock := status.FinalizedL2.Number
 
-	spans := l.SplitRangeBasic(newL2StartBlock, newL2EndBlock)
+	var spans []Span
+	if l.planner != nil {
+		spans, err = l.planner.Spans(l.ctx, newL2StartBlock, newL2EndBlock, l.settings().MaxBlockRangePerSpanProof)
+		if err != nil {
+			l.Log.Warn("failed to plan spans, falling back to fixed-size spans", "err", err)
+			l.Metr.RecordError("range_planner", 1)
+			spans = l.SplitRangeBasic(newL2StartBlock, newL2EndBlock)
+		}
+	} else if l.Cfg.AlignToChannels {
+		spans, err = l.SplitRangeAlignedToChannels(ctx, newL2StartBlock, newL2EndBlock)
+		if err != nil {
+			l.Log.Warn("failed to align spans to channels, falling back to fixed-size spans", "err", err)
+			spans = l.SplitRangeBasic(newL2StartBlock, newL2EndBlock)
+		}
+	} else {
+		spans = l.SplitRangeBasic(newL2StartBlock, newL2EndBlock)
+	}
 
-	// Add each span to the DB. If there are no spans, we will not create any proofs.
+	leases, err := l.activeRangeLeases()
+	if err != nil {
+		return err
+	}
+
+	// Add the spans to the DB in a single transaction. If there are no spans, we will not create any proofs.
+	var spanRanges []db.SpanRange
 	for _, span := range spans {
-		err := l.db.NewEntry(proofrequest.TypeSPAN, span.Start, span.End)
-		l.Log.Info("New range proof request.", "start", span.Start, "end", span.End)
-		if err != nil {
-			l.Log.Error("failed to add span to db", "err", err)
-			return err
+		// Spans that overlap a range leased to an external proving job aren't queued until the lease ends, and later
+		// spans wait for them, like they wait for unavailable Alt-DA batch data.
+		if lease := rangeLeaseOf(leases, span.Start, span.End); lease != nil {
+			l.Log.Info("Range is leased to an external job, not queuing span yet.", "start", span.Start, "end", span.End, "leaseID", lease.ID, "owner", lease.Owner, "expires", lease.ExpiresTime)
+			break
 		}
+		// On Alt-DA chains, spans are only queued once their batch data is available. Later spans wait for the
+		// unavailable one, so that the queued spans stay contiguous.
+		if err := l.CheckAltDAAvailability(ctx, span.Start, span.End); err != nil {
+			l.Log.Warn("Alt-DA batch data unavailable, not queuing span yet.", "start", span.Start, "end", span.End, "err", err)
+			l.Metr.RecordError("altda_unavailable", 1)
+			break
+		}
+		spanRanges = append(spanRanges, db.SpanRange{
+			Start:        span.Start,
+			End:          span.End,
+			ProofTimeout: l.proofTimeout(proofrequest.TypeSPAN, span.Start, span.End),
+		})
+	}
+	created, err := l.db.NewSpanEntries(spanRanges)
+	if err != nil {
+		l.Log.Error("failed to add spans to db", "err", err)
+		return err
+	}
+	if created > 0 {
+		l.Log.Info("New range proof requests.", "start", spanRanges[0].Start, "end", spanRanges[len(spanRanges)-1].End, "spans", created)
 	}
 
 	return nil
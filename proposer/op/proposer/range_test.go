@@ -0,0 +1,30 @@
+package proposer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlignSpansToBoundaries(t *testing.T) {
+	// Spans end on the highest boundary that fits, and are cut at the max size when none does.
+	spans := alignSpansToBoundaries(100, 420, 100, []uint64{150, 180, 250, 400})
+	require.Equal(t, []Span{
+		{Start: 100, End: 180},
+		{Start: 180, End: 250},
+		{Start: 250, End: 350},
+	}, spans)
+
+	// Without boundaries, the spans are the same as SplitRangeBasic.
+	l := &L2OutputSubmitter{DriverSetup: DriverSetup{Cfg: ProposerConfig{MaxBlockRangePerSpanProof: 100}}}
+	require.Equal(t, l.SplitRangeBasic(100, 420), alignSpansToBoundaries(100, 420, 100, nil))
+}
+
+func TestChannelBoundaryCache(t *testing.T) {
+	var cache channelBoundaryCache
+	cache.add(100, 20, []uint64{150, 250})
+	// Boundaries fetched again are kept once, and the ones at or before the start are dropped.
+	cache.add(200, 30, []uint64{250, 220, 300})
+	require.Equal(t, uint64(30), cache.l1Block)
+	require.Equal(t, []uint64{220, 250, 300}, cache.boundaries)
+}
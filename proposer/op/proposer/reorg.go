@@ -0,0 +1,144 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// recordL2BlockHash records the hash of the L2 block at the end of a span proof's range as it is sent to the server,
+// so the proof is invalidated if the L2 chain reorgs past it. A span whose hash can't be fetched is still proven, it's
+// just not checked for reorgs.
+func (l *L2OutputSubmitter) recordL2BlockHash(ctx context.Context, p *ent.ProofRequest) {
+	if l.Cfg.ReorgCheckInterval == 0 || p.Type != proofrequest.TypeSPAN {
+		return
+	}
+	output, err := l.FetchOutput(ctx, p.EndBlock)
+	if err != nil {
+		l.Log.Warn("failed to fetch the L2 block hash of span proof, it isn't checked for reorgs", "id", p.ID, "end", p.EndBlock, "err", err)
+		return
+	}
+	hash := output.BlockRef.Hash.Hex()
+	if err := l.db.SetL2BlockHash(p.ID, hash); err != nil {
+		l.Log.Error("failed to set L2 block hash", "err", err)
+		return
+	}
+	p.L2BlockHash = hash
+}
+
+// checkReorgs invalidates the proofs that haven't been proposed yet, and were generated against blocks that reorged:
+// span proofs whose L2 end block changed since they were sent to the server, and AGG proofs whose checkpointed L1
+// block changed, or that aggregate a reorged span proof. The ranges of invalidated span proofs are queued again, and
+// the AGG proofs are derived again once their span proofs are complete, so they're proven against the new chain.
+func (l *L2OutputSubmitter) checkReorgs(ctx context.Context, l1 blockHeaderSource) error {
+	latest, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get the latest L2OO block: %w", err)
+	}
+	reqs, err := l.db.GetUnproposedProofRequests(latest.Uint64())
+	if err != nil {
+		return err
+	}
+
+	// The hashes of the L2 blocks are shared by retries of the same range.
+	l2Hashes := make(map[uint64]string)
+	var reorgedSpans []Span
+	for _, req := range reqs {
+		if req.Type != proofrequest.TypeSPAN || req.L2BlockHash == "" {
+			continue
+		}
+		hash, ok := l2Hashes[req.EndBlock]
+		if !ok {
+			output, err := l.FetchOutput(ctx, req.EndBlock)
+			if err != nil {
+				return err
+			}
+			hash = output.BlockRef.Hash.Hex()
+			l2Hashes[req.EndBlock] = hash
+		}
+		if hash == req.L2BlockHash {
+			continue
+		}
+		reason := fmt.Sprintf("L2 block %d reorged from %s to %s", req.EndBlock, req.L2BlockHash, hash)
+		invalidated, err := l.invalidateProofRequest(req, reason, "l2_reorg")
+		if err != nil {
+			return err
+		}
+		if !invalidated {
+			continue
+		}
+		reorgedSpans = append(reorgedSpans, Span{Start: req.StartBlock, End: req.EndBlock})
+		queued, err := l.db.HasProofRequestForRange(req.Type, req.StartBlock, req.EndBlock)
+		if err != nil {
+			return err
+		}
+		if !queued {
+			if err := l.db.NewEntry(req.Type, req.StartBlock, req.EndBlock, l.proofTimeout(req.Type, req.StartBlock, req.EndBlock)); err != nil {
+				return err
+			}
+		}
+	}
+
+	l1Hashes := make(map[uint64]string)
+	for _, req := range reqs {
+		if req.Type != proofrequest.TypeAGG {
+			continue
+		}
+		var reason string
+		if span := overlappingSpan(reorgedSpans, req.StartBlock, req.EndBlock); span != nil {
+			reason = fmt.Sprintf("span proof %d-%d reorged", span.Start, span.End)
+		} else if req.L1BlockHash != "" {
+			hash, ok := l1Hashes[req.L1BlockNumber]
+			if !ok {
+				header, err := l1.HeaderByNumber(ctx, new(big.Int).SetUint64(req.L1BlockNumber))
+				if err != nil {
+					return fmt.Errorf("failed to get L1 block %d: %w", req.L1BlockNumber, err)
+				}
+				hash = header.Hash().Hex()
+				l1Hashes[req.L1BlockNumber] = hash
+			}
+			if hash != req.L1BlockHash {
+				reason = fmt.Sprintf("checkpointed L1 block %d reorged from %s to %s", req.L1BlockNumber, req.L1BlockHash, hash)
+			}
+		}
+		if reason == "" {
+			continue
+		}
+		if _, err := l.invalidateProofRequest(req, reason, "l1_reorg"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// invalidateProofRequest fails a proof request that was generated against reorged blocks, and counts it in the error
+// metric with the given label. Returns false if its status changed in the meantime, e.g. because it failed.
+func (l *L2OutputSubmitter) invalidateProofRequest(req *ent.ProofRequest, reason, label string) (bool, error) {
+	err := l.db.InvalidateProofRequest(req.ID, req.Status, reason)
+	if errors.Is(err, db.ErrProofStatusChanged) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	l.Log.Warn("Invalidated proof request of reorged blocks", "id", req.ID, "type", req.Type, "start", req.StartBlock, "end", req.EndBlock, "status", req.Status, "reason", reason)
+	l.Metr.RecordError(label, 1)
+	return true, nil
+}
+
+// overlappingSpan returns the first of the spans that shares a block with the range from start to end, or nil.
+func overlappingSpan(spans []Span, start, end uint64) *Span {
+	for i := range spans {
+		if spans[i].Start < end && spans[i].End > start {
+			return &spans[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package proposer
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+func TestCheckReorgs(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	l := newFakeL2OODriver(t, newFakeL2OO(100, 200), proofDB)
+	l.Cfg.ReorgCheckInterval = time.Minute
+	client := l.RollupProvider.(fakeRollupProvider).client
+	ctx := context.Background()
+
+	require.NoError(t, proofDB.ImportSpanProofs(100, []db.SpanRange{{Start: 100, End: 200}, {Start: 200, End: 300}, {Start: 300, End: 400}}, 10))
+	spans, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, span := range spans[:2] {
+		l.recordL2BlockHash(ctx, span)
+		require.NoError(t, proofDB.UpdateProofStatus(span.ID, proofrequest.StatusPROVING))
+		require.NoError(t, proofDB.AddFulfilledProof(span.ID, []byte("proof")))
+	}
+	l.recordL2BlockHash(ctx, spans[2])
+	require.NoError(t, proofDB.UpdateProofStatus(spans[2].ID, proofrequest.StatusPROVING))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 100, 300, 0))
+	l1Block := gasUsedHeaders(0)
+	l1Hash, err := l1Block.HeaderByNumber(ctx, big.NewInt(900))
+	require.NoError(t, err)
+	agg, err := proofDB.AddL1BlockInfoToAggRequest(100, 300, 900, l1Hash.Hash().Hex())
+	require.NoError(t, err)
+	status := func(req *ent.ProofRequest) proofrequest.Status {
+		req, err := proofDB.GetProofRequest(req.ID)
+		require.NoError(t, err)
+		return req.Status
+	}
+
+	// Nothing reorged.
+	require.NoError(t, l.checkReorgs(ctx, l1Block))
+	require.Equal(t, proofrequest.StatusCOMPLETE, status(spans[1]))
+	require.Equal(t, proofrequest.StatusPROVING, status(spans[2]))
+	require.Equal(t, proofrequest.StatusUNREQ, status(agg))
+
+	// The checkpointed L1 block reorged, so the AGG proof is invalidated, and its spans are kept.
+	require.NoError(t, l.checkReorgs(ctx, gasUsedHeaders(1)))
+	require.Equal(t, proofrequest.StatusFAILED, status(agg))
+	require.Equal(t, proofrequest.StatusCOMPLETE, status(spans[0]))
+
+	// The L2 chain reorged past block 300, so the spans ending at or after it are invalidated and queued again, and so
+	// is an AGG proof over them.
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 100, 300, 0))
+	aggs, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeAGG, 100, 300, proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	client.roots[300] = common.Hash{0x30}
+	client.roots[400] = common.Hash{0x40}
+	require.NoError(t, l.checkReorgs(ctx, l1Block))
+	require.Equal(t, proofrequest.StatusCOMPLETE, status(spans[0]))
+	require.Equal(t, proofrequest.StatusFAILED, status(spans[1]))
+	require.Equal(t, proofrequest.StatusFAILED, status(spans[2]))
+	require.Equal(t, proofrequest.StatusFAILED, status(aggs[0]))
+	for _, span := range spans[1:] {
+		requeued, err := proofDB.GetProofRequestsWithBlockRangeAndStatus(proofrequest.TypeSPAN, span.StartBlock, span.EndBlock, proofrequest.StatusUNREQ)
+		require.NoError(t, err)
+		require.Len(t, requeued, 1)
+	}
+
+	// Proposed outputs aren't checked.
+	l.l2ooContract.(*fakeL2OO).latest = 200
+	client.roots[200] = common.Hash{0x20}
+	require.NoError(t, l.checkReorgs(ctx, l1Block))
+	require.Equal(t, proofrequest.StatusCOMPLETE, status(spans[0]))
+}
@@ -0,0 +1,104 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+// REPLICATION_WAIT is how long a warm standby waits for the active proposer's next change before polling again.
+const REPLICATION_WAIT = 30 * time.Second
+
+// MAX_REPLICATION_WAIT is the longest the active proposer waits for a change before responding to a standby.
+const MAX_REPLICATION_WAIT = 5 * time.Minute
+
+// ReplicationChanges returns the proof requests that changed after the given position of the DB's change feed, for a
+// warm standby to apply.
+func (l *L2OutputSubmitter) ReplicationChanges(ctx context.Context, epoch string, since uint64, wait time.Duration) (*db.ReplicationBatch, error) {
+	batch, err := l.db.ReplicationChanges(ctx, epoch, since, min(wait, MAX_REPLICATION_WAIT))
+	if errors.Is(err, db.ErrReplicationUnsupported) {
+		return nil, fmt.Errorf("%w: %w", rpc.ErrInvalidRequest, err)
+	}
+	return batch, err
+}
+
+// replicate keeps the DB of a warm standby in sync with the active proposer's, until the standby is stopped. The
+// standby starts from a snapshot, and then applies the changes as they're made. A failed fetch is retried after the
+// poll interval, from the last change that was applied.
+func (l *L2OutputSubmitter) replicate() {
+	defer l.wg.Done()
+	var epoch string
+	var seq uint64
+	for {
+		batch, err := l.fetchReplicationBatch(l.ctx, epoch, seq)
+		if err == nil {
+			err = l.db.ApplyReplicationBatch(batch)
+		}
+		if err != nil {
+			if l.ctx.Err() != nil {
+				return
+			}
+			l.Log.Error("failed to replicate the active proposer", "err", err)
+			l.Metr.RecordError("replication", 1)
+			select {
+			case <-time.After(l.Cfg.PollInterval):
+				continue
+			case <-l.done:
+				return
+			}
+		}
+
+		if batch.Snapshot {
+			l.Log.Info("Replicated a snapshot of the active proposer", "requests", len(batch.Requests), "epoch", batch.Epoch)
+		} else if len(batch.Requests) > 0 || len(batch.Deleted) > 0 {
+			l.Log.Debug("Replicated changes of the active proposer", "updated", len(batch.Requests), "deleted", len(batch.Deleted), "seq", batch.Seq)
+		}
+		epoch, seq = batch.Epoch, batch.Seq
+	}
+}
+
+// fetchReplicationBatch long-polls the active proposer's admin HTTP API for the changes after the given position.
+func (l *L2OutputSubmitter) fetchReplicationBatch(ctx context.Context, epoch string, since uint64) (*db.ReplicationBatch, error) {
+	query := url.Values{}
+	query.Set("epoch", epoch)
+	query.Set("since", strconv.FormatUint(since, 10))
+	query.Set("wait", strconv.FormatInt(int64(REPLICATION_WAIT.Seconds()), 10))
+	req, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(l.Cfg.ReplicateFrom, "/")+"/replication/changes?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+l.Cfg.ReplicateToken)
+
+	client := &http.Client{Timeout: REPLICATION_WAIT + PROOF_STATUS_TIMEOUT}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var adminErr struct {
+			Error string `json:"error"`
+		}
+		body, _ := io.ReadAll(resp.Body)
+		if err := json.Unmarshal(body, &adminErr); err != nil || adminErr.Error == "" {
+			return nil, fmt.Errorf("active proposer responded with status %d", resp.StatusCode)
+		}
+		return nil, fmt.Errorf("active proposer responded with status %d: %s", resp.StatusCode, adminErr.Error)
+	}
+	var batch db.ReplicationBatch
+	if err := json.NewDecoder(resp.Body).Decode(&batch); err != nil {
+		return nil, fmt.Errorf("failed to decode replication batch: %w", err)
+	}
+	return &batch, nil
+}
@@ -0,0 +1,192 @@
+package proposer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrInvalidServerResponse is returned when a response from the OP Succinct server doesn't match the schema the
+// proposer expects, e.g. because the server changed its API.
+var ErrInvalidServerResponse = errors.New("invalid response from the op-succinct server")
+
+// MaxProofSize is the largest proof the proposer accepts from the server. Compressed span proofs are a few MB, so a
+// larger proof means the response is corrupt.
+const MaxProofSize = 32 << 20
+
+// proofIDLength is the length of the request IDs of the prover network.
+const proofIDLength = 32
+
+// ServerError is a non-200 response from the OP Succinct server. Retryable is whether the server expects that
+// retrying the request can succeed, e.g. after an RPC failure. Requests that failed with a non-retryable error are split
+// instead, or failed permanently if the code says that the request itself is unsupported or invalid.
+type ServerError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Retryable  bool
+}
+
+func (e *ServerError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("received non-200 status code: %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("received non-200 status code: %d: %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// permanentServerErrorCodes are the codes of server errors that no retry or split of the request can fix.
+var permanentServerErrorCodes = map[string]bool{
+	"unsupported":     true,
+	"invalid_request": true,
+}
+
+// Permanent returns whether the request failed in a way that no retry or split can fix.
+func (e *ServerError) Permanent() bool {
+	return !e.Retryable && permanentServerErrorCodes[e.Code]
+}
+
+// parseServerError reads the error from the body of a non-200 response. The server reports errors as a JSON object with
+// a code, a message and a retryable flag. Errors of older servers, either {"error": ...} or plain text, are retryable.
+func parseServerError(statusCode int, body []byte) *ServerError {
+	var structured struct {
+		Code      *string `json:"code"`
+		Message   string  `json:"message"`
+		Retryable *bool   `json:"retryable"`
+		Error     string  `json:"error"`
+	}
+	if err := json.Unmarshal(body, &structured); err != nil {
+		return &ServerError{StatusCode: statusCode, Message: string(body), Retryable: true}
+	}
+	if structured.Code == nil || structured.Retryable == nil {
+		return &ServerError{StatusCode: statusCode, Message: structured.Error, Retryable: true}
+	}
+	return &ServerError{StatusCode: statusCode, Code: *structured.Code, Message: structured.Message, Retryable: *structured.Retryable}
+}
+
+// serverResponse is a response from the OP Succinct server that can be validated after it is decoded.
+type serverResponse interface {
+	// requiredFields are the JSON fields that must be present and non-null in the response.
+	requiredFields() []string
+	// validate checks the decoded values.
+	validate() error
+}
+
+func (r *WitnessGenerationResponse) requiredFields() []string {
+	return []string{"proof_id"}
+}
+
+func (r *WitnessGenerationResponse) validate() error {
+	if len(r.ProofID) != proofIDLength {
+		return fmt.Errorf("proof_id is %d bytes, expected %d", len(r.ProofID), proofIDLength)
+	}
+	return nil
+}
+
+func (r *ProofStatusResponse) requiredFields() []string {
+	return []string{"fulfillment_status", "execution_status", "proof"}
+}
+
+func (r *ProofStatusResponse) validate() error {
+	if r.FulfillmentStatus < SP1FulfillmentStatusUnspecified || r.FulfillmentStatus > SP1FulfillmentStatusUnfulfillable {
+		return fmt.Errorf("unknown fulfillment_status %d", r.FulfillmentStatus)
+	}
+	if r.ExecutionStatus < SP1ExecutionStatusUnspecified || r.ExecutionStatus > SP1ExecutionStatusUnexecutable {
+		return fmt.Errorf("unknown execution_status %d", r.ExecutionStatus)
+	}
+	if r.FulfillmentStatus == SP1FulfillmentStatusFulfilled && len(r.Proof) == 0 {
+		return errors.New("proof is fulfilled, but the proof is empty")
+	}
+	if len(r.Proof) > MaxProofSize {
+		return fmt.Errorf("proof is %d bytes, larger than the max of %d", len(r.Proof), MaxProofSize)
+	}
+	if r.Fee != "" {
+		if fee, ok := new(big.Int).SetString(r.Fee, 10); !ok || fee.Sign() < 0 {
+			return fmt.Errorf("fee %q is not a non-negative decimal integer", r.Fee)
+		}
+	}
+	if r.Fulfiller != "" && !common.IsHexAddress(r.Fulfiller) {
+		return fmt.Errorf("fulfiller %q is not an address", r.Fulfiller)
+	}
+	return nil
+}
+
+func (r *ProofStatusBatchResponse) requiredFields() []string {
+	return []string{"statuses"}
+}
+
+func (r *ProofStatusBatchResponse) validate() error {
+	for _, entry := range r.Statuses {
+		if (entry.Status == nil) == (entry.Error == "") {
+			return fmt.Errorf("proof %s must have either a status or an error", entry.ProofID)
+		}
+		if entry.Status == nil {
+			continue
+		}
+		if err := entry.Status.validate(); err != nil {
+			return fmt.Errorf("proof %s: %w", entry.ProofID, err)
+		}
+	}
+	return nil
+}
+
+func (r *VersionResponse) requiredFields() []string {
+	// The program vkeys are only needed to check the server's programs against the L2OO, see reconcileOnChainConfig.
+	return []string{"sp1_circuit_version"}
+}
+
+func (r *VersionResponse) validate() error {
+	return nil
+}
+
+func (r *ValidateConfigResponse) requiredFields() []string {
+	return []string{"rollup_config_hash_valid", "agg_vkey_valid", "range_vkey_valid"}
+}
+
+func (r *ValidateConfigResponse) validate() error {
+	return nil
+}
+
+func (r *CapacityResponse) requiredFields() []string {
+	return []string{"max_witness_gen", "in_flight"}
+}
+
+func (r *CapacityResponse) validate() error {
+	if r.MaxWitnessGen == 0 {
+		return errors.New("max_witness_gen is 0")
+	}
+	return nil
+}
+
+// decodeServerResponse decodes the body of a response from the given endpoint of the OP Succinct server into v, and
+// checks it against the expected schema. A response that doesn't match is logged and recorded in the error metric,
+// rather than letting zero values flow into the proof request state machine.
+func (l *L2OutputSubmitter) decodeServerResponse(endpoint string, body []byte, v serverResponse) error {
+	if err := decodeAndValidate(body, v); err != nil {
+		l.Log.Error("invalid response from the op-succinct server", "endpoint", endpoint, "err", err)
+		l.Metr.RecordError("invalid_server_response", 1)
+		return fmt.Errorf("%s: %w", endpoint, err)
+	}
+	return nil
+}
+
+func decodeAndValidate(body []byte, v serverResponse) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidServerResponse, err)
+	}
+	for _, field := range v.requiredFields() {
+		if raw, ok := fields[field]; !ok || string(raw) == "null" {
+			return fmt.Errorf("%w: missing field %q", ErrInvalidServerResponse, field)
+		}
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidServerResponse, err)
+	}
+	if err := v.validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidServerResponse, err)
+	}
+	return nil
+}
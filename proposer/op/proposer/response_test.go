@@ -0,0 +1,51 @@
+package proposer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeAndValidateProofStatus(t *testing.T) {
+	var status ProofStatusResponse
+	require.NoError(t, decodeAndValidate([]byte(`{"fulfillment_status":3,"execution_status":2,"proof":[1,2,3]}`), &status))
+	require.Equal(t, SP1FulfillmentStatusFulfilled, status.FulfillmentStatus)
+	require.Equal(t, []byte{1, 2, 3}, status.Proof)
+
+	// The cost is optional.
+	require.NoError(t, decodeAndValidate([]byte(`{"fulfillment_status":3,"execution_status":2,"proof":[1],"cycles":100,"fee":"25","fulfiller":"0x00000000000000000000000000000000000000aa"}`), &status))
+	require.Equal(t, uint64(100), status.Cycles)
+	require.Equal(t, "25", status.Fee)
+
+	for name, body := range map[string]string{
+		"missing field":           `{"fulfillment_status":3,"proof":[1]}`,
+		"null field":              `{"fulfillment_status":3,"execution_status":null,"proof":[1]}`,
+		"unknown status":          `{"fulfillment_status":7,"execution_status":2,"proof":[1]}`,
+		"fulfilled without proof": `{"fulfillment_status":3,"execution_status":2,"proof":[]}`,
+		"not an object":           `"ok"`,
+		"negative fee":            `{"fulfillment_status":3,"execution_status":2,"proof":[1],"fee":"-1"}`,
+		"invalid fulfiller":       `{"fulfillment_status":3,"execution_status":2,"proof":[1],"fulfiller":"prover"}`,
+	} {
+		var status ProofStatusResponse
+		require.ErrorIs(t, decodeAndValidate([]byte(body), &status), ErrInvalidServerResponse, name)
+	}
+
+	var resp WitnessGenerationResponse
+	require.ErrorIs(t, decodeAndValidate([]byte(`{"proof_id":[1,2]}`), &resp), ErrInvalidServerResponse)
+}
+
+func TestParseServerError(t *testing.T) {
+	err := parseServerError(422, []byte(`{"code":"unsupported","message":"Alt-DA","retryable":false}`))
+	require.Equal(t, &ServerError{StatusCode: 422, Code: "unsupported", Message: "Alt-DA"}, err)
+	require.True(t, err.Permanent())
+
+	err = parseServerError(422, []byte(`{"code":"execution_failed","message":"oom","retryable":false}`))
+	require.False(t, err.Retryable)
+	require.False(t, err.Permanent())
+
+	// Errors of older servers are retryable.
+	err = parseServerError(500, []byte(`{"error":"rpc down"}`))
+	require.Equal(t, &ServerError{StatusCode: 500, Message: "rpc down", Retryable: true}, err)
+	err = parseServerError(500, []byte("rpc down"))
+	require.Equal(t, &ServerError{StatusCode: 500, Message: "rpc down", Retryable: true}, err)
+}
@@ -0,0 +1,460 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+)
+
+// RequestStatus describes a proof request, and for requests that haven't been sent to the server yet, why not.
+type RequestStatus struct {
+	ID            int    `json:"id"`
+	Type          string `json:"type"`
+	StartBlock    uint64 `json:"start_block"`
+	EndBlock      uint64 `json:"end_block"`
+	Status        string `json:"status"`
+	BlockedReason string `json:"blocked_reason"`
+	// ExternalRef is the ID of the request in an operator's external job system, if one was attached.
+	ExternalRef string `json:"external_ref,omitempty"`
+	// ProverBackend is the URL of the server the request was sent to, once it was sent.
+	ProverBackend string `json:"prover_backend,omitempty"`
+	// ErrorMessage is the error the server reported when the request failed to be sent.
+	ErrorMessage string `json:"error_message,omitempty"`
+	// CreatedBy, RequestedBy and CompletedBy are the IDs of the proposer instances that created the request, sent it
+	// to the server, and stored its proof.
+	CreatedBy   string `json:"created_by,omitempty"`
+	RequestedBy string `json:"requested_by,omitempty"`
+	CompletedBy string `json:"completed_by,omitempty"`
+	// Attempts is the number of earlier requests for the range that failed and were retried.
+	Attempts uint64 `json:"attempts,omitempty"`
+	// DowntimeCause is the cause of the proposer downtime whose backlog the request was created to catch up on, if
+	// any.
+	DowntimeCause string `json:"downtime_cause,omitempty"`
+}
+
+// ProofRetrieval describes where a proof is stored, and includes the proof once it is available in the hot tier.
+type ProofRetrieval struct {
+	ID              int    `json:"id"`
+	StorageTier     string `json:"storage_tier"`
+	RetrievalStatus string `json:"retrieval_status"`
+	Proof           []byte `json:"proof,omitempty"`
+	// IPFSCID is the CID the proof was pinned to IPFS under, if it was exported.
+	IPFSCID string `json:"ipfs_cid,omitempty"`
+	// ProverBackend is the URL of the server that the proof was requested from.
+	ProverBackend string `json:"prover_backend,omitempty"`
+}
+
+// ProofRange is a range of L2 blocks to prove. Proofs for the range cover the blocks after Start, up to and including
+// End.
+type ProofRange struct {
+	Start uint64 `json:"start"`
+	End   uint64 `json:"end"`
+}
+
+// ProvingETA is the forecast of when an L2 block will be covered by span proofs, at the proving rate observed so far.
+type ProvingETA struct {
+	TargetBlock        uint64  `json:"target_block"`
+	HighestProvenBlock uint64  `json:"highest_proven_block"`
+	BlocksRemaining    uint64  `json:"blocks_remaining"`
+	EWMARate           float64 `json:"ewma_rate"`
+	LinearRate         float64 `json:"linear_rate"`
+	// ETASeconds is nil if there is no proving history to forecast from.
+	ETASeconds *uint64 `json:"eta_seconds"`
+}
+
+// PauseStatus is which parts of the pipeline are paused by an admin.
+type PauseStatus struct {
+	SubmissionsPaused   bool `json:"submissions_paused"`
+	ProofRequestsPaused bool `json:"proof_requests_paused"`
+}
+
+// LimiterStatus is the remaining capacity under the proposer's concurrency limits.
+type LimiterStatus struct {
+	// WitnessGenLimit is the effective witness generation limit, which is lowered below WitnessGenMax while the server
+	// reports it is overloaded.
+	WitnessGenLimit        uint64 `json:"witness_gen_limit"`
+	WitnessGenMax          uint64 `json:"witness_gen_max"`
+	WitnessGenInFlight     uint64 `json:"witness_gen_in_flight"`
+	WitnessGenRemaining    uint64 `json:"witness_gen_remaining"`
+	ProofRequestsMax       uint64 `json:"proof_requests_max"`
+	ProofRequestsInFlight  uint64 `json:"proof_requests_in_flight"`
+	ProofRequestsRemaining uint64 `json:"proof_requests_remaining"`
+}
+
+// BreakGlassStatus is the break-glass override that lifts the proof request limits, if one is active.
+type BreakGlassStatus struct {
+	Active bool   `json:"active"`
+	Reason string `json:"reason,omitempty"`
+	// ExpiresAt is the unix timestamp at which the override expires.
+	ExpiresAt uint64 `json:"expires_at,omitempty"`
+}
+
+// RangeEstimate is the estimated cost of proving an L2 block range, from the gas used by its blocks and the proving
+// rate observed so far.
+type RangeEstimate struct {
+	Start uint64 `json:"start"`
+	End   uint64 `json:"end"`
+	// SpanProofs is the number of span proofs the range planner would split the range into.
+	SpanProofs uint64 `json:"span_proofs"`
+	GasUsed    uint64 `json:"gas_used"`
+	// EstimatedCycles is the estimated cycle count of proving the span proofs, including the overhead of each span
+	// proof and the unused cycles of their last shards.
+	EstimatedCycles uint64 `json:"estimated_cycles"`
+	// ProvingSeconds is how long proving the range takes at the current proving rate. It is nil if there is no proving
+	// history to forecast from.
+	ProvingSeconds *uint64 `json:"proving_seconds"`
+}
+
+// ProofCosts is the proving cost of the proofs completed within an L2 block range, as reported by the prover network,
+// to forecast the cost of proving future ranges. Fees are in the base units of the PROVE token, as decimal strings.
+type ProofCosts struct {
+	Start      uint64 `json:"start"`
+	End        uint64 `json:"end"`
+	SpanProofs uint64 `json:"span_proofs"`
+	AggProofs  uint64 `json:"agg_proofs"`
+	// Blocks is the number of L2 blocks covered by the span proofs.
+	Blocks uint64 `json:"blocks"`
+	Cycles uint64 `json:"cycles"`
+	Fee    string `json:"fee"`
+	// CyclesPerBlock and FeePerBlock are the cycles and fees of all the proofs, per block covered by the span proofs.
+	CyclesPerBlock float64 `json:"cycles_per_block"`
+	FeePerBlock    string  `json:"fee_per_block"`
+	// FulfillmentSeconds is the average time from a proof being requested from the prover network until it was
+	// fulfilled.
+	FulfillmentSeconds float64 `json:"fulfillment_seconds"`
+	// Unreported is the number of completed proofs whose cost the prover network didn't report, which aren't included
+	// in the totals.
+	Unreported uint64 `json:"unreported"`
+}
+
+// ProverStats are the statistics of a prover on the prover network, from the proof requests it was assigned, to
+// detect slow or unreliable provers.
+type ProverStats struct {
+	// Prover is the address of the prover.
+	Prover    string `json:"prover"`
+	Fulfilled uint64 `json:"fulfilled"`
+	// Failed is the number of requests the prover was assigned, but that were unfulfillable or timed out.
+	Failed uint64 `json:"failed"`
+	// FailureRate is the share of the requests assigned to the prover that failed, between 0 and 1.
+	FailureRate float64 `json:"failure_rate"`
+	Cycles      uint64  `json:"cycles"`
+	// Fee is the total fee paid to the prover, in the base units of the PROVE token, as a decimal string.
+	Fee string `json:"fee"`
+	// FulfillmentSeconds is the average time from a proof being requested until the prover fulfilled it.
+	FulfillmentSeconds float64 `json:"fulfillment_seconds"`
+	// CyclesPerSecond is the cycles of the fulfilled proofs over the time the prover took to fulfill them, which
+	// compares provers independently of the size of the proofs they were assigned.
+	CyclesPerSecond float64 `json:"cycles_per_second"`
+}
+
+// ProverBackendStatus is the health of a prover backend, i.e. an OP Succinct server the proposer sends requests to.
+type ProverBackendStatus struct {
+	URL string `json:"url"`
+	// UnreachableSeconds is how long the backend has been unreachable, or 0 if it's reachable.
+	UnreachableSeconds uint64 `json:"unreachable_seconds"`
+	// FailureRate is the moving average of the share of witness generation requests that failed with a server-side
+	// error on the backend, between 0 and 1.
+	FailureRate float64 `json:"failure_rate"`
+	// Evicted is whether the backend is evicted from the witness generation servers that span proof requests are
+	// balanced across, because its requests kept failing.
+	Evicted bool `json:"evicted"`
+	// CircuitBreakerOpen is whether no proofs are requested from the backend until it passes a health check, because
+	// too many requests to it failed in a row.
+	CircuitBreakerOpen bool `json:"circuit_breaker_open"`
+	// ProbeFailing is whether no proofs are requested from the backend until it passes the next health probe, because
+	// it failed its latest one.
+	ProbeFailing bool `json:"probe_failing"`
+}
+
+// AggSpan is a span proof that is aggregated by an AGG proof request.
+type AggSpan struct {
+	ID          int    `json:"id"`
+	StartBlock  uint64 `json:"start_block"`
+	EndBlock    uint64 `json:"end_block"`
+	Status      string `json:"status"`
+	StorageTier string `json:"storage_tier"`
+}
+
+// EffectiveConfig is the configuration that the proposer is running with, after the pipeline spec and the on-chain
+// config are applied, with secrets redacted. Hash identifies it in the logs and the config_info metric.
+type EffectiveConfig struct {
+	Hash   string         `json:"hash"`
+	Config map[string]any `json:"config"`
+}
+
+// RangeLease is a range of L2 blocks claimed by an external proving job, which the proposer doesn't prove until the
+// lease expires or is released. The lease covers the blocks after Start, up to and including End.
+type RangeLease struct {
+	ID    int    `json:"id"`
+	Owner string `json:"owner"`
+	Start uint64 `json:"start"`
+	End   uint64 `json:"end"`
+	// ExpiresAt is the unix timestamp at which the lease expires.
+	ExpiresAt uint64 `json:"expires_at"`
+}
+
+// RangeCancellation is the result of cancelling the proof requests of a range of L2 blocks.
+type RangeCancellation struct {
+	// Cancelled are the proof requests that were cancelled.
+	Cancelled []RequestStatus `json:"cancelled"`
+	// ProverCancelled is the number of cancelled requests that their server also cancelled on the prover network.
+	ProverCancelled int `json:"prover_cancelled"`
+	// Requeued are the span proofs that were queued to prove the blocks of the cancelled span proofs again.
+	Requeued []ProofRange `json:"requeued"`
+}
+
+// Downtime is a window in which no proposer instance was running, derived from the heartbeats of their runs.
+type Downtime struct {
+	// Start and End are the unix timestamps of the last heartbeat before the downtime and the start of the next run.
+	Start uint64 `json:"start"`
+	End   uint64 `json:"end"`
+	// Cause is MAINTENANCE if the proposer was stopped before the downtime, or CRASH if it exited without stopping.
+	Cause string `json:"cause"`
+	// Instance is the ID of the proposer instance that ran before the downtime.
+	Instance string `json:"instance"`
+}
+
+// ErrInvalidRequest is wrapped by the errors of admin requests that can't be carried out as requested, e.g. cancelling
+// a proof request that already completed, as opposed to failures of the proposer.
+var ErrInvalidRequest = errors.New("invalid admin request")
+
+// OPSuccinctDriver exposes the OP Succinct specific state of the proposer driver. It complements the op-proposer
+// ProposerDriver, which only supports starting and stopping the proposer.
+type OPSuccinctDriver interface {
+	PendingRequestStatuses(ctx context.Context) ([]RequestStatus, error)
+	RetrieveProof(ctx context.Context, id int) (ProofRetrieval, error)
+	ImportProofs(ctx context.Context, ranges []ProofRange) ([]ProofRange, error)
+	ProvingETA(ctx context.Context, l2Block uint64) (ProvingETA, error)
+	SetSubmissionsPaused(ctx context.Context, paused bool) error
+	SetProofRequestsPaused(ctx context.Context, paused bool) error
+	PauseStatus(ctx context.Context) (PauseStatus, error)
+	AggSpans(ctx context.Context, aggID int) ([]AggSpan, error)
+	SetExternalRef(ctx context.Context, id int, ref string) (RequestStatus, error)
+	ProofRequestByExternalRef(ctx context.Context, ref string) (RequestStatus, error)
+	LimiterStatus(ctx context.Context) (LimiterStatus, error)
+	BreakGlass(ctx context.Context, seconds uint64, reason string) (BreakGlassStatus, error)
+	EndBreakGlass(ctx context.Context) error
+	BreakGlassStatus(ctx context.Context) (BreakGlassStatus, error)
+	ProofRequestsWithStatus(ctx context.Context, status string) ([]RequestStatus, error)
+	RetryProofRequest(ctx context.Context, id int) (RequestStatus, error)
+	CancelProofRequest(ctx context.Context, id int) (RequestStatus, error)
+	CancelRange(ctx context.Context, start, end uint64) (RangeCancellation, error)
+	ProverBackendStatuses(ctx context.Context) ([]ProverBackendStatus, error)
+	EstimateRange(ctx context.Context, start, end uint64) (RangeEstimate, error)
+	ProofCosts(ctx context.Context, start, end uint64) (ProofCosts, error)
+	ProverStats(ctx context.Context, since uint64) ([]ProverStats, error)
+	EffectiveConfig(ctx context.Context) (EffectiveConfig, error)
+	ReplicationChanges(ctx context.Context, epoch string, since uint64, wait time.Duration) (*db.ReplicationBatch, error)
+	AcquireRangeLease(ctx context.Context, owner string, start, end, seconds uint64) (RangeLease, error)
+	RenewRangeLease(ctx context.Context, id int, owner string, seconds uint64) (RangeLease, error)
+	ReleaseRangeLease(ctx context.Context, id int, owner string) error
+	RangeLeases(ctx context.Context) ([]RangeLease, error)
+	Downtimes(ctx context.Context, since uint64) ([]Downtime, error)
+}
+
+type adminAPI struct {
+	b   OPSuccinctDriver
+	log log.Logger
+}
+
+func NewAdminAPI(dr OPSuccinctDriver, log log.Logger) *adminAPI {
+	return &adminAPI{
+		b:   dr,
+		log: log,
+	}
+}
+
+// GetAdminAPI returns the OP Succinct admin API. It is registered in the same `admin` namespace as the op-proposer
+// admin API, and the RPC server merges the methods of both.
+func GetAdminAPI(api *adminAPI) gethrpc.API {
+	return gethrpc.API{
+		Namespace: "admin",
+		Service:   api,
+	}
+}
+
+// PendingRequests returns every proof request that hasn't completed, with a human-readable reason for why it is not
+// currently being processed.
+func (a *adminAPI) PendingRequests(ctx context.Context) ([]RequestStatus, error) {
+	return a.b.PendingRequestStatuses(ctx)
+}
+
+// RetrieveProof returns the proof with the given ID. If the proof has been moved to cold storage, a retrieval is
+// triggered, and the proof is returned by a later call once the retrieval status is RESTORED.
+func (a *adminAPI) RetrieveProof(ctx context.Context, id int) (ProofRetrieval, error) {
+	return a.b.RetrieveProof(ctx, id)
+}
+
+// ImportProofs queues span proofs for the given ranges ahead of the planner, e.g. before a planned backfill. The ranges
+// are split into span proofs of at most the max block range per span proof. The import is all-or-nothing, and is
+// rejected if it overlaps the existing requests, leaves gaps in the coverage, or exceeds the unrequested span proof
+// budget. Returns the ranges of the queued span proofs.
+func (a *adminAPI) ImportProofs(ctx context.Context, ranges []ProofRange) ([]ProofRange, error) {
+	return a.b.ImportProofs(ctx, ranges)
+}
+
+// ProvingETA forecasts how long it will take until the given L2 block is covered by a contiguous chain of span proofs
+// from the latest proposed block. If the block is 0, the finalized L2 block is used.
+func (a *adminAPI) ProvingETA(ctx context.Context, l2Block uint64) (ProvingETA, error) {
+	return a.b.ProvingETA(ctx, l2Block)
+}
+
+// PauseSubmissions stops sending L1 transactions, e.g. during contract maintenance, while proving continues. Unlike
+// admin_stopProposer, span proofs keep being requested and fulfilled.
+func (a *adminAPI) PauseSubmissions(ctx context.Context) error {
+	return a.b.SetSubmissionsPaused(ctx, true)
+}
+
+// ResumeSubmissions resumes sending L1 transactions after PauseSubmissions.
+func (a *adminAPI) ResumeSubmissions(ctx context.Context) error {
+	return a.b.SetSubmissionsPaused(ctx, false)
+}
+
+// PauseProofRequests stops requesting new proofs from the server, while proofs that were already requested are still
+// tracked, and completed AGG proofs are still proposed.
+func (a *adminAPI) PauseProofRequests(ctx context.Context) error {
+	return a.b.SetProofRequestsPaused(ctx, true)
+}
+
+// ResumeProofRequests resumes requesting new proofs after PauseProofRequests.
+func (a *adminAPI) ResumeProofRequests(ctx context.Context) error {
+	return a.b.SetProofRequestsPaused(ctx, false)
+}
+
+// PauseStatus returns which parts of the pipeline are paused.
+func (a *adminAPI) PauseStatus(ctx context.Context) (PauseStatus, error) {
+	return a.b.PauseStatus(ctx)
+}
+
+// AggSpans returns the span proofs aggregated by the AGG proof request with the given ID, ordered by start block. The
+// spans are linked when the AGG proof request is created, and can't be archived while it is pending.
+func (a *adminAPI) AggSpans(ctx context.Context, aggID int) ([]AggSpan, error) {
+	return a.b.AggSpans(ctx, aggID)
+}
+
+// SetExternalRef attaches the ID of a proof request in an external job system, e.g. an orchestration system's job ID,
+// to the proof request with the given ID. References are unique, and can't be changed once set. Setting the same
+// reference again returns the request unchanged, so the call can be retried safely.
+func (a *adminAPI) SetExternalRef(ctx context.Context, id int, ref string) (RequestStatus, error) {
+	return a.b.SetExternalRef(ctx, id, ref)
+}
+
+// ProofRequestByExternalRef returns the proof request with the given external reference.
+func (a *adminAPI) ProofRequestByExternalRef(ctx context.Context, ref string) (RequestStatus, error) {
+	return a.b.ProofRequestByExternalRef(ctx, ref)
+}
+
+// LimiterStatus returns the remaining capacity under the witness generation and proof request concurrency limits. The
+// effective witness generation limit is persisted, so it isn't reset by restarting the proposer.
+func (a *adminAPI) LimiterStatus(ctx context.Context) (LimiterStatus, error) {
+	return a.b.LimiterStatus(ctx)
+}
+
+// BreakGlass lifts the span proof budget, the concurrency limits and the unrequested span proof budget of imports for
+// the given number of seconds, so that an on-call engineer can force a critical output through during an incident.
+// The reason is logged with every proof request that exceeds a limit under the override.
+func (a *adminAPI) BreakGlass(ctx context.Context, seconds uint64, reason string) (BreakGlassStatus, error) {
+	a.log.Warn("Break-glass override requested", "seconds", seconds, "reason", reason)
+	return a.b.BreakGlass(ctx, seconds, reason)
+}
+
+// EndBreakGlass ends the break-glass override before it expires.
+func (a *adminAPI) EndBreakGlass(ctx context.Context) error {
+	return a.b.EndBreakGlass(ctx)
+}
+
+// BreakGlassStatus returns the active break-glass override.
+func (a *adminAPI) BreakGlassStatus(ctx context.Context) (BreakGlassStatus, error) {
+	return a.b.BreakGlassStatus(ctx)
+}
+
+// ProofRequestsWithStatus returns the proof requests with the given status, e.g. FAILED.
+func (a *adminAPI) ProofRequestsWithStatus(ctx context.Context, status string) ([]RequestStatus, error) {
+	return a.b.ProofRequestsWithStatus(ctx, status)
+}
+
+// RetryProofRequest fails the proof request with the given ID if it hasn't failed yet, and queues a new request for the
+// same range, e.g. for a request that is stuck on the prover network. Returns the new request.
+func (a *adminAPI) RetryProofRequest(ctx context.Context, id int) (RequestStatus, error) {
+	a.log.Info("Proof request retry requested", "id", id)
+	return a.b.RetryProofRequest(ctx, id)
+}
+
+// CancelProofRequest fails the proof request with the given ID without retrying it. AGG proofs can't cover the range
+// of a cancelled span proof until it is retried or re-imported.
+func (a *adminAPI) CancelProofRequest(ctx context.Context, id int) (RequestStatus, error) {
+	a.log.Info("Proof request cancellation requested", "id", id)
+	return a.b.CancelProofRequest(ctx, id)
+}
+
+// CancelRange fails the proof requests that haven't completed or failed yet and cover a block after start, up to and
+// including end, and asks their servers to cancel the ones that were sent to the prover network. The blocks of the
+// cancelled span proofs are planned again and queued.
+func (a *adminAPI) CancelRange(ctx context.Context, start, end uint64) (RangeCancellation, error) {
+	a.log.Info("Range cancellation requested", "start", start, "end", end)
+	return a.b.CancelRange(ctx, start, end)
+}
+
+// ProverBackends returns the health of every prover backend, i.e. whether it's reachable, and the rate at which its
+// witness generation requests fail with server-side errors.
+func (a *adminAPI) ProverBackends(ctx context.Context) ([]ProverBackendStatus, error) {
+	return a.b.ProverBackendStatuses(ctx)
+}
+
+// EstimateRange estimates the span proofs, cycles and proving time of the L2 block range from start to end, e.g. to
+// check that a new submission interval can be proven in time.
+func (a *adminAPI) EstimateRange(ctx context.Context, start, end uint64) (RangeEstimate, error) {
+	return a.b.EstimateRange(ctx, start, end)
+}
+
+// ProofCosts returns the cycles and fees of the proofs completed within the L2 block range from start to end, so
+// operators can forecast the cost of proving future ranges.
+func (a *adminAPI) ProofCosts(ctx context.Context, start, end uint64) (ProofCosts, error) {
+	return a.b.ProofCosts(ctx, start, end)
+}
+
+// ProverStats returns the statistics of the provers on the prover network that were assigned the proposer's proof
+// requests that were last updated at or after the unix time since, or all of them if it's 0.
+func (a *adminAPI) ProverStats(ctx context.Context, since uint64) ([]ProverStats, error) {
+	return a.b.ProverStats(ctx, since)
+}
+
+// EffectiveConfig returns the configuration that the proposer is running with, with secrets redacted, and its hash, so
+// operators can check the settings of a running proposer.
+func (a *adminAPI) EffectiveConfig(ctx context.Context) (EffectiveConfig, error) {
+	return a.b.EffectiveConfig(ctx)
+}
+
+// AcquireRangeLease claims the L2 block range from start to end for an external proving job, e.g. a backfill script or
+// a re-prover, for the given number of seconds. The proposer doesn't plan or request span proofs that overlap the range
+// until the lease expires or is released, so the range isn't proven twice. A range that overlaps another lease can't
+// be claimed.
+func (a *adminAPI) AcquireRangeLease(ctx context.Context, owner string, start, end, seconds uint64) (RangeLease, error) {
+	return a.b.AcquireRangeLease(ctx, owner, start, end, seconds)
+}
+
+// RenewRangeLease extends the lease with the given ID, held by owner, to the given number of seconds from now. Long
+// running jobs renew their lease before it expires.
+func (a *adminAPI) RenewRangeLease(ctx context.Context, id int, owner string, seconds uint64) (RangeLease, error) {
+	return a.b.RenewRangeLease(ctx, id, owner, seconds)
+}
+
+// ReleaseRangeLease ends the lease with the given ID, held by owner, e.g. once the job is done or was abandoned.
+func (a *adminAPI) ReleaseRangeLease(ctx context.Context, id int, owner string) error {
+	return a.b.ReleaseRangeLease(ctx, id, owner)
+}
+
+// RangeLeases returns the range leases that haven't expired.
+func (a *adminAPI) RangeLeases(ctx context.Context) ([]RangeLease, error) {
+	return a.b.RangeLeases(ctx)
+}
+
+// Downtimes returns the windows since the given unix timestamp in which no proposer was running, and their causes.
+func (a *adminAPI) Downtimes(ctx context.Context, since uint64) ([]Downtime, error) {
+	return a.b.Downtimes(ctx, since)
+}
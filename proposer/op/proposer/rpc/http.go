@@ -0,0 +1,185 @@
+package rpc
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// adminHTTPHandler serves the admin API over plain HTTP, for operators and tools that don't speak JSON-RPC.
+type adminHTTPHandler struct {
+	b   OPSuccinctDriver
+	log log.Logger
+}
+
+// NewAdminHTTPHandler returns the handler of the admin HTTP API. Every request must carry the given token as a bearer
+// token. The endpoints are:
+//
+//   - GET /requests: the pending proof requests, or with ?status=, the proof requests with that status.
+//   - POST /requests/{id}/retry: fail a proof request and queue a new one for its range.
+//   - POST /requests/{id}/cancel: fail a proof request without retrying it.
+//   - POST /ranges/cancel?start=&end=: fail the pending proof requests of a block range, and queue its span proofs
+//     again.
+//   - GET /pause: which parts of the pipeline are paused.
+//   - POST /pause/{loop}, POST /resume/{loop}: pause or resume the `submissions` or `proof-requests` loop.
+//   - GET /config: the effective configuration, with secrets redacted, and its hash.
+//   - GET /replication/changes?epoch=&since=&wait=: the proof requests that changed after the given position of the
+//     DB's change feed, waiting up to wait seconds for one, for a warm standby to replicate.
+func NewAdminHTTPHandler(dr OPSuccinctDriver, token string, log log.Logger) http.Handler {
+	h := &adminHTTPHandler{b: dr, log: log}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /requests", h.listRequests)
+	mux.HandleFunc("POST /requests/{id}/retry", h.retryRequest)
+	mux.HandleFunc("POST /requests/{id}/cancel", h.cancelRequest)
+	mux.HandleFunc("POST /ranges/cancel", h.cancelRange)
+	mux.HandleFunc("GET /pause", h.pauseStatus)
+	mux.HandleFunc("POST /pause/{loop}", h.setPaused(true))
+	mux.HandleFunc("POST /resume/{loop}", h.setPaused(false))
+	mux.HandleFunc("GET /config", h.effectiveConfig)
+	mux.HandleFunc("GET /replication/changes", h.replicationChanges)
+	return requireBearerToken(token, mux)
+}
+
+// requireBearerToken rejects requests that don't carry the given bearer token.
+func requireBearerToken(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			writeError(w, http.StatusUnauthorized, errors.New("missing or invalid admin token"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *adminHTTPHandler) listRequests(w http.ResponseWriter, r *http.Request) {
+	status := r.URL.Query().Get("status")
+	if status == "" {
+		statuses, err := h.b.PendingRequestStatuses(r.Context())
+		h.respond(w, statuses, err)
+		return
+	}
+	statuses, err := h.b.ProofRequestsWithStatus(r.Context(), status)
+	h.respond(w, statuses, err)
+}
+
+func (h *adminHTTPHandler) retryRequest(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("the proof request ID must be an integer"))
+		return
+	}
+	h.log.Info("Proof request retry requested over HTTP", "id", id)
+	status, err := h.b.RetryProofRequest(r.Context(), id)
+	h.respond(w, status, err)
+}
+
+func (h *adminHTTPHandler) cancelRequest(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("the proof request ID must be an integer"))
+		return
+	}
+	h.log.Info("Proof request cancellation requested over HTTP", "id", id)
+	status, err := h.b.CancelProofRequest(r.Context(), id)
+	h.respond(w, status, err)
+}
+
+func (h *adminHTTPHandler) cancelRange(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	start, err := strconv.ParseUint(query.Get("start"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("start must be an unsigned integer"))
+		return
+	}
+	end, err := strconv.ParseUint(query.Get("end"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("end must be an unsigned integer"))
+		return
+	}
+	h.log.Info("Range cancellation requested over HTTP", "start", start, "end", end)
+	cancellation, err := h.b.CancelRange(r.Context(), start, end)
+	h.respond(w, cancellation, err)
+}
+
+func (h *adminHTTPHandler) pauseStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := h.b.PauseStatus(r.Context())
+	h.respond(w, status, err)
+}
+
+func (h *adminHTTPHandler) effectiveConfig(w http.ResponseWriter, r *http.Request) {
+	config, err := h.b.EffectiveConfig(r.Context())
+	h.respond(w, config, err)
+}
+
+func (h *adminHTTPHandler) replicationChanges(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var since, wait uint64
+	var err error
+	if v := query.Get("since"); v != "" {
+		if since, err = strconv.ParseUint(v, 10, 64); err != nil {
+			writeError(w, http.StatusBadRequest, errors.New("since must be an unsigned integer"))
+			return
+		}
+	}
+	if v := query.Get("wait"); v != "" {
+		if wait, err = strconv.ParseUint(v, 10, 64); err != nil {
+			writeError(w, http.StatusBadRequest, errors.New("wait must be a number of seconds"))
+			return
+		}
+	}
+	batch, err := h.b.ReplicationChanges(r.Context(), query.Get("epoch"), since, time.Duration(wait)*time.Second)
+	h.respond(w, batch, err)
+}
+
+func (h *adminHTTPHandler) setPaused(paused bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		switch loop := r.PathValue("loop"); loop {
+		case "submissions":
+			err = h.b.SetSubmissionsPaused(r.Context(), paused)
+		case "proof-requests":
+			err = h.b.SetProofRequestsPaused(r.Context(), paused)
+		default:
+			writeError(w, http.StatusNotFound, errors.New("the loop must be `submissions` or `proof-requests`"))
+			return
+		}
+		if err != nil {
+			h.respond(w, nil, err)
+			return
+		}
+		status, err := h.b.PauseStatus(r.Context())
+		h.respond(w, status, err)
+	}
+}
+
+// respond writes the given value as JSON, or the error if there is one.
+func (h *adminHTTPHandler) respond(w http.ResponseWriter, v any, err error) {
+	if errors.Is(err, ErrInvalidRequest) {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err != nil {
+		h.log.Error("admin HTTP request failed", "err", err)
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.log.Warn("failed to write admin HTTP response", "err", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(struct {
+		Error string `json:"error"`
+	}{err.Error()})
+}
@@ -1,13 +1,18 @@
 package proposer
 
+import "github.com/ethereum/go-ethereum/common"
+
 type SpanProofRequest struct {
 	Start uint64 `json:"start"`
 	End   uint64 `json:"end"`
 }
 
 type AggProofRequest struct {
-	Subproofs [][]byte `json:"subproofs"`
-	L1Head    string   `json:"head"`
+	Subproofs [][]byte `json:"subproofs,omitempty"`
+	// SubproofIDs are the prover network request IDs of the subproofs, which the server fetches the subproofs with
+	// instead of the request embedding them.
+	SubproofIDs []string `json:"subproof_ids,omitempty"`
+	L1Head      string   `json:"head"`
 }
 
 type ValidateConfigRequest struct {
@@ -20,10 +25,20 @@ type ValidateConfigResponse struct {
 	RangeVkeyValid        bool `json:"range_vkey_valid"`
 }
 
+// VersionResponse is the response type for the `version` RPC from the op-succinct-server.
+type VersionResponse struct {
+	SP1CircuitVersion   string      `json:"sp1_circuit_version"`
+	RangeVkeyCommitment common.Hash `json:"range_vkey_commitment"`
+	AggVkeyHash         common.Hash `json:"agg_vkey_hash"`
+}
+
 // WitnessGenerationResponse is the response type for the `request_span_proof` and `request_agg_proof`
 // RPCs from the op-succinct-server.
 type WitnessGenerationResponse struct {
 	ProofID []byte `json:"proof_id"`
+	// ArtifactID identifies the witness data the server kept on disk for the request. Empty if the server didn't keep
+	// any.
+	ArtifactID string `json:"artifact_id"`
 }
 
 // UnclaimDescription is the description of why a proof was unclaimed.
@@ -79,5 +94,32 @@ type ProofStatusResponse struct {
 	FulfillmentStatus SP1FulfillmentStatus `json:"fulfillment_status"`
 	ExecutionStatus   SP1ExecutionStatus   `json:"execution_status"`
 	Proof             []byte               `json:"proof"`
+	// Cycles is the number of SP1 cycles the fulfilled proof took, if the server reports it.
+	Cycles uint64 `json:"cycles"`
+	// Fee is the fee paid to the prover network for the fulfilled proof, in the base units of the PROVE token, as a
+	// decimal string. It's empty if the server doesn't report it.
+	Fee string `json:"fee"`
+	// Fulfiller is the address of the prover that was assigned the request on the prover network, which is reported
+	// for fulfilled and unfulfillable requests. It's empty if no prover was assigned, or the server doesn't report it.
+	Fulfiller string `json:"fulfiller"`
+}
+
+// ProofStatusBatchRequest is the request type for the batched `/status` RPC to the op-succinct-server, which gets the
+// statuses of several proofs in one round-trip.
+type ProofStatusBatchRequest struct {
+	ProofIDs []string `json:"proof_ids"`
+}
+
+// ProofStatusBatchResponse is the response type for the batched `/status` RPC. The statuses are in the order of the
+// requested proof IDs.
+type ProofStatusBatchResponse struct {
+	Statuses []ProofStatusBatchEntry `json:"statuses"`
+}
+
+// ProofStatusBatchEntry is the status of a proof in a ProofStatusBatchResponse, or the error the server got fetching it.
+type ProofStatusBatchEntry struct {
+	ProofID string               `json:"proof_id"`
+	Status  *ProofStatusResponse `json:"status,omitempty"`
+	Error   string               `json:"error,omitempty"`
 }
 
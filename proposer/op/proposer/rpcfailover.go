@@ -0,0 +1,343 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+const (
+	// rpcFailureRateWeight is the weight of the latest request in the failure rate of an RPC endpoint.
+	rpcFailureRateWeight = 0.2
+	// rpcEvictionFailureRate is the failure rate above which requests fail over from an RPC endpoint.
+	rpcEvictionFailureRate = 0.5
+	// rpcEvictionCooldown is how long requests fail over from an evicted RPC endpoint. It's then readmitted with a clean
+	// failure rate, and evicted again if its requests keep failing.
+	rpcEvictionCooldown = time.Minute
+)
+
+// rpcHead is how the head of an RPC endpoint is probed: the JSON-RPC method that returns it, and how its block number
+// is parsed from the method's result.
+type rpcHead struct {
+	method string
+	parse  func(result json.RawMessage) (uint64, error)
+}
+
+// l1Head probes the head of an L1 RPC endpoint.
+var l1Head = rpcHead{
+	method: "eth_blockNumber",
+	parse: func(result json.RawMessage) (uint64, error) {
+		var number hexutil.Uint64
+		err := json.Unmarshal(result, &number)
+		return uint64(number), err
+	},
+}
+
+// rollupHead probes the safe head of a rollup node, which is the head that outputs are proposed from.
+var rollupHead = rpcHead{
+	method: "optimism_syncStatus",
+	parse: func(result json.RawMessage) (uint64, error) {
+		var status struct {
+			SafeL2 struct {
+				Number uint64 `json:"number"`
+			} `json:"safe_l2"`
+		}
+		err := json.Unmarshal(result, &status)
+		return status.SafeL2.Number, err
+	},
+}
+
+// rpcEndpoint is the health of one of the endpoints of an RPC failover.
+type rpcEndpoint struct {
+	url *url.URL
+	// failureRate is the exponentially weighted moving average of the failures of the requests sent to the endpoint,
+	// between 0 and 1.
+	failureRate float64
+	// evictedUntil is when the endpoint is readmitted if it's evicted, or zero.
+	evictedUntil time.Time
+	// lagging is whether the endpoint's head lagged too far behind the others when they were last probed.
+	lagging bool
+}
+
+// rpcFailover is an http.RoundTripper that sends each JSON-RPC request to the healthiest of several endpoints of the
+// same chain, so that a single flaky RPC provider doesn't halt the proposer. An endpoint is healthy while it isn't
+// evicted for failing too many requests, and while its head doesn't lag behind the others. Requests go to the first
+// healthy endpoint in the configured order, and a request that fails is retried on the next endpoint.
+type rpcFailover struct {
+	log       log.Logger
+	metr      opsuccinctmetrics.OPSuccinctMetricer
+	name      string
+	head      rpcHead
+	maxLag    uint64
+	transport http.RoundTripper
+
+	mu        sync.Mutex
+	endpoints []*rpcEndpoint
+
+	cancel context.CancelFunc
+}
+
+func newRPCFailover(log log.Logger, metr opsuccinctmetrics.OPSuccinctMetricer, name string, urls []string, head rpcHead, maxLag uint64) (*rpcFailover, error) {
+	if err := checkHTTPEndpoints(urls); err != nil {
+		return nil, err
+	}
+	endpoints := make([]*rpcEndpoint, len(urls))
+	for i, s := range urls {
+		u, _ := url.Parse(s)
+		endpoints[i] = &rpcEndpoint{url: u}
+	}
+	return &rpcFailover{
+		log:       log,
+		metr:      metr,
+		name:      name,
+		head:      head,
+		maxLag:    maxLag,
+		transport: http.DefaultTransport,
+		endpoints: endpoints,
+	}, nil
+}
+
+// checkHTTPEndpoints returns an error unless all the URLs are HTTP URLs.
+func checkHTTPEndpoints(urls []string) error {
+	for _, s := range urls {
+		u, err := url.Parse(s)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%s is not an HTTP URL", redactURL(s))
+		}
+	}
+	return nil
+}
+
+// RoundTrip sends the request to the endpoints in failover order, until one of them responds without a server-side
+// error. JSON-RPC errors, e.g. reverts, are responses of a healthy endpoint, so they aren't failed over.
+func (f *rpcFailover) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	endpoints := f.order(time.Now())
+	var resp *http.Response
+	var err error
+	for i, endpoint := range endpoints {
+		resp, err = f.transport.RoundTrip(endpointRequest(req, endpoint.url, body))
+		// A request that the caller cancelled says nothing about the endpoint.
+		if req.Context().Err() != nil {
+			return resp, err
+		}
+		failed := err != nil || resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+		f.onResult(endpoint, failed)
+		if !failed || i == len(endpoints)-1 {
+			return resp, err
+		}
+		reason := "unreachable"
+		if err == nil {
+			reason = resp.Status
+			resp.Body.Close()
+		}
+		f.log.Warn("RPC request failed, failing over to the next endpoint", "rpc", f.name, "endpoint", redactURL(endpoint.url.String()), "reason", reason, "err", err)
+		f.metr.RecordError("rpc_failover", 1)
+	}
+	return resp, err
+}
+
+// endpointRequest returns a copy of the request that is sent to the endpoint with the given body.
+func endpointRequest(req *http.Request, endpoint *url.URL, body []byte) *http.Request {
+	out := req.Clone(req.Context())
+	out.URL = endpoint
+	out.Host = endpoint.Host
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	out.ContentLength = int64(len(body))
+	if endpoint.User != nil {
+		password, _ := endpoint.User.Password()
+		out.SetBasicAuth(endpoint.User.Username(), password)
+	}
+	return out
+}
+
+// order returns the endpoints in the order that requests fail over across them: the healthy endpoints in the
+// configured order, followed by the unhealthy ones from the lowest failure rate. Evicted endpoints whose cooldown is
+// over are readmitted with a clean failure rate.
+func (f *rpcFailover) order(now time.Time) []*rpcEndpoint {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var healthy, unhealthy []*rpcEndpoint
+	for _, endpoint := range f.endpoints {
+		if !endpoint.evictedUntil.IsZero() && !now.Before(endpoint.evictedUntil) {
+			endpoint.evictedUntil = time.Time{}
+			endpoint.failureRate = 0
+			f.log.Info("Readmitted evicted RPC endpoint", "rpc", f.name, "endpoint", redactURL(endpoint.url.String()))
+		}
+		if endpoint.evictedUntil.IsZero() && !endpoint.lagging {
+			healthy = append(healthy, endpoint)
+		} else {
+			unhealthy = append(unhealthy, endpoint)
+		}
+	}
+	sort.SliceStable(unhealthy, func(i, j int) bool {
+		return unhealthy[i].failureRate < unhealthy[j].failureRate
+	})
+	return append(healthy, unhealthy...)
+}
+
+// onResult records whether a request sent to the endpoint failed, and evicts the endpoint once its failure rate
+// crosses rpcEvictionFailureRate.
+func (f *rpcFailover) onResult(endpoint *rpcEndpoint, failed bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var outcome float64
+	if failed {
+		outcome = 1
+	}
+	endpoint.failureRate += rpcFailureRateWeight * (outcome - endpoint.failureRate)
+	if endpoint.failureRate > rpcEvictionFailureRate && endpoint.evictedUntil.IsZero() {
+		endpoint.evictedUntil = time.Now().Add(rpcEvictionCooldown)
+		f.log.Warn("Evicted failing RPC endpoint", "rpc", f.name, "endpoint", redactURL(endpoint.url.String()), "failureRate", endpoint.failureRate, "until", endpoint.evictedUntil)
+		f.metr.RecordError("rpc_endpoint_evicted", 1)
+	}
+}
+
+// checkHeads probes the heads of all endpoints, and marks the endpoints whose head lags more than maxLag blocks behind
+// the best one as lagging. Endpoints that can't be probed count a failed request.
+func (f *rpcFailover) checkHeads(ctx context.Context) {
+	heads := make([]uint64, len(f.endpoints))
+	errs := make([]error, len(f.endpoints))
+	var wg sync.WaitGroup
+	for i, endpoint := range f.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			heads[i], errs[i] = f.probeHead(ctx, endpoint.url)
+		}()
+	}
+	wg.Wait()
+
+	var best uint64
+	for i, endpoint := range f.endpoints {
+		if errs[i] != nil {
+			f.log.Warn("Failed to probe the head of RPC endpoint", "rpc", f.name, "endpoint", redactURL(endpoint.url.String()), "err", errs[i])
+			f.onResult(endpoint, true)
+			continue
+		}
+		best = max(best, heads[i])
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i, endpoint := range f.endpoints {
+		if errs[i] != nil {
+			continue
+		}
+		lagging := best-heads[i] > f.maxLag
+		if lagging && !endpoint.lagging {
+			f.log.Warn("RPC endpoint is lagging, failing over from it", "rpc", f.name, "endpoint", redactURL(endpoint.url.String()), "head", heads[i], "best", best)
+			f.metr.RecordError("rpc_endpoint_lagging", 1)
+		} else if !lagging && endpoint.lagging {
+			f.log.Info("RPC endpoint caught up", "rpc", f.name, "endpoint", redactURL(endpoint.url.String()), "head", heads[i], "best", best)
+		}
+		endpoint.lagging = lagging
+	}
+}
+
+// probeHead returns the head block number of the endpoint.
+func (f *rpcFailover) probeHead(ctx context.Context, endpoint *url.URL) (uint64, error) {
+	body := []byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":%q,"params":[]}`, f.head.method))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := f.transport.RoundTrip(endpointRequest(req, endpoint, body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("received status code %d", resp.StatusCode)
+	}
+	var msg struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&msg); err != nil {
+		return 0, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if msg.Error != nil {
+		return 0, fmt.Errorf("%s failed: %s", f.head.method, msg.Error.Message)
+	}
+	return f.head.parse(msg.Result)
+}
+
+// Start probes the heads of the endpoints every interval, each probe with the given timeout, until the failover is
+// closed.
+func (f *rpcFailover) Start(interval, timeout time.Duration) {
+	ctx, cancel := context.WithCancel(context.Background())
+	f.cancel = cancel
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			cCtx, cCancel := context.WithTimeout(ctx, timeout)
+			f.checkHeads(cCtx)
+			cCancel()
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Close stops probing the heads of the endpoints.
+func (f *rpcFailover) Close() {
+	if f.cancel != nil {
+		f.cancel()
+	}
+}
+
+// dialWithFailover dials an RPC endpoint of the given chain with its fallbacks, through a failover that routes each
+// request to the healthiest of them.
+func (ps *ProposerService) dialWithFailover(ctx context.Context, cfg *CLIConfig, name string, urls []string, head rpcHead) (*gethrpc.Client, error) {
+	failover, err := newRPCFailover(ps.Log, ps.Metrics, name, urls, head, cfg.RpcMaxLagBlocks)
+	if err != nil {
+		return nil, err
+	}
+	client, err := gethrpc.DialOptions(ctx, urls[0], gethrpc.WithHTTPClient(&http.Client{Transport: failover}))
+	if err != nil {
+		return nil, err
+	}
+	failover.Start(cfg.RpcHealthCheckInterval, cfg.NetworkTimeout)
+	ps.rpcFailovers = append(ps.rpcFailovers, failover)
+	ps.Log.Info("Failing over RPC requests across endpoints", "rpc", name, "endpoints", redactedURLs(urls))
+	return client, nil
+}
+
+// redactedURLs redacts the URLs for logging.
+func redactedURLs(urls []string) string {
+	redactedUrls := make([]string, len(urls))
+	for i, u := range urls {
+		redactedUrls[i] = redactURL(u)
+	}
+	return strings.Join(redactedUrls, ",")
+}
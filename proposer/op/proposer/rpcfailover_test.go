@@ -0,0 +1,94 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+// fakeL1RPC is an L1 RPC endpoint that answers eth_blockNumber with its head, or fails with a server error while down.
+type fakeL1RPC struct {
+	head     atomic.Uint64
+	down     atomic.Bool
+	requests atomic.Int64
+}
+
+func (f *fakeL1RPC) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.requests.Add(1)
+	if f.down.Load() {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	var req struct {
+		ID json.RawMessage `json:"id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"%s"}`, req.ID, hexutil.Uint64(f.head.Load()))
+}
+
+func TestRPCFailover(t *testing.T) {
+	primary, fallback := &fakeL1RPC{}, &fakeL1RPC{}
+	primary.head.Store(100)
+	fallback.head.Store(100)
+	primaryServer, fallbackServer := httptest.NewServer(primary), httptest.NewServer(fallback)
+	defer primaryServer.Close()
+	defer fallbackServer.Close()
+
+	failover, err := newRPCFailover(log.New(), opsuccinctmetrics.NoopMetrics, "l1", []string{primaryServer.URL, fallbackServer.URL}, l1Head, 5)
+	require.NoError(t, err)
+	ctx := context.Background()
+	client, err := gethrpc.DialOptions(ctx, primaryServer.URL, gethrpc.WithHTTPClient(&http.Client{Transport: failover}))
+	require.NoError(t, err)
+	defer client.Close()
+	blockNumber := func() uint64 {
+		var head hexutil.Uint64
+		require.NoError(t, client.CallContext(ctx, &head, "eth_blockNumber"))
+		return uint64(head)
+	}
+
+	// Requests go to the primary while it's healthy.
+	require.Equal(t, uint64(100), blockNumber())
+	require.Equal(t, int64(1), primary.requests.Load())
+	require.Equal(t, int64(0), fallback.requests.Load())
+
+	// Requests that fail on the primary are retried on the fallback, until the primary is evicted.
+	primary.down.Store(true)
+	fallback.head.Store(101)
+	for i := 0; i < 4; i++ {
+		require.Equal(t, uint64(101), blockNumber())
+	}
+	require.Equal(t, int64(5), primary.requests.Load())
+	require.Equal(t, uint64(101), blockNumber())
+	require.Equal(t, int64(5), primary.requests.Load())
+
+	// A primary that lags behind is failed over from even though its requests succeed.
+	failover, err = newRPCFailover(log.New(), opsuccinctmetrics.NoopMetrics, "l1", []string{primaryServer.URL, fallbackServer.URL}, l1Head, 5)
+	require.NoError(t, err)
+	primary.down.Store(false)
+	fallback.head.Store(110)
+	failover.checkHeads(ctx)
+	require.Equal(t, fallbackServer.URL, failover.order(time.Now())[0].url.String())
+
+	// Once it catches up, requests go to the primary again.
+	primary.head.Store(108)
+	failover.checkHeads(ctx)
+	require.Equal(t, primaryServer.URL, failover.order(time.Now())[0].url.String())
+
+	_, err = newRPCFailover(log.New(), opsuccinctmetrics.NoopMetrics, "l1", []string{"ws://localhost:8546"}, l1Head, 5)
+	require.Error(t, err)
+}
@@ -0,0 +1,209 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// safeABI is the ABI of the functions of the Gnosis Safe contract that Safe transactions are built with.
+const safeABI = `[{"type":"function","name":"nonce","stateMutability":"view","inputs":[],"outputs":[
+	{"name":"","type":"uint256"}
+]},{"type":"function","name":"getTransactionHash","stateMutability":"view","inputs":[
+	{"name":"to","type":"address"},
+	{"name":"value","type":"uint256"},
+	{"name":"data","type":"bytes"},
+	{"name":"operation","type":"uint8"},
+	{"name":"safeTxGas","type":"uint256"},
+	{"name":"baseGas","type":"uint256"},
+	{"name":"gasPrice","type":"uint256"},
+	{"name":"gasToken","type":"address"},
+	{"name":"refundReceiver","type":"address"},
+	{"name":"_nonce","type":"uint256"}
+],"outputs":[
+	{"name":"","type":"bytes32"}
+]}]`
+
+// safeTxOrigin is the origin that Safe transactions are proposed with, which the Safe apps show their owners.
+const safeTxOrigin = "op-succinct-proposer"
+
+// SafeTransaction is a Safe transaction as it's proposed to the Safe Transaction Service. The proposer's transactions
+// are plain calls without gas refunds, so the gas fields are zero, and whoever executes the transaction pays its gas.
+type SafeTransaction struct {
+	To             common.Address `json:"to"`
+	Value          string         `json:"value"`
+	Data           hexutil.Bytes  `json:"data"`
+	Operation      uint8          `json:"operation"`
+	SafeTxGas      string         `json:"safeTxGas"`
+	BaseGas        string         `json:"baseGas"`
+	GasPrice       string         `json:"gasPrice"`
+	GasToken       common.Address `json:"gasToken"`
+	RefundReceiver common.Address `json:"refundReceiver"`
+	Nonce          uint64         `json:"nonce"`
+	// ContractTransactionHash is the hash of the transaction that the Safe's owners sign, and Signature is the
+	// signature of the Sender, an owner or a delegate of the Safe.
+	ContractTransactionHash common.Hash    `json:"contractTransactionHash"`
+	Sender                  common.Address `json:"sender"`
+	Signature               hexutil.Bytes  `json:"signature"`
+	Origin                  string         `json:"origin"`
+}
+
+// pendingSafeTransaction is a Safe transaction that the Safe Transaction Service lists as not executed yet. Its data
+// is null for transfers, and its nonce is a string in some versions of the service.
+type pendingSafeTransaction struct {
+	To         common.Address `json:"to"`
+	Data       *hexutil.Bytes `json:"data"`
+	Nonce      json.Number    `json:"nonce"`
+	SafeTxHash common.Hash    `json:"safeTxHash"`
+}
+
+// newSafeContract binds the Gnosis Safe contract at the given address.
+func newSafeContract(address common.Address, caller bind.ContractCaller) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(safeABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Safe ABI: %w", err)
+	}
+	return bind.NewBoundContract(address, parsed, caller, nil, nil), nil
+}
+
+// proposeToSafe proposes the output proposal to the Safe at SAFE_ADDRESS through the Safe Transaction Service, for
+// teams whose proposer role is held by a multisig. The output is proposed once its owners sign and execute the Safe
+// transaction, so on later polls the same proposal is found pending and isn't proposed again. A proposal of a later
+// output takes the next Safe nonce, so the owners can execute either of them.
+func (l *L2OutputSubmitter) proposeToSafe(ctx context.Context, output *eth.OutputResponse, proof []byte, l1BlockNum uint64) error {
+	candidate, err := l.proposalCandidate(output, proof, l1BlockNum)
+	if err != nil {
+		return err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	var out []interface{}
+	if err := l.safe.Call(opts, &out, "nonce"); err != nil {
+		return fmt.Errorf("failed to get the Safe nonce: %w", err)
+	}
+	nonce := out[0].(*big.Int).Uint64()
+
+	pending, err := l.pendingSafeTransactions(ctx, nonce)
+	if err != nil {
+		return fmt.Errorf("failed to get the pending Safe transactions: %w", err)
+	}
+	for _, tx := range pending {
+		if tx.To == *candidate.To && tx.Data != nil && bytes.Equal(*tx.Data, candidate.TxData) {
+			l.Log.Info("Proposal is pending in the Safe, waiting for its owners to execute it", "safe", l.Cfg.SafeAddress, "nonce", tx.Nonce, "safeTxHash", tx.SafeTxHash, "end", output.BlockRef.Number)
+			return nil
+		}
+		n, err := strconv.ParseUint(tx.Nonce.String(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid nonce %q of pending Safe transaction %s: %w", tx.Nonce, tx.SafeTxHash, err)
+		}
+		nonce = max(nonce, n+1)
+	}
+
+	value := new(big.Int)
+	if candidate.Value != nil {
+		value = candidate.Value
+	}
+	out = nil
+	err = l.safe.Call(opts, &out, "getTransactionHash", *candidate.To, value, candidate.TxData, uint8(0), common.Big0, common.Big0, common.Big0, common.Address{}, common.Address{}, new(big.Int).SetUint64(nonce))
+	if err != nil {
+		return fmt.Errorf("failed to get the Safe transaction hash: %w", err)
+	}
+	safeTxHash := common.Hash(out[0].([32]byte))
+	signature, err := crypto.Sign(safeTxHash.Bytes(), l.SafeSigner)
+	if err != nil {
+		return fmt.Errorf("failed to sign the Safe transaction: %w", err)
+	}
+	// The Safe recovers the signer of a plain ECDSA signature from v of 27 or 28.
+	signature[crypto.RecoveryIDOffset] += 27
+
+	tx := SafeTransaction{
+		To:                      *candidate.To,
+		Value:                   value.String(),
+		Data:                    candidate.TxData,
+		SafeTxGas:               "0",
+		BaseGas:                 "0",
+		GasPrice:                "0",
+		Nonce:                   nonce,
+		ContractTransactionHash: safeTxHash,
+		Sender:                  crypto.PubkeyToAddress(l.SafeSigner.PublicKey),
+		Signature:               signature,
+		Origin:                  safeTxOrigin,
+	}
+	if err := l.postSafeTransaction(ctx, tx); err != nil {
+		l.Metr.RecordError("safe_tx_service", 1)
+		return fmt.Errorf("failed to propose the Safe transaction: %w", err)
+	}
+	l.Log.Info("Proposed the output proposal to the Safe, waiting for its owners to sign and execute it", "safe", l.Cfg.SafeAddress, "nonce", nonce, "safeTxHash", safeTxHash, "end", output.BlockRef.Number)
+	return nil
+}
+
+// pendingSafeTransactions returns the Safe transactions that aren't executed yet, from the given nonce on.
+func (l *L2OutputSubmitter) pendingSafeTransactions(ctx context.Context, nonce uint64) ([]pendingSafeTransaction, error) {
+	url := fmt.Sprintf("%s?executed=false&nonce__gte=%d&ordering=nonce&limit=100", l.safeTxServiceURL(), nonce)
+	var page struct {
+		Results []pendingSafeTransaction `json:"results"`
+	}
+	if err := l.safeTxServiceRequest(ctx, "GET", url, nil, &page); err != nil {
+		return nil, err
+	}
+	return page.Results, nil
+}
+
+// postSafeTransaction proposes the signed Safe transaction to the Safe Transaction Service.
+func (l *L2OutputSubmitter) postSafeTransaction(ctx context.Context, tx SafeTransaction) error {
+	body, err := json.Marshal(tx)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+	return l.safeTxServiceRequest(ctx, "POST", l.safeTxServiceURL(), body, nil)
+}
+
+// safeTxServiceURL returns the URL of the Safe's multisig transactions on the Safe Transaction Service.
+func (l *L2OutputSubmitter) safeTxServiceURL() string {
+	return fmt.Sprintf("%s/api/v1/safes/%s/multisig-transactions/", strings.TrimSuffix(l.Cfg.SafeTxServiceUrl, "/"), common.HexToAddress(l.Cfg.SafeAddress).Hex())
+}
+
+// safeTxServiceRequest sends a request to the Safe Transaction Service, authenticated with SAFE_TX_SERVICE_TOKEN if
+// it's set, and decodes the response into out, unless it's nil.
+func (l *L2OutputSubmitter) safeTxServiceRequest(ctx context.Context, method, url string, body []byte, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if l.Cfg.SafeTxServiceToken != "" {
+		req.Header.Set("Authorization", "Bearer "+l.Cfg.SafeTxServiceToken)
+	}
+	client := &http.Client{Timeout: l.Cfg.NetworkTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("received status code %d: %s", resp.StatusCode, respBody)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	opsuccinctbindings "github.com/succinctlabs/op-succinct-go/bindings"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+// fakeSafe is a Safe contract with the given nonce, whose transaction hashes are the hashes of the call data.
+type fakeSafe struct {
+	abi   abi.ABI
+	nonce uint64
+}
+
+func (f *fakeSafe) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (f *fakeSafe) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	method, err := f.abi.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	if method.Name == "nonce" {
+		return method.Outputs.Pack(new(big.Int).SetUint64(f.nonce))
+	}
+	return method.Outputs.Pack(crypto.Keccak256Hash(call.Data))
+}
+
+func TestProposeToSafe(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(safeABI))
+	require.NoError(t, err)
+	safeAddr := common.Address{0x5a}
+	safe, err := newSafeContract(safeAddr, &fakeSafe{abi: parsed, nonce: 5})
+	require.NoError(t, err)
+	l2ooABI, err := opsuccinctbindings.OPSuccinctL2OutputOracleMetaData.GetAbi()
+	require.NoError(t, err)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	var pending []map[string]any
+	var posted []SafeTransaction
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/safes/"+safeAddr.Hex()+"/multisig-transactions/", r.URL.Path)
+		require.Equal(t, "Bearer s3cret", r.Header.Get("Authorization"))
+		if r.Method == "GET" {
+			require.Equal(t, "false", r.URL.Query().Get("executed"))
+			require.Equal(t, "5", r.URL.Query().Get("nonce__gte"))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"results": pending}))
+			return
+		}
+		var tx SafeTransaction
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&tx))
+		posted = append(posted, tx)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	l2oo := common.Address{0x20}
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg: ProposerConfig{
+				L2OutputOracleAddr: &l2oo,
+				SafeAddress:        safeAddr.Hex(),
+				SafeTxServiceUrl:   server.URL + "/",
+				SafeTxServiceToken: "s3cret",
+			},
+			SafeSigner: key,
+		},
+		l2ooABI: l2ooABI,
+		safe:    safe,
+	}
+	output := &eth.OutputResponse{OutputRoot: eth.Bytes32{0x01}, BlockRef: eth.L2BlockRef{Number: 300}}
+
+	// The proposal is signed by the proposer's key with the Safe's nonce.
+	require.NoError(t, l.proposeToSafe(context.Background(), output, []byte("proof"), 90))
+	require.Len(t, posted, 1)
+	tx := posted[0]
+	require.Equal(t, l2oo, tx.To)
+	require.Equal(t, uint64(5), tx.Nonce)
+	require.Equal(t, "0", tx.Value)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), tx.Sender)
+	require.Contains(t, []byte{27, 28}, tx.Signature[64])
+	sig := append([]byte{}, tx.Signature...)
+	sig[64] -= 27
+	pub, err := crypto.SigToPub(tx.ContractTransactionHash.Bytes(), sig)
+	require.NoError(t, err)
+	require.Equal(t, tx.Sender, crypto.PubkeyToAddress(*pub))
+
+	// A proposal that is pending in the Safe isn't proposed again.
+	pending = []map[string]any{{"to": tx.To, "data": tx.Data, "nonce": 5, "safeTxHash": tx.ContractTransactionHash}}
+	require.NoError(t, l.proposeToSafe(context.Background(), output, []byte("proof"), 90))
+	require.Len(t, posted, 1)
+
+	// A proposal of another output takes the next nonce.
+	output.BlockRef.Number = 400
+	require.NoError(t, l.proposeToSafe(context.Background(), output, []byte("proof"), 90))
+	require.Len(t, posted, 2)
+	require.Equal(t, uint64(6), posted[1].Nonce)
+	require.NotEqual(t, tx.ContractTransactionHash, posted[1].ContractTransactionHash)
+}
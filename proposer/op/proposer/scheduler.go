@@ -0,0 +1,322 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/rpc"
+)
+
+var _ rpc.OPSuccinctDriver = (*L2OutputSubmitter)(nil)
+
+// PendingRequestStatuses returns every proof request that hasn't completed yet, along with a human-readable reason for
+// why it isn't making progress. The reasons mirror the scheduling decisions made in RequestQueuedProofs, so operators
+// can tell why a proof is stuck without reading the code.
+func (l *L2OutputSubmitter) PendingRequestStatuses(ctx context.Context) ([]rpc.RequestStatus, error) {
+	l.mutex.Lock()
+	running := l.running
+	l.mutex.Unlock()
+
+	// Read all requests from a single snapshot, so that a request changing status while the report is built isn't
+	// listed twice or left out.
+	var statuses []rpc.RequestStatus
+	err := l.db.ReadSnapshot(func(snapshot *db.ProofDB) error {
+		unreqs, err := snapshot.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+		if err != nil {
+			return fmt.Errorf("failed to get unrequested proofs: %w", err)
+		}
+		witnessGenReqs, err := snapshot.GetAllProofsWithStatus(proofrequest.StatusWITNESSGEN)
+		if err != nil {
+			return fmt.Errorf("failed to get witness generation proofs: %w", err)
+		}
+		provingReqs, err := snapshot.GetAllProofsWithStatus(proofrequest.StatusPROVING)
+		if err != nil {
+			return fmt.Errorf("failed to get proving proofs: %w", err)
+		}
+		blockedReqs, err := snapshot.GetAllProofsWithStatus(proofrequest.StatusBLOCKED)
+		if err != nil {
+			return fmt.Errorf("failed to get blocked proofs: %w", err)
+		}
+		next, err := l.nextProofToRequest(snapshot)
+		if err != nil {
+			return err
+		}
+
+		now := uint64(time.Now().Unix())
+		for _, req := range witnessGenReqs {
+			reason := fmt.Sprintf("witness generation in progress for %ds", now-req.LastUpdatedTime)
+			statuses = append(statuses, newRequestStatus(req, reason))
+		}
+		for _, req := range provingReqs {
+			reason := fmt.Sprintf("waiting for the prover network to fulfill request %s", req.ProverRequestID)
+			statuses = append(statuses, newRequestStatus(req, reason))
+		}
+		leases, err := snapshot.GetActiveRangeLeases(now)
+		if err != nil {
+			return err
+		}
+		for _, req := range blockedReqs {
+			reason := "unblocked: removed from BLOCKED_RANGES or the lease ended, will be queued again on the next poll"
+			if r, ok := l.blockedRangeOf(req.StartBlock, req.EndBlock); ok {
+				reason = fmt.Sprintf("blocked: overlaps the range %s in BLOCKED_RANGES", r)
+			} else if lease := rangeLeaseOf(leases, req.StartBlock, req.EndBlock); lease != nil {
+				reason = fmt.Sprintf("leased: blocks %d-%d are leased to %s for another %ds", lease.StartBlock, lease.EndBlock, lease.Owner, lease.ExpiresTime-now)
+			}
+			statuses = append(statuses, newRequestStatus(req, reason))
+		}
+
+		// Requests are dispatched escalated first, then AGG first, then in order of start block.
+		sort.Slice(unreqs, func(i, j int) bool {
+			if ei, ej := l.slaEscalated(unreqs[i].StartBlock), l.slaEscalated(unreqs[j].StartBlock); ei != ej {
+				return ei
+			}
+			if unreqs[i].Type != unreqs[j].Type {
+				return unreqs[i].Type == proofrequest.TypeAGG
+			}
+			return unreqs[i].StartBlock < unreqs[j].StartBlock
+		})
+		for _, req := range unreqs {
+			reason := l.blockedReason(snapshot, req, next, running, len(witnessGenReqs), len(provingReqs))
+			statuses = append(statuses, newRequestStatus(req, reason))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+// AggSpans returns the span proofs linked to the AGG proof request with the given ID.
+func (l *L2OutputSubmitter) AggSpans(ctx context.Context, aggID int) ([]rpc.AggSpan, error) {
+	agg, err := l.db.GetProofRequest(aggID)
+	if err != nil {
+		return nil, err
+	}
+	if agg.Type != proofrequest.TypeAGG {
+		return nil, fmt.Errorf("proof request %d is a %s proof request, not an AGG proof request", aggID, agg.Type)
+	}
+
+	spans, err := l.db.GetAggSpans(aggID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]rpc.AggSpan, 0, len(spans))
+	for _, span := range spans {
+		result = append(result, rpc.AggSpan{
+			ID:          span.ID,
+			StartBlock:  span.StartBlock,
+			EndBlock:    span.EndBlock,
+			Status:      span.Status.String(),
+			StorageTier: span.StorageTier.String(),
+		})
+	}
+	return result, nil
+}
+
+// maxExternalRefLength bounds the length of external references, which are stored on every proof request.
+const maxExternalRefLength = 256
+
+// SetExternalRef attaches the ID of the proof request in an external job system to the proof request.
+func (l *L2OutputSubmitter) SetExternalRef(ctx context.Context, id int, ref string) (rpc.RequestStatus, error) {
+	if ref == "" {
+		return rpc.RequestStatus{}, fmt.Errorf("the external reference must not be empty")
+	}
+	if len(ref) > maxExternalRefLength {
+		return rpc.RequestStatus{}, fmt.Errorf("the external reference is longer than %d bytes", maxExternalRefLength)
+	}
+	req, err := l.db.SetExternalRef(id, ref)
+	if err != nil {
+		return rpc.RequestStatus{}, err
+	}
+	l.Log.Info("attached external reference to proof request", "id", id, "ref", ref)
+	return newRequestStatus(req, ""), nil
+}
+
+// ProofRequestByExternalRef returns the proof request with the given external reference.
+func (l *L2OutputSubmitter) ProofRequestByExternalRef(ctx context.Context, ref string) (rpc.RequestStatus, error) {
+	req, err := l.db.GetProofRequestByExternalRef(ref)
+	if err != nil {
+		return rpc.RequestStatus{}, err
+	}
+	return newRequestStatus(req, ""), nil
+}
+
+// proofRequestsHeldReason returns why no proofs are requested at all right now. Returns an empty string if they are.
+func (l *L2OutputSubmitter) proofRequestsHeldReason() string {
+	if l.Cfg.Follower {
+		return "follower: proofs are requested by the active proposer"
+	}
+	if l.proofRequestsPaused.Load() {
+		return "paused: new proof requests are paused by an admin"
+	}
+	if reason := l.programMismatchReason(); reason != "" {
+		return "held: " + reason
+	}
+	if reason := l.bootstrapMismatchReason(); reason != "" {
+		return "held: " + reason
+	}
+	return ""
+}
+
+// blockedReason explains why an unrequested proof hasn't been sent to the server yet.
+func (l *L2OutputSubmitter) blockedReason(snapshot *db.ProofDB, req, next *ent.ProofRequest, running bool, numWitnessGen, numProving int) string {
+	if !running {
+		return "paused: the proposer is stopped"
+	}
+	if reason := l.proofRequestsHeldReason(); reason != "" {
+		return reason
+	}
+	if req.Type == proofrequest.TypeAGG && l.submissionsPaused.Load() {
+		return "paused: L1 submissions are paused by an admin, so the L1 block hash can't be checkpointed"
+	}
+	if now := uint64(time.Now().Unix()); req.NotBefore > now {
+		return fmt.Sprintf("backing off: retry %d isn't requested for another %ds", req.Attempts, req.NotBefore-now)
+	}
+	if backend := l.proverBackend(req); l.circuitBreaker.open(backend) {
+		return fmt.Sprintf("circuit breaker open: requests to %s failed repeatedly, waiting for it to pass a health check", backend)
+	}
+	if backend := l.proverBackend(req); l.witnessGenProbe.failed(backend) != nil {
+		return fmt.Sprintf("health probe failing: %s failed its latest health probe, waiting for it to pass the next one: %v", backend, l.witnessGenProbe.failed(backend))
+	}
+
+	if next != nil && next.ID != req.ID {
+		if l.slaEscalated(next.StartBlock) && !l.slaEscalated(req.StartBlock) {
+			return fmt.Sprintf("queued behind request %d, which is escalated because the oldest unproven block is past the SLA", next.ID)
+		}
+		if next.Type == proofrequest.TypeAGG && req.Type == proofrequest.TypeSPAN {
+			return fmt.Sprintf("queued behind AGG request %d, which takes priority over span proofs", next.ID)
+		}
+		return fmt.Sprintf("queued behind request %d, only one request is dispatched per poll interval", next.ID)
+	}
+
+	if req.Type == proofrequest.TypeAGG {
+		if _, err := snapshot.GetSpanProofChainRefs(req.StartBlock, req.EndBlock); err != nil {
+			return fmt.Sprintf("awaiting subproofs: %v", err)
+		}
+		if req.L1BlockHash == "" {
+			return "awaiting L1 block hash checkpoint, which is sent on the next poll"
+		}
+		return "ready, will be requested on the next poll"
+	}
+
+	reason, err := l.spanProofBlockedReason(req, numWitnessGen, numProving)
+	if err != nil {
+		return fmt.Sprintf("unknown: %v", err)
+	}
+	if reason != "" {
+		return reason
+	}
+	return "ready, will be requested on the next poll"
+}
+
+// spanProofBlockedReason returns why the span proof can't be requested from the server now, given the number of
+// requests in witness generation and proving. Returns an empty string if it can. RequestQueuedProofs schedules span proofs with
+// it, so the reasons reported by the admin API are the scheduling decisions themselves.
+func (l *L2OutputSubmitter) spanProofBlockedReason(req *ent.ProofRequest, numWitnessGen, numProving int) (string, error) {
+	// The cost budget caps the spending on the prover network, so unlike the other limits, it isn't preempted by the
+	// scheduling policy or SLA escalation, only lifted by a break-glass override.
+	if reason, err := l.costBudgetReason(); err != nil || (reason != "" && l.activeBreakGlass() == nil) {
+		return reason, err
+	}
+	reason, err := l.spanProofLimitReason(req, numWitnessGen, numProving)
+	if err != nil || reason == "" {
+		return reason, err
+	}
+	// A break-glass override lifts all of the limits.
+	if l.activeBreakGlass() != nil {
+		return "", nil
+	}
+	// Span proofs that the next AGG proof waits for preempt every limit but the one on witness generation, which
+	// protects the server, as do escalated span proofs.
+	if l.preemptsLimits(req) || l.slaEscalated(req.StartBlock) {
+		return l.witnessGenLimitReason(req, numWitnessGen), nil
+	}
+	return reason, nil
+}
+
+// spanProofLimitReason returns which limit keeps the span proof from being requested now, or an empty string if none
+// does.
+func (l *L2OutputSubmitter) spanProofLimitReason(req *ent.ProofRequest, numWitnessGen, numProving int) (string, error) {
+	settings := l.settings()
+
+	if reason := l.witnessGenLimitReason(req, numWitnessGen); reason != "" {
+		return reason, nil
+	}
+
+	// The total number of concurrent proofs is capped at MAX_CONCURRENT_PROOF_REQUESTS.
+	if numWitnessGen+numProving >= int(settings.MaxConcurrentProofRequests) {
+		return fmt.Sprintf("max concurrent proof requests reached (%d/%d)", numWitnessGen+numProving, settings.MaxConcurrentProofRequests), nil
+	}
+
+	// The span proofs requested from the prover network are capped by the pipeline spec's budget.
+	exhausted, requested, err := l.spanProofBudgetExhausted()
+	if err != nil {
+		return "", fmt.Errorf("failed to check the span proof budget: %w", err)
+	}
+	if exhausted {
+		return fmt.Sprintf("budget exhausted: %d span proofs were requested in the last hour", requested), nil
+	}
+	return "", nil
+}
+
+// witnessGenLimitReason returns why the span proof can't start witness generation now, or an empty string if it can.
+func (l *L2OutputSubmitter) witnessGenLimitReason(req *ent.ProofRequest, numWitnessGen int) string {
+	// The number of witness generation requests is capped at MAX_CONCURRENT_WITNESS_GEN. This prevents overloading the
+	// machine with processes spawned by the witness generation server.
+	// Once https://github.com/anton-rs/kona/issues/553 is fixed, we may be able to remove this check.
+	// The effective cap is lowered below MAX_CONCURRENT_WITNESS_GEN while the server reports it is overloaded, and the
+	// mock proofs of differential checks generate witnesses too. The cap applies to each witness generation server,
+	// and span proof requests are balanced across them.
+	witnessGen := numWitnessGen + int(l.differentialChecks.Load())
+	servers := uint64(len(l.Cfg.witnessGenServers()))
+	if limit := l.witnessGenLimiter.Limit() * servers; witnessGen >= int(limit) && !l.skipWitnessGenLimit(req) {
+		return fmt.Sprintf("max concurrent witness generation reached (%d/%d, configured max %d)", witnessGen, limit, l.witnessGenLimiter.Max()*servers)
+	}
+	return ""
+}
+
+const (
+	// SchedulingPolicyStartBlock requests AGG proofs first, then span proofs in order of start block, all of them within
+	// the limits on proof requests.
+	SchedulingPolicyStartBlock = "start-block"
+	// SchedulingPolicyPreempt requests proofs in the same order, but lets the span proofs that the next AGG proof waits
+	// for preempt the limits on concurrent proof requests and the span proof budget, so that far-future span proofs
+	// filling them don't delay the next output.
+	SchedulingPolicyPreempt = "preempt"
+)
+
+// preemptsLimits returns whether the span proof covers blocks of the next L2OO output, and the scheduling policy lets
+// such span proofs preempt the limits on proof requests.
+func (l *L2OutputSubmitter) preemptsLimits(req *ent.ProofRequest) bool {
+	if l.Cfg.SchedulingPolicy != SchedulingPolicyPreempt {
+		return false
+	}
+	next := l.nextOutputBlock.Load()
+	return next != 0 && req.StartBlock < next
+}
+
+func newRequestStatus(req *ent.ProofRequest, reason string) rpc.RequestStatus {
+	return rpc.RequestStatus{
+		ID:            req.ID,
+		Type:          req.Type.String(),
+		StartBlock:    req.StartBlock,
+		EndBlock:      req.EndBlock,
+		Status:        req.Status.String(),
+		BlockedReason: reason,
+		ExternalRef:   req.ExternalRef,
+		ProverBackend: req.ProverBackend,
+		ErrorMessage:  req.ErrorMessage,
+		CreatedBy:     req.CreatedBy,
+		RequestedBy:   req.RequestedBy,
+		CompletedBy:   req.CompletedBy,
+		Attempts:      req.Attempts,
+		DowntimeCause: req.DowntimeCause,
+	}
+}
@@ -0,0 +1,140 @@
+package proposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+func TestSpanProofBlockedReason(t *testing.T) {
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log: log.New(),
+			Cfg: ProposerConfig{MaxConcurrentWitnessGen: 4, MaxConcurrentProofRequests: 6},
+		},
+		witnessGenLimiter: newWitnessGenLimiter(4),
+	}
+	span := &ent.ProofRequest{Type: proofrequest.TypeSPAN, StartBlock: 100, EndBlock: 110}
+
+	reason, err := l.spanProofBlockedReason(span, 3, 2)
+	require.NoError(t, err)
+	require.Empty(t, reason)
+
+	reason, err = l.spanProofBlockedReason(span, 4, 0)
+	require.NoError(t, err)
+	require.Contains(t, reason, "max concurrent witness generation reached (4/4")
+
+	// Differential checks take up witness generation slots.
+	l.differentialChecks.Add(1)
+	reason, err = l.spanProofBlockedReason(span, 3, 0)
+	require.NoError(t, err)
+	require.Contains(t, reason, "max concurrent witness generation reached")
+	l.differentialChecks.Add(-1)
+
+	// The limit is lowered while the server is overloaded.
+	l.witnessGenLimiter.OnOverloaded()
+	reason, err = l.spanProofBlockedReason(span, 2, 0)
+	require.NoError(t, err)
+	require.Contains(t, reason, "(2/2, configured max 4)")
+
+	reason, err = l.spanProofBlockedReason(span, 1, 5)
+	require.NoError(t, err)
+	require.Equal(t, "max concurrent proof requests reached (6/6)", reason)
+}
+
+func TestPreemptSchedulingPolicy(t *testing.T) {
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log: log.New(),
+			Cfg: ProposerConfig{MaxConcurrentWitnessGen: 4, MaxConcurrentProofRequests: 2, SchedulingPolicy: SchedulingPolicyPreempt},
+		},
+		witnessGenLimiter: newWitnessGenLimiter(4),
+	}
+	imminent := &ent.ProofRequest{Type: proofrequest.TypeSPAN, StartBlock: 100, EndBlock: 110}
+	farFuture := &ent.ProofRequest{Type: proofrequest.TypeSPAN, StartBlock: 200, EndBlock: 210}
+
+	// Until the next output is known, no span proof preempts the limits.
+	reason, err := l.spanProofBlockedReason(imminent, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, "max concurrent proof requests reached (2/2)", reason)
+
+	l.nextOutputBlock.Store(150)
+	reason, err = l.spanProofBlockedReason(imminent, 0, 2)
+	require.NoError(t, err)
+	require.Empty(t, reason)
+	reason, err = l.spanProofBlockedReason(farFuture, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, "max concurrent proof requests reached (2/2)", reason)
+
+	// The witness generation limit still applies.
+	reason, err = l.spanProofBlockedReason(imminent, 4, 0)
+	require.NoError(t, err)
+	require.Contains(t, reason, "max concurrent witness generation reached")
+
+	// Without the policy, the span proofs of the next output wait like every other span proof.
+	l.Cfg.SchedulingPolicy = SchedulingPolicyStartBlock
+	reason, err = l.spanProofBlockedReason(imminent, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, "max concurrent proof requests reached (2/2)", reason)
+}
+
+func TestBreakGlassLiftsLimits(t *testing.T) {
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{MaxConcurrentWitnessGen: 1, MaxConcurrentProofRequests: 1},
+		},
+		witnessGenLimiter: newWitnessGenLimiter(1),
+	}
+	span := &ent.ProofRequest{Type: proofrequest.TypeSPAN, StartBlock: 100, EndBlock: 110}
+
+	_, err := l.BreakGlass(context.Background(), 60, "")
+	require.Error(t, err)
+	_, err = l.BreakGlass(context.Background(), 0, "incident")
+	require.Error(t, err)
+
+	status, err := l.BreakGlass(context.Background(), 60, "incident")
+	require.NoError(t, err)
+	require.True(t, status.Active)
+	reason, err := l.spanProofBlockedReason(span, 1, 1)
+	require.NoError(t, err)
+	require.Empty(t, reason)
+
+	// Expired overrides don't lift the limits anymore.
+	l.breakGlass.Store(&breakGlassOverride{reason: "incident", expiresAt: time.Now().Add(-time.Second)})
+	reason, err = l.spanProofBlockedReason(span, 1, 1)
+	require.NoError(t, err)
+	require.NotEmpty(t, reason)
+	status, err = l.BreakGlassStatus(context.Background())
+	require.NoError(t, err)
+	require.False(t, status.Active)
+}
+
+func TestBlockedReason(t *testing.T) {
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log: log.New(),
+			Cfg: ProposerConfig{MaxConcurrentWitnessGen: 1, MaxConcurrentProofRequests: 1},
+		},
+		witnessGenLimiter: newWitnessGenLimiter(1),
+	}
+	span := &ent.ProofRequest{ID: 1, Type: proofrequest.TypeSPAN}
+	agg := &ent.ProofRequest{ID: 2, Type: proofrequest.TypeAGG}
+
+	require.Equal(t, "paused: the proposer is stopped", l.blockedReason(nil, span, span, false, 0, 0))
+	require.Equal(t, "ready, will be requested on the next poll", l.blockedReason(nil, span, span, true, 0, 0))
+	require.Contains(t, l.blockedReason(nil, span, agg, true, 0, 0), "queued behind AGG request 2")
+	require.Contains(t, l.blockedReason(nil, span, span, true, 1, 0), "max concurrent witness generation reached")
+
+	l.proofRequestsPaused.Store(true)
+	require.Equal(t, "paused: new proof requests are paused by an admin", l.blockedReason(nil, span, span, true, 0, 0))
+	require.Equal(t, "paused: new proof requests are paused by an admin", l.proofRequestsHeldReason())
+}
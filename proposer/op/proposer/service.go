@@ -2,9 +2,11 @@ package proposer
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -12,18 +14,24 @@ import (
 	"github.com/ethereum-optimism/optimism/op-proposer/proposer/rpc"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	opclient "github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
+	"github.com/succinctlabs/op-succinct-go/proposer/coldstore"
 	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	opsuccinctrpc "github.com/succinctlabs/op-succinct-go/proposer/rpc"
 )
 
 var ErrAlreadyStopped = errors.New("already stopped")
@@ -43,6 +51,9 @@ type ProposerConfig struct {
 	DisputeGameFactoryAddr *common.Address
 	DisputeGameType        uint32
 
+	// ConfigContractAddr is the OPSuccinctProposerConfig contract that proving parameters are read from. Nil if unset.
+	ConfigContractAddr *common.Address
+
 	// AllowNonFinalized enables the proposal of safe, but non-finalized L2 blocks.
 	// The L1 block-hash embedded in the proposal TX is checked and should ensure the proposal
 	// is never valid on an alternative L1 chain that would produce different L2 data.
@@ -60,10 +71,101 @@ type ProposerConfig struct {
 	MaxConcurrentWitnessGen    uint64
 	WitnessGenTimeout          uint64
 	L2ChainID                  uint64
-	ProofTimeout               uint64
+	SpanProofTimeout           uint64
+	SpanProofTimeoutPerBlock   uint64
+	AggProofTimeout            uint64
 	OPSuccinctServerUrl        string
+	OPSuccinctServerUrls       []string
 	MaxConcurrentProofRequests uint64
 	Mock                       bool
+	DifferentialTest           bool
+	PipelineSpecPath           string
+	ColdStorageDir             string
+	ColdStorageS3              *coldstore.S3Config
+	ProofHotWindow             time.Duration
+	WatchOnly                  bool
+	WatchProposerAddr          *common.Address
+	WatchReproveSampleRate     float64
+	AltDAServerUrl             string
+	AltDACommitmentType        string
+	BatchInboxAddr             common.Address
+	AlignToChannels            bool
+	MaxUnrequestedSpanProofs   uint64
+	WitnessGenRetries          uint64
+	WitnessGenRetryBackoff     time.Duration
+	SpanCompactionInterval     time.Duration
+	Telemetry                  bool
+	TelemetryEndpoint          string
+	TelemetryInterval          time.Duration
+	RangePlanner               string
+	L2EthRpc                   string
+	SpanOverheadCycles         uint64
+	FastPathMaxBlocks          uint64
+	IPFSApiUrl                 string
+	ProverFallbackServerUrls   []string
+	ProverFailoverAttempts     uint64
+	ProverUnreachableTimeout   time.Duration
+	ProofStatusLongPoll        time.Duration
+	InstanceID                 string
+	PreCheckpointLead          time.Duration
+	PreCheckpointMaxAge        time.Duration
+	DbConnectionString         string
+	SchedulingPolicy           string
+	MaxProofRetries            uint64
+	ProofRetryBackoff          time.Duration
+	NonceConflictAction        string
+	ProofRequestGzipThreshold  uint64
+	MinSpanProofBlocks         uint64
+	AggSubproofsByReference    bool
+	ProverNetworkRpcUrl        string
+	BlockedRanges              []string
+	ProofStoreDir              string
+	ProofStoreS3               *coldstore.S3Config
+	DoubleCheckServerUrl       string
+	DoubleCheckSampleRate      float64
+	TracingEndpoint            string
+	SLAMaxUnprovenAge          time.Duration
+	SLAPremiumServerUrl        string
+	MetadataExportPath         string
+	MetadataExportInterval     time.Duration
+	CircuitBreakerThreshold    uint64
+	CircuitBreakerBackoff      time.Duration
+	StrictMode                 bool
+	AggBoundaryPolicy          string
+	ShutdownGracePeriod        time.Duration
+	WitnessGenCapacityInterval time.Duration
+	ReplicateFrom              string
+	ReplicateToken             string
+	CostBudget                 string
+	CostBudgetPeriod           time.Duration
+	MaxSubmissionBaseFeeGwei   uint64
+	MaxSubmissionDelay         time.Duration
+	SubmissionMaxReverts       uint64
+	SubmissionMaxResends       uint64
+	SubmissionMaxNonceResyncs  uint64
+	L1TxTimeout                time.Duration
+	DbReplicaConnectionString  string
+	WitnessGenLatencyTarget    time.Duration
+	WitnessGenMaxFailureRate   float64
+	PrivateTxRpc               string
+	PrivateTxTimeout           time.Duration
+	VerificationServiceUrl     string
+	VerificationServiceToken   string
+	SafeAddress                string
+	SafeTxServiceUrl           string
+	SafeTxServiceToken         string
+	L1RpcFallbackUrls          []string
+	RollupRpcFallbackUrls      []string
+	RpcMaxLagBlocks            uint64
+	RpcHealthCheckInterval     time.Duration
+	DowntimeThreshold          time.Duration
+	ReorgCheckInterval         time.Duration
+	CheckpointConfirmations    uint64
+	CheckpointFinalized        bool
+	WitnessGenProbeInterval    time.Duration
+	LeaderElection             bool
+	Follower                   bool
+	ForecastPath               string
 }
 
 type ProposerService struct {
@@ -76,13 +178,23 @@ type ProposerService struct {
 	L1Client       *ethclient.Client
 	RollupProvider dial.RollupProvider
 
+	// PrivateTxManager sends transactions through the private relay at PRIVATE_TX_RPC. Nil if it isn't set.
+	PrivateTxManager txmgr.TxManager
+
+	// SafeSigner signs the Safe transactions that proposals are proposed as. Nil if SAFE_ADDRESS isn't set.
+	SafeSigner *ecdsa.PrivateKey
+
 	driver *L2OutputSubmitter
 
+	// rpcFailovers route the requests to RPC endpoints that have fallbacks.
+	rpcFailovers []*rpcFailover
+
 	Version string
 
 	pprofService *oppprof.Service
 	metricsSrv   *httputil.HTTPServer
 	rpcServer    *oprpc.Server
+	adminServer  *httputil.HTTPServer
 
 	balanceMetricer io.Closer
 
@@ -120,14 +232,129 @@ func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string
 	ps.MaxBlockRangePerSpanProof = cfg.MaxBlockRangePerSpanProof
 	ps.MaxConcurrentWitnessGen = cfg.MaxConcurrentWitnessGen
 	ps.WitnessGenTimeout = cfg.WitnessGenTimeout
-	ps.OPSuccinctServerUrl = cfg.OPSuccinctServerUrl
-	ps.ProofTimeout = cfg.ProofTimeout
+	ps.OPSuccinctServerUrl = cfg.OPSuccinctServerUrls[0]
+	ps.OPSuccinctServerUrls = cfg.OPSuccinctServerUrls
+	ps.SpanProofTimeout = cfg.SpanProofTimeout
+	ps.SpanProofTimeoutPerBlock = cfg.SpanProofTimeoutPerBlock
+	ps.AggProofTimeout = cfg.AggProofTimeout
 	ps.L2ChainID = cfg.L2ChainID
 	ps.MaxConcurrentProofRequests = cfg.MaxConcurrentProofRequests
 	ps.Mock = cfg.Mock
-
+	ps.DifferentialTest = cfg.DifferentialTest
+	ps.PipelineSpecPath = cfg.PipelineSpecPath
+	ps.ColdStorageDir = cfg.ColdStorageDir
+	if cfg.ColdStorageS3Bucket != "" {
+		ps.ColdStorageS3 = &coldstore.S3Config{
+			Endpoint:        cfg.ColdStorageS3Endpoint,
+			Region:          cfg.ColdStorageS3Region,
+			Bucket:          cfg.ColdStorageS3Bucket,
+			StorageClass:    cfg.ColdStorageS3StorageClass,
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		}
+	}
+	ps.ProofHotWindow = cfg.ProofHotWindow
+	ps.WatchOnly = cfg.WatchOnly
+	ps.WatchReproveSampleRate = cfg.WatchReproveSampleRate
+	ps.AltDAServerUrl = cfg.AltDAServerUrl
+	ps.AltDACommitmentType = cfg.AltDACommitmentType
+	ps.BatchInboxAddr = cfg.BatchInboxAddress
+	ps.AlignToChannels = cfg.AlignToChannels
+	ps.MaxUnrequestedSpanProofs = cfg.MaxUnrequestedSpanProofs
+	ps.WitnessGenRetries = cfg.WitnessGenRetries
+	ps.WitnessGenRetryBackoff = cfg.WitnessGenRetryBackoff
+	ps.SpanCompactionInterval = cfg.SpanCompactionInterval
+	ps.Telemetry = cfg.Telemetry
+	ps.TelemetryEndpoint = cfg.TelemetryEndpoint
+	ps.TelemetryInterval = cfg.TelemetryInterval
+	ps.RangePlanner = cfg.RangePlanner
+	ps.L2EthRpc = cfg.L2EthRpc
+	ps.SpanOverheadCycles = cfg.SpanOverheadCycles
+	ps.FastPathMaxBlocks = cfg.FastPathMaxBlocks
+	ps.IPFSApiUrl = cfg.IPFSApiUrl
+	ps.ProverFallbackServerUrls = cfg.ProverFallbackServerUrls
+	ps.ProverFailoverAttempts = cfg.ProverFailoverAttempts
+	ps.ProverUnreachableTimeout = cfg.ProverUnreachableTimeout
+	ps.ProofStatusLongPoll = cfg.ProofStatusLongPoll
+	ps.InstanceID = cfg.InstanceID
+	ps.PreCheckpointLead = cfg.PreCheckpointLead
+	ps.PreCheckpointMaxAge = cfg.PreCheckpointMaxAge
+	ps.DbConnectionString = cfg.DbConnectionString
+	ps.SchedulingPolicy = cfg.SchedulingPolicy
+	ps.MaxProofRetries = cfg.MaxProofRetries
+	ps.ProofRetryBackoff = cfg.ProofRetryBackoff
+	ps.NonceConflictAction = cfg.NonceConflictAction
+	ps.ProofRequestGzipThreshold = cfg.ProofRequestGzipThreshold
+	ps.MinSpanProofBlocks = cfg.MinSpanProofBlocks
+	ps.AggSubproofsByReference = cfg.AggSubproofsByReference
+	ps.ProverNetworkRpcUrl = cfg.ProverNetworkRpcUrl
+	ps.BlockedRanges = cfg.BlockedRanges
+	ps.ProofStoreDir = cfg.ProofStoreDir
+	if cfg.ProofStoreS3Bucket != "" {
+		// Unlike the cold tier, proofs in the proof store are read whenever an AGG proof is requested, so they're
+		// written with the standard storage class.
+		ps.ProofStoreS3 = &coldstore.S3Config{
+			Endpoint:        cfg.ProofStoreS3Endpoint,
+			Region:          cfg.ProofStoreS3Region,
+			Bucket:          cfg.ProofStoreS3Bucket,
+			StorageClass:    "STANDARD",
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		}
+	}
+	ps.DoubleCheckServerUrl = cfg.DoubleCheckServerUrl
+	ps.DoubleCheckSampleRate = cfg.DoubleCheckSampleRate
+	ps.TracingEndpoint = cfg.TracingEndpoint
+	ps.SLAMaxUnprovenAge = cfg.SLAMaxUnprovenAge
+	ps.SLAPremiumServerUrl = cfg.SLAPremiumServerUrl
+	ps.MetadataExportPath = cfg.MetadataExportPath
+	ps.MetadataExportInterval = cfg.MetadataExportInterval
+	ps.CircuitBreakerThreshold = cfg.CircuitBreakerThreshold
+	ps.CircuitBreakerBackoff = cfg.CircuitBreakerBackoff
+	ps.StrictMode = cfg.StrictMode
+	ps.AggBoundaryPolicy = cfg.AggBoundaryPolicy
+	ps.ShutdownGracePeriod = cfg.ShutdownGracePeriod
+	ps.WitnessGenCapacityInterval = cfg.WitnessGenCapacityInterval
+	ps.ReplicateFrom = cfg.ReplicateFrom
+	ps.ReplicateToken = cfg.ReplicateToken
+	ps.CostBudget = cfg.CostBudget
+	ps.CostBudgetPeriod = cfg.CostBudgetPeriod
+	ps.MaxSubmissionBaseFeeGwei = cfg.MaxSubmissionBaseFeeGwei
+	ps.MaxSubmissionDelay = cfg.MaxSubmissionDelay
+	ps.SubmissionMaxReverts = cfg.SubmissionMaxReverts
+	ps.SubmissionMaxResends = cfg.SubmissionMaxResends
+	ps.SubmissionMaxNonceResyncs = cfg.SubmissionMaxNonceResyncs
+	ps.L1TxTimeout = cfg.L1TxTimeout
+	ps.DbReplicaConnectionString = cfg.DbReplicaConnectionString
+	ps.WitnessGenLatencyTarget = cfg.WitnessGenLatencyTarget
+	ps.WitnessGenMaxFailureRate = cfg.WitnessGenMaxFailureRate
+	ps.PrivateTxRpc = cfg.PrivateTxRpc
+	ps.PrivateTxTimeout = cfg.PrivateTxTimeout
+	ps.VerificationServiceUrl = cfg.VerificationServiceUrl
+	ps.VerificationServiceToken = cfg.VerificationServiceToken
+	ps.SafeAddress = cfg.SafeAddress
+	ps.SafeTxServiceUrl = cfg.SafeTxServiceUrl
+	ps.SafeTxServiceToken = cfg.SafeTxServiceToken
+	ps.L1RpcFallbackUrls = cfg.L1RpcFallbackUrls
+	ps.RollupRpcFallbackUrls = cfg.RollupRpcFallbackUrls
+	ps.RpcMaxLagBlocks = cfg.RpcMaxLagBlocks
+	ps.RpcHealthCheckInterval = cfg.RpcHealthCheckInterval
+	ps.DowntimeThreshold = cfg.DowntimeThreshold
+	ps.ReorgCheckInterval = cfg.ReorgCheckInterval
+	ps.CheckpointConfirmations = cfg.CheckpointConfirmations
+	ps.CheckpointFinalized = cfg.CheckpointFinalized
+	ps.WitnessGenProbeInterval = cfg.WitnessGenProbeInterval
+	ps.LeaderElection = cfg.LeaderElection
+	ps.Follower = cfg.Follower
+	ps.ForecastPath = cfg.ForecastPath
+
+	ps.initInstanceID()
 	ps.initL2ooAddress(cfg)
 	ps.initDGF(cfg)
+	ps.initWatchProposerAddress(cfg)
+	ps.initConfigContractAddress(cfg)
 
 	if err := ps.initRPCClients(ctx, cfg); err != nil {
 		return err
@@ -148,6 +375,9 @@ func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string
 	if err := ps.initRPCServer(cfg); err != nil {
 		return fmt.Errorf("failed to start RPC server: %w", err)
 	}
+	if err := ps.initAdminServer(cfg); err != nil {
+		return fmt.Errorf("failed to start admin HTTP server: %w", err)
+	}
 
 	ps.Metrics.RecordInfo(ps.Version)
 	ps.Metrics.RecordUp()
@@ -155,14 +385,31 @@ func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string
 }
 
 func (ps *ProposerService) initRPCClients(ctx context.Context, cfg *CLIConfig) error {
-	l1Client, err := dial.DialEthClientWithTimeout(ctx, dial.DefaultDialTimeout, ps.Log, cfg.L1EthRpc)
-	if err != nil {
-		return fmt.Errorf("failed to dial L1 RPC: %w", err)
+	var l1Client *ethclient.Client
+	if len(cfg.L1RpcFallbackUrls) > 0 {
+		rpcClient, err := ps.dialWithFailover(ctx, cfg, "l1", append([]string{cfg.L1EthRpc}, cfg.L1RpcFallbackUrls...), l1Head)
+		if err != nil {
+			return fmt.Errorf("failed to dial L1 RPC: %w", err)
+		}
+		l1Client = ethclient.NewClient(rpcClient)
+	} else {
+		var err error
+		l1Client, err = dial.DialEthClientWithTimeout(ctx, dial.DefaultDialTimeout, ps.Log, cfg.L1EthRpc)
+		if err != nil {
+			return fmt.Errorf("failed to dial L1 RPC: %w", err)
+		}
 	}
 	ps.L1Client = l1Client
 
 	var rollupProvider dial.RollupProvider
-	if strings.Contains(cfg.RollupRpc, ",") {
+	var err error
+	if len(cfg.RollupRpcFallbackUrls) > 0 {
+		var rpcClient *gethrpc.Client
+		rpcClient, err = ps.dialWithFailover(ctx, cfg, "rollup", append([]string{cfg.RollupRpc}, cfg.RollupRpcFallbackUrls...), rollupHead)
+		if err == nil {
+			rollupProvider, err = dial.NewStaticL2RollupProviderFromExistingRollup(sources.NewRollupClient(opclient.NewBaseRPCClient(rpcClient)))
+		}
+	} else if strings.Contains(cfg.RollupRpc, ",") {
 		rollupUrls := strings.Split(cfg.RollupRpc, ",")
 		rollupProvider, err = dial.NewActiveL2RollupProvider(ctx, rollupUrls, cfg.ActiveSequencerCheckDuration, dial.DefaultDialTimeout, ps.Log)
 	} else {
@@ -178,7 +425,8 @@ func (ps *ProposerService) initRPCClients(ctx context.Context, cfg *CLIConfig) e
 func (ps *ProposerService) initMetrics(cfg *CLIConfig) {
 	if cfg.MetricsConfig.Enabled {
 		procName := "default"
-		ps.Metrics = opsuccinctmetrics.NewMetrics(procName)
+		// Record the metrics on a background goroutine, so that a slow metrics sink never stalls the driver loop.
+		ps.Metrics = opsuccinctmetrics.NewAsyncMetrics(opsuccinctmetrics.NewMetrics(procName), opsuccinctmetrics.DefaultAsyncQueueSize)
 	} else {
 		ps.Metrics = opsuccinctmetrics.NoopMetrics
 	}
@@ -197,6 +445,28 @@ func (ps *ProposerService) initTxManager(cfg *CLIConfig) error {
 		return err
 	}
 	ps.TxManager = txManager
+
+	// The private relay only differs from the L1 RPC in where transactions are sent, so the tx manager sending through
+	// it uses the same account and fee settings.
+	if cfg.PrivateTxRpc != "" {
+		privateCfg := cfg.TxMgrConfig
+		privateCfg.L1RPCURL = cfg.PrivateTxRpc
+		privateTxManager, err := txmgr.NewSimpleTxManager("proposer-private", ps.Log, ps.Metrics, privateCfg)
+		if err != nil {
+			return fmt.Errorf("failed to create the tx manager of the private relay: %w", err)
+		}
+		ps.PrivateTxManager = privateTxManager
+	}
+
+	// Safe transactions are signed with the proposer's key, which the Safe Transaction Service only accepts from an
+	// owner or a delegate of the Safe.
+	if cfg.SafeAddress != "" {
+		key, err := crypto.HexToECDSA(strings.TrimPrefix(cfg.TxMgrConfig.PrivateKey, "0x"))
+		if err != nil {
+			return fmt.Errorf("failed to parse the private key that Safe transactions are signed with: %w", err)
+		}
+		ps.SafeSigner = key
+	}
 	return nil
 }
 
@@ -227,7 +497,7 @@ func (ps *ProposerService) initMetricsServer(cfg *CLIConfig) error {
 		return fmt.Errorf("metrics were enabled, but metricer %T does not expose registry for metrics-server", ps.Metrics)
 	}
 	ps.Log.Debug("Starting metrics server", "addr", cfg.MetricsConfig.ListenAddr, "port", cfg.MetricsConfig.ListenPort)
-	metricsSrv, err := opmetrics.StartServer(m.Registry(), cfg.MetricsConfig.ListenAddr, cfg.MetricsConfig.ListenPort)
+	metricsSrv, err := opsuccinctmetrics.StartServer(m.Registry(), cfg.MetricsConfig.ListenAddr, cfg.MetricsConfig.ListenPort)
 	if err != nil {
 		return fmt.Errorf("failed to start metrics server: %w", err)
 	}
@@ -255,12 +525,45 @@ func (ps *ProposerService) initDGF(cfg *CLIConfig) {
 	ps.DisputeGameType = cfg.DisputeGameType
 }
 
+// initInstanceID defaults the instance ID to the hostname, which tells instances apart in most deployments.
+func (ps *ProposerService) initInstanceID() {
+	if ps.InstanceID != "" {
+		return
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		ps.Log.Warn("failed to get hostname for the instance ID", "err", err)
+		return
+	}
+	ps.InstanceID = hostname
+}
+
+func (ps *ProposerService) initWatchProposerAddress(cfg *CLIConfig) {
+	watchProposerAddress, err := opservice.ParseAddress(cfg.WatchProposerAddress)
+	if err != nil {
+		// Return no error & set no watch-only related configuration fields.
+		return
+	}
+	ps.WatchProposerAddr = &watchProposerAddress
+}
+
+func (ps *ProposerService) initConfigContractAddress(cfg *CLIConfig) {
+	configContractAddress, err := opservice.ParseAddress(cfg.ConfigContractAddress)
+	if err != nil {
+		// Return no error & read no proving parameters from a contract.
+		return
+	}
+	ps.ConfigContractAddr = &configContractAddress
+}
+
 func (ps *ProposerService) initDriver() error {
 	driver, err := NewL2OutputSubmitter(DriverSetup{
 		Log:            ps.Log,
 		Metr:           ps.Metrics,
 		Cfg:            ps.ProposerConfig,
 		Txmgr:          ps.TxManager,
+		PrivateTxmgr:   ps.PrivateTxManager,
+		SafeSigner:     ps.SafeSigner,
 		L1Client:       ps.L1Client,
 		RollupProvider: ps.RollupProvider,
 	})
@@ -281,6 +584,8 @@ func (ps *ProposerService) initRPCServer(cfg *CLIConfig) error {
 	if cfg.RPCConfig.EnableAdmin {
 		adminAPI := rpc.NewAdminAPI(ps.driver, ps.Metrics, ps.Log)
 		server.AddAPI(rpc.GetAdminAPI(adminAPI))
+		opsuccinctAdminAPI := opsuccinctrpc.NewAdminAPI(ps.driver, ps.Log)
+		server.AddAPI(opsuccinctrpc.GetAdminAPI(opsuccinctAdminAPI))
 		ps.Log.Info("Admin RPC enabled")
 	}
 	ps.Log.Info("Starting JSON-RPC server")
@@ -291,6 +596,21 @@ func (ps *ProposerService) initRPCServer(cfg *CLIConfig) error {
 	return nil
 }
 
+// initAdminServer serves the admin HTTP API, if an address is configured for it.
+func (ps *ProposerService) initAdminServer(cfg *CLIConfig) error {
+	if cfg.AdminAddr == "" {
+		return nil
+	}
+	handler := opsuccinctrpc.NewAdminHTTPHandler(ps.driver, cfg.AdminToken, ps.Log)
+	server, err := httputil.StartHTTPServer(cfg.AdminAddr, handler)
+	if err != nil {
+		return fmt.Errorf("failed to start admin HTTP server: %w", err)
+	}
+	ps.Log.Info("Started admin HTTP server", "addr", server.Addr())
+	ps.adminServer = server
+	return nil
+}
+
 // Start runs once upon start of the proposer lifecycle,
 // and starts L2Output-submission work if the proposer is configured to start submit data on startup.
 func (ps *ProposerService) Start(_ context.Context) error {
@@ -330,6 +650,11 @@ func (ps *ProposerService) Stop(ctx context.Context) error {
 			result = errors.Join(result, fmt.Errorf("failed to stop RPC server: %w", err))
 		}
 	}
+	if ps.adminServer != nil {
+		if err := ps.adminServer.Stop(ctx); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to stop admin HTTP server: %w", err))
+		}
+	}
 	if ps.pprofService != nil {
 		if err := ps.pprofService.Stop(ctx); err != nil {
 			result = errors.Join(result, fmt.Errorf("failed to stop PProf server: %w", err))
@@ -344,12 +669,18 @@ func (ps *ProposerService) Stop(ctx context.Context) error {
 	if ps.TxManager != nil {
 		ps.TxManager.Close()
 	}
+	if ps.PrivateTxManager != nil {
+		ps.PrivateTxManager.Close()
+	}
 
 	if ps.metricsSrv != nil {
 		if err := ps.metricsSrv.Stop(ctx); err != nil {
 			result = errors.Join(result, fmt.Errorf("failed to stop metrics server: %w", err))
 		}
 	}
+	if m, ok := ps.Metrics.(*opsuccinctmetrics.AsyncMetrics); ok {
+		m.Close()
+	}
 
 	if ps.L1Client != nil {
 		ps.L1Client.Close()
@@ -358,6 +689,9 @@ func (ps *ProposerService) Stop(ctx context.Context) error {
 	if ps.RollupProvider != nil {
 		ps.RollupProvider.Close()
 	}
+	for _, failover := range ps.rpcFailovers {
+		failover.Close()
+	}
 
 	if result == nil {
 		ps.stopped.Store(true)
@@ -0,0 +1,47 @@
+package proposer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+)
+
+const (
+	// SignerModeLocal signs the L1 transactions with the PRIVATE_KEY or MNEMONIC held by the proposer.
+	SignerModeLocal = "local"
+	// SignerModeRemote signs the L1 transactions with a remote signer at --signer.endpoint that implements
+	// eth_signTransaction, like web3signer or op-signer, so the proposer never holds the key. Keys in a cloud KMS are
+	// used through such a signer, e.g. web3signer with an AWS KMS key, or op-signer with a GCP Cloud KMS key.
+	SignerModeRemote = "remote"
+)
+
+// checkSignerMode checks that the tx manager's signer flags match the signer mode. Every mode but local keeps the key
+// out of the proposer, so a private key or mnemonic is rejected instead of being silently ignored or preferred. Without
+// a mode, the tx manager picks the signer from the flags that are set, like it did before the mode was added.
+func checkSignerMode(mode string, cfg txmgr.CLIConfig) error {
+	if mode == "" {
+		return nil
+	}
+	hasKey := cfg.PrivateKey != "" || cfg.Mnemonic != ""
+	hasEndpoint := cfg.SignerCLIConfig.Endpoint != ""
+	switch mode {
+	case SignerModeLocal:
+		if hasEndpoint {
+			return errors.New("the local signer mode signs with the private key or mnemonic, but a remote signer endpoint is set: use --signer.mode=remote")
+		}
+		if !hasKey {
+			return errors.New("the local signer mode requires a private key or mnemonic")
+		}
+	case SignerModeRemote:
+		if hasKey {
+			return fmt.Errorf("the %s signer mode keeps the key out of the proposer, but a private key or mnemonic is set: remove it", mode)
+		}
+		if !hasEndpoint || cfg.SignerCLIConfig.Address == "" {
+			return fmt.Errorf("the %s signer mode requires the --signer.endpoint and --signer.address of the signer", mode)
+		}
+	default:
+		return fmt.Errorf("unknown signer mode %q, must be %q or %q", mode, SignerModeLocal, SignerModeRemote)
+	}
+	return nil
+}
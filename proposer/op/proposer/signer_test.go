@@ -0,0 +1,29 @@
+package proposer
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckSignerMode(t *testing.T) {
+	local := txmgr.CLIConfig{PrivateKey: "0x01"}
+	remote := txmgr.CLIConfig{}
+	remote.SignerCLIConfig.Endpoint = "https://signer.example.com"
+	remote.SignerCLIConfig.Address = "0x0000000000000000000000000000000000000001"
+	both := remote
+	both.PrivateKey = "0x01"
+
+	require.NoError(t, checkSignerMode("", local))
+	require.NoError(t, checkSignerMode("", both))
+	require.NoError(t, checkSignerMode(SignerModeLocal, local))
+	require.ErrorContains(t, checkSignerMode(SignerModeLocal, both), "remote signer endpoint is set")
+	require.ErrorContains(t, checkSignerMode(SignerModeLocal, txmgr.CLIConfig{}), "requires a private key")
+	require.NoError(t, checkSignerMode(SignerModeRemote, remote))
+	require.ErrorContains(t, checkSignerMode(SignerModeRemote, both), "private key or mnemonic is set")
+	require.ErrorContains(t, checkSignerMode(SignerModeRemote, txmgr.CLIConfig{}), "--signer.endpoint")
+	// KMS keys are only supported through a remote signer that is backed by the KMS.
+	require.ErrorContains(t, checkSignerMode("aws-kms", remote), "unknown signer mode")
+	require.ErrorContains(t, checkSignerMode("vault", local), "unknown signer mode")
+}
@@ -0,0 +1,94 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// updateSLAEscalation escalates the proof requests of the next L2OO output while the oldest unproven block, the one
+// after the latest output, is older than SLA_MAX_UNPROVEN_AGE. Escalated requests are requested before all others,
+// preempt the limits on concurrent proof requests and the span proof budget, and are sent to SLA_PREMIUM_SERVER_URL if
+// it's set, so the proving lag is bounded without an operator stepping in.
+func (l *L2OutputSubmitter) updateSLAEscalation(ctx context.Context, latest, next uint64) error {
+	age, err := l.unprovenBlockAge(ctx, latest+1)
+	if err != nil {
+		return err
+	}
+	l.setSLAEscalation(latest+1, next, age)
+	return nil
+}
+
+// setSLAEscalation escalates the proof requests up to the next output if the oldest unproven block is older than
+// SLA_MAX_UNPROVEN_AGE, and ends the escalation otherwise.
+func (l *L2OutputSubmitter) setSLAEscalation(oldest, next uint64, age time.Duration) {
+	if age <= l.Cfg.SLAMaxUnprovenAge {
+		if l.slaEscalationEnd.Swap(0) != 0 {
+			l.Log.Info("Oldest unproven block is within the SLA again, ending the escalation", "block", oldest, "age", age)
+		}
+		return
+	}
+	if l.slaEscalationEnd.Swap(next) != next {
+		l.Log.Warn("Oldest unproven block is past the SLA, escalating the proof requests of the next output", "block", oldest, "age", age, "maxAge", l.Cfg.SLAMaxUnprovenAge, "nextOutputBlock", next, "premiumServer", l.Cfg.SLAPremiumServerUrl)
+		l.Metr.RecordError("sla_escalation", 1)
+	}
+}
+
+// unprovenBlockAge returns how long ago the L2 block was produced, or 0 if it wasn't yet. Its timestamp is derived
+// from the unsafe head, so the block doesn't have to be fetched.
+func (l *L2OutputSubmitter) unprovenBlockAge(ctx context.Context, block uint64) (time.Duration, error) {
+	rollupClient, err := l.RollupProvider.RollupClient(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rollup client: %w", err)
+	}
+	status, err := rollupClient.SyncStatus(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get sync status: %w", err)
+	}
+	if status.UnsafeL2.Number < block {
+		return 0, nil
+	}
+	blockTime, err := l.l2ooContract.L2BLOCKTIME(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get L2 block time: %w", err)
+	}
+	timestamp := status.UnsafeL2.Time - (status.UnsafeL2.Number-block)*blockTime.Uint64()
+	return time.Since(time.Unix(int64(timestamp), 0)), nil
+}
+
+// slaEscalated returns whether a proof request starting at the given block is escalated, because it's needed for the
+// next output while the oldest unproven block is past the SLA.
+func (l *L2OutputSubmitter) slaEscalated(start uint64) bool {
+	end := l.slaEscalationEnd.Load()
+	return end != 0 && start < end
+}
+
+// nextProofToRequest returns the proof request that RequestQueuedProofs dispatches next, or nil if there is none.
+// Escalated requests go first. Otherwise, AGG requests go first, unless L1 submissions are paused, since their L1
+// block hash can't be checkpointed then, followed by span proofs in order of start block.
+func (l *L2OutputSubmitter) nextProofToRequest(proofDB *db.ProofDB) (*ent.ProofRequest, error) {
+	next, err := proofDB.GetNextUnrequestedProof()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unrequested proofs: %w", err)
+	}
+	if next == nil {
+		return nil, nil
+	}
+	pausedAgg := next.Type == proofrequest.TypeAGG && l.submissionsPaused.Load()
+	if pausedAgg || (l.slaEscalationEnd.Load() != 0 && !l.slaEscalated(next.StartBlock)) {
+		span, err := proofDB.GetNextUnrequestedSpanProof()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get unrequested span proofs: %w", err)
+		}
+		if pausedAgg || (span != nil && l.slaEscalated(span.StartBlock)) {
+			return span, nil
+		}
+	}
+	return next, nil
+}
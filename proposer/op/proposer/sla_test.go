@@ -0,0 +1,72 @@
+package proposer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+func TestSLAEscalation(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	l2oo := newFakeL2OO(100, 100)
+	l := newFakeL2OODriver(t, l2oo, proofDB)
+	l.Cfg = ProposerConfig{
+		OPSuccinctServerUrl:        "http://default",
+		SLAMaxUnprovenAge:          time.Hour,
+		SLAPremiumServerUrl:        "http://premium",
+		MaxConcurrentWitnessGen:    4,
+		MaxConcurrentProofRequests: 2,
+	}
+	l.witnessGenLimiter = newWitnessGenLimiter(4)
+	client := l.RollupProvider.(fakeRollupProvider).client
+	ctx := context.Background()
+
+	require.NoError(t, proofDB.ImportSpanProofs(100, []db.SpanRange{{Start: 100, End: 200}, {Start: 200, End: 300}}, 10))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 300, 400, 10))
+	imminent := &ent.ProofRequest{Type: proofrequest.TypeSPAN, StartBlock: 100, EndBlock: 200}
+
+	// Block 101 was produced 30 minutes ago, with 2s blocks.
+	client.unsafe = eth.L2BlockRef{Number: 1000, Time: uint64(time.Now().Add(-30*time.Minute).Unix()) + 899*2}
+	require.NoError(t, l.DeriveAggProofs(ctx))
+	require.False(t, l.slaEscalated(imminent.StartBlock))
+	require.Equal(t, "http://default", l.proverServerUrl(proofrequest.TypeSPAN, 100, 200))
+	next, err := l.nextProofToRequest(proofDB)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.TypeAGG, next.Type)
+
+	// Once it's older than the max age, the span proofs of the next output are escalated.
+	client.unsafe.Time -= uint64(time.Hour.Seconds())
+	require.NoError(t, l.DeriveAggProofs(ctx))
+	require.True(t, l.slaEscalated(100))
+	require.False(t, l.slaEscalated(200))
+	require.Equal(t, "http://premium", l.proverServerUrl(proofrequest.TypeSPAN, 100, 200))
+	require.Equal(t, "http://default", l.proverServerUrl(proofrequest.TypeSPAN, 200, 300))
+	require.Contains(t, l.knownProverBackends(), "http://premium")
+
+	// They're requested ahead of AGG proofs, and past the limit on concurrent proof requests.
+	next, err = l.nextProofToRequest(proofDB)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), next.StartBlock)
+	reason, err := l.spanProofBlockedReason(imminent, 0, 2)
+	require.NoError(t, err)
+	require.Empty(t, reason)
+	reason, err = l.spanProofBlockedReason(imminent, 4, 0)
+	require.NoError(t, err)
+	require.Contains(t, reason, "max concurrent witness generation reached")
+
+	// The escalation ends once the next output is proposed, and the oldest unproven block is recent again.
+	l2oo.latest = 200
+	client.unsafe.Time = uint64(time.Now().Unix())
+	require.NoError(t, l.DeriveAggProofs(ctx))
+	require.False(t, l.slaEscalated(100))
+}
@@ -0,0 +1,316 @@
+package proposer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// PipelineSpec is a declarative description of the proof pipeline, loaded from a YAML file. It lets GitOps workflows
+// manage the proposer's scheduling behavior without restarting it with a different set of CLI flags. Settings that are
+// omitted from the spec are set by the CLI flags.
+//
+// Example:
+//
+//	chain_id: 10
+//	ranges:
+//	  max_block_range_per_span_proof: 300
+//	concurrency:
+//	  max_concurrent_witness_gen: 5
+//	  max_concurrent_proof_requests: 10
+//	timeouts:
+//	  span_proof_timeout: 14400
+//	  span_proof_timeout_per_block: 10
+//	  agg_proof_timeout: 3600
+//	  chains:
+//	    8453:
+//	      span_proof_timeout_per_block: 20
+//	provers:
+//	  - server_url: http://op-succinct-server-small:3000
+//	    max_blocks: 50
+//	  - server_url: http://op-succinct-server-large:3000
+//	budgets:
+//	  max_span_proof_requests_per_hour: 100
+//	alerting:
+//	  max_failed_span_proofs_per_hour: 5
+//	  max_unrequested_proofs: 200
+type PipelineSpec struct {
+	// ChainID is the L2 chain the spec is meant for. If set, it must match the chain the proposer is running against.
+	ChainID uint64 `yaml:"chain_id"`
+
+	Ranges struct {
+		MaxBlockRangePerSpanProof *uint64 `yaml:"max_block_range_per_span_proof"`
+	} `yaml:"ranges"`
+
+	Concurrency struct {
+		MaxConcurrentWitnessGen    *uint64 `yaml:"max_concurrent_witness_gen"`
+		MaxConcurrentProofRequests *uint64 `yaml:"max_concurrent_proof_requests"`
+	} `yaml:"concurrency"`
+
+	Timeouts struct {
+		TimeoutSpec `yaml:",inline"`
+		// Chains overrides the timeouts for individual L2 chains, keyed by chain ID, so that a spec shared by the
+		// proposers of several chains can tune each of them.
+		Chains map[uint64]TimeoutSpec `yaml:"chains"`
+	} `yaml:"timeouts"`
+
+	// Provers are the prover tiers that span proofs are routed to by the size of their range.
+	Provers []ProverTier `yaml:"provers"`
+
+	Budgets struct {
+		// MaxSpanProofRequestsPerHour caps the span proofs requested from the prover network in any hour. Unlimited if 0.
+		MaxSpanProofRequestsPerHour uint64 `yaml:"max_span_proof_requests_per_hour"`
+	} `yaml:"budgets"`
+
+	Alerting AlertingSpec `yaml:"alerting"`
+}
+
+// TimeoutSpec sets the time in seconds that new proof requests are given to be generated.
+type TimeoutSpec struct {
+	SpanProofTimeout         *uint64 `yaml:"span_proof_timeout"`
+	SpanProofTimeoutPerBlock *uint64 `yaml:"span_proof_timeout_per_block"`
+	AggProofTimeout          *uint64 `yaml:"agg_proof_timeout"`
+}
+
+// ProverTier is an op-succinct-server that span proofs up to a given size are requested from. Span proofs are requested
+// from the first tier that their range fits in, and from OP_SUCCINCT_SERVER_URL if there's none. AGG proofs, proof
+// status polls and config validation always go to OP_SUCCINCT_SERVER_URL, which must use the same prover network.
+type ProverTier struct {
+	ServerUrl string `yaml:"server_url"`
+	// MaxBlocks is the largest span proof requested from the tier. Unbounded if 0, which is only allowed for the last tier.
+	MaxBlocks uint64 `yaml:"max_blocks"`
+}
+
+// AlertingSpec sets the thresholds above which the pipeline's health is logged as an error and counted in the error
+// metrics. Thresholds that are 0 are disabled.
+type AlertingSpec struct {
+	// MaxFailedSpanProofsPerHour alerts when more span proofs failed in the last hour.
+	MaxFailedSpanProofsPerHour uint64 `yaml:"max_failed_span_proofs_per_hour"`
+	// MaxUnrequestedProofs alerts when more proof requests are queued.
+	MaxUnrequestedProofs uint64 `yaml:"max_unrequested_proofs"`
+}
+
+// LoadPipelineSpec reads and parses the pipeline spec at the given path. Unknown keys are rejected so that typos don't
+// silently leave a setting unmanaged.
+func LoadPipelineSpec(path string) (*PipelineSpec, []byte, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read pipeline spec: %w", err)
+	}
+
+	var spec PipelineSpec
+	dec := yaml.NewDecoder(bytes.NewReader(raw))
+	dec.KnownFields(true)
+	if err := dec.Decode(&spec); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse pipeline spec: %w", err)
+	}
+	return &spec, raw, nil
+}
+
+// Check validates the spec against the chain the proposer is running against.
+func (s *PipelineSpec) Check(l2ChainID uint64) error {
+	if s.ChainID != 0 && s.ChainID != l2ChainID {
+		return fmt.Errorf("pipeline spec is for chain %d, but the proposer is running against chain %d", s.ChainID, l2ChainID)
+	}
+	if v := s.Ranges.MaxBlockRangePerSpanProof; v != nil && *v == 0 {
+		return errors.New("max_block_range_per_span_proof must be greater than 0")
+	}
+	if v := s.Concurrency.MaxConcurrentWitnessGen; v != nil && *v == 0 {
+		return errors.New("max_concurrent_witness_gen must be greater than 0")
+	}
+	if v := s.Concurrency.MaxConcurrentProofRequests; v != nil && *v == 0 {
+		return errors.New("max_concurrent_proof_requests must be greater than 0")
+	}
+	for i, tier := range s.Provers {
+		if tier.ServerUrl == "" {
+			return fmt.Errorf("prover tier %d has no server_url", i)
+		}
+		if tier.MaxBlocks == 0 && i != len(s.Provers)-1 {
+			return fmt.Errorf("prover tier %d has no max_blocks, which is only allowed for the last tier", i)
+		}
+	}
+	return nil
+}
+
+// pipelineSettings are the settings that can change while the proposer is running. They are replaced as a whole when
+// the pipeline spec changes, so that goroutines reading them never see a partially applied spec.
+type pipelineSettings struct {
+	MaxBlockRangePerSpanProof   uint64
+	MaxConcurrentWitnessGen     uint64
+	MaxConcurrentProofRequests  uint64
+	SpanProofTimeout            uint64
+	SpanProofTimeoutPerBlock    uint64
+	AggProofTimeout             uint64
+	ProverTiers                 []ProverTier
+	MaxSpanProofRequestsPerHour uint64
+	Alerting                    AlertingSpec
+}
+
+// defaultPipelineSettings returns the settings configured by the CLI flags.
+func defaultPipelineSettings(cfg ProposerConfig) pipelineSettings {
+	return pipelineSettings{
+		MaxBlockRangePerSpanProof:  cfg.MaxBlockRangePerSpanProof,
+		MaxConcurrentWitnessGen:    cfg.MaxConcurrentWitnessGen,
+		MaxConcurrentProofRequests: cfg.MaxConcurrentProofRequests,
+		SpanProofTimeout:           cfg.SpanProofTimeout,
+		SpanProofTimeoutPerBlock:   cfg.SpanProofTimeoutPerBlock,
+		AggProofTimeout:            cfg.AggProofTimeout,
+	}
+}
+
+// apply returns the settings with the ones the spec manages for the given chain replaced.
+func (s *PipelineSpec) apply(settings pipelineSettings, l2ChainID uint64) pipelineSettings {
+	set := func(field *uint64, value *uint64) {
+		if value != nil {
+			*field = *value
+		}
+	}
+	set(&settings.MaxBlockRangePerSpanProof, s.Ranges.MaxBlockRangePerSpanProof)
+	set(&settings.MaxConcurrentWitnessGen, s.Concurrency.MaxConcurrentWitnessGen)
+	set(&settings.MaxConcurrentProofRequests, s.Concurrency.MaxConcurrentProofRequests)
+	for _, timeouts := range []TimeoutSpec{s.Timeouts.TimeoutSpec, s.Timeouts.Chains[l2ChainID]} {
+		set(&settings.SpanProofTimeout, timeouts.SpanProofTimeout)
+		set(&settings.SpanProofTimeoutPerBlock, timeouts.SpanProofTimeoutPerBlock)
+		set(&settings.AggProofTimeout, timeouts.AggProofTimeout)
+	}
+	settings.ProverTiers = s.Provers
+	settings.MaxSpanProofRequestsPerHour = s.Budgets.MaxSpanProofRequestsPerHour
+	settings.Alerting = s.Alerting
+	return settings
+}
+
+// check validates the settings against the settings that can't change while the proposer is running.
+func (s pipelineSettings) check(cfg ProposerConfig) error {
+	if cfg.FastPathMaxBlocks > 0 && cfg.FastPathMaxBlocks >= s.MaxBlockRangePerSpanProof {
+		return fmt.Errorf("the fast path max blocks (%d) must be less than the max block range per span proof (%d)", cfg.FastPathMaxBlocks, s.MaxBlockRangePerSpanProof)
+	}
+	return nil
+}
+
+// settings returns the settings currently in effect. Until a pipeline spec is applied, they are the CLI flags.
+func (l *L2OutputSubmitter) settings() pipelineSettings {
+	if s := l.currentSettings.Load(); s != nil {
+		return *s
+	}
+	return defaultPipelineSettings(l.Cfg)
+}
+
+// reconcilePipelineSpec re-reads the pipeline spec and, if it changed since it was last applied, applies it on top of
+// the CLI flags, so that settings removed from the spec go back to their CLI value. This runs at the start of every
+// loop iteration, while the settings are read concurrently by proof requests and the admin API, so they are swapped
+// atomically.
+func (l *L2OutputSubmitter) reconcilePipelineSpec() error {
+	if l.Cfg.PipelineSpecPath == "" {
+		return nil
+	}
+
+	spec, raw, err := LoadPipelineSpec(l.Cfg.PipelineSpecPath)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(raw, l.appliedSpec) {
+		return nil
+	}
+	if err := spec.Check(l.Cfg.L2ChainID); err != nil {
+		return fmt.Errorf("invalid pipeline spec: %w", err)
+	}
+	next := spec.apply(defaultPipelineSettings(l.Cfg), l.Cfg.L2ChainID)
+	if err := next.check(l.Cfg); err != nil {
+		return fmt.Errorf("invalid pipeline spec: %w", err)
+	}
+
+	prev := l.settings()
+	for _, change := range []struct {
+		name     string
+		old, new uint64
+	}{
+		{"max_block_range_per_span_proof", prev.MaxBlockRangePerSpanProof, next.MaxBlockRangePerSpanProof},
+		{"max_concurrent_witness_gen", prev.MaxConcurrentWitnessGen, next.MaxConcurrentWitnessGen},
+		{"max_concurrent_proof_requests", prev.MaxConcurrentProofRequests, next.MaxConcurrentProofRequests},
+		{"span_proof_timeout", prev.SpanProofTimeout, next.SpanProofTimeout},
+		{"span_proof_timeout_per_block", prev.SpanProofTimeoutPerBlock, next.SpanProofTimeoutPerBlock},
+		{"agg_proof_timeout", prev.AggProofTimeout, next.AggProofTimeout},
+		{"max_span_proof_requests_per_hour", prev.MaxSpanProofRequestsPerHour, next.MaxSpanProofRequestsPerHour},
+	} {
+		if change.old != change.new {
+			l.Log.Info("Applying pipeline spec", "setting", change.name, "old", change.old, "new", change.new)
+		}
+	}
+	l.Log.Info("Applied pipeline spec", "proverTiers", len(next.ProverTiers), "alerting", next.Alerting)
+
+	l.currentSettings.Store(&next)
+	// With negotiation, the maximum is the capacity the servers report, and the spec only applies to servers that don't.
+	if l.Cfg.WitnessGenCapacityInterval == 0 {
+		l.witnessGenLimiter.SetMax(next.MaxConcurrentWitnessGen)
+	}
+	l.appliedSpec = raw
+	return nil
+}
+
+// proverServerUrl returns the URL of the first server that a proof for the given range is requested from.
+func (l *L2OutputSubmitter) proverServerUrl(proofType proofrequest.Type, start, end uint64) string {
+	return l.proverServerUrls(proofType, start, end)[0]
+}
+
+// proverServerUrls returns the URLs of the servers that a proof for the given range is requested from. Span proofs
+// that don't go to the SLA premium server or a prover tier are balanced across all witness generation servers.
+func (l *L2OutputSubmitter) proverServerUrls(proofType proofrequest.Type, start, end uint64) []string {
+	if l.Cfg.SLAPremiumServerUrl != "" && l.slaEscalated(start) {
+		return []string{l.Cfg.SLAPremiumServerUrl}
+	}
+	if proofType == proofrequest.TypeSPAN {
+		for _, tier := range l.settings().ProverTiers {
+			if tier.MaxBlocks == 0 || end-start <= tier.MaxBlocks {
+				return []string{tier.ServerUrl}
+			}
+		}
+		return l.Cfg.witnessGenServers()
+	}
+	return l.Cfg.witnessGenServers()[:1]
+}
+
+// spanProofBudgetExhausted returns whether the span proofs requested from the prover network in the last hour reached
+// the budget of the pipeline spec, along with the number requested.
+func (l *L2OutputSubmitter) spanProofBudgetExhausted() (bool, int, error) {
+	budget := l.settings().MaxSpanProofRequestsPerHour
+	if budget == 0 {
+		return false, 0, nil
+	}
+	requested, err := l.db.GetNumberOfSpanProofRequestsSince(uint64(time.Now().Add(-time.Hour).Unix()))
+	if err != nil {
+		return false, 0, err
+	}
+	return uint64(requested) >= budget, requested, nil
+}
+
+// checkPipelineAlerts compares the health of the pipeline against the alerting thresholds of the pipeline spec.
+func (l *L2OutputSubmitter) checkPipelineAlerts() error {
+	alerting := l.settings().Alerting
+	if threshold := alerting.MaxFailedSpanProofsPerHour; threshold > 0 {
+		failed, err := l.db.GetFailedSpanProofsSince(uint64(time.Now().Add(-time.Hour).Unix()))
+		if err != nil {
+			return err
+		}
+		if uint64(len(failed)) > threshold {
+			l.Log.Error("Pipeline alert: too many span proofs failed in the last hour", "failed", len(failed), "threshold", threshold)
+			l.Metr.RecordError("alert_failed_span_proofs", 1)
+		}
+	}
+	if threshold := alerting.MaxUnrequestedProofs; threshold > 0 {
+		unrequested, err := l.db.GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ)
+		if err != nil {
+			return err
+		}
+		if uint64(unrequested) > threshold {
+			l.Log.Error("Pipeline alert: too many proof requests are queued", "unrequested", unrequested, "threshold", threshold)
+			l.Metr.RecordError("alert_unrequested_proofs", 1)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package proposer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+func TestPipelineSpecCheck(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spec.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("chain_id: 10\nranges:\n  max_block_range_per_span_proof: 0\n"), 0o644))
+	spec, _, err := LoadPipelineSpec(path)
+	require.NoError(t, err)
+	require.ErrorContains(t, spec.Check(11), "chain 10")
+	require.ErrorContains(t, spec.Check(10), "max_block_range_per_span_proof")
+
+	// Typos are rejected instead of leaving a setting unmanaged.
+	require.NoError(t, os.WriteFile(path, []byte("ranges:\n  max_block_range_per_span: 100\n"), 0o644))
+	_, _, err = LoadPipelineSpec(path)
+	require.Error(t, err)
+
+	// Only the last prover tier can be unbounded.
+	require.NoError(t, os.WriteFile(path, []byte("provers:\n  - server_url: http://a\n  - server_url: http://b\n    max_blocks: 10\n"), 0o644))
+	spec, _, err = LoadPipelineSpec(path)
+	require.NoError(t, err)
+	require.ErrorContains(t, spec.Check(10), "max_blocks")
+}
+
+func TestReconcilePipelineSpec(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spec.yaml")
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log: log.New(),
+			Cfg: ProposerConfig{
+				PipelineSpecPath:           path,
+				MaxBlockRangePerSpanProof:  300,
+				MaxConcurrentWitnessGen:    5,
+				MaxConcurrentProofRequests: 10,
+				SpanProofTimeout:           100,
+				FastPathMaxBlocks:          20,
+				OPSuccinctServerUrl:        "http://default",
+			},
+		},
+		witnessGenLimiter: newWitnessGenLimiter(5),
+	}
+
+	spec := `
+ranges:
+  max_block_range_per_span_proof: 100
+concurrency:
+  max_concurrent_witness_gen: 2
+provers:
+  - server_url: http://small
+    max_blocks: 50
+`
+	require.NoError(t, os.WriteFile(path, []byte(spec), 0o644))
+	require.NoError(t, l.reconcilePipelineSpec())
+	require.Equal(t, uint64(100), l.settings().MaxBlockRangePerSpanProof)
+	require.Equal(t, uint64(2), l.witnessGenLimiter.Limit())
+	require.Equal(t, "http://small", l.proverServerUrl(proofrequest.TypeSPAN, 0, 50))
+	require.Equal(t, "http://default", l.proverServerUrl(proofrequest.TypeSPAN, 0, 51))
+	require.Equal(t, "http://default", l.proverServerUrl(proofrequest.TypeAGG, 0, 10))
+	// The CLI configuration is never modified.
+	require.Equal(t, uint64(300), l.Cfg.MaxBlockRangePerSpanProof)
+
+	// Settings removed from the spec go back to their CLI value.
+	require.NoError(t, os.WriteFile(path, []byte("timeouts:\n  span_proof_timeout: 200\n"), 0o644))
+	require.NoError(t, l.reconcilePipelineSpec())
+	require.Equal(t, uint64(300), l.settings().MaxBlockRangePerSpanProof)
+	require.Equal(t, uint64(200), l.settings().SpanProofTimeout)
+	require.Empty(t, l.settings().ProverTiers)
+	require.Equal(t, "http://default", l.proverServerUrl(proofrequest.TypeSPAN, 0, 50))
+
+	// A spec that conflicts with the fast path is rejected, and the last applied one stays in effect.
+	require.NoError(t, os.WriteFile(path, []byte("ranges:\n  max_block_range_per_span_proof: 20\n"), 0o644))
+	require.ErrorContains(t, l.reconcilePipelineSpec(), "fast path")
+	require.Equal(t, uint64(300), l.settings().MaxBlockRangePerSpanProof)
+	require.Equal(t, uint64(200), l.settings().SpanProofTimeout)
+}
+
+func TestPipelineSpecPerChainTimeouts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spec.yaml")
+	spec := `
+timeouts:
+  span_proof_timeout: 200
+  agg_proof_timeout: 300
+  chains:
+    10:
+      agg_proof_timeout: 50
+`
+	require.NoError(t, os.WriteFile(path, []byte(spec), 0o644))
+	parsed, _, err := LoadPipelineSpec(path)
+	require.NoError(t, err)
+
+	defaults := pipelineSettings{SpanProofTimeout: 100, AggProofTimeout: 100}
+	settings := parsed.apply(defaults, 10)
+	require.Equal(t, uint64(200), settings.SpanProofTimeout)
+	require.Equal(t, uint64(50), settings.AggProofTimeout)
+	settings = parsed.apply(defaults, 11)
+	require.Equal(t, uint64(200), settings.SpanProofTimeout)
+	require.Equal(t, uint64(300), settings.AggProofTimeout)
+}
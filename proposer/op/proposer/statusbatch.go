@@ -0,0 +1,167 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"golang.org/x/sync/errgroup"
+)
+
+// statusBatchSize is the largest number of proof statuses requested from the server at once. It matches the largest
+// batch that the server accepts.
+const statusBatchSize = 256
+
+// errStatusBatchUnsupported is returned when the server doesn't serve batched proof status requests.
+var errStatusBatchUnsupported = errors.New("the server doesn't support batched proof status requests")
+
+// pollProofStatuses polls the statuses of the given proof requests, and returns them in the same order along with the
+// error of each poll. The statuses of all requests on a server are fetched in batches of up to statusBatchSize, in
+// one round-trip per batch. Servers that don't support batched status requests are polled once per request. With
+// PROVER_NETWORK_RPC_URL, the statuses are polled from the prover network directly instead. Polls still in flight when
+// ctx is done are cancelled.
+func (l *L2OutputSubmitter) pollProofStatuses(ctx context.Context, reqs []*ent.ProofRequest) ([]ProofStatusResponse, []error) {
+	if l.network != nil {
+		return l.pollNetworkProofStatuses(ctx, reqs)
+	}
+
+	statuses := make([]ProofStatusResponse, len(reqs))
+	pollErrs := make([]error, len(reqs))
+
+	// The indices of the requests on each backend.
+	byBackend := make(map[string][]int)
+	var backends []string
+	for i, req := range reqs {
+		backend := l.proverBackend(req)
+		if _, ok := byBackend[backend]; !ok {
+			backends = append(backends, backend)
+		}
+		byBackend[backend] = append(byBackend[backend], i)
+	}
+
+	// Requests on servers that don't support batched status requests are polled one by one, once the batches are done.
+	var mu sync.Mutex
+	var pollEach []int
+	var g errgroup.Group
+	g.SetLimit(statusPollConcurrency)
+	for _, backend := range backends {
+		indices := byBackend[backend]
+		if unsupported, _ := l.statusBatchUnsupported.Load(backend); unsupported == true {
+			pollEach = append(pollEach, indices...)
+			continue
+		}
+		for start := 0; start < len(indices); start += statusBatchSize {
+			batch := indices[start:min(start+statusBatchSize, len(indices))]
+			g.Go(func() error {
+				ids := make([]string, len(batch))
+				for j, i := range batch {
+					ids[j] = reqs[i].ProverRequestID
+				}
+				batchStatuses, batchErrs, err := l.GetProofStatuses(ctx, backend, ids)
+				if errors.Is(err, errStatusBatchUnsupported) {
+					l.Log.Info("Server doesn't support batched proof status requests, polling each proof", "backend", backend)
+					l.statusBatchUnsupported.Store(backend, true)
+					mu.Lock()
+					pollEach = append(pollEach, batch...)
+					mu.Unlock()
+					return nil
+				}
+				for j, i := range batch {
+					if err != nil {
+						pollErrs[i] = err
+						continue
+					}
+					statuses[i], pollErrs[i] = batchStatuses[j], batchErrs[j]
+				}
+				return nil
+			})
+		}
+	}
+	g.Wait()
+
+	for _, i := range pollEach {
+		g.Go(func() error {
+			statuses[i], pollErrs[i] = l.GetProofStatus(ctx, l.proverBackend(reqs[i]), reqs[i].ProverRequestID)
+			return nil
+		})
+	}
+	g.Wait()
+	return statuses, pollErrs
+}
+
+// GetProofStatuses gets the statuses of the proofs with the given IDs from the server in one round-trip, in the order of
+// the IDs, along with the error of each proof whose status the server couldn't get. Returns errStatusBatchUnsupported if
+// the server doesn't serve batched status requests.
+func (l *L2OutputSubmitter) GetProofStatuses(ctx context.Context, serverUrl string, proofIDs []string) ([]ProofStatusResponse, []error, error) {
+	jsonBody, err := json.Marshal(ProofStatusBatchRequest{ProofIDs: proofIDs})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", serverUrl+"/status", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: PROOF_STATUS_TIMEOUT}
+	resp, err := client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, nil, ctx.Err()
+		}
+		l.backendHealth.onUnreachable(serverUrl, time.Now())
+		if err, ok := err.(net.Error); ok && err.Timeout() {
+			return nil, nil, fmt.Errorf("request timed out after %s: %w", PROOF_STATUS_TIMEOUT, err)
+		}
+		return nil, nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	l.backendHealth.onReachable(serverUrl)
+	l.recordRequestEncodings(serverUrl, resp.Header)
+
+	// Older servers only serve the status of a single proof at /status/:proof_id.
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+		return nil, nil, errStatusBatchUnsupported
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading the response body: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		serverErr := parseServerError(resp.StatusCode, body)
+		l.Log.Error("Failed to get proof statuses",
+			"status", resp.StatusCode,
+			"code", serverErr.Code,
+			"error", serverErr.Message)
+		return nil, nil, serverErr
+	}
+
+	var batch ProofStatusBatchResponse
+	if err := l.decodeServerResponse("status", body, &batch); err != nil {
+		return nil, nil, err
+	}
+	if len(batch.Statuses) != len(proofIDs) {
+		return nil, nil, fmt.Errorf("%w: got %d proof statuses for %d proofs", ErrInvalidServerResponse, len(batch.Statuses), len(proofIDs))
+	}
+	statuses := make([]ProofStatusResponse, len(proofIDs))
+	errs := make([]error, len(proofIDs))
+	for i, entry := range batch.Statuses {
+		switch {
+		case entry.ProofID != proofIDs[i]:
+			return nil, nil, fmt.Errorf("%w: got the status of proof %s in place of proof %s", ErrInvalidServerResponse, entry.ProofID, proofIDs[i])
+		case entry.Error != "":
+			errs[i] = fmt.Errorf("the server failed to get the proof status: %s", entry.Error)
+		default:
+			statuses[i] = *entry.Status
+		}
+	}
+	return statuses, errs, nil
+}
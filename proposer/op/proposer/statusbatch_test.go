@@ -0,0 +1,80 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+func TestPollProofStatuses(t *testing.T) {
+	var batchRequests atomic.Int64
+	batching := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/status", r.URL.Path)
+		batchRequests.Add(1)
+		var req ProofStatusBatchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		resp := ProofStatusBatchResponse{}
+		for _, id := range req.ProofIDs {
+			if id == "bad" {
+				resp.Statuses = append(resp.Statuses, ProofStatusBatchEntry{ProofID: id, Error: "invalid proof ID bad"})
+				continue
+			}
+			status := &ProofStatusResponse{FulfillmentStatus: SP1FulfillmentStatusFulfilled, Proof: []byte(id)}
+			resp.Statuses = append(resp.Statuses, ProofStatusBatchEntry{ProofID: id, Status: status})
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer batching.Close()
+
+	var legacyRequests atomic.Int64
+	legacy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		legacyRequests.Add(1)
+		if r.URL.Path == "/status" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		id := strings.TrimPrefix(r.URL.Path, "/status/")
+		require.NoError(t, json.NewEncoder(w).Encode(ProofStatusResponse{FulfillmentStatus: SP1FulfillmentStatusFulfilled, Proof: []byte(id)}))
+	}))
+	defer legacy.Close()
+
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+		},
+	}
+	reqs := []*ent.ProofRequest{
+		{ProverRequestID: "a", ProverBackend: batching.URL},
+		{ProverRequestID: "b", ProverBackend: legacy.URL},
+		{ProverRequestID: "bad", ProverBackend: batching.URL},
+		{ProverRequestID: "c", ProverBackend: legacy.URL},
+	}
+
+	// All statuses on the batching server are fetched at once, and the legacy server is polled for each proof.
+	statuses, errs := l.pollProofStatuses(context.Background(), reqs)
+	require.Equal(t, int64(1), batchRequests.Load())
+	require.Equal(t, int64(3), legacyRequests.Load())
+	for i, id := range []string{"a", "b", "", "c"} {
+		require.Equal(t, id, string(statuses[i].Proof))
+	}
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	require.ErrorContains(t, errs[2], "invalid proof ID bad")
+	require.NoError(t, errs[3])
+
+	// The legacy server isn't sent batched requests anymore.
+	_, errs = l.pollProofStatuses(context.Background(), reqs)
+	require.Equal(t, int64(5), legacyRequests.Load())
+	require.NoError(t, errs[1])
+}
@@ -0,0 +1,137 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// errProposalReverted is returned when the proposal transaction was mined, but reverted.
+var errProposalReverted = errors.New("proposal transaction reverted")
+
+// submissionFailure is how an attempt at proposing the output of a completed AGG proof failed.
+type submissionFailure string
+
+const (
+	// submissionReverted is a proposal that reverted, on-chain or when its gas was estimated. It's only resent once
+	// its simulation passes.
+	submissionReverted submissionFailure = "reverted"
+	// submissionUnderpriced and submissionDropped are proposals that weren't mined, because their fees were too low or
+	// they were dropped from the mempool. They're resent, and the tx manager prices the resend at the current fees.
+	submissionUnderpriced submissionFailure = "underpriced"
+	submissionDropped     submissionFailure = "dropped"
+	// submissionNonceConflict is a proposal that was sent with a nonce that was already used, or skips one. The tx
+	// manager re-reads the account's nonce after a nonce error, so the resend uses the current one.
+	submissionNonceConflict submissionFailure = "nonce_conflict"
+)
+
+// submissionErrorPatterns map the errors that L1 nodes return for rejected transactions, which lose their type over
+// RPC, to how the submission failed.
+var submissionErrorPatterns = []struct {
+	pattern string
+	failure submissionFailure
+}{
+	{"execution reverted", submissionReverted},
+	{"transaction underpriced", submissionUnderpriced},
+	{"max fee per gas less than block base fee", submissionUnderpriced},
+	{"nonce too low", submissionNonceConflict},
+	{"nonce too high", submissionNonceConflict},
+}
+
+// classifySubmissionError returns how the proposal failed, or an empty string if the error isn't a failure of the
+// proposal transaction itself, like an unreachable L1 node, which is retried on the next loop iteration as usual.
+func classifySubmissionError(err error) submissionFailure {
+	if errors.Is(err, errProposalReverted) {
+		return submissionReverted
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return submissionDropped
+	}
+	msg := strings.ToLower(err.Error())
+	for _, p := range submissionErrorPatterns {
+		if strings.Contains(msg, p.pattern) {
+			return p.failure
+		}
+	}
+	return ""
+}
+
+// recordSubmissionFailure counts the failed proposal of the completed AGG proof against the retry limit of how it
+// failed, and records the error. Once a limit is reached, the AGG proof is moved to the dead-letter status, so that it
+// waits for an admin to retry it instead of spending gas on a proposal that keeps failing. Returns the error.
+func (l *L2OutputSubmitter) recordSubmissionFailure(aggProof *ent.ProofRequest, failure submissionFailure, err error) error {
+	l.Metr.RecordError("submission_"+string(failure), 1)
+	var counter string
+	var limit uint64
+	switch failure {
+	case submissionReverted:
+		counter, limit = proofrequest.FieldSubmissionReverts, l.Cfg.SubmissionMaxReverts
+	case submissionUnderpriced, submissionDropped:
+		counter, limit = proofrequest.FieldSubmissionResends, l.Cfg.SubmissionMaxResends
+	case submissionNonceConflict:
+		counter, limit = proofrequest.FieldSubmissionNonceResyncs, l.Cfg.SubmissionMaxNonceResyncs
+	}
+	attempts, dbErr := l.db.AddSubmissionFailure(aggProof.ID, counter, err.Error())
+	if dbErr != nil {
+		return fmt.Errorf("failed to propose output: %w, and to count the failure: %w", err, dbErr)
+	}
+	if limit == 0 || attempts < limit {
+		return fmt.Errorf("failed to propose output, %s %d times: %w", failure, attempts, err)
+	}
+
+	dlErr := l.db.TransitionProofStatus(aggProof.ID, proofrequest.StatusCOMPLETE, proofrequest.StatusDEADLETTER)
+	if dlErr != nil && !errors.Is(dlErr, db.ErrProofStatusChanged) {
+		return fmt.Errorf("failed to propose output: %w, and to dead-letter the AGG proof: %w", err, dlErr)
+	}
+	l.Log.Error("Proposal of the AGG proof failed too often, moved it to the dead-letter status", "id", aggProof.ID, "start", aggProof.StartBlock, "end", aggProof.EndBlock, "failure", failure, "attempts", attempts, "err", err)
+	l.Metr.RecordError("alert_submission_deadlettered", 1)
+	return nil
+}
+
+// submissionDelayReason returns why proposing the completed AGG proof is delayed because the L1 base fee is above
+// MAX_SUBMISSION_BASE_FEE_GWEI, or an empty string if it can be proposed now. Proposals of escalated requests aren't
+// delayed, since the oldest unproven block is already past the SLA.
+func (l *L2OutputSubmitter) submissionDelayReason(ctx context.Context, aggProof *ent.ProofRequest) (string, error) {
+	if l.Cfg.MaxSubmissionBaseFeeGwei == 0 || l.slaEscalated(aggProof.StartBlock) {
+		return "", nil
+	}
+	header, err := l.L1Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the L1 head: %w", err)
+	}
+	if header.BaseFee == nil {
+		return "", nil
+	}
+	l.Metr.RecordL1BaseFee(gweiFromWei(header.BaseFee))
+	return baseFeeDelayReason(header.BaseFee, l.Cfg.MaxSubmissionBaseFeeGwei, aggProof.FulfilledTime, l.Cfg.MaxSubmissionDelay, time.Now()), nil
+}
+
+// baseFeeDelayReason returns why a proposal is delayed if the base fee is above the max base fee, until the AGG proof
+// fulfilled at the given Unix time waited for the max delay. AGG proofs without a fulfillment time, like those
+// completed before it was tracked, aren't delayed.
+func baseFeeDelayReason(baseFee *big.Int, maxBaseFeeGwei uint64, fulfilledTime uint64, maxDelay time.Duration, now time.Time) string {
+	maxBaseFee := new(big.Int).Mul(new(big.Int).SetUint64(maxBaseFeeGwei), big.NewInt(params.GWei))
+	if baseFee.Cmp(maxBaseFee) <= 0 || fulfilledTime == 0 {
+		return ""
+	}
+	waited := now.Sub(time.Unix(int64(fulfilledTime), 0))
+	if waited >= maxDelay {
+		return ""
+	}
+	return fmt.Sprintf("L1 base fee of %g gwei is above %d gwei, delaying the proposal for up to %s more", gweiFromWei(baseFee), maxBaseFeeGwei, (maxDelay - waited).Round(time.Second))
+}
+
+// gweiFromWei converts an amount in wei to gwei, for metrics and logs.
+func gweiFromWei(wei *big.Int) float64 {
+	gwei, _ := new(big.Rat).SetFrac(wei, big.NewInt(params.GWei)).Float64()
+	return gwei
+}
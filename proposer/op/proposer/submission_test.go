@@ -0,0 +1,128 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+func TestBaseFeeDelayReason(t *testing.T) {
+	now := time.Unix(10_000, 0)
+	fulfilled := uint64(now.Add(-10 * time.Minute).Unix())
+	gwei := func(n int64) *big.Int { return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e9)) }
+
+	// The base fee is at the threshold.
+	require.Empty(t, baseFeeDelayReason(gwei(50), 50, fulfilled, time.Hour, now))
+
+	reason := baseFeeDelayReason(gwei(51), 50, fulfilled, time.Hour, now)
+	require.Contains(t, reason, "51 gwei is above 50 gwei")
+	require.Contains(t, reason, "50m0s more")
+
+	// The proof waited for the max delay.
+	require.Empty(t, baseFeeDelayReason(gwei(51), 50, fulfilled, 10*time.Minute, now))
+
+	// The fulfillment time isn't known.
+	require.Empty(t, baseFeeDelayReason(gwei(51), 50, 0, time.Hour, now))
+}
+
+// failingL2OO fails the proposals sent to it with the given errors, in order, before it accepts them.
+type failingL2OO struct {
+	*fakeL2OO
+	sendErrs    []error
+	simulateErr error
+}
+
+func (f *failingL2OO) sendTransaction(ctx context.Context, output *eth.OutputResponse, proof []byte, l1BlockNum uint64) error {
+	if len(f.sendErrs) > 0 {
+		err := f.sendErrs[0]
+		f.sendErrs = f.sendErrs[1:]
+		return err
+	}
+	return f.fakeL2OO.sendTransaction(ctx, output, proof, l1BlockNum)
+}
+
+func (f *failingL2OO) simulateProposal(context.Context, *eth.OutputResponse, []byte, uint64) error {
+	return f.simulateErr
+}
+
+func TestClassifySubmissionError(t *testing.T) {
+	for err, want := range map[error]submissionFailure{
+		fmt.Errorf("%w: 0x01", errProposalReverted):                                              submissionReverted,
+		errors.New("failed to estimate gas: execution reverted: L1 block hash not checkpointed"): submissionReverted,
+		errors.New("replacement transaction underpriced"):                                        submissionUnderpriced,
+		errors.New("max fee per gas less than block base fee"):                                   submissionUnderpriced,
+		fmt.Errorf("failed to send: %w", context.DeadlineExceeded):                               submissionDropped,
+		errors.New("Nonce too low"):                                                              submissionNonceConflict,
+		errors.New("connection refused"):                                                         "",
+		errForeignPendingTransactions:                                                            "",
+	} {
+		require.Equal(t, want, classifySubmissionError(err), err.Error())
+	}
+}
+
+func TestSubmissionRetryLadder(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l2oo := &failingL2OO{fakeL2OO: newFakeL2OO(100, 150)}
+	l := newFakeL2OODriver(t, l2oo.fakeL2OO, proofDB)
+	l.transactor = l2oo
+	l.Cfg.SubmissionMaxReverts = 2
+	l.Cfg.SubmissionMaxResends = 3
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 100, 300, 0))
+	aggs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	l1BlockNumber, l1BlockHash, err := l2oo.checkpointBlockHash(context.Background())
+	require.NoError(t, err)
+	_, err = proofDB.AddL1BlockInfoToAggRequest(100, 300, l1BlockNumber, l1BlockHash.Hex())
+	require.NoError(t, err)
+	require.NoError(t, proofDB.UpdateProofStatus(aggs[0].ID, proofrequest.StatusPROVING))
+	require.NoError(t, proofDB.AddFulfilledProof(aggs[0].ID, []byte("proof")))
+
+	// Underpriced and dropped proposals are resent, and counted against the same limit.
+	l2oo.sendErrs = []error{errors.New("transaction underpriced"), context.DeadlineExceeded}
+	require.Error(t, l.SubmitAggProofs(context.Background()))
+	require.Error(t, l.SubmitAggProofs(context.Background()))
+	agg, err := proofDB.GetProofRequest(aggs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), agg.SubmissionResends)
+
+	// A reverted proposal isn't resent while its simulation still reverts.
+	l2oo.sendErrs = []error{fmt.Errorf("%w: 0x01", errProposalReverted)}
+	l2oo.simulateErr = errors.New("execution reverted: output root mismatch")
+	require.Error(t, l.SubmitAggProofs(context.Background()))
+	agg, err = proofDB.GetProofRequest(aggs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), agg.SubmissionReverts)
+	require.Contains(t, agg.ErrorMessage, "output root mismatch")
+
+	// The simulation failing for another reason isn't counted.
+	l2oo.simulateErr = errors.New("connection refused")
+	require.Error(t, l.SubmitAggProofs(context.Background()))
+
+	// Once the simulation reverts too often, the AGG proof is dead-lettered.
+	l2oo.simulateErr = errors.New("execution reverted: output root mismatch")
+	require.NoError(t, l.SubmitAggProofs(context.Background()))
+	agg, err = proofDB.GetProofRequest(aggs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusDEADLETTER, agg.Status)
+	require.Empty(t, l2oo.proposals)
+
+	// Once the simulation passes, the proposal is resent.
+	require.NoError(t, proofDB.TransitionProofStatus(aggs[0].ID, proofrequest.StatusDEADLETTER, proofrequest.StatusCOMPLETE))
+	l2oo.simulateErr = nil
+	require.NoError(t, l.SubmitAggProofs(context.Background()))
+	require.Equal(t, []uint64{300}, l2oo.proposals)
+}
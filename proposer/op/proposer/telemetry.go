@@ -0,0 +1,45 @@
+package proposer
+
+import (
+	"context"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/telemetry"
+)
+
+// ReportTelemetry sends an anonymized report of the pipeline statistics since the last report to the telemetry
+// endpoint. The statistics are reset even if sending fails, so a report never covers more than one interval.
+func (l *L2OutputSubmitter) ReportTelemetry(ctx context.Context) error {
+	since := l.lastTelemetryReport
+	now := time.Now()
+	l.lastTelemetryReport = now
+
+	proofs, err := l.db.Analytics().GetCompletedProofsSince(uint64(since.Unix()))
+	if err != nil {
+		return err
+	}
+	durations, failures := l.telemetry.Flush()
+	report := telemetry.Report{
+		SP1Version:    "unknown",
+		PeriodSeconds: uint64(now.Sub(since).Seconds()),
+		Proofs:        map[string]telemetry.ProofStats{},
+		Durations:     durations,
+		Failures:      failures,
+	}
+	for _, proof := range proofs {
+		stats := report.Proofs[proof.Type.String()]
+		stats.Add(uint64(len(proof.Proof)))
+		report.Proofs[proof.Type.String()] = stats
+	}
+	if version, err := l.GetServerVersion(ctx); err != nil {
+		l.Log.Warn("failed to get the SP1 version of the server for telemetry", "err", err)
+	} else {
+		report.SP1Version = version.SP1CircuitVersion
+	}
+
+	if err := telemetry.Send(ctx, l.Cfg.TelemetryEndpoint, report); err != nil {
+		return err
+	}
+	l.Log.Info("sent telemetry report", "proofs", len(proofs), "period", now.Sub(since).Truncate(time.Second))
+	return nil
+}
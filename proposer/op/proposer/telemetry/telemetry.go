@@ -0,0 +1,153 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+// Report is the anonymized telemetry report sent to the telemetry endpoint. It only holds aggregate statistics keyed
+// by fixed labels. It must never hold anything that identifies the chain or the operator, like addresses, chain IDs,
+// block numbers, URLs or proof request IDs.
+type Report struct {
+	// SP1Version is the SP1 circuit version of the op-succinct-server, or "unknown".
+	SP1Version string `json:"sp1_version"`
+	// PeriodSeconds is the length of the period the report covers.
+	PeriodSeconds uint64 `json:"period_seconds"`
+	// Proofs are the sizes of the proofs completed in the period, keyed by proof type.
+	Proofs map[string]ProofStats `json:"proofs"`
+	// Durations are the durations of the witness generation and proving stages, keyed by
+	// "<stage>/<proof type>/<range size bucket>".
+	Durations map[string]DurationStats `json:"durations"`
+	// Failures are the number of failures in the period, keyed by "<stage>/<reason>".
+	Failures map[string]uint64 `json:"failures"`
+}
+
+// ProofStats are the sizes of a set of proofs.
+type ProofStats struct {
+	Count        uint64 `json:"count"`
+	AvgSizeBytes uint64 `json:"avg_size_bytes"`
+	MaxSizeBytes uint64 `json:"max_size_bytes"`
+}
+
+// Add adds a proof of the given size to the stats.
+func (s *ProofStats) Add(size uint64) {
+	s.AvgSizeBytes = (s.AvgSizeBytes*s.Count + size) / (s.Count + 1)
+	s.MaxSizeBytes = max(s.MaxSizeBytes, size)
+	s.Count++
+}
+
+// DurationStats are the durations of a set of runs of a stage.
+type DurationStats struct {
+	Count      uint64  `json:"count"`
+	AvgSeconds float64 `json:"avg_seconds"`
+	MaxSeconds float64 `json:"max_seconds"`
+}
+
+func (s *DurationStats) add(d time.Duration) {
+	s.AvgSeconds = (s.AvgSeconds*float64(s.Count) + d.Seconds()) / float64(s.Count+1)
+	s.MaxSeconds = max(s.MaxSeconds, d.Seconds())
+	s.Count++
+}
+
+// Collector wraps an OPSuccinctMetricer to aggregate the stage durations and failures recorded on it for the next
+// telemetry report. All updates are passed through to the wrapped metricer.
+type Collector struct {
+	opsuccinctmetrics.OPSuccinctMetricer
+
+	mu        sync.Mutex
+	durations map[string]*DurationStats
+	failures  map[string]uint64
+}
+
+var _ opsuccinctmetrics.OPSuccinctMetricer = (*Collector)(nil)
+
+// NewCollector starts aggregating the updates recorded on the returned metricer, and passes them through to m.
+func NewCollector(m opsuccinctmetrics.OPSuccinctMetricer) *Collector {
+	return &Collector{
+		OPSuccinctMetricer: m,
+		durations:          map[string]*DurationStats{},
+		failures:           map[string]uint64{},
+	}
+}
+
+func (c *Collector) RecordProveFailure(reason string, rangeSize uint64, traceID string) {
+	c.OPSuccinctMetricer.RecordProveFailure(reason, rangeSize, traceID)
+	c.recordFailure("proving/" + reason)
+}
+
+func (c *Collector) RecordWitnessGenFailure(reason string, rangeSize uint64, traceID string) {
+	c.OPSuccinctMetricer.RecordWitnessGenFailure(reason, rangeSize, traceID)
+	c.recordFailure("witness_gen/" + reason)
+}
+
+func (c *Collector) RecordWitnessGenDuration(proofType string, rangeSize uint64, d time.Duration) {
+	c.OPSuccinctMetricer.RecordWitnessGenDuration(proofType, rangeSize, d)
+	c.recordDuration("witness_gen/"+proofType+"/"+opsuccinctmetrics.RangeSizeBucket(rangeSize), d)
+}
+
+func (c *Collector) RecordProvingDuration(proofType string, rangeSize uint64, d time.Duration, traceID string) {
+	c.OPSuccinctMetricer.RecordProvingDuration(proofType, rangeSize, d, traceID)
+	c.recordDuration("proving/"+proofType+"/"+opsuccinctmetrics.RangeSizeBucket(rangeSize), d)
+}
+
+func (c *Collector) recordFailure(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failures[key]++
+}
+
+func (c *Collector) recordDuration(key string, d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.durations[key] == nil {
+		c.durations[key] = &DurationStats{}
+	}
+	c.durations[key].add(d)
+}
+
+// Flush returns the durations and failures aggregated since the last flush, and resets them.
+func (c *Collector) Flush() (map[string]DurationStats, map[string]uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	durations := make(map[string]DurationStats, len(c.durations))
+	for key, stats := range c.durations {
+		durations[key] = *stats
+	}
+	failures := c.failures
+	c.durations = map[string]*DurationStats{}
+	c.failures = map[string]uint64{}
+	return durations, failures
+}
+
+// Send posts the report to the telemetry endpoint.
+func Send(ctx context.Context, endpoint string, report Report) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed to encode telemetry report: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send telemetry report: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("telemetry endpoint returned status code %d: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+func TestCollectorFlush(t *testing.T) {
+	c := NewCollector(opsuccinctmetrics.NoopMetrics)
+	c.RecordProvingDuration("SPAN", 20, 10*time.Second, "")
+	c.RecordProvingDuration("SPAN", 30, 30*time.Second, "")
+	c.RecordProvingDuration("AGG", 300, time.Minute, "")
+	c.RecordProveFailure("timeout", 20, "")
+	c.RecordProveFailure("timeout", 20, "")
+	c.RecordWitnessGenFailure("Overloaded", 5, "")
+
+	durations, failures := c.Flush()
+	require.Equal(t, map[string]DurationStats{
+		"proving/SPAN/11-50": {Count: 2, AvgSeconds: 20, MaxSeconds: 30},
+		"proving/AGG/200+":   {Count: 1, AvgSeconds: 60, MaxSeconds: 60},
+	}, durations)
+	require.Equal(t, map[string]uint64{"proving/timeout": 2, "witness_gen/Overloaded": 1}, failures)
+
+	// Flushing resets the statistics.
+	durations, failures = c.Flush()
+	require.Empty(t, durations)
+	require.Empty(t, failures)
+}
+
+func TestProofStatsAdd(t *testing.T) {
+	var stats ProofStats
+	stats.Add(100)
+	stats.Add(300)
+	require.Equal(t, ProofStats{Count: 2, AvgSizeBytes: 200, MaxSizeBytes: 300}, stats)
+}
@@ -0,0 +1,62 @@
+package proposer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/tracing"
+)
+
+// proofSpanContext returns the span context of the root span of a proof request's trace. It's derived from the request,
+// so the spans of every poll that works on the request are added to the same trace, without storing the trace in the
+// DB. A retried request is a new row, so each attempt is its own trace.
+func proofSpanContext(req *ent.ProofRequest) tracing.SpanContext {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("proof-request/%d/%d", req.ID, req.RequestAddedTime)))
+	var sc tracing.SpanContext
+	copy(sc.TraceID[:], sum[:16])
+	copy(sc.SpanID[:], sum[16:24])
+	return sc
+}
+
+// proofTraceContext returns a copy of ctx in which spans are added to the trace of the proof request.
+func proofTraceContext(ctx context.Context, req *ent.ProofRequest) context.Context {
+	return tracing.ContextWithSpanContext(ctx, proofSpanContext(req))
+}
+
+// traceID returns the hex-encoded ID of the trace of the current span in ctx, which metrics link to as an exemplar. It's
+// empty if tracing is disabled, since the trace isn't exported.
+func (l *L2OutputSubmitter) traceID(ctx context.Context) string {
+	sc := tracing.SpanContextFromContext(ctx)
+	if l.tracer == nil || !sc.IsValid() {
+		return ""
+	}
+	return hex.EncodeToString(sc.TraceID[:])
+}
+
+// proofTraceID returns the hex-encoded ID of the trace of the proof request, or an empty string if tracing is disabled.
+func (l *L2OutputSubmitter) proofTraceID(req *ent.ProofRequest) string {
+	return l.traceID(proofTraceContext(l.ctx, req))
+}
+
+// proofSpanAttributes returns the attributes that identify a proof request on its spans.
+func proofSpanAttributes(req *ent.ProofRequest) []tracing.Attribute {
+	return []tracing.Attribute{
+		tracing.Int("proof_request.id", req.ID),
+		tracing.String("proof_request.type", req.Type.String()),
+		tracing.Uint64("proof_request.start_block", req.StartBlock),
+		tracing.Uint64("proof_request.end_block", req.EndBlock),
+	}
+}
+
+// endProofTrace records the root span of a proof request's trace, from the request being added to the DB until now,
+// once its proof was fulfilled or it failed. The root span is only recorded once the request is done, but the spans
+// of the earlier stages are its children from the start, since its ID is derived from the request.
+func (l *L2OutputSubmitter) endProofTrace(req *ent.ProofRequest, err error) {
+	span := l.tracer.StartWithSpanContext(proofSpanContext(req), "proof_request", time.Unix(int64(req.RequestAddedTime), 0), proofSpanAttributes(req)...)
+	span.RecordError(err)
+	span.End()
+}
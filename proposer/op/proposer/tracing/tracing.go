@@ -0,0 +1,360 @@
+// Package tracing traces the proof pipeline with OpenTelemetry spans. Spans are exported in batches to an OpenTelemetry
+// collector with OTLP over HTTP, in its JSON encoding, and propagated to the op-succinct-server with the W3C
+// traceparent header. It only implements what the pipeline uses, so it doesn't depend on the OpenTelemetry SDK.
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// exportInterval is how often the finished spans are exported.
+	exportInterval = 5 * time.Second
+	// exportTimeout is the timeout of exporting a batch of spans.
+	exportTimeout = 10 * time.Second
+	// maxQueuedSpans bounds the finished spans waiting to be exported. Spans that end while the queue is full, e.g.
+	// because the collector is down, are dropped.
+	maxQueuedSpans = 2048
+)
+
+// TraceParentHeader is the W3C Trace Context header that the span context is propagated in.
+const TraceParentHeader = "traceparent"
+
+// SpanContext identifies a span and the trace it belongs to.
+type SpanContext struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+}
+
+// IsValid returns whether the trace and span IDs are set.
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != [16]byte{} && sc.SpanID != [8]byte{}
+}
+
+// TraceParent returns the span context as the value of a sampled traceparent header.
+func (sc SpanContext) TraceParent() string {
+	return fmt.Sprintf("00-%x-%x-01", sc.TraceID, sc.SpanID)
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpanContext returns a copy of ctx in which spans are started as children of sc.
+func ContextWithSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, sc)
+}
+
+// SpanContextFromContext returns the span context of the current span in ctx, which isn't valid if there is none.
+func SpanContextFromContext(ctx context.Context) SpanContext {
+	sc, _ := ctx.Value(spanContextKey{}).(SpanContext)
+	return sc
+}
+
+// Inject sets the traceparent header to the current span in ctx, if there is one.
+func Inject(ctx context.Context, header http.Header) {
+	if sc := SpanContextFromContext(ctx); sc.IsValid() {
+		header.Set(TraceParentHeader, sc.TraceParent())
+	}
+}
+
+// Attribute is a key-value pair attached to a span. Values are strings, bools or integers.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// String, Bool, Int and Uint64 create attributes of their types.
+func String(key, value string) Attribute        { return Attribute{key, value} }
+func Bool(key string, value bool) Attribute     { return Attribute{key, value} }
+func Int(key string, value int) Attribute       { return Attribute{key, int64(value)} }
+func Uint64(key string, value uint64) Attribute { return Attribute{key, int64(value)} }
+
+// Span is an operation of the proof pipeline. All methods of a nil span are no-ops, so callers don't need to check
+// whether tracing is enabled.
+type Span struct {
+	tracer *Tracer
+	sc     SpanContext
+	parent [8]byte
+	name   string
+	start  time.Time
+
+	mu    sync.Mutex
+	attrs []Attribute
+	err   string
+	ended bool
+}
+
+// SpanContext returns the span context of the span, which isn't valid for a nil span.
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.sc
+}
+
+// SetAttributes adds attributes to the span.
+func (s *Span) SetAttributes(attrs ...Attribute) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attrs = append(s.attrs, attrs...)
+}
+
+// RecordError sets the status of the span to the error. A nil error is ignored.
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err.Error()
+}
+
+// End ends the span now, and queues it for export. Only the first call has an effect.
+func (s *Span) End() {
+	s.EndAt(time.Now())
+}
+
+// EndAt ends the span at the given time, and queues it for export. Only the first call has an effect.
+func (s *Span) EndAt(end time.Time) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	span := otlpSpan{
+		TraceID:           hex.EncodeToString(s.sc.TraceID[:]),
+		SpanID:            hex.EncodeToString(s.sc.SpanID[:]),
+		Name:              s.name,
+		Kind:              spanKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(end.UnixNano(), 10),
+		Attributes:        otlpAttributes(s.attrs),
+	}
+	if s.parent != [8]byte{} {
+		span.ParentSpanID = hex.EncodeToString(s.parent[:])
+	}
+	if s.err != "" {
+		span.Status = &otlpStatus{Code: statusCodeError, Message: s.err}
+	}
+	s.mu.Unlock()
+	s.tracer.enqueue(span)
+}
+
+// Tracer starts spans and exports them once they end. All methods of a nil tracer are no-ops and return nil spans.
+type Tracer struct {
+	endpoint    string
+	serviceName string
+	client      *http.Client
+	log         log.Logger
+
+	mu      sync.Mutex
+	queued  []otlpSpan
+	dropped bool
+}
+
+// NewTracer starts a tracer that exports spans to the OTLP/HTTP endpoint of an OpenTelemetry collector, e.g.
+// http://localhost:4318. Spans are posted to its /v1/traces path every few seconds, and by Flush.
+func NewTracer(endpoint, serviceName string, logger log.Logger) *Tracer {
+	t := &Tracer{
+		endpoint:    strings.TrimSuffix(endpoint, "/") + "/v1/traces",
+		serviceName: serviceName,
+		client:      &http.Client{Timeout: exportTimeout},
+		log:         logger,
+	}
+	go t.loop()
+	return t
+}
+
+// Start starts a span now, as a child of the current span in ctx, or as the root of a new trace if there is none.
+// Returns a copy of ctx in which the new span is the current span.
+func (t *Tracer) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, *Span) {
+	return t.StartAt(ctx, name, time.Now(), attrs...)
+}
+
+// StartAt is like Start, for a span that started at the given time, e.g. when the operation is recorded after the fact.
+func (t *Tracer) StartAt(ctx context.Context, name string, start time.Time, attrs ...Attribute) (context.Context, *Span) {
+	if t == nil {
+		return ctx, nil
+	}
+	parent := SpanContextFromContext(ctx)
+	sc := SpanContext{TraceID: parent.TraceID}
+	if !parent.IsValid() {
+		rand.Read(sc.TraceID[:])
+	}
+	rand.Read(sc.SpanID[:])
+	span := t.newSpan(sc, parent.SpanID, name, start, attrs)
+	return ContextWithSpanContext(ctx, sc), span
+}
+
+// StartWithSpanContext starts a root span with the given span context, which its children may have been started
+// with already. This records spans whose IDs are derived from something other than the context, like a proof request
+// that is traced across several polls of the pipeline.
+func (t *Tracer) StartWithSpanContext(sc SpanContext, name string, start time.Time, attrs ...Attribute) *Span {
+	if t == nil {
+		return nil
+	}
+	return t.newSpan(sc, [8]byte{}, name, start, attrs)
+}
+
+func (t *Tracer) newSpan(sc SpanContext, parent [8]byte, name string, start time.Time, attrs []Attribute) *Span {
+	return &Span{tracer: t, sc: sc, parent: parent, name: name, start: start, attrs: attrs}
+}
+
+func (t *Tracer) enqueue(span otlpSpan) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.queued) >= maxQueuedSpans {
+		if !t.dropped {
+			t.log.Warn("Dropping trace spans, the export queue is full", "endpoint", t.endpoint)
+			t.dropped = true
+		}
+		return
+	}
+	t.queued = append(t.queued, span)
+}
+
+func (t *Tracer) loop() {
+	for range time.Tick(exportInterval) {
+		t.export()
+	}
+}
+
+// Flush exports the spans that ended, e.g. before the proposer stops.
+func (t *Tracer) Flush() {
+	if t == nil {
+		return
+	}
+	t.export()
+}
+
+// export posts the queued spans to the collector. A batch that fails to export is dropped, so a collector that is
+// down doesn't hold up the pipeline.
+func (t *Tracer) export() {
+	t.mu.Lock()
+	spans := t.queued
+	t.queued = nil
+	t.dropped = false
+	t.mu.Unlock()
+	if len(spans) == 0 {
+		return
+	}
+
+	if err := t.post(spans); err != nil {
+		t.log.Warn("Failed to export trace spans", "endpoint", t.endpoint, "spans", len(spans), "err", err)
+	}
+}
+
+func (t *Tracer) post(spans []otlpSpan) error {
+	body, err := json.Marshal(otlpRequest{ResourceSpans: []otlpResourceSpans{{
+		Resource: otlpResource{Attributes: otlpAttributes([]Attribute{String("service.name", t.serviceName)})},
+		ScopeSpans: []otlpScopeSpans{{
+			Scope: otlpScope{Name: t.serviceName},
+			Spans: spans,
+		}},
+	}}})
+	if err != nil {
+		return fmt.Errorf("failed to marshal spans: %w", err)
+	}
+	req, err := http.NewRequest("POST", t.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send spans: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("collector responded with status %d: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
+
+// The OTLP/HTTP JSON encoding of an ExportTraceServiceRequest. IDs are hex-encoded, and 64-bit integers are strings.
+
+const (
+	spanKindInternal = 1
+	statusCodeError  = 2
+)
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            *otlpStatus    `json:"status,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string         `json:"key"`
+	Value map[string]any `json:"value"`
+}
+
+func otlpAttributes(attrs []Attribute) []otlpKeyValue {
+	kvs := make([]otlpKeyValue, 0, len(attrs))
+	for _, attr := range attrs {
+		var value map[string]any
+		switch v := attr.Value.(type) {
+		case bool:
+			value = map[string]any{"boolValue": v}
+		case int64:
+			value = map[string]any{"intValue": strconv.FormatInt(v, 10)}
+		default:
+			value = map[string]any{"stringValue": fmt.Sprint(v)}
+		}
+		kvs = append(kvs, otlpKeyValue{Key: attr.Key, Value: value})
+	}
+	return kvs
+}
@@ -0,0 +1,60 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracer(t *testing.T) {
+	var got otlpRequest
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/traces", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer collector.Close()
+
+	tracer := NewTracer(collector.URL+"/", "test", log.New())
+	root := SpanContext{TraceID: [16]byte{0x01}, SpanID: [8]byte{0x02}}
+	ctx, span := tracer.Start(ContextWithSpanContext(context.Background(), root), "child", Int("n", 3))
+	require.Equal(t, root.TraceID, span.SpanContext().TraceID)
+	require.Equal(t, span.SpanContext(), SpanContextFromContext(ctx))
+
+	header := http.Header{}
+	Inject(ctx, header)
+	require.Equal(t, span.SpanContext().TraceParent(), header.Get(TraceParentHeader))
+
+	span.RecordError(errors.New("boom"))
+	span.End()
+	span.End()
+	tracer.Flush()
+
+	require.Len(t, got.ResourceSpans, 1)
+	spans := got.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 1)
+	require.Equal(t, hex.EncodeToString(root.TraceID[:]), spans[0].TraceID)
+	require.Equal(t, hex.EncodeToString(root.SpanID[:]), spans[0].ParentSpanID)
+	require.Equal(t, "child", spans[0].Name)
+	require.Equal(t, statusCodeError, spans[0].Status.Code)
+	require.Equal(t, "3", spans[0].Attributes[0].Value["intValue"])
+}
+
+func TestNilTracer(t *testing.T) {
+	var tracer *Tracer
+	ctx, span := tracer.Start(context.Background(), "span")
+	require.Nil(t, span)
+	span.SetAttributes(Bool("ok", true))
+	span.End()
+	tracer.Flush()
+
+	header := http.Header{}
+	Inject(ctx, header)
+	require.Empty(t, header.Get(TraceParentHeader))
+}
@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -264,6 +265,59 @@ func GetSpanBatchRanges(config reassemble.Config, rollupCfg *rollup.Config, star
 	return ranges, nil
 }
 
+// GetChannelBoundaries fetches the channels posted to the BatchInbox in the L1 blocks from l1Start to l1End, and returns
+// the last L2 block of every channel that ends in the L2 block range of the config, in ascending order. Unlike
+// GetAllSpanBatchesInL2BlockRange, the rollup config is passed in rather than loaded from the configs directory. The
+// fetched batches are removed from config.DataDir when it returns.
+func GetChannelBoundaries(config BatchDecoderConfig, rollupCfg *rollup.Config, l1Start, l1End uint64) ([]uint64, error) {
+	// Fetch the batches posted to the BatchInbox contract in the given L1 block range and store them in config.DataDir.
+	defer os.RemoveAll(config.DataDir)
+	err := fetchBatchesBetweenL1Blocks(config, rollupCfg, l1Start, l1End)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch batches: %w", err)
+	}
+
+	reassembleConfig := reassemble.Config{
+		BatchInbox:    config.BatchInboxAddress,
+		InDirectory:   config.DataDir,
+		L2ChainID:     config.L2ChainID,
+		L2GenesisTime: config.L2GenesisTime,
+		L2BlockTime:   config.L2BlockTime,
+	}
+	frames := reassemble.LoadFrames(reassembleConfig.InDirectory, reassembleConfig.BatchInbox)
+	framesByChannel := make(map[derive.ChannelID][]reassemble.FrameWithMetadata)
+	for _, frame := range frames {
+		framesByChannel[frame.Frame.ID] = append(framesByChannel[frame.Frame.ID], frame)
+	}
+
+	var boundaries []uint64
+	for id, frames := range framesByChannel {
+		ch := processFrames(reassembleConfig, rollupCfg, id, frames)
+		// Derivation drops channels that are incomplete or invalid, so they don't end at a boundary.
+		if !ch.IsReady || ch.InvalidFrames || ch.InvalidBatches {
+			continue
+		}
+
+		var channelEnd uint64
+		for _, b := range ch.Batches {
+			if b == nil {
+				continue
+			}
+			batchEndBlock := TimestampToBlock(rollupCfg, b.GetTimestamp())
+			if spanBatch, ok := b.AsSpanBatch(); ok {
+				batchEndBlock += uint64(spanBatch.GetBlockCount()) - 1
+			}
+			channelEnd = max(channelEnd, batchEndBlock)
+		}
+		if channelEnd > config.L2StartBlock && channelEnd <= config.L2EndBlock {
+			boundaries = append(boundaries, channelEnd)
+		}
+	}
+	slices.Sort(boundaries)
+
+	return slices.Compact(boundaries), nil
+}
+
 // Set up the batch decoder config.
 func setupBatchDecoderConfig(config *BatchDecoderConfig) (*rollup.Config, error) {
 	rollupCfg, err := LoadOPStackRollupConfigFromChainID(config.L2ChainID.Uint64())
@@ -0,0 +1,149 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// VerificationRequest is the body posted to the verification service at VERIFICATION_SERVICE_URL: the AGG proof, and
+// the program vkey and public values that the L2OO verifies it against.
+type VerificationRequest struct {
+	Vkey          common.Hash   `json:"vkey"`
+	PublicValues  hexutil.Bytes `json:"public_values"`
+	Proof         hexutil.Bytes `json:"proof"`
+	L2BlockNumber uint64        `json:"l2_block_number"`
+}
+
+// VerificationResponse is the response of the verification service. Reason explains why an invalid proof was rejected.
+type VerificationResponse struct {
+	Valid  bool   `json:"valid"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// aggregationOutputsType is the AggregationOutputs struct that the L2OO encodes as the public values of an AGG proof.
+var aggregationOutputsType = func() abi.Arguments {
+	bytes32, _ := abi.NewType("bytes32", "", nil)
+	uint256, _ := abi.NewType("uint256", "", nil)
+	return abi.Arguments{{Type: bytes32}, {Type: bytes32}, {Type: bytes32}, {Type: uint256}, {Type: bytes32}, {Type: bytes32}}
+}()
+
+// verifyWithService posts the AGG proof to the verification service, and returns whether it can be proposed. If the
+// service rejects it, it's moved to the dead-letter status, so that an admin looks into it before any L1 gas is spent
+// on it. If the service can't be reached, an error is returned, and the proposal is held until it can. Always true
+// without a verification service.
+func (l *L2OutputSubmitter) verifyWithService(ctx context.Context, aggProof *ent.ProofRequest, output *eth.OutputResponse) (bool, error) {
+	if l.Cfg.VerificationServiceUrl == "" {
+		return true, nil
+	}
+	req, err := l.verificationRequest(ctx, aggProof, output)
+	if err != nil {
+		return false, err
+	}
+	resp, err := l.postVerificationRequest(ctx, req)
+	if err != nil {
+		l.Metr.RecordError("verification_service", 1)
+		return false, fmt.Errorf("failed to verify the AGG proof with the verification service: %w", err)
+	}
+	if resp.Valid {
+		l.Log.Info("Verification service accepted the AGG proof", "start", aggProof.StartBlock, "end", aggProof.EndBlock)
+		return true, nil
+	}
+
+	l.Log.Error("Verification service rejected the AGG proof, moved it to the dead-letter status", "id", aggProof.ID, "start", aggProof.StartBlock, "end", aggProof.EndBlock, "reason", resp.Reason)
+	l.Metr.RecordError("alert_verification_rejected", 1)
+	if err := l.db.SetErrorMessage(aggProof.ID, "rejected by the verification service: "+resp.Reason); err != nil {
+		return false, err
+	}
+	err = l.db.TransitionProofStatus(aggProof.ID, proofrequest.StatusCOMPLETE, proofrequest.StatusDEADLETTER)
+	if err != nil && !errors.Is(err, db.ErrProofStatusChanged) {
+		return false, err
+	}
+	return false, nil
+}
+
+// verificationRequest builds the verification request of the AGG proof, with the public values that the L2OO would
+// verify it against if it was proposed now.
+func (l *L2OutputSubmitter) verificationRequest(ctx context.Context, aggProof *ent.ProofRequest, output *eth.OutputResponse) (VerificationRequest, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	l1Head, err := l.l2ooContract.HistoricBlockHashes(opts, new(big.Int).SetUint64(aggProof.L1BlockNumber))
+	if err != nil {
+		return VerificationRequest{}, fmt.Errorf("failed to get the checkpointed L1 block hash: %w", err)
+	}
+	latest, err := l.l2ooContract.LatestBlockNumber(opts)
+	if err != nil {
+		return VerificationRequest{}, fmt.Errorf("failed to get the latest block number: %w", err)
+	}
+	preOutput, err := l.l2ooContract.GetL2OutputAfter(opts, latest)
+	if err != nil {
+		return VerificationRequest{}, fmt.Errorf("failed to get the latest output: %w", err)
+	}
+	rollupConfigHash, err := l.l2ooContract.RollupConfigHash(opts)
+	if err != nil {
+		return VerificationRequest{}, fmt.Errorf("failed to get the rollup config hash: %w", err)
+	}
+	rangeVkey, err := l.l2ooContract.RangeVkeyCommitment(opts)
+	if err != nil {
+		return VerificationRequest{}, fmt.Errorf("failed to get the range vkey commitment: %w", err)
+	}
+	aggVkey, err := l.l2ooContract.AggregationVkey(opts)
+	if err != nil {
+		return VerificationRequest{}, fmt.Errorf("failed to get the aggregation vkey: %w", err)
+	}
+
+	publicValues, err := aggregationOutputsType.Pack(l1Head, preOutput.OutputRoot, [32]byte(output.OutputRoot), new(big.Int).SetUint64(aggProof.EndBlock), rollupConfigHash, rangeVkey)
+	if err != nil {
+		return VerificationRequest{}, fmt.Errorf("failed to encode the public values: %w", err)
+	}
+	return VerificationRequest{Vkey: common.Hash(aggVkey), PublicValues: publicValues, Proof: aggProof.Proof, L2BlockNumber: aggProof.EndBlock}, nil
+}
+
+// postVerificationRequest posts the verification request to the verification service, authenticated with
+// VERIFICATION_SERVICE_TOKEN if it's set.
+func (l *L2OutputSubmitter) postVerificationRequest(ctx context.Context, verification VerificationRequest) (VerificationResponse, error) {
+	body, err := json.Marshal(verification)
+	if err != nil {
+		return VerificationResponse{}, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", l.Cfg.VerificationServiceUrl, bytes.NewReader(body))
+	if err != nil {
+		return VerificationResponse{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if l.Cfg.VerificationServiceToken != "" {
+		req.Header.Set("Authorization", "Bearer "+l.Cfg.VerificationServiceToken)
+	}
+	client := &http.Client{Timeout: l.Cfg.NetworkTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return VerificationResponse{}, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return VerificationResponse{}, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return VerificationResponse{}, fmt.Errorf("received status code %d: %s", resp.StatusCode, respBody)
+	}
+	var verified VerificationResponse
+	if err := json.Unmarshal(respBody, &verified); err != nil {
+		return VerificationResponse{}, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return verified, nil
+}
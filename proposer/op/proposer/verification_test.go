@@ -0,0 +1,79 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+func TestVerifyWithService(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l2oo := &freshL2OO{fakeL2OO: newFakeL2OO(100, 200), startingRoot: common.Hash{0x01}}
+	l2oo.aggVkey = common.Hash{0x02}
+	l := newFakeL2OODriver(t, l2oo.fakeL2OO, proofDB)
+	l.l2ooContract = l2oo
+
+	var received []VerificationRequest
+	response := VerificationResponse{Valid: false, Reason: "invalid proof"}
+	status := http.StatusServiceUnavailable
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer s3cret", r.Header.Get("Authorization"))
+		var req VerificationRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		received = append(received, req)
+		w.WriteHeader(status)
+		require.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	defer server.Close()
+	l.Cfg.VerificationServiceUrl = server.URL
+	l.Cfg.VerificationServiceToken = "s3cret"
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 100, 300, 0))
+	aggs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	l1BlockNumber, l1BlockHash, err := l2oo.checkpointBlockHash(context.Background())
+	require.NoError(t, err)
+	_, err = proofDB.AddL1BlockInfoToAggRequest(100, 300, l1BlockNumber, l1BlockHash.Hex())
+	require.NoError(t, err)
+	require.NoError(t, proofDB.UpdateProofStatus(aggs[0].ID, proofrequest.StatusPROVING))
+	require.NoError(t, proofDB.AddFulfilledProof(aggs[0].ID, []byte("proof")))
+
+	// The proposal is held while the service is unavailable.
+	require.Error(t, l.SubmitAggProofs(context.Background()))
+	require.Empty(t, l2oo.proposals)
+	require.Len(t, received, 1)
+	require.Equal(t, common.Hash{0x02}, received[0].Vkey)
+	require.Equal(t, []byte("proof"), []byte(received[0].Proof))
+	require.Equal(t, uint64(300), received[0].L2BlockNumber)
+	// The public values are the ABI-encoded AggregationOutputs, which start with the L1 head and the L2 pre-root.
+	require.Len(t, received[0].PublicValues, 6*32)
+	require.Equal(t, l1BlockHash.Bytes(), []byte(received[0].PublicValues[:32]))
+	require.Equal(t, common.Hash{0x01}.Bytes(), []byte(received[0].PublicValues[32:64]))
+
+	// A rejected proof is dead-lettered without being proposed.
+	status = http.StatusOK
+	require.NoError(t, l.SubmitAggProofs(context.Background()))
+	require.Empty(t, l2oo.proposals)
+	agg, err := proofDB.GetProofRequest(aggs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusDEADLETTER, agg.Status)
+	require.Contains(t, agg.ErrorMessage, "invalid proof")
+
+	// An accepted proof is proposed.
+	require.NoError(t, proofDB.TransitionProofStatus(aggs[0].ID, proofrequest.StatusDEADLETTER, proofrequest.StatusCOMPLETE))
+	response = VerificationResponse{Valid: true}
+	require.NoError(t, l.SubmitAggProofs(context.Background()))
+	require.Equal(t, []uint64{300}, l2oo.proposals)
+}
@@ -0,0 +1,91 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// sp1VerifierABI is the ABI of the verifyProof function of the ISP1Verifier interface, which both the SP1 verifier
+// gateway and the SP1MockVerifier implement.
+const sp1VerifierABI = `[{"type":"function","name":"verifyProof","stateMutability":"view","inputs":[
+	{"name":"programVKey","type":"bytes32"},
+	{"name":"publicValues","type":"bytes"},
+	{"name":"proofBytes","type":"bytes"}
+],"outputs":[]}]`
+
+// detectMockVerifier returns whether the verifier is an SP1MockVerifier rather than a real verifier, like the SP1
+// verifier gateway. It calls verifyProof with an empty proof, which the mock verifier accepts, and which a real
+// verifier rejects, since the proof doesn't start with the selector of a verifier version.
+func detectMockVerifier(ctx context.Context, client L1Client, verifier common.Address) (bool, error) {
+	code, err := client.CodeAt(ctx, verifier, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the code of verifier %s: %w", verifier, err)
+	}
+	// A call to an account without code succeeds, so it would look like a mock verifier.
+	if len(code) == 0 {
+		return false, fmt.Errorf("verifier %s has no code", verifier)
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(sp1VerifierABI))
+	if err != nil {
+		return false, fmt.Errorf("failed to parse verifier ABI: %w", err)
+	}
+	data, err := parsed.Pack("verifyProof", common.Hash{}, []byte{}, []byte{})
+	if err != nil {
+		return false, fmt.Errorf("failed to encode verifyProof call: %w", err)
+	}
+	_, err = client.CallContract(ctx, ethereum.CallMsg{To: &verifier, Data: data}, nil)
+	if err == nil {
+		return true, nil
+	}
+	if strings.Contains(err.Error(), "execution reverted") {
+		return false, nil
+	}
+	return false, fmt.Errorf("failed to call verifier %s: %w", verifier, err)
+}
+
+// checkVerifier detects whether the L2OO's verifier is a mock verifier, and compares it with the proposer's mock mode.
+// Mock proofs submitted to a real verifier are rejected, and real proofs submitted to a mock verifier are accepted
+// without being verified, so in strict mode a mismatch fails startup. Otherwise, it's logged as an error.
+func (l *L2OutputSubmitter) checkVerifier(ctx context.Context, client L1Client) error {
+	verifier, err := l.l2ooContract.Verifier(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return l.verifierCheckFailed(fmt.Errorf("failed to get the L2OO's verifier: %w", err))
+	}
+	mock, err := detectMockVerifier(ctx, client, verifier)
+	if err != nil {
+		return l.verifierCheckFailed(err)
+	}
+	l.Log.Info("Detected L2OO verifier", "verifier", verifier, "mock", mock)
+
+	var mismatch error
+	switch {
+	case l.Cfg.Mock && !mock:
+		mismatch = fmt.Errorf("the proposer requests mock proofs, but the L2OO's verifier %s is a real verifier, which rejects them", verifier)
+	case !l.Cfg.Mock && mock:
+		mismatch = fmt.Errorf("the proposer requests real proofs, but the L2OO's verifier %s is a mock verifier, which accepts any proof", verifier)
+	default:
+		return nil
+	}
+	if l.Cfg.StrictMode {
+		return mismatch
+	}
+	l.Log.Error("Mock mode doesn't match the L2OO's verifier", "err", mismatch)
+	l.Metr.RecordError("verifier_mismatch", 1)
+	return nil
+}
+
+// verifierCheckFailed fails startup in strict mode, where the verifier has to be checked, and logs the error otherwise.
+func (l *L2OutputSubmitter) verifierCheckFailed(err error) error {
+	if l.Cfg.StrictMode {
+		return fmt.Errorf("strict mode requires the L2OO's verifier to be checked: %w", err)
+	}
+	l.Log.Warn("Failed to check whether the L2OO's verifier matches the mock mode", "err", err)
+	return nil
+}
@@ -0,0 +1,70 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	opsuccinctmetrics "github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+// fakeVerifierClient answers calls to a verifier like the SP1MockVerifier if mock is set, and like the SP1 verifier
+// gateway otherwise.
+type fakeVerifierClient struct {
+	code []byte
+	mock bool
+}
+
+func (c *fakeVerifierClient) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *fakeVerifierClient) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return c.code, nil
+}
+
+func (c *fakeVerifierClient) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	if c.mock {
+		return nil, nil
+	}
+	return nil, errors.New("execution reverted")
+}
+
+func TestCheckVerifier(t *testing.T) {
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:  log.New(),
+			Metr: opsuccinctmetrics.NoopMetrics,
+			Cfg:  ProposerConfig{StrictMode: true},
+		},
+		l2ooContract: newFakeL2OO(0, 100),
+	}
+	ctx := context.Background()
+	gateway := &fakeVerifierClient{code: []byte{1}}
+	mockVerifier := &fakeVerifierClient{code: []byte{1}, mock: true}
+
+	// Real proofs against a real verifier, and mock proofs against a mock verifier.
+	require.NoError(t, l.checkVerifier(ctx, gateway))
+	l.Cfg.Mock = true
+	require.NoError(t, l.checkVerifier(ctx, mockVerifier))
+
+	// A mismatch fails startup in strict mode.
+	require.ErrorContains(t, l.checkVerifier(ctx, gateway), "is a real verifier")
+	l.Cfg.Mock = false
+	require.ErrorContains(t, l.checkVerifier(ctx, mockVerifier), "is a mock verifier")
+
+	// A verifier without code can't be checked, since any call to it succeeds.
+	require.ErrorContains(t, l.checkVerifier(ctx, &fakeVerifierClient{mock: true}), "has no code")
+
+	// Otherwise, the mismatch is only logged.
+	l.Cfg.StrictMode = false
+	require.NoError(t, l.checkVerifier(ctx, mockVerifier))
+	require.NoError(t, l.checkVerifier(ctx, &fakeVerifierClient{}))
+}
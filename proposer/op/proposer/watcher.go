@@ -0,0 +1,165 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// watchPosition is the position of an OutputProposed event on L1.
+type watchPosition struct {
+	l1Block  uint64
+	logIndex uint
+}
+
+func (p watchPosition) after(q watchPosition) bool {
+	return p.l1Block > q.l1Block || (p.l1Block == q.l1Block && p.logIndex > q.logIndex)
+}
+
+// WatchOutputs checks every output proposed on the L2OO by the watched proposer since the last call. The output root
+// of each proposal is independently re-derived from the rollup node, and any divergence is reported. A sampled
+// fraction of the proposed ranges is also queued to be re-proven with span proofs. Progress is recorded after every
+// event, so if a call fails, the next one resumes after the last event that was processed.
+func (l *L2OutputSubmitter) WatchOutputs(ctx context.Context) error {
+	l1Head, err := l.L1Client.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get L1 head: %w", err)
+	}
+	// Start watching from the current L1 head, outputs proposed before the watcher started are not re-checked.
+	if l.watchFromL1Block == 0 {
+		l.watchFromL1Block = l1Head
+	}
+	if l1Head < l.watchFromL1Block {
+		return nil
+	}
+
+	iter, err := l.l2ooFilterer.FilterOutputProposed(&bind.FilterOpts{
+		Start:   l.watchFromL1Block,
+		End:     &l1Head,
+		Context: ctx,
+	}, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to filter OutputProposed events: %w", err)
+	}
+	defer iter.Close()
+
+	for iter.Next() {
+		event := iter.Event
+		pos := watchPosition{l1Block: event.Raw.BlockNumber, logIndex: event.Raw.Index}
+		if !pos.after(l.lastWatchedEvent) {
+			continue
+		}
+
+		proposer, err := l.txSender(ctx, event.Raw.TxHash)
+		if err != nil {
+			return err
+		}
+		if proposer == *l.Cfg.WatchProposerAddr {
+			if err := l.verifyProposedOutput(ctx, event.L2OutputIndex.Uint64(), event.L2BlockNumber.Uint64(), common.Hash(event.OutputRoot), event.Raw.TxHash); err != nil {
+				return err
+			}
+		}
+		l.lastWatchedEvent = pos
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("failed to iterate OutputProposed events: %w", err)
+	}
+
+	l.watchFromL1Block = l1Head + 1
+	return nil
+}
+
+// verifyProposedOutput compares an output root proposed by the watched proposer with the one derived by the rollup
+// node, and samples the range since the previous proposal to be re-proven.
+func (l *L2OutputSubmitter) verifyProposedOutput(ctx context.Context, l2OutputIndex, l2BlockNumber uint64, outputRoot, txHash common.Hash) error {
+	output, err := l.FetchOutput(ctx, l2BlockNumber)
+	if err != nil {
+		return fmt.Errorf("failed to fetch output at block %d: %w", l2BlockNumber, err)
+	}
+	if common.Hash(output.OutputRoot) != outputRoot {
+		l.Log.Error("DIVERGENCE: proposed output root does not match the output root derived by the rollup node",
+			"l2OutputIndex", l2OutputIndex,
+			"l2BlockNumber", l2BlockNumber,
+			"proposed", outputRoot,
+			"derived", common.Hash(output.OutputRoot),
+			"tx", txHash)
+		l.Metr.RecordError("watch_divergence", 1)
+	} else {
+		l.Log.Info("verified proposed output", "l2OutputIndex", l2OutputIndex, "l2BlockNumber", l2BlockNumber, "outputRoot", outputRoot)
+	}
+
+	if err := l.sampleReprove(l.lastWatchedL2Block, l2BlockNumber); err != nil {
+		return err
+	}
+	l.lastWatchedL2Block = l2BlockNumber
+	return nil
+}
+
+// sampleReprove queues span proofs for the proposed range [start, end] with probability WatchReproveSampleRate. The
+// sample is seeded with the end block, and ranges that already have span proofs aren't queued again, so processing
+// the same proposal twice queues its range at most once.
+func (l *L2OutputSubmitter) sampleReprove(start, end uint64) error {
+	if start == 0 || l.Cfg.WatchReproveSampleRate <= 0 || rand.New(rand.NewSource(int64(end))).Float64() >= l.Cfg.WatchReproveSampleRate {
+		return nil
+	}
+	queued, err := l.db.HasSpanProofRequestsWithin(start, end)
+	if err != nil {
+		return err
+	}
+	if queued {
+		return nil
+	}
+
+	spans := l.SplitRangeCovering(start, end)
+	spanRanges := make([]db.SpanRange, len(spans))
+	for i, span := range spans {
+		spanRanges[i] = db.SpanRange{Start: span.Start, End: span.End, ProofTimeout: l.proofTimeout(proofrequest.TypeSPAN, span.Start, span.End)}
+	}
+	if _, err := l.db.NewSpanEntries(spanRanges); err != nil {
+		return fmt.Errorf("failed to queue re-proof of spans: %w", err)
+	}
+	l.Log.Info("queued sampled re-proof of proposed range", "start", start, "end", end, "spans", len(spans))
+	return nil
+}
+
+// checkProposedOutputRoots compares the output roots that a re-proven span proof claims with the output roots proposed
+// on the L2OO at the span's boundaries, for the boundaries that were proposed.
+func (l *L2OutputSubmitter) checkProposedOutputRoots(ctx context.Context, req *ent.ProofRequest, info *BootInfo) error {
+	for _, claim := range []struct {
+		block uint64
+		root  common.Hash
+	}{{req.StartBlock, info.L2PreRoot}, {req.EndBlock, info.L2PostRoot}} {
+		proposal, err := l.l2ooContract.GetL2OutputAfter(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(claim.block))
+		if err != nil {
+			return fmt.Errorf("failed to get proposed output after block %d: %w", claim.block, err)
+		}
+		if proposal.L2BlockNumber.Uint64() != claim.block {
+			continue
+		}
+		if common.Hash(proposal.OutputRoot) != claim.root {
+			return fmt.Errorf("%w: span proof claims output root %s at block %d, but %s was proposed", errOutputRootDivergence, claim.root, claim.block, common.Hash(proposal.OutputRoot))
+		}
+	}
+	return nil
+}
+
+// txSender returns the sender of the L1 transaction with the given hash.
+func (l *L2OutputSubmitter) txSender(ctx context.Context, txHash common.Hash) (common.Address, error) {
+	tx, _, err := l.L1Client.TransactionByHash(ctx, txHash)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get transaction %s: %w", txHash, err)
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to recover sender of transaction %s: %w", txHash, err)
+	}
+	return sender, nil
+}
@@ -0,0 +1,71 @@
+package proposer
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+func TestVerifyProposedOutput(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l := newFakeL2OODriver(t, newFakeL2OO(0, 100), proofDB)
+	l.Cfg.WatchReproveSampleRate = 1
+	l.Cfg.MaxBlockRangePerSpanProof = 100
+	ctx := context.Background()
+	root := func(block uint64) common.Hash { return common.BigToHash(new(big.Int).SetUint64(block)) }
+
+	// There's no previous proposal to re-prove from.
+	require.NoError(t, l.verifyProposedOutput(ctx, 0, 100, root(100), common.Hash{}))
+	require.NoError(t, l.verifyProposedOutput(ctx, 1, 200, root(200), common.Hash{}))
+	unreqs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, unreqs, 1)
+	require.Equal(t, uint64(100), unreqs[0].StartBlock)
+	require.Equal(t, uint64(200), unreqs[0].EndBlock)
+
+	// Processing the same proposal again doesn't queue its range twice.
+	l.lastWatchedL2Block = 100
+	require.NoError(t, l.verifyProposedOutput(ctx, 1, 200, root(200), common.Hash{}))
+	unreqs, err = proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, unreqs, 1)
+
+	// A proposal that can't be verified isn't recorded as watched.
+	require.Error(t, l.verifyProposedOutput(ctx, 2, 250, root(250), common.Hash{}))
+	require.Equal(t, uint64(200), l.lastWatchedL2Block)
+}
+
+func TestCheckProposedOutputRoots(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	l2oo := newFakeL2OO(0, 100)
+	l := newFakeL2OODriver(t, l2oo, proofDB)
+	root := func(block uint64) common.Hash { return common.BigToHash(new(big.Int).SetUint64(block)) }
+	for _, block := range []uint64{100, 200} {
+		l2oo.checkpoints[1] = common.Hash{}
+		require.NoError(t, l2oo.sendTransaction(context.Background(), &eth.OutputResponse{OutputRoot: eth.Bytes32(root(block)), BlockRef: eth.L2BlockRef{Number: block}}, nil, 1))
+	}
+
+	req := &ent.ProofRequest{StartBlock: 100, EndBlock: 200}
+	require.NoError(t, l.checkProposedOutputRoots(context.Background(), req, &BootInfo{L2PreRoot: root(100), L2PostRoot: root(200)}))
+	err = l.checkProposedOutputRoots(context.Background(), req, &BootInfo{L2PreRoot: root(100), L2PostRoot: root(201)})
+	require.ErrorIs(t, err, errOutputRootDivergence)
+
+	// Only boundaries that were proposed are checked.
+	req = &ent.ProofRequest{StartBlock: 150, EndBlock: 200}
+	require.NoError(t, l.checkProposedOutputRoots(context.Background(), req, &BootInfo{L2PreRoot: root(1), L2PostRoot: root(200)}))
+}
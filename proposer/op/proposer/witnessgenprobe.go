@@ -0,0 +1,109 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// WITNESS_GEN_PROBE_BLOCKS is the number of blocks that the health probe of a server executes, which end at the L2OO's
+// latest block. The range was proposed already, so it executes on a healthy server.
+const WITNESS_GEN_PROBE_BLOCKS = 1
+
+// witnessGenProbe tracks the servers that failed their latest health probe, so no proofs are requested from them.
+// Servers that weren't probed yet aren't failing.
+type witnessGenProbe struct {
+	mu      sync.Mutex
+	failing map[string]error
+}
+
+// record records the result of a health probe of the server.
+func (p *witnessGenProbe) record(server string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err == nil {
+		delete(p.failing, server)
+		return
+	}
+	if p.failing == nil {
+		p.failing = make(map[string]error)
+	}
+	p.failing[server] = err
+}
+
+// failed returns why the server failed its latest health probe, or nil if it passed it.
+func (p *witnessGenProbe) failed(server string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.failing[server]
+}
+
+// ProbeWitnessGen runs the health probe on every prover backend at once: a tiny range that was proposed already is
+// executed through the server's mock proof path, which generates the witness and executes the range program without
+// requesting a proof. Servers that fail it, e.g. because they can't reach their L1 or L2 nodes, don't get expensive
+// proof requests until they pass the next probe.
+func (l *L2OutputSubmitter) ProbeWitnessGen(ctx context.Context) error {
+	latest, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get the latest L2OO block: %w", err)
+	}
+	if latest.Uint64() < WITNESS_GEN_PROBE_BLOCKS {
+		return nil
+	}
+	body, err := json.Marshal(SpanProofRequest{Start: latest.Uint64() - WITNESS_GEN_PROBE_BLOCKS, End: latest.Uint64()})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	for _, server := range l.knownProverBackends() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			took, err := l.probeWitnessGen(ctx, server, body)
+			l.Metr.RecordWitnessGenProbe(server, took, err == nil)
+			if err != nil && l.witnessGenProbe.failed(server) == nil {
+				l.Log.Error("Server failed its health probe, not requesting proofs from it until it passes", "server", server, "err", err)
+				l.Metr.RecordError("witness_gen_probe", 1)
+			} else if err == nil && l.witnessGenProbe.failed(server) != nil {
+				l.Log.Info("Server passed its health probe again", "server", server, "took", took)
+			}
+			l.witnessGenProbe.record(server, err)
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+// probeWitnessGen sends the health probe to the server. Returns how long the server took to execute it.
+func (l *L2OutputSubmitter) probeWitnessGen(ctx context.Context, server string, body []byte) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(l.Cfg.WitnessGenTimeout)*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server+"/request_mock_span_proof", bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	sent := time.Now()
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, parseServerError(resp.StatusCode, respBody)
+	}
+	return time.Since(sent), nil
+}
@@ -0,0 +1,61 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+)
+
+func TestProbeWitnessGen(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+
+	healthy := map[string]bool{}
+	newServer := func(name string) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "/request_mock_span_proof", r.URL.Path)
+			var req SpanProofRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			// The probe executes the last block that the L2OO proposed.
+			require.Equal(t, SpanProofRequest{Start: 199, End: 200}, req)
+			if !healthy[name] {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write([]byte("{}"))
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+	a, b := newServer("a"), newServer("b")
+	healthy["a"] = true
+
+	l := newFakeL2OODriver(t, newFakeL2OO(200, 100), proofDB)
+	l.Cfg.OPSuccinctServerUrls = []string{a.URL, b.URL}
+	l.Cfg.WitnessGenTimeout = 10
+
+	// Servers that weren't probed yet get requests.
+	require.Equal(t, a.URL, l.leastLoadedServer(l.Cfg.OPSuccinctServerUrls))
+	require.NoError(t, l.ProbeWitnessGen(context.Background()))
+	require.NoError(t, l.witnessGenProbe.failed(a.URL))
+	require.Error(t, l.witnessGenProbe.failed(b.URL))
+
+	// A server that fails the probe gets no requests until it passes the next one.
+	statuses, err := l.ProverBackendStatuses(context.Background())
+	require.NoError(t, err)
+	require.False(t, statuses[0].ProbeFailing)
+	require.True(t, statuses[1].ProbeFailing)
+	require.Equal(t, a.URL, l.leastLoadedServer([]string{b.URL, a.URL}))
+
+	healthy["a"], healthy["b"] = false, true
+	require.NoError(t, l.ProbeWitnessGen(context.Background()))
+	require.Equal(t, b.URL, l.leastLoadedServer(l.Cfg.OPSuccinctServerUrls))
+}
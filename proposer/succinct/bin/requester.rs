@@ -0,0 +1,132 @@
+use anyhow::Result;
+use axum::{
+    extract::{DefaultBodyLimit, State},
+    http::{header::AUTHORIZATION, HeaderMap, StatusCode},
+    response::{IntoResponse, Response},
+    routing::post,
+    Json, Router,
+};
+use log::{error, info};
+use op_succinct_proposer::{ProofProgram, ProofRequestIntent, ProofResponse};
+use sp1_sdk::{network::NetworkProver, utils, Prover, ProverClient, SP1ProvingKey};
+use std::{env, sync::Arc};
+use tower_http::limit::RequestBodyLimitLayer;
+
+pub const RANGE_ELF: &[u8] = include_bytes!("../../../elf/range-elf");
+pub const AGG_ELF: &[u8] = include_bytes!("../../../elf/aggregation-elf");
+
+/// The requester service holds the prover network credentials, and submits the proof requests that the server
+/// delegates to it. The server never sees the NETWORK_PRIVATE_KEY.
+#[derive(Clone)]
+struct RequesterState {
+    network_prover: Arc<NetworkProver>,
+    range_pk: Arc<SP1ProvingKey>,
+    agg_pk: Arc<SP1ProvingKey>,
+    auth_token: Option<String>,
+}
+
+#[tokio::main]
+async fn main() -> Result<()> {
+    // Enable logging.
+    env::set_var("RUST_LOG", "info");
+
+    // Set up the SP1 SDK logger.
+    utils::setup_logger();
+    dotenv::dotenv().ok();
+
+    let network_prover = Arc::new(ProverClient::builder().network().build());
+    let (range_pk, _) = network_prover.setup(RANGE_ELF);
+    let (agg_pk, _) = network_prover.setup(AGG_ELF);
+
+    let state = RequesterState {
+        network_prover,
+        range_pk: Arc::new(range_pk),
+        agg_pk: Arc::new(agg_pk),
+        auth_token: env::var("REQUESTER_AUTH_TOKEN").ok(),
+    };
+    if state.auth_token.is_none() {
+        info!("REQUESTER_AUTH_TOKEN is not set, requests are not authenticated");
+    }
+
+    let app = Router::new()
+        .route("/request", post(request_proof))
+        .layer(DefaultBodyLimit::disable())
+        .layer(RequestBodyLimitLayer::new(102400 * 1024 * 1024))
+        .with_state(state);
+
+    let port = env::var("PORT").unwrap_or_else(|_| "3100".to_string());
+    let listener = tokio::net::TcpListener::bind(format!("0.0.0.0:{}", port))
+        .await
+        .unwrap();
+
+    info!("Requester listening on {}", listener.local_addr().unwrap());
+    axum::serve(listener, app).await?;
+    Ok(())
+}
+
+/// Submit a proof request to the prover network for the server.
+async fn request_proof(
+    State(state): State<RequesterState>,
+    headers: HeaderMap,
+    Json(intent): Json<ProofRequestIntent>,
+) -> Result<(StatusCode, Json<ProofResponse>), AppError> {
+    if let Some(token) = &state.auth_token {
+        let authorized = headers
+            .get(AUTHORIZATION)
+            .and_then(|value| value.to_str().ok())
+            .and_then(|value| value.strip_prefix("Bearer "))
+            .is_some_and(|value| value == token);
+        if !authorized {
+            return Err(AppError(
+                StatusCode::UNAUTHORIZED,
+                anyhow::anyhow!("Invalid auth token"),
+            ));
+        }
+    }
+
+    let pk = match intent.program {
+        ProofProgram::Range => &state.range_pk,
+        ProofProgram::Aggregation => &state.agg_pk,
+    };
+    let stdin = intent.stdin()?;
+    let mut request = state
+        .network_prover
+        .prove(pk, &stdin)
+        .mode(intent.mode()?)
+        .strategy(intent.strategy()?)
+        .skip_simulation(intent.skip_simulation);
+    if let Some(cycle_limit) = intent.cycle_limit {
+        request = request.cycle_limit(cycle_limit);
+    }
+
+    let proof_id = request.request_async().await.map_err(|e| {
+        error!("Failed to request {:?} proof: {}", intent.program, e);
+        anyhow::anyhow!("Failed to request proof: {}", e)
+    })?;
+    info!("Requested {:?} proof {}", intent.program, proof_id);
+
+    Ok((
+        StatusCode::OK,
+        Json(ProofResponse {
+            proof_id: proof_id.to_vec(),
+            artifact_id: None,
+        }),
+    ))
+}
+
+pub struct AppError(StatusCode, anyhow::Error);
+
+impl IntoResponse for AppError {
+    fn into_response(self) -> Response {
+        (self.0, format!("{}", self.1)).into_response()
+    }
+}
+
+impl<E> From<E> for AppError
+where
+    E: Into<anyhow::Error>,
+{
+    fn from(err: E) -> Self {
+        Self(StatusCode::INTERNAL_SERVER_ERROR, err.into())
+    }
+}
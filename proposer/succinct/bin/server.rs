@@ -1,8 +1,9 @@
-use alloy_primitives::{hex, Address, B256};
+use alloy_primitives::{hex, keccak256, Address, B256, U256};
 use anyhow::Result;
 use axum::{
-    extract::{DefaultBodyLimit, Path, State},
-    http::StatusCode,
+    extract::{DefaultBodyLimit, Path, Query, State},
+    http::{header::ACCEPT_ENCODING, HeaderMap, HeaderValue, StatusCode},
+    middleware::map_response,
     response::{IntoResponse, Response},
     routing::{get, post},
     Json, Router,
@@ -19,24 +20,33 @@ use op_succinct_host_utils::{
     L2OutputOracle, ProgramType,
 };
 use op_succinct_proposer::{
-    AggProofRequest, ProofResponse, ProofStatus, SpanProofRequest, SuccinctProposerConfig,
-    ValidateConfigRequest, ValidateConfigResponse,
+    proof_request_digest, tagged_cycle_limit, AggProofRequest, CapacityResponse,
+    CleanupArtifactsRequest, DelegatedRequester, ErrorResponse, IdempotencyCache, ProofProgram,
+    ProofRequestIntent, ProofResponse, ProofStatus, ProofStatusBatchEntry, ProofStatusBatchRequest,
+    ProofStatusBatchResponse, ProofStatusQuery, SpanProofRequest, SuccinctProposerConfig,
+    ValidateConfigRequest, ValidateConfigResponse, VersionResponse, WitnessGenCapacity,
+    WitnessGenSlot, IDEMPOTENCY_KEY_HEADER, MAX_PROOF_STATUS_BATCH_SIZE, MAX_PROOF_STATUS_WAIT_SECS,
+    TRACEPARENT_HEADER,
 };
 use sp1_sdk::{
     network::{
-        proto::network::{ExecutionStatus, FulfillmentStatus},
-        FulfillmentStrategy,
+        client::NetworkClient,
+        proto::network::{ExecutionStatus, FulfillmentStatus, ProofMode},
+        FulfillmentStrategy, DEFAULT_NETWORK_RPC_URL,
     },
     utils, HashableKey, Prover, ProverClient, SP1Proof, SP1ProofMode, SP1ProofWithPublicValues,
-    SP1_CIRCUIT_VERSION,
+    SP1VerifyingKey, SP1_CIRCUIT_VERSION,
 };
 use std::{
     env, fs,
+    future::Future,
+    path::PathBuf,
     str::FromStr,
     sync::Arc,
-    time::{Instant, SystemTime, UNIX_EPOCH},
+    time::{Duration, Instant, SystemTime, UNIX_EPOCH},
 };
-use tower_http::limit::RequestBodyLimitLayer;
+use tokio::{sync::Semaphore, task::JoinSet};
+use tower_http::{decompression::RequestDecompressionLayer, limit::RequestBodyLimitLayer};
 
 pub const RANGE_ELF: &[u8] = include_bytes!("../../../elf/range-elf");
 pub const AGG_ELF: &[u8] = include_bytes!("../../../elf/aggregation-elf");
@@ -50,7 +60,30 @@ async fn main() -> Result<()> {
     utils::setup_logger();
     dotenv::dotenv().ok();
 
-    let network_prover = Arc::new(ProverClient::builder().network().build());
+    // Network proof requests can be delegated to a separate requester service that holds the prover network
+    // credentials. The server then only reads the status of requests, which doesn't need a funded key, so an ephemeral
+    // key is used if NETWORK_PRIVATE_KEY isn't set.
+    let requester = env::var("PROOF_REQUESTER_URL").ok().map(|url| {
+        DelegatedRequester::new(url, env::var("PROOF_REQUESTER_AUTH_TOKEN").ok())
+    });
+    let network_private_key = match (&requester, env::var("NETWORK_PRIVATE_KEY")) {
+        (_, Ok(key)) => key,
+        (Some(_), Err(_)) => {
+            let nanos = SystemTime::now().duration_since(UNIX_EPOCH)?.as_nanos();
+            hex::encode(keccak256(nanos.to_le_bytes()))
+        }
+        (None, Err(_)) => anyhow::bail!("NETWORK_PRIVATE_KEY must be set"),
+    };
+    let network_rpc_url =
+        env::var("NETWORK_RPC_URL").unwrap_or_else(|_| DEFAULT_NETWORK_RPC_URL.to_string());
+    let network_prover = Arc::new(
+        ProverClient::builder()
+            .network()
+            .private_key(&network_private_key)
+            .rpc_url(&network_rpc_url)
+            .build(),
+    );
+    let network_client = Arc::new(NetworkClient::new(&network_private_key, network_rpc_url));
     let (range_pk, range_vk) = network_prover.setup(RANGE_ELF);
     let (agg_pk, agg_vk) = network_prover.setup(AGG_ELF);
     let multi_block_vkey_u8 = u32_to_u8(range_vk.vk.hash_u32());
@@ -92,17 +125,34 @@ async fn main() -> Result<()> {
         agg_proof_strategy,
         agg_proof_mode,
         network_prover,
+        network_client,
+        idempotency_cache: Arc::new(IdempotencyCache::default()),
+        witness_gen_capacity: Arc::new(WitnessGenCapacity::from_env()),
+        requester,
     };
+    info!(
+        "Running up to {} witness generations at once",
+        global_hashes.witness_gen_capacity.status().max_witness_gen
+    );
 
     let app = Router::new()
         .route("/request_span_proof", post(request_span_proof))
         .route("/request_agg_proof", post(request_agg_proof))
         .route("/request_mock_span_proof", post(request_mock_span_proof))
         .route("/request_mock_agg_proof", post(request_mock_agg_proof))
+        .route("/status", post(get_proof_statuses))
         .route("/status/:proof_id", get(get_proof_status))
         .route("/validate_config", post(validate_config))
+        .route("/cleanup_artifacts", post(cleanup_artifacts))
+        .route("/version", get(version))
+        .route("/health", get(health))
+        .route("/capacity", get(capacity))
         .layer(DefaultBodyLimit::disable())
         .layer(RequestBodyLimitLayer::new(102400 * 1024 * 1024))
+        // Request bodies may be gzip-compressed, e.g. AGG proof requests that embed all of their subproofs. Clients
+        // learn that they can compress them from the Accept-Encoding header of every response (RFC 7694).
+        .layer(RequestDecompressionLayer::new())
+        .layer(map_response(advertise_request_encodings))
         .with_state(global_hashes);
 
     let port = env::var("PORT").unwrap_or_else(|_| "3000".to_string());
@@ -115,6 +165,47 @@ async fn main() -> Result<()> {
     Ok(())
 }
 
+/// Advertise that request bodies may be gzip-compressed.
+async fn advertise_request_encodings(mut response: Response) -> Response {
+    response.headers_mut().insert(ACCEPT_ENCODING, HeaderValue::from_static("gzip"));
+    response
+}
+
+/// Get the SP1 circuit version and the program vkeys the server proves with.
+async fn version(State(state): State<SuccinctProposerConfig>) -> Json<VersionResponse> {
+    Json(VersionResponse {
+        sp1_circuit_version: SP1_CIRCUIT_VERSION.to_string(),
+        range_vkey_commitment: state.range_vkey_commitment,
+        agg_vkey_hash: state.agg_vkey_hash,
+    })
+}
+
+/// Report that the server is up and accepting requests. Proposers probe this endpoint before resuming proof requests
+/// to a server whose requests kept failing.
+async fn health() -> StatusCode {
+    StatusCode::OK
+}
+
+/// Report the witness generation capacity of the server, which proposers negotiate their concurrency from.
+async fn capacity(State(state): State<SuccinctProposerConfig>) -> Json<CapacityResponse> {
+    Json(state.witness_gen_capacity.status())
+}
+
+/// Reserve a slot for a witness generation run, or reject the request with a 503 if the server is at capacity, so the
+/// proposer backs off instead of the runs competing for the CPUs.
+fn acquire_witness_gen_slot(state: &SuccinctProposerConfig) -> Result<WitnessGenSlot, AppError> {
+    state.witness_gen_capacity.try_acquire().ok_or_else(|| {
+        let status = state.witness_gen_capacity.status();
+        AppError(
+            OverloadedError(format!(
+                "{} of {} witness generations are in progress",
+                status.in_flight, status.max_witness_gen
+            ))
+            .into(),
+        )
+    })
+}
+
 /// Validate the configuration of the L2 Output Oracle.
 async fn validate_config(
     State(state): State<SuccinctProposerConfig>,
@@ -147,9 +238,22 @@ async fn validate_config(
 /// Request a proof for a span of blocks.
 async fn request_span_proof(
     State(state): State<SuccinctProposerConfig>,
+    headers: HeaderMap,
     Json(payload): Json<SpanProofRequest>,
 ) -> Result<(StatusCode, Json<ProofResponse>), AppError> {
-    info!("Received span proof request: {:?}", payload);
+    info!("Received span proof request: {:?} (trace {})", payload, trace_id(&headers));
+    let response =
+        with_idempotency_key(&state, &headers, span_proof(state.clone(), payload)).await?;
+    Ok((StatusCode::OK, Json(response)))
+}
+
+/// Generate the witness for a span of blocks and request its proof from the network. Returns the proof ID, and the ID
+/// of the witness data left in the data directory.
+async fn span_proof(
+    state: SuccinctProposerConfig,
+    payload: SpanProofRequest,
+) -> Result<ProofResponse, AppError> {
+    let _slot = acquire_witness_gen_slot(&state)?;
     let fetcher = match OPSuccinctDataFetcher::new_with_rollup_config(RunContext::Docker).await {
         Ok(f) => f,
         Err(e) => {
@@ -157,6 +261,7 @@ async fn request_span_proof(
             return Err(AppError(e));
         }
     };
+    check_altda_unsupported(&fetcher)?;
 
     let host_args = match fetcher
         .get_host_args(
@@ -178,6 +283,11 @@ async fn request_span_proof(
         }
     };
 
+    let artifact_id = host_args
+        .kona_args
+        .data_dir
+        .as_deref()
+        .and_then(witness_artifact_id);
     let mem_kv_store = start_server_and_native_client(host_args).await?;
 
     let sp1_stdin = match get_proof_stdin(mem_kv_store) {
@@ -191,39 +301,123 @@ async fn request_span_proof(
         }
     };
 
-    let proof_id = state
-        .network_prover
-        .prove(&state.range_pk, &sp1_stdin)
-        .compressed()
-        .strategy(state.range_proof_strategy)
-        .skip_simulation(true)
-        .cycle_limit(1_000_000_000_000)
-        .request_async()
-        .await
-        .map_err(|e| {
-            error!("Failed to request proof: {}", e);
-            AppError(anyhow::anyhow!("Failed to request proof: {}", e))
-        })?;
-
-    Ok((
-        StatusCode::OK,
-        Json(ProofResponse {
+    let digest = proof_request_digest(&state.range_vk, SP1ProofMode::Compressed, &sp1_stdin)?;
+    let cycle_limit = tagged_cycle_limit(&digest);
+    if let Some(proof_id) =
+        find_existing_request(&state, &state.range_vk, SP1ProofMode::Compressed, cycle_limit).await
+    {
+        info!(
+            "Attaching to existing proof request {} for span {}-{}",
+            proof_id, payload.start, payload.end
+        );
+        return Ok(ProofResponse {
             proof_id: proof_id.to_vec(),
-        }),
-    ))
+            artifact_id,
+        });
+    }
+
+    let request = match &state.requester {
+        Some(requester) => {
+            let intent = ProofRequestIntent::new(
+                ProofProgram::Range,
+                SP1ProofMode::Compressed,
+                state.range_proof_strategy,
+                &sp1_stdin,
+                Some(cycle_limit),
+                true,
+            )?;
+            requester.request(&intent).await
+        }
+        None => {
+            state
+                .network_prover
+                .prove(&state.range_pk, &sp1_stdin)
+                .compressed()
+                .strategy(state.range_proof_strategy)
+                .skip_simulation(true)
+                .cycle_limit(cycle_limit)
+                .request_async()
+                .await
+        }
+    };
+    let proof_id = request.map_err(|e| {
+        error!("Failed to request proof: {}", e);
+        AppError(anyhow::anyhow!("Failed to request proof: {}", e))
+    })?;
+
+    Ok(ProofResponse {
+        proof_id: proof_id.to_vec(),
+        artifact_id,
+    })
 }
 
 /// Request an aggregation proof for a set of subproofs.
 async fn request_agg_proof(
     State(state): State<SuccinctProposerConfig>,
+    headers: HeaderMap,
     Json(payload): Json<AggProofRequest>,
 ) -> Result<(StatusCode, Json<ProofResponse>), AppError> {
-    info!("Received agg proof request");
-    let mut proofs_with_pv: Vec<SP1ProofWithPublicValues> = payload
-        .subproofs
-        .iter()
-        .map(|sp| bincode::deserialize(sp).unwrap())
-        .collect();
+    info!("Received agg proof request (trace {})", trace_id(&headers));
+    let response = with_idempotency_key(&state, &headers, agg_proof(state.clone(), payload)).await?;
+    Ok((StatusCode::OK, Json(response)))
+}
+
+/// Load the subproofs of an AGG proof request. They are either embedded in the request, or referenced by the IDs of
+/// their prover network requests, in which case they're fetched from the prover network. A subproof that isn't
+/// fulfilled on the network is reported as `subproof_unavailable`, so the proposer can send the subproofs by value.
+async fn load_subproofs(
+    state: &SuccinctProposerConfig,
+    payload: &AggProofRequest,
+) -> Result<Vec<SP1ProofWithPublicValues>, AppError> {
+    if payload.subproof_ids.is_empty() {
+        return Ok(payload
+            .subproofs
+            .iter()
+            .map(|sp| bincode::deserialize(sp).unwrap())
+            .collect());
+    }
+    if !payload.subproofs.is_empty() {
+        return Err(AppError::non_retryable(
+            "invalid_request",
+            "subproofs and subproof_ids can't both be set".to_string(),
+        ));
+    }
+
+    let mut proofs = Vec::with_capacity(payload.subproof_ids.len());
+    for id in &payload.subproof_ids {
+        let proof_id = match hex::decode(id) {
+            Ok(bytes) if bytes.len() == 32 => B256::from_slice(&bytes),
+            _ => {
+                return Err(AppError::non_retryable(
+                    "invalid_request",
+                    format!("Invalid subproof ID {}", id),
+                ))
+            }
+        };
+        let (status, proof) = state.network_prover.get_proof_status(proof_id).await?;
+        match proof {
+            Some(proof) if status.fulfillment_status == FulfillmentStatus::Fulfilled as i32 => {
+                proofs.push(proof)
+            }
+            _ => {
+                error!("Subproof {} isn't fulfilled on the prover network", id);
+                return Err(AppError::non_retryable(
+                    "subproof_unavailable",
+                    format!("Subproof {} isn't fulfilled on the prover network", id),
+                ));
+            }
+        }
+    }
+    Ok(proofs)
+}
+
+/// Fetch the L1 headers for a set of subproofs and request their aggregation proof from the network. Returns the proof
+/// ID.
+async fn agg_proof(
+    state: SuccinctProposerConfig,
+    payload: AggProofRequest,
+) -> Result<ProofResponse, AppError> {
+    let mut proofs_with_pv = load_subproofs(&state, &payload).await?;
 
     let boot_infos: Vec<BootInfoStruct> = proofs_with_pv
         .iter_mut()
@@ -240,17 +434,18 @@ async fn request_agg_proof(
             Ok(bytes) => bytes,
             Err(e) => {
                 error!("Failed to decode L1 head hex string: {}", e);
-                return Err(AppError(anyhow::anyhow!(
-                    "Failed to decode L1 head hex string: {}",
-                    e
-                )));
+                return Err(AppError::non_retryable(
+                    "invalid_request",
+                    format!("Failed to decode L1 head hex string: {}", e),
+                ));
             }
         },
         None => {
             error!("Invalid L1 head format: missing 0x prefix");
-            return Err(AppError(anyhow::anyhow!(
-                "Invalid L1 head format: missing 0x prefix"
-            )));
+            return Err(AppError::non_retryable(
+                "invalid_request",
+                "Invalid L1 head format: missing 0x prefix".to_string(),
+            ));
         }
     };
 
@@ -261,10 +456,13 @@ async fn request_agg_proof(
                 "Invalid L1 head length: expected 32 bytes, got {}",
                 l1_head_bytes.len()
             );
-            return Err(AppError(anyhow::anyhow!(
-                "Invalid L1 head length: expected 32 bytes, got {}",
-                l1_head_bytes.len()
-            )));
+            return Err(AppError::non_retryable(
+                "invalid_request",
+                format!(
+                    "Invalid L1 head length: expected 32 bytes, got {}",
+                    l1_head_bytes.len()
+                ),
+            ));
         }
     };
 
@@ -302,14 +500,42 @@ async fn request_agg_proof(
             }
         };
 
-    let proof_id = match state
-        .network_prover
-        .prove(&state.agg_pk, &stdin)
-        .mode(state.agg_proof_mode)
-        .strategy(state.agg_proof_strategy)
-        .request_async()
-        .await
+    let digest = proof_request_digest(&state.agg_vk, state.agg_proof_mode, &stdin)?;
+    let cycle_limit = tagged_cycle_limit(&digest);
+    if let Some(proof_id) =
+        find_existing_request(&state, &state.agg_vk, state.agg_proof_mode, cycle_limit).await
     {
+        info!("Attaching to existing agg proof request {}", proof_id);
+        return Ok(ProofResponse {
+            proof_id: proof_id.to_vec(),
+            artifact_id: None,
+        });
+    }
+
+    let request = match &state.requester {
+        Some(requester) => {
+            let intent = ProofRequestIntent::new(
+                ProofProgram::Aggregation,
+                state.agg_proof_mode,
+                state.agg_proof_strategy,
+                &stdin,
+                Some(cycle_limit),
+                false,
+            )?;
+            requester.request(&intent).await
+        }
+        None => {
+            state
+                .network_prover
+                .prove(&state.agg_pk, &stdin)
+                .mode(state.agg_proof_mode)
+                .strategy(state.agg_proof_strategy)
+                .cycle_limit(cycle_limit)
+                .request_async()
+                .await
+        }
+    };
+    let proof_id = match request {
         Ok(id) => id,
         Err(e) => {
             error!("Failed to request proof: {}", e);
@@ -317,20 +543,190 @@ async fn request_agg_proof(
         }
     };
 
-    Ok((
-        StatusCode::OK,
-        Json(ProofResponse {
-            proof_id: proof_id.to_vec(),
-        }),
-    ))
+    Ok(ProofResponse {
+        proof_id: proof_id.to_vec(),
+        artifact_id: None,
+    })
+}
+
+/// The trace ID of the proposer's trace of a proof request, from its traceparent header, so the server's logs of a
+/// request can be found from the proposer's trace. Returns "none" if the header is missing or invalid.
+fn trace_id(headers: &HeaderMap) -> &str {
+    let trace_id = headers
+        .get(TRACEPARENT_HEADER)
+        .and_then(|v| v.to_str().ok())
+        .and_then(|traceparent| traceparent.split('-').nth(1));
+    match trace_id {
+        Some(id) if id.len() == 32 && id.bytes().all(|b| b.is_ascii_hexdigit()) => id,
+        _ => "none",
+    }
+}
+
+/// Run a proof request, deduplicated by the idempotency key header if the proposer set one. A request retried with the
+/// same key waits for the original run and returns its proof ID, instead of generating the witness again. The request
+/// runs on its own task, so that it completes for the retry even if the original connection was dropped.
+async fn with_idempotency_key<F>(
+    state: &SuccinctProposerConfig,
+    headers: &HeaderMap,
+    request: F,
+) -> Result<ProofResponse, AppError>
+where
+    F: Future<Output = Result<ProofResponse, AppError>> + Send + 'static,
+{
+    let key = match headers.get(IDEMPOTENCY_KEY_HEADER).and_then(|v| v.to_str().ok()) {
+        Some(key) => key,
+        None => return request.await,
+    };
+
+    let entry = state.idempotency_cache.entry(key);
+    if entry.initialized() {
+        info!("Returning the result of the request with idempotency key {}", key);
+    }
+    tokio::spawn(async move { entry.get_or_try_init(|| request).await.cloned() })
+        .await
+        .map_err(|e| AppError(anyhow::anyhow!("Proof request task failed: {}", e)))?
+}
+
+/// How many outstanding requests for a program are fetched per fulfillment status when looking for an existing request.
+const OUTSTANDING_REQUESTS_LIMIT: u32 = 100;
+
+/// Find an outstanding network request for the same proof, so that a proof requested again, e.g. after a proposer
+/// restart or an HA failover, attaches to it instead of paying for a duplicate. Requests are matched by their program,
+/// proof mode and the cycle limit tagged with the digest of the stdin. The network is the source of truth, so this
+/// works across servers and restarts without any local state. Failing to query the network only means a duplicate may
+/// be sent, so the error is logged rather than failing the request.
+async fn find_existing_request(
+    state: &SuccinctProposerConfig,
+    vk: &SP1VerifyingKey,
+    mode: SP1ProofMode,
+    cycle_limit: u64,
+) -> Option<B256> {
+    let vk_hash = match hex::decode(vk.bytes32()) {
+        Ok(vk_hash) => vk_hash,
+        Err(e) => {
+            error!("Failed to decode verifying key hash: {}", e);
+            return None;
+        }
+    };
+    let now = SystemTime::now()
+        .duration_since(UNIX_EPOCH)
+        .unwrap()
+        .as_secs();
+
+    for fulfillment_status in [FulfillmentStatus::Requested, FulfillmentStatus::Assigned] {
+        let response = match state
+            .network_client
+            .get_filtered_proof_requests(
+                None,
+                Some(fulfillment_status as i32),
+                None,
+                Some(now),
+                Some(vk_hash.clone()),
+                None,
+                None,
+                None,
+                None,
+                Some(OUTSTANDING_REQUESTS_LIMIT),
+                None,
+                Some(network_proof_mode(mode) as i32),
+            )
+            .await
+        {
+            Ok(response) => response,
+            Err(e) => {
+                error!("Failed to query outstanding proof requests: {}", e);
+                return None;
+            }
+        };
+        if let Some(request) = response
+            .requests
+            .into_iter()
+            .find(|request| request.cycle_limit == cycle_limit && request.deadline > now)
+        {
+            return Some(B256::from_slice(&request.request_id));
+        }
+    }
+    None
+}
+
+/// The prover network's proof mode for an SP1 proof mode.
+fn network_proof_mode(mode: SP1ProofMode) -> ProofMode {
+    match mode {
+        SP1ProofMode::Core => ProofMode::Core,
+        SP1ProofMode::Compressed => ProofMode::Compressed,
+        SP1ProofMode::Plonk => ProofMode::Plonk,
+        SP1ProofMode::Groth16 => ProofMode::Groth16,
+    }
+}
+
+/// The witness data of span proofs is kept in `<WITNESS_DATA_DIR>/<L2 chain ID>/<start>-<end>` in the Docker run
+/// context, which the artifact ID is the relative path of.
+const WITNESS_DATA_DIR: &str = "/usr/local/data";
+
+/// Get the artifact ID of the witness data directory of a span proof.
+fn witness_artifact_id(data_dir: &std::path::Path) -> Option<String> {
+    let range = data_dir.file_name()?.to_str()?;
+    let chain_id = data_dir.parent()?.file_name()?.to_str()?;
+    Some(format!("{}/{}", chain_id, range))
+}
+
+/// Get the witness data directory of an artifact ID. Returns None if the ID isn't of the form `<chain ID>/<start>-<end>`,
+/// so that a request can't delete anything outside of the data directory.
+fn witness_artifact_dir(artifact_id: &str) -> Option<PathBuf> {
+    let (chain_id, range) = artifact_id.split_once('/')?;
+    let (start, end) = range.split_once('-')?;
+    let is_number = |s: &str| !s.is_empty() && s.bytes().all(|b| b.is_ascii_digit());
+    if !is_number(chain_id) || !is_number(start) || !is_number(end) {
+        return None;
+    }
+    Some(PathBuf::from(WITNESS_DATA_DIR).join(chain_id).join(range))
+}
+
+/// Delete the witness data of a request that reached a terminal state. Deleting an artifact that doesn't exist
+/// succeeds, so the proposer can retry the cleanup.
+async fn cleanup_artifacts(
+    Json(payload): Json<CleanupArtifactsRequest>,
+) -> Result<StatusCode, AppError> {
+    let dir = match witness_artifact_dir(&payload.artifact_id) {
+        Some(dir) => dir,
+        None => return Ok(StatusCode::BAD_REQUEST),
+    };
+    match fs::remove_dir_all(&dir) {
+        Ok(()) => info!("Deleted witness data {}", dir.display()),
+        Err(e) if e.kind() == std::io::ErrorKind::NotFound => {}
+        Err(e) => {
+            error!("Failed to delete witness data {}: {}", dir.display(), e);
+            return Err(AppError(anyhow::anyhow!("Failed to delete witness data: {}", e)));
+        }
+    }
+    Ok(StatusCode::OK)
+}
+
+/// The witness generator derives batch data from L1 calldata and blobs only, so the batch data of an Alt-DA chain
+/// can't be derived, and span proofs for it are rejected instead of generating a witness that fails to prove.
+fn check_altda_unsupported(fetcher: &OPSuccinctDataFetcher) -> Result<(), AppError> {
+    let altda_enabled = fetcher
+        .rollup_config
+        .as_ref()
+        .is_some_and(|config| config.alt_da_config.is_some());
+    if altda_enabled {
+        error!("Span proofs for Alt-DA chains are not supported");
+        return Err(AppError::non_retryable(
+            "unsupported",
+            "The witness generator can't derive batch data of Alt-DA chains".to_string(),
+        ));
+    }
+    Ok(())
 }
 
 /// Request a mock proof for a span of blocks.
 async fn request_mock_span_proof(
     State(state): State<SuccinctProposerConfig>,
+    headers: HeaderMap,
     Json(payload): Json<SpanProofRequest>,
 ) -> Result<(StatusCode, Json<ProofStatus>), AppError> {
-    info!("Received mock span proof request: {:?}", payload);
+    info!("Received mock span proof request: {:?} (trace {})", payload, trace_id(&headers));
+    let _slot = acquire_witness_gen_slot(&state)?;
     let fetcher = match OPSuccinctDataFetcher::new_with_rollup_config(RunContext::Docker).await {
         Ok(f) => f,
         Err(e) => {
@@ -338,6 +734,7 @@ async fn request_mock_span_proof(
             return Err(AppError(e));
         }
     };
+    check_altda_unsupported(&fetcher)?;
 
     let host_args = match fetcher
         .get_host_args(
@@ -372,7 +769,14 @@ async fn request_mock_span_proof(
 
     // Note(ratan): In a future version of the server which only supports mock proofs, Arc<MockProver> should be used to reduce memory usage.
     let prover = ProverClient::builder().mock().build();
-    let (pv, report) = prover.execute(RANGE_ELF, &sp1_stdin).run().unwrap();
+    // The range program fails to execute the same way every time, e.g. when it runs out of memory, so the proposer
+    // splits the span instead of retrying it.
+    let (pv, report) = prover.execute(RANGE_ELF, &sp1_stdin).run().map_err(|e| {
+        AppError::non_retryable(
+            "execution_failed",
+            format!("Failed to execute range program: {}", e),
+        )
+    })?;
     let execution_duration = start_time.elapsed();
 
     let block_data = fetcher
@@ -418,6 +822,9 @@ async fn request_mock_span_proof(
             fulfillment_status: FulfillmentStatus::Fulfilled.into(),
             execution_status: ExecutionStatus::UnspecifiedExecutionStatus.into(),
             proof: proof_bytes,
+            cycles: 0,
+            fee: String::new(),
+            fulfiller: String::new(),
         }),
     ))
 }
@@ -425,15 +832,12 @@ async fn request_mock_span_proof(
 /// Request mock aggregation proof.
 async fn request_mock_agg_proof(
     State(state): State<SuccinctProposerConfig>,
+    headers: HeaderMap,
     Json(payload): Json<AggProofRequest>,
 ) -> Result<(StatusCode, Json<ProofStatus>), AppError> {
-    info!("Received mock agg proof request!");
+    info!("Received mock agg proof request (trace {})", trace_id(&headers));
 
-    let mut proofs_with_pv: Vec<SP1ProofWithPublicValues> = payload
-        .subproofs
-        .iter()
-        .map(|sp| bincode::deserialize(sp).unwrap())
-        .collect();
+    let mut proofs_with_pv = load_subproofs(&state, &payload).await?;
 
     let boot_infos: Vec<BootInfoStruct> = proofs_with_pv
         .iter_mut()
@@ -507,6 +911,9 @@ async fn request_mock_agg_proof(
             fulfillment_status: FulfillmentStatus::Fulfilled.into(),
             execution_status: ExecutionStatus::UnspecifiedExecutionStatus.into(),
             proof: proof.bytes(),
+            cycles: 0,
+            fee: String::new(),
+            fulfiller: String::new(),
         }),
     ))
 }
@@ -515,17 +922,97 @@ async fn request_mock_agg_proof(
 async fn get_proof_status(
     State(state): State<SuccinctProposerConfig>,
     Path(proof_id): Path<String>,
+    Query(query): Query<ProofStatusQuery>,
 ) -> Result<(StatusCode, Json<ProofStatus>), AppError> {
     info!("Received proof status request: {:?}", proof_id);
 
-    let proof_id_bytes = hex::decode(proof_id)?;
+    let proof_id = B256::from_slice(&hex::decode(proof_id)?);
+
+    // Long-poll the prover network until the fulfillment status changes, so the proposer picks up fulfilled proofs
+    // within seconds instead of on its next polling tick.
+    let wait = Duration::from_secs(query.wait.unwrap_or(0).min(MAX_PROOF_STATUS_WAIT_SECS));
+    let deadline = Instant::now() + wait;
+    loop {
+        let status = fetch_proof_status(&state, proof_id).await?;
+        if query.since != Some(status.fulfillment_status)
+            || Instant::now() + PROOF_STATUS_POLL_INTERVAL > deadline
+        {
+            return Ok((StatusCode::OK, Json(status)));
+        }
+        tokio::time::sleep(PROOF_STATUS_POLL_INTERVAL).await;
+    }
+}
+
+/// The number of proof statuses of a batched proof status request that are fetched from the prover network at once.
+const PROOF_STATUS_BATCH_CONCURRENCY: usize = 16;
+
+/// Get the statuses of several proofs in one request, in the order of the requested proof IDs. A proof whose status
+/// can't be fetched is reported with an error, without failing the statuses of the other proofs.
+async fn get_proof_statuses(
+    State(state): State<SuccinctProposerConfig>,
+    Json(payload): Json<ProofStatusBatchRequest>,
+) -> Result<(StatusCode, Json<ProofStatusBatchResponse>), AppError> {
+    info!("Received proof status request for {} proofs", payload.proof_ids.len());
+    if payload.proof_ids.len() > MAX_PROOF_STATUS_BATCH_SIZE {
+        return Err(AppError::non_retryable(
+            "invalid_request",
+            format!(
+                "at most {} proof statuses can be requested at once, got {}",
+                MAX_PROOF_STATUS_BATCH_SIZE,
+                payload.proof_ids.len()
+            ),
+        ));
+    }
+
+    let permits = Arc::new(Semaphore::new(PROOF_STATUS_BATCH_CONCURRENCY));
+    let mut tasks = JoinSet::new();
+    for (i, proof_id) in payload.proof_ids.iter().enumerate() {
+        let state = state.clone();
+        let permits = permits.clone();
+        let proof_id = proof_id.clone();
+        tasks.spawn(async move {
+            let _permit = permits.acquire_owned().await?;
+            let status = match hex::decode(&proof_id) {
+                Ok(bytes) if bytes.len() == 32 => {
+                    fetch_proof_status(&state, B256::from_slice(&bytes))
+                        .await
+                        .map_err(|e| e.0.to_string())
+                }
+                _ => Err(format!("invalid proof ID {}", proof_id)),
+            };
+            anyhow::Ok((i, status))
+        });
+    }
+
+    let mut statuses: Vec<ProofStatusBatchEntry> = payload
+        .proof_ids
+        .into_iter()
+        .map(|proof_id| ProofStatusBatchEntry {
+            proof_id,
+            status: None,
+            error: None,
+        })
+        .collect();
+    while let Some(result) = tasks.join_next().await {
+        let (i, status) = result??;
+        match status {
+            Ok(status) => statuses[i].status = Some(status),
+            Err(e) => statuses[i].error = Some(e),
+        }
+    }
+    Ok((StatusCode::OK, Json(ProofStatusBatchResponse { statuses })))
+}
 
+/// The interval at which a long-polled proof status request re-checks the prover network.
+const PROOF_STATUS_POLL_INTERVAL: Duration = Duration::from_secs(2);
+
+/// Get the status of a proof request from the prover network, with the proof if it's fulfilled.
+async fn fetch_proof_status(
+    state: &SuccinctProposerConfig,
+    proof_id: B256,
+) -> Result<ProofStatus, AppError> {
     // This request will time out if the server is down.
-    let (status, maybe_proof) = match state
-        .network_prover
-        .get_proof_status(B256::from_slice(&proof_id_bytes))
-        .await
-    {
+    let (status, maybe_proof) = match state.network_prover.get_proof_status(proof_id).await {
         Ok(res) => res,
         Err(e) => {
             error!("Failed to get proof status: {}", e);
@@ -543,20 +1030,23 @@ async fn get_proof_status(
         error!(
             "Proof request timed out on the server. Default timeout is set to 4 hours. Returning status as Unfulfillable."
         );
-        return Ok((
-            StatusCode::OK,
-            Json(ProofStatus {
-                fulfillment_status: FulfillmentStatus::Unfulfillable.into(),
-                execution_status: ExecutionStatus::Executed.into(),
-                proof: vec![],
-            }),
-        ));
+        // The prover that was assigned the request, if any, failed to fulfill it in time.
+        let details = fetch_proof_details(state, proof_id).await;
+        return Ok(ProofStatus {
+            fulfillment_status: FulfillmentStatus::Unfulfillable.into(),
+            execution_status: ExecutionStatus::Executed.into(),
+            proof: vec![],
+            cycles: 0,
+            fee: String::new(),
+            fulfiller: details.fulfiller,
+        });
     }
 
     let fulfillment_status = status.fulfillment_status;
     let execution_status = status.execution_status;
     if fulfillment_status == FulfillmentStatus::Fulfilled as i32 {
         let proof: SP1ProofWithPublicValues = maybe_proof.unwrap();
+        let details = fetch_proof_details(state, proof_id).await;
 
         match proof.proof {
             SP1Proof::Compressed(_) => {
@@ -564,66 +1054,169 @@ async fn get_proof_status(
                 // Note: We're re-serializing the entire struct with bincode here, but this is fine
                 // because we're on localhost and the size of the struct is small.
                 let proof_bytes = bincode::serialize(&proof).unwrap();
-                return Ok((
-                    StatusCode::OK,
-                    Json(ProofStatus {
-                        fulfillment_status,
-                        execution_status,
-                        proof: proof_bytes,
-                    }),
-                ));
+                return Ok(ProofStatus {
+                    fulfillment_status,
+                    execution_status,
+                    proof: proof_bytes,
+                    cycles: details.cycles,
+                    fee: details.fee,
+                    fulfiller: details.fulfiller,
+                });
             }
             SP1Proof::Groth16(_) => {
                 // If it's a groth16 proof, we need to get the proof bytes that we put on-chain.
                 let proof_bytes = proof.bytes();
-                return Ok((
-                    StatusCode::OK,
-                    Json(ProofStatus {
-                        fulfillment_status,
-                        execution_status,
-                        proof: proof_bytes,
-                    }),
-                ));
+                return Ok(ProofStatus {
+                    fulfillment_status,
+                    execution_status,
+                    proof: proof_bytes,
+                    cycles: details.cycles,
+                    fee: details.fee,
+                    fulfiller: details.fulfiller,
+                });
             }
             SP1Proof::Plonk(_) => {
                 // If it's a plonk proof, we need to get the proof bytes that we put on-chain.
                 let proof_bytes = proof.bytes();
-                return Ok((
-                    StatusCode::OK,
-                    Json(ProofStatus {
-                        fulfillment_status,
-                        execution_status,
-                        proof: proof_bytes,
-                    }),
-                ));
+                return Ok(ProofStatus {
+                    fulfillment_status,
+                    execution_status,
+                    proof: proof_bytes,
+                    cycles: details.cycles,
+                    fee: details.fee,
+                    fulfiller: details.fulfiller,
+                });
             }
             _ => (),
         }
     } else if fulfillment_status == FulfillmentStatus::Unfulfillable as i32 {
-        return Ok((
-            StatusCode::OK,
-            Json(ProofStatus {
-                fulfillment_status,
-                execution_status,
-                proof: vec![],
-            }),
-        ));
-    }
-    Ok((
-        StatusCode::OK,
-        Json(ProofStatus {
+        let details = fetch_proof_details(state, proof_id).await;
+        return Ok(ProofStatus {
             fulfillment_status,
             execution_status,
             proof: vec![],
-        }),
-    ))
+            cycles: 0,
+            fee: String::new(),
+            fulfiller: details.fulfiller,
+        });
+    }
+    Ok(ProofStatus {
+        fulfillment_status,
+        execution_status,
+        proof: vec![],
+        cycles: 0,
+        fee: String::new(),
+        fulfiller: String::new(),
+    })
+}
+
+/// The cost of a proof request and the prover it was assigned to, as reported by the prover network. Values that
+/// aren't known are empty.
+#[derive(Default)]
+struct ProofDetails {
+    cycles: u64,
+    /// The amount deducted from the requester's balance less the refund, in the base units of the PROVE token.
+    fee: String,
+    /// The hex address of the assigned prover.
+    fulfiller: String,
+}
+
+/// Get the cycles of a proof request, the fee paid for it and the prover it was assigned to from the prover network.
+/// The details are only reported to the proposer for forecasting and prover statistics, so a failed lookup is logged
+/// and reported as unknown rather than failing the status request.
+async fn fetch_proof_details(state: &SuccinctProposerConfig, proof_id: B256) -> ProofDetails {
+    let request = match state.network_client.get_proof_request_details(proof_id, None).await {
+        Ok(details) => details.request,
+        Err(e) => {
+            error!("Failed to get proof request details: {}", e);
+            return ProofDetails::default();
+        }
+    };
+    let Some(request) = request else {
+        return ProofDetails::default();
+    };
+
+    let amount = |amount: &Option<String>| {
+        amount.as_deref().and_then(|amount| U256::from_str(amount).ok()).unwrap_or(U256::ZERO)
+    };
+    let fee = match request.deduction_amount {
+        Some(_) => amount(&request.deduction_amount)
+            .saturating_sub(amount(&request.refund_amount))
+            .to_string(),
+        None => String::new(),
+    };
+    let fulfiller = match request.fulfiller {
+        Some(fulfiller) if fulfiller.len() == 20 => Address::from_slice(&fulfiller).to_string(),
+        _ => String::new(),
+    };
+    ProofDetails { cycles: request.cycles.unwrap_or(0), fee, fulfiller }
 }
 
 pub struct AppError(anyhow::Error);
 
+impl AppError {
+    /// An error that retrying the same request can't fix, reported under the given code.
+    fn non_retryable(code: &'static str, message: String) -> Self {
+        AppError(NonRetryableError { code, message }.into())
+    }
+}
+
+/// An error that retrying the same request can't fix. Any other error is reported as retryable.
+#[derive(Debug)]
+struct NonRetryableError {
+    code: &'static str,
+    message: String,
+}
+
+impl std::fmt::Display for NonRetryableError {
+    fn fmt(&self, f: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+        write!(f, "{}", self.message)
+    }
+}
+
+impl std::error::Error for NonRetryableError {}
+
+/// The server is at its witness generation capacity. Reported with a 503, which proposers back off from.
+#[derive(Debug)]
+struct OverloadedError(String);
+
+impl std::fmt::Display for OverloadedError {
+    fn fmt(&self, f: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+        write!(f, "{}", self.0)
+    }
+}
+
+impl std::error::Error for OverloadedError {}
+
 impl IntoResponse for AppError {
     fn into_response(self) -> Response {
-        (StatusCode::INTERNAL_SERVER_ERROR, format!("{}", self.0)).into_response()
+        if let Some(e) = self.0.downcast_ref::<OverloadedError>() {
+            let body = ErrorResponse {
+                code: "overloaded".to_string(),
+                message: e.0.clone(),
+                retryable: true,
+            };
+            return (StatusCode::SERVICE_UNAVAILABLE, Json(body)).into_response();
+        }
+        let (status, body) = match self.0.downcast_ref::<NonRetryableError>() {
+            Some(e) => (
+                StatusCode::UNPROCESSABLE_ENTITY,
+                ErrorResponse {
+                    code: e.code.to_string(),
+                    message: e.message.clone(),
+                    retryable: false,
+                },
+            ),
+            None => (
+                StatusCode::INTERNAL_SERVER_ERROR,
+                ErrorResponse {
+                    code: "internal".to_string(),
+                    message: format!("{}", self.0),
+                    retryable: true,
+                },
+            ),
+        };
+        (status, Json(body)).into_response()
     }
 }
 
@@ -1,11 +1,23 @@
-use alloy_primitives::B256;
+use alloy_primitives::{keccak256, B256};
+use anyhow::{anyhow, bail, Result};
 use base64::{engine::general_purpose, Engine as _};
 use serde::{Deserialize, Deserializer, Serialize};
 use serde_repr::{Deserialize_repr, Serialize_repr};
 use sp1_sdk::{
-    network::FulfillmentStrategy, NetworkProver, SP1ProofMode, SP1ProvingKey, SP1VerifyingKey,
+    network::{client::NetworkClient, FulfillmentStrategy},
+    HashableKey, NetworkProver, SP1ProofMode, SP1ProvingKey, SP1Stdin, SP1VerifyingKey,
 };
-use std::sync::Arc;
+use std::{
+    collections::HashMap,
+    env,
+    sync::{
+        atomic::{AtomicUsize, Ordering},
+        Arc, Mutex,
+    },
+    thread,
+    time::{Duration, Instant},
+};
+use tokio::sync::OnceCell;
 
 #[derive(Serialize, Deserialize, Debug)]
 pub struct ValidateConfigRequest {
@@ -27,19 +39,39 @@ pub struct SpanProofRequest {
 
 #[derive(Deserialize, Serialize, Debug)]
 pub struct AggProofRequest {
-    #[serde(deserialize_with = "deserialize_base64_vec")]
+    #[serde(default, deserialize_with = "deserialize_base64_vec")]
     pub subproofs: Vec<Vec<u8>>,
+    /// The hex-encoded IDs of the prover network requests of the subproofs. The server fetches the subproofs from the
+    /// prover network when they're given, instead of the request embedding them.
+    #[serde(default)]
+    pub subproof_ids: Vec<String>,
     pub head: String,
 }
 
+#[derive(Serialize, Deserialize, Debug)]
+pub struct VersionResponse {
+    pub sp1_circuit_version: String,
+    pub range_vkey_commitment: B256,
+    pub agg_vkey_hash: B256,
+}
+
 #[derive(Deserialize, Serialize, Debug)]
 pub struct MockProofResponse {
     pub proof_id: String,
 }
 
-#[derive(Serialize, Deserialize, Debug)]
+#[derive(Serialize, Deserialize, Debug, Clone)]
 pub struct ProofResponse {
     pub proof_id: Vec<u8>,
+    /// Identifies the witness data the server kept on disk for the request. The proposer passes it to the cleanup
+    /// endpoint once the proof reaches a terminal state.
+    #[serde(default, skip_serializing_if = "Option::is_none")]
+    pub artifact_id: Option<String>,
+}
+
+#[derive(Serialize, Deserialize, Debug)]
+pub struct CleanupArtifactsRequest {
+    pub artifact_id: String,
 }
 
 #[derive(Debug, Serialize_repr, Deserialize_repr)]
@@ -73,8 +105,65 @@ pub struct ProofStatus {
     pub fulfillment_status: i32,
     pub execution_status: i32,
     pub proof: Vec<u8>,
+    /// The SP1 cycles of a fulfilled proof, as reported by the prover network. 0 if it isn't known.
+    #[serde(default)]
+    pub cycles: u64,
+    /// The fee paid to the prover network for a fulfilled proof, in the base units of the PROVE token, as a decimal
+    /// string. Empty if it isn't known.
+    #[serde(default)]
+    pub fee: String,
+    /// The address of the prover that was assigned the proof request on the prover network, as a hex string. Empty if
+    /// no prover was assigned, or it isn't known.
+    #[serde(default)]
+    pub fulfiller: String,
+}
+
+#[derive(Serialize, Deserialize)]
+/// The body of a non-200 response. `retryable` is whether retrying the same request can succeed, e.g. after an RPC
+/// failure. Requests that failed with a non-retryable error are split by the proposer, unless the code is `unsupported`
+/// or `invalid_request`, which no split can fix.
+pub struct ErrorResponse {
+    pub code: String,
+    pub message: String,
+    pub retryable: bool,
+}
+
+#[derive(Deserialize)]
+/// The query of a proof status request. With `wait`, the server long-polls: it only responds once the fulfillment
+/// status differs from `since`, or after `wait` seconds, whichever comes first.
+pub struct ProofStatusQuery {
+    pub wait: Option<u64>,
+    pub since: Option<i32>,
+}
+
+/// The longest time in seconds that a proof status request can wait for a status change.
+pub const MAX_PROOF_STATUS_WAIT_SECS: u64 = 300;
+
+#[derive(Deserialize)]
+/// The body of a batched proof status request, which gets the statuses of several proofs in one round-trip.
+pub struct ProofStatusBatchRequest {
+    pub proof_ids: Vec<String>,
+}
+
+#[derive(Serialize, Deserialize)]
+/// The statuses of the proofs of a batched proof status request, in the order of the requested proof IDs.
+pub struct ProofStatusBatchResponse {
+    pub statuses: Vec<ProofStatusBatchEntry>,
+}
+
+#[derive(Serialize, Deserialize)]
+/// The status of a proof in a batched proof status response, or the error fetching it if there was one.
+pub struct ProofStatusBatchEntry {
+    pub proof_id: String,
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub status: Option<ProofStatus>,
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub error: Option<String>,
 }
 
+/// The largest number of proofs whose statuses can be requested in one batched proof status request.
+pub const MAX_PROOF_STATUS_BATCH_SIZE: usize = 256;
+
 /// Configuration of the L2 Output Oracle contract. Created once at server start-up, monitors if there are any changes
 /// to the contract's configuration.
 #[derive(Clone)]
@@ -90,6 +179,244 @@ pub struct SuccinctProposerConfig {
     pub agg_proof_strategy: FulfillmentStrategy,
     pub agg_proof_mode: SP1ProofMode,
     pub network_prover: Arc<NetworkProver>,
+    /// Client of the prover network API, used to look up outstanding requests for a proof.
+    pub network_client: Arc<NetworkClient>,
+    pub idempotency_cache: Arc<IdempotencyCache>,
+    /// Bounds the witness generation runs of the server.
+    pub witness_gen_capacity: Arc<WitnessGenCapacity>,
+    /// The requester service that network proof requests are delegated to, if the server doesn't hold the prover
+    /// network credentials itself.
+    pub requester: Option<DelegatedRequester>,
+}
+
+/// The program that a delegated proof request is for.
+#[derive(Serialize, Deserialize, Debug, Clone, Copy, PartialEq, Eq)]
+#[serde(rename_all = "lowercase")]
+pub enum ProofProgram {
+    Range,
+    Aggregation,
+}
+
+/// A proof request that the server delegates to a separate requester service, which holds the prover network
+/// credentials and submits the request to the network. The requester only proves its own copy of the programs, so the
+/// intent names the program rather than carrying the ELF.
+#[derive(Serialize, Deserialize, Debug)]
+pub struct ProofRequestIntent {
+    pub program: ProofProgram,
+    /// The proof mode: `compressed`, `groth16` or `plonk`.
+    pub mode: String,
+    /// The fulfillment strategy, e.g. `RESERVED` or `HOSTED`.
+    pub strategy: String,
+    /// The bincode serialized stdin, base64 encoded.
+    pub stdin: String,
+    pub cycle_limit: Option<u64>,
+    pub skip_simulation: bool,
+}
+
+impl ProofRequestIntent {
+    pub fn new(
+        program: ProofProgram,
+        mode: SP1ProofMode,
+        strategy: FulfillmentStrategy,
+        stdin: &SP1Stdin,
+        cycle_limit: Option<u64>,
+        skip_simulation: bool,
+    ) -> Result<Self> {
+        let mode = match mode {
+            SP1ProofMode::Compressed => "compressed",
+            SP1ProofMode::Groth16 => "groth16",
+            SP1ProofMode::Plonk => "plonk",
+            _ => bail!("Unsupported proof mode for delegated requests: {:?}", mode),
+        };
+        Ok(Self {
+            program,
+            mode: mode.to_string(),
+            strategy: strategy.as_str_name().to_string(),
+            stdin: general_purpose::STANDARD.encode(bincode::serialize(stdin)?),
+            cycle_limit,
+            skip_simulation,
+        })
+    }
+
+    pub fn mode(&self) -> Result<SP1ProofMode> {
+        match self.mode.as_str() {
+            "compressed" => Ok(SP1ProofMode::Compressed),
+            "groth16" => Ok(SP1ProofMode::Groth16),
+            "plonk" => Ok(SP1ProofMode::Plonk),
+            mode => bail!("Unknown proof mode {}", mode),
+        }
+    }
+
+    pub fn strategy(&self) -> Result<FulfillmentStrategy> {
+        FulfillmentStrategy::from_str_name(&self.strategy)
+            .ok_or_else(|| anyhow!("Unknown fulfillment strategy {}", self.strategy))
+    }
+
+    pub fn stdin(&self) -> Result<SP1Stdin> {
+        Ok(bincode::deserialize(&general_purpose::STANDARD.decode(&self.stdin)?)?)
+    }
+}
+
+/// Client of a requester service that submits proof requests to the prover network on behalf of the server. Used to
+/// separate duties when the prover network credentials are held by a different team than the one running the chain.
+#[derive(Clone)]
+pub struct DelegatedRequester {
+    url: String,
+    auth_token: Option<String>,
+    client: reqwest::Client,
+}
+
+impl DelegatedRequester {
+    pub fn new(url: String, auth_token: Option<String>) -> Self {
+        Self {
+            url,
+            auth_token,
+            client: reqwest::Client::new(),
+        }
+    }
+
+    /// Send the intent to the requester service, and return the ID of the request it submitted to the network.
+    pub async fn request(&self, intent: &ProofRequestIntent) -> Result<B256> {
+        let mut request = self
+            .client
+            .post(format!("{}/request", self.url))
+            .json(intent);
+        if let Some(token) = &self.auth_token {
+            request = request.bearer_auth(token);
+        }
+        let response = request.send().await?;
+        if !response.status().is_success() {
+            bail!(
+                "Requester service returned {}: {}",
+                response.status(),
+                response.text().await.unwrap_or_default()
+            );
+        }
+        let response: ProofResponse = response.json().await?;
+        if response.proof_id.len() != 32 {
+            bail!(
+                "Requester service returned a proof ID of {} bytes",
+                response.proof_id.len()
+            );
+        }
+        Ok(B256::from_slice(&response.proof_id))
+    }
+}
+
+/// The header the proposer sets to a key that is unique to each proof request row, and is kept the same when the HTTP
+/// request is retried.
+pub const IDEMPOTENCY_KEY_HEADER: &str = "idempotency-key";
+
+/// The W3C Trace Context header the proposer propagates the trace of a proof request in.
+pub const TRACEPARENT_HEADER: &str = "traceparent";
+
+/// How long the result of a request is kept for retries with the same idempotency key.
+const IDEMPOTENCY_KEY_TTL: Duration = Duration::from_secs(24 * 60 * 60);
+
+/// The responses of in-flight and recently completed proof requests, keyed by their idempotency key. A retried request
+/// waits for and returns the result of the original one instead of running witness generation again. Failed requests
+/// leave their entry empty, so that a retry runs the request again.
+#[derive(Default)]
+pub struct IdempotencyCache {
+    entries: Mutex<HashMap<String, (Instant, Arc<OnceCell<ProofResponse>>)>>,
+}
+
+impl IdempotencyCache {
+    /// Get the entry for the given key, creating it if it doesn't exist yet. Entries older than the TTL are evicted.
+    pub fn entry(&self, key: &str) -> Arc<OnceCell<ProofResponse>> {
+        let mut entries = self.entries.lock().unwrap();
+        entries.retain(|_, (created, _)| created.elapsed() < IDEMPOTENCY_KEY_TTL);
+        entries
+            .entry(key.to_string())
+            .or_insert_with(|| (Instant::now(), Arc::new(OnceCell::new())))
+            .1
+            .clone()
+    }
+}
+
+#[derive(Serialize, Deserialize, Debug)]
+/// The witness generation capacity of the server. Proposers negotiate their witness generation concurrency from it.
+pub struct CapacityResponse {
+    /// The number of witness generation runs the server runs at once. Requests beyond it are rejected with a 503.
+    pub max_witness_gen: usize,
+    /// The number of witness generation runs in progress, for any client.
+    pub in_flight: usize,
+}
+
+/// Bounds the number of witness generation runs in progress. Each run spawns a native host that uses about two cores,
+/// so the capacity defaults to half of the available cores.
+pub struct WitnessGenCapacity {
+    max: usize,
+    in_flight: AtomicUsize,
+}
+
+impl WitnessGenCapacity {
+    pub fn new(max: usize) -> Self {
+        Self {
+            max: max.max(1),
+            in_flight: AtomicUsize::new(0),
+        }
+    }
+
+    /// The capacity set with MAX_CONCURRENT_WITNESS_GEN, or half of the available cores.
+    pub fn from_env() -> Self {
+        let cores = thread::available_parallelism().map_or(1, |cores| cores.get());
+        let max = env::var("MAX_CONCURRENT_WITNESS_GEN")
+            .ok()
+            .and_then(|max| max.parse().ok())
+            .unwrap_or(cores / 2);
+        Self::new(max)
+    }
+
+    /// Reserve a slot for a witness generation run, which is released when the returned slot is dropped. Returns
+    /// `None` if the server is at capacity.
+    pub fn try_acquire(self: &Arc<Self>) -> Option<WitnessGenSlot> {
+        self.in_flight
+            .fetch_update(Ordering::SeqCst, Ordering::SeqCst, |n| (n < self.max).then_some(n + 1))
+            .ok()
+            .map(|_| WitnessGenSlot(self.clone()))
+    }
+
+    pub fn status(&self) -> CapacityResponse {
+        CapacityResponse {
+            max_witness_gen: self.max,
+            in_flight: self.in_flight.load(Ordering::SeqCst),
+        }
+    }
+}
+
+/// A witness generation run in progress, see [`WitnessGenCapacity::try_acquire`].
+pub struct WitnessGenSlot(Arc<WitnessGenCapacity>);
+
+impl Drop for WitnessGenSlot {
+    fn drop(&mut self) {
+        self.0.in_flight.fetch_sub(1, Ordering::SeqCst);
+    }
+}
+
+/// The cycle limit that proof requests are sent with. High enough to never be reached, so that its low bits can tag a
+/// request with its digest, see [`tagged_cycle_limit`].
+pub const CYCLE_LIMIT: u64 = 1_000_000_000_000;
+
+/// The cycle limit to send the proof request with the given digest with. The prover network doesn't return the stdin
+/// of a request without downloading it, so the first 4 bytes of the digest are added to the cycle limit, which lets an
+/// outstanding request for the same proof be recognized among the requests for the same program.
+pub fn tagged_cycle_limit(digest: &B256) -> u64 {
+    let mut tag = [0u8; 4];
+    tag.copy_from_slice(&digest[..4]);
+    CYCLE_LIMIT + u64::from(u32::from_be_bytes(tag))
+}
+
+/// Compute the digest that identifies a proof request: the verifying key of the program, the proof mode, and the stdin.
+pub fn proof_request_digest(
+    vk: &SP1VerifyingKey,
+    mode: SP1ProofMode,
+    stdin: &SP1Stdin,
+) -> Result<B256> {
+    let mut preimage = vk.bytes32().into_bytes();
+    preimage.extend_from_slice(format!("{:?}", mode).as_bytes());
+    preimage.extend_from_slice(&bincode::serialize(stdin)?);
+    Ok(keccak256(preimage))
 }
 
 /// Deserialize a vector of base64 strings into a vector of vectors of bytes. Go serializes